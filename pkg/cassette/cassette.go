@@ -0,0 +1,274 @@
+// Package cassette records API calls made during acceptance tests into files (cassettes)
+// and replays them later, so that the same tests may run without cloud credentials and network access.
+//
+// Calls are matched by method and request. Values which differ from run to run, i.e. generated names,
+// IDs and timestamps sent by the client, are normalized: a string of the recorded request may be replaced
+// by another one, and every such replacement is applied to all following requests and responses of the cassette.
+package cassette
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Mode tells whether calls are recorded or replayed.
+type Mode int
+
+const (
+	Off Mode = iota
+	Record
+	Replay
+)
+
+// ReplayToken is IAM token to be used in replay mode. It is never sent anywhere.
+const ReplayToken = "t1.replay.token"
+
+// ParseMode parses mode name, empty string means Off.
+func ParseMode(s string) (Mode, error) {
+	switch strings.ToLower(s) {
+	case "", "off":
+		return Off, nil
+	case "record":
+		return Record, nil
+	case "replay":
+		return Replay, nil
+	}
+	return Off, fmt.Errorf("unknown cassette mode %q, expected record or replay", s)
+}
+
+func (m Mode) String() string {
+	switch m {
+	case Record:
+		return "record"
+	case Replay:
+		return "replay"
+	}
+	return "off"
+}
+
+// Cassette is the content of one cassette file.
+type Cassette struct {
+	// Env keeps environment of the recorded run, i.e. folder ID, which is restored for replay.
+	Env          map[string]string `json:"env,omitempty"`
+	Interactions []*Interaction    `json:"interactions"`
+}
+
+// Interaction is one recorded call.
+type Interaction struct {
+	// Method is full gRPC method name or HTTP method.
+	Method string `json:"method"`
+	// Request is gRPC request in JSON form, or URL and form values of HTTP request.
+	Request interface{} `json:"request"`
+	// Response is gRPC response in JSON form, it is empty for failed calls.
+	Response interface{} `json:"response,omitempty"`
+	// Status is gRPC status of failed call in JSON form.
+	Status interface{} `json:"status,omitempty"`
+	// HTTP is the response of HTTP call.
+	HTTP *HTTPResponse `json:"http,omitempty"`
+}
+
+// HTTPResponse is recorded response of S3 or YMQ call.
+type HTTPResponse struct {
+	StatusCode int                 `json:"status_code"`
+	Header     map[string][]string `json:"header,omitempty"`
+	Body       string              `json:"body,omitempty"`
+}
+
+// Recorder records or replays calls into one cassette at a time.
+// Cassettes are switched by Start and Stop, so tests which use it must not run in parallel.
+type Recorder struct {
+	mode Mode
+	dir  string
+
+	mu       sync.Mutex
+	name     string
+	cassette *Cassette
+	used     []bool
+	subst    substitutions
+
+	serverOnce sync.Once
+	listener   *bufconn.Listener
+}
+
+// New returns recorder which keeps cassettes in dir.
+func New(mode Mode, dir string) *Recorder {
+	return &Recorder{mode: mode, dir: dir}
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Start starts cassette with the given name, i.e. the name of the test. Starting the current cassette again does nothing.
+// In replay mode the returned error wraps os.ErrNotExist if nothing has been recorded for the name.
+func (r *Recorder) Start(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette != nil {
+		if r.name == name {
+			return nil
+		}
+		return fmt.Errorf("cassette %q is still in use, tests using cassettes must run with -parallel=1", r.name)
+	}
+
+	c := &Cassette{}
+	if r.mode == Replay {
+		data, err := ioutil.ReadFile(r.path(name))
+		if err != nil {
+			return fmt.Errorf("cannot load cassette %q: %w", name, err)
+		}
+		if err := json.Unmarshal(data, c); err != nil {
+			return fmt.Errorf("cannot parse cassette %q: %w", name, err)
+		}
+	}
+
+	r.name = name
+	r.cassette = c
+	r.used = make([]bool, len(c.Interactions))
+	r.subst = substitutions{}
+	return nil
+}
+
+// Stop finishes current cassette, in record mode it is written to file. Stopping with no cassette started does nothing.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette == nil {
+		return nil
+	}
+	c, name := r.cassette, r.name
+	r.cassette, r.name, r.used, r.subst = nil, "", nil, nil
+
+	if r.mode != Record {
+		return nil
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cannot encode cassette %q: %w", name, err)
+	}
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path(name), append(data, '\n'), 0644)
+}
+
+// Env saves the listed environment variables into current cassette in record mode,
+// and restores the saved ones in replay mode.
+func (r *Recorder) Env(names ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette == nil {
+		return fmt.Errorf("no cassette started")
+	}
+
+	switch r.mode {
+	case Record:
+		for _, name := range names {
+			if v := os.Getenv(name); v != "" {
+				if r.cassette.Env == nil {
+					r.cassette.Env = map[string]string{}
+				}
+				r.cassette.Env[name] = v
+			}
+		}
+	case Replay:
+		for _, name := range names {
+			if v, ok := r.cassette.Env[name]; ok {
+				if err := os.Setenv(name, v); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// path returns file name of the cassette, subtest names are kept in the same directory.
+func (r *Recorder) path(name string) string {
+	name = strings.NewReplacer("/", "__", " ", "_").Replace(name)
+	return filepath.Join(r.dir, name+".json")
+}
+
+// record appends interaction to current cassette, calls made with no cassette started are not recorded.
+func (r *Recorder) record(i *Interaction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette != nil {
+		r.cassette.Interactions = append(r.cassette.Interactions, i)
+		r.used = append(r.used, true)
+	}
+}
+
+// replay finds interaction matching the request and returns its copy with substitutions applied.
+// Must be called with r.mu held.
+func (r *Recorder) replay(method string, request interface{}, readOnly bool) (*Interaction, error) {
+	if r.cassette == nil {
+		return nil, fmt.Errorf("no cassette started for call of %s", method)
+	}
+
+	found := -1
+	var foundSubst substitutions
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] || in.Method != method {
+			continue
+		}
+		work := r.subst.clone()
+		if !work.match(in.Request, request) {
+			continue
+		}
+		if found < 0 || len(work) < len(foundSubst) {
+			found, foundSubst = i, work
+		}
+		if len(work) == len(r.subst) {
+			break
+		}
+	}
+
+	// Terraform may read the same thing more times than it did during recording, repeat the last answer then.
+	if found < 0 && readOnly {
+		for i := len(r.cassette.Interactions) - 1; i >= 0; i-- {
+			in := r.cassette.Interactions[i]
+			work := r.subst.clone()
+			if r.used[i] && in.Method == method && work.match(in.Request, request) {
+				found, foundSubst = i, work
+				break
+			}
+		}
+	}
+
+	if found < 0 {
+		data, _ := json.Marshal(request)
+		return nil, fmt.Errorf("cassette %q has no recorded call of %s matching request %s", r.name, method, data)
+	}
+
+	r.used[found] = true
+	r.subst = foundSubst
+
+	in := r.cassette.Interactions[found]
+	out := &Interaction{
+		Method:   in.Method,
+		Request:  in.Request,
+		Response: r.subst.applyTree(in.Response),
+		Status:   r.subst.applyTree(in.Status),
+	}
+	if in.HTTP != nil {
+		out.HTTP = &HTTPResponse{
+			StatusCode: in.HTTP.StatusCode,
+			Header:     in.HTTP.Header,
+			Body:       r.subst.apply(in.HTTP.Body),
+		}
+	}
+	return out, nil
+}
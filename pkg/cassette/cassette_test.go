@@ -0,0 +1,198 @@
+package cassette
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+func buildSDK(t *testing.T, r *Recorder, endpoint string) *ycsdk.SDK {
+	sdk, err := ycsdk.Build(context.Background(), ycsdk.Config{
+		Credentials: ycsdk.NewIAMTokenCredentials(fakecloud.Token),
+		Endpoint:    endpoint,
+		Plaintext:   true,
+	}, r.DialOptions()...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sdk.Shutdown(context.Background()) })
+	return sdk
+}
+
+func createNetwork(t *testing.T, sdk *ycsdk.SDK, name string) *vpc.Network {
+	ctx := context.Background()
+	op, err := sdk.WrapOperation(sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{
+		FolderId:    fakecloud.FolderID,
+		Name:        name,
+		Description: "network " + name,
+	}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	resp, err := op.Response()
+	require.NoError(t, err)
+	return resp.(*vpc.Network)
+}
+
+func TestSubstitutionsMatch(t *testing.T) {
+	s := substitutions{}
+	recorded := map[string]interface{}{"name": "tf-net-11111111", "size": "10737418240", "zone": "ru-central1-a"}
+
+	assert.False(t, s.match(recorded, map[string]interface{}{"name": "tf-net-22222222", "size": "21474836480", "zone": "ru-central1-a"}))
+	assert.False(t, s.clone().match(recorded, map[string]interface{}{"name": "tf-net-22222222", "size": "10737418240", "zone": "ru-central1-b"}))
+	assert.False(t, s.clone().match(recorded, map[string]interface{}{"name": "tf-net-22222222"}))
+
+	s = substitutions{}
+	require.True(t, s.match(recorded, map[string]interface{}{"name": "tf-net-22222222", "size": "10737418240", "zone": "ru-central1-a"}))
+	assert.Equal(t, substitutions{"tf-net-11111111": "tf-net-22222222"}, s)
+
+	// Known value must be substituted the same way, also inside of other strings.
+	assert.False(t, s.clone().match("tf-net-11111111", "tf-net-33333333"))
+	assert.True(t, s.clone().match("network tf-net-11111111", "network tf-net-22222222"))
+	assert.Equal(t, map[string]interface{}{"fqdn": "tf-net-22222222.internal"}, s.applyTree(map[string]interface{}{"fqdn": "tf-net-11111111.internal"}))
+}
+
+func TestRecordReplayGRPC(t *testing.T) {
+	dir := t.TempDir()
+
+	server, err := fakecloud.Start(fakecloud.WithOperationPolls(2))
+	require.NoError(t, err)
+	defer server.Stop()
+
+	recorder := New(Record, dir)
+	require.NoError(t, recorder.Start("TestNetwork"))
+	sdk := buildSDK(t, recorder, server.Addr())
+	recorded := createNetwork(t, sdk, "tf-net-11111111")
+	_, err = sdk.VPC().Network().Get(context.Background(), &vpc.GetNetworkRequest{NetworkId: "enpmissing00000000000"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.NoError(t, recorder.Stop())
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "TestNetwork.json"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), fakecloud.Token)
+
+	server.Stop()
+	player := New(Replay, dir)
+	require.NoError(t, player.Start("TestNetwork"))
+	sdk = buildSDK(t, player, "api.cloud.yandex.net:443")
+
+	start := time.Now()
+	replayed := createNetwork(t, sdk, "tf-net-22222222")
+	assert.Less(t, int64(time.Since(start)), int64(time.Second), "replay should not wait between polls")
+	assert.Equal(t, recorded.Id, replayed.Id)
+	assert.Equal(t, "tf-net-22222222", replayed.Name)
+	assert.Equal(t, "network tf-net-22222222", replayed.Description)
+
+	_, err = sdk.VPC().Network().Get(context.Background(), &vpc.GetNetworkRequest{NetworkId: "enpmissing00000000000"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = sdk.VPC().Network().Delete(context.Background(), &vpc.DeleteNetworkRequest{NetworkId: recorded.Id})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, err.Error(), "no recorded call")
+	require.NoError(t, player.Stop())
+}
+
+func TestRecordReplayHTTP(t *testing.T) {
+	dir := t.TempDir()
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte("<Name>" + strings.Split(r.URL.Path, "/")[1] + "</Name>"))
+	}))
+	defer server.Close()
+
+	get := func(r *Recorder, base http.RoundTripper, url string) (int, string) {
+		resp, err := (&http.Client{Transport: r.Transport(base)}).Get(url)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(body)
+	}
+
+	recorder := New(Record, dir)
+	require.NoError(t, recorder.Start("TestBucket"))
+	code, body := get(recorder, nil, server.URL+"/tf-bucket-11111111/key?acl=")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "<Name>tf-bucket-11111111</Name>", body)
+	require.NoError(t, recorder.Stop())
+
+	player := New(Replay, dir)
+	require.NoError(t, player.Start("TestBucket"))
+	code, body = get(player, nil, server.URL+"/tf-bucket-22222222/key?acl=")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "<Name>tf-bucket-22222222</Name>", body)
+
+	// Reads may be repeated.
+	_, body = get(player, nil, server.URL+"/tf-bucket-22222222/key?acl=")
+	assert.Equal(t, "<Name>tf-bucket-22222222</Name>", body)
+	assert.Equal(t, 1, calls)
+	require.NoError(t, player.Stop())
+}
+
+func TestCassetteEnv(t *testing.T) {
+	dir := t.TempDir()
+	const name = "YC_CASSETTE_TEST_FOLDER_ID"
+	defer os.Unsetenv(name)
+
+	require.NoError(t, os.Setenv(name, "b1gfolder"))
+	recorder := New(Record, dir)
+	require.NoError(t, recorder.Start("TestMain"))
+	require.NoError(t, recorder.Env(name))
+	require.NoError(t, recorder.Stop())
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "TestMain.json"))
+	require.NoError(t, err)
+	var c Cassette
+	require.NoError(t, json.Unmarshal(data, &c))
+	assert.Equal(t, map[string]string{name: "b1gfolder"}, c.Env)
+
+	require.NoError(t, os.Unsetenv(name))
+	player := New(Replay, dir)
+	require.NoError(t, player.Start("TestMain"))
+	require.NoError(t, player.Env(name))
+	assert.Equal(t, "b1gfolder", os.Getenv(name))
+}
+
+func TestStart(t *testing.T) {
+	dir := t.TempDir()
+
+	err := New(Replay, dir).Start("TestMissing")
+	assert.True(t, errors.Is(err, os.ErrNotExist), "unexpected error: %v", err)
+
+	r := New(Record, dir)
+	require.NoError(t, r.Start("TestA"))
+	require.NoError(t, r.Start("TestA"))
+	assert.Error(t, r.Start("TestB"))
+	require.NoError(t, r.Stop())
+	require.NoError(t, r.Start("TestB/subtest"))
+	require.NoError(t, r.Stop())
+	assert.FileExists(t, filepath.Join(dir, "TestB__subtest.json"))
+}
+
+func TestRedact(t *testing.T) {
+	tree := redact(map[string]interface{}{
+		"yandex_passport_oauth_token": "AQAAAA",
+		"key":                         map[string]interface{}{"id": "aje", "private_key": "-----BEGIN"},
+	})
+	assert.Equal(t, map[string]interface{}{
+		"yandex_passport_oauth_token": redacted,
+		"key":                         map[string]interface{}{"id": "aje", "private_key": redacted},
+	}, tree)
+}
@@ -0,0 +1,187 @@
+package cassette
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/readonly"
+)
+
+// pollIntervalHeader is the response header which tells SDK how long to wait before next poll of operation.
+const pollIntervalHeader = "x-operation-poll-interval"
+
+// redactedFields hold credentials, they are never written to cassettes.
+var redactedFields = map[string]bool{
+	"yandex_passport_oauth_token": true,
+	"jwt":                         true,
+	"iam_token":                   true,
+	"private_key":                 true,
+	"secret":                      true,
+}
+
+const redacted = "REDACTED"
+
+// DialOptions returns options for gRPC connections of SDK, they must be passed after all other interceptors.
+// In replay mode connections go to in-memory server which is never actually called.
+func (r *Recorder) DialOptions() []grpc.DialOption {
+	opts := []grpc.DialOption{grpc.WithChainUnaryInterceptor(r.interceptUnary)}
+	if r.mode == Replay {
+		opts = append(opts,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(r.dialReplay))
+	}
+	return opts
+}
+
+func (r *Recorder) interceptUnary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if r.mode == Replay {
+		return r.replayUnary(method, req, reply, opts)
+	}
+
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if r.mode == Record {
+		r.recordUnary(method, req, reply, err)
+	}
+	return err
+}
+
+func (r *Recorder) recordUnary(method string, req, reply interface{}, callErr error) {
+	i := &Interaction{Method: method}
+
+	var err error
+	if i.Request, err = messageTree(req); err != nil {
+		return
+	}
+	if callErr == nil {
+		i.Response, err = messageTree(reply)
+	} else {
+		i.Status, err = statusTree(callErr)
+	}
+	if err != nil {
+		return
+	}
+	r.record(i)
+}
+
+func (r *Recorder) replayUnary(method string, req, reply interface{}, opts []grpc.CallOption) error {
+	request, err := messageTree(req)
+	if err != nil {
+		return status.Errorf(codes.Internal, "cassette: %v", err)
+	}
+
+	r.mu.Lock()
+	i, err := r.replay(method, request, readonly.IsReadOnlyMethod(method))
+	r.mu.Unlock()
+	if err != nil {
+		return status.Errorf(codes.Internal, "cassette: %v", err)
+	}
+
+	// Recorded operations are already done, there is no need to wait between polls.
+	for _, opt := range opts {
+		if h, ok := opt.(grpc.HeaderCallOption); ok {
+			*h.HeaderAddr = metadata.Pairs(pollIntervalHeader, "0")
+		}
+	}
+
+	if i.Status != nil {
+		st := &spb.Status{}
+		if err := unmarshalTree(i.Status, st); err != nil {
+			return status.Errorf(codes.Internal, "cassette: %v", err)
+		}
+		return status.ErrorProto(st)
+	}
+
+	msg, ok := reply.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "cassette: unexpected reply type %T", reply)
+	}
+	if err := unmarshalTree(i.Response, msg); err != nil {
+		return status.Errorf(codes.Internal, "cassette: %v", err)
+	}
+	return nil
+}
+
+func (r *Recorder) dialReplay(ctx context.Context, _ string) (net.Conn, error) {
+	r.serverOnce.Do(func() {
+		r.listener = bufconn.Listen(1 << 20)
+		go func() { _ = grpc.NewServer().Serve(r.listener) }()
+	})
+	return r.listener.DialContext(ctx)
+}
+
+// messageTree converts proto message into decoded JSON value with credentials redacted.
+func messageTree(m interface{}) (interface{}, error) {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unexpected message type %T", m)
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return decodeTree(data)
+}
+
+// statusTree converts error into decoded JSON form of gRPC status. Details of unknown types are dropped.
+func statusTree(err error) (interface{}, error) {
+	st := status.Convert(err).Proto()
+	marshal := protojson.MarshalOptions{UseProtoNames: true}.Marshal
+	data, marshalErr := marshal(st)
+	if marshalErr != nil {
+		st.Details = nil
+		data, marshalErr = marshal(st)
+	}
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	return decodeTree(data)
+}
+
+func decodeTree(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var tree interface{}
+	if err := dec.Decode(&tree); err != nil {
+		return nil, err
+	}
+	return redact(tree), nil
+}
+
+func unmarshalTree(tree interface{}, m proto.Message) error {
+	data, err := json.Marshal(tree)
+	if err != nil {
+		return err
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, m)
+}
+
+// redact replaces values of credential fields.
+func redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			if _, ok := item.(string); ok && redactedFields[k] {
+				v[k] = redacted
+			} else {
+				v[k] = redact(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redact(item)
+		}
+	}
+	return v
+}
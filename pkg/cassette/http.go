@@ -0,0 +1,135 @@
+package cassette
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// skippedHeaders differ from run to run and are not needed for replay.
+var skippedHeaders = map[string]bool{
+	"Date":             true,
+	"X-Amz-Request-Id": true,
+	"X-Amz-Id-2":       true,
+}
+
+// Transport returns HTTP transport for S3 and YMQ clients, base is used for real calls in record mode.
+func (r *Recorder) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{r: r, base: base}
+}
+
+type transport struct {
+	r    *Recorder
+	base http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	request, err := requestTree(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.r.mode == Replay {
+		t.r.mu.Lock()
+		i, err := t.r.replay(req.Method, request, req.Method == http.MethodGet || req.Method == http.MethodHead)
+		t.r.mu.Unlock()
+		if err != nil {
+			return nil, fmt.Errorf("cassette: %w", err)
+		}
+		if i.HTTP == nil {
+			return nil, fmt.Errorf("cassette: recorded call of %s is not HTTP one", req.Method)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.HTTP.StatusCode, http.StatusText(i.HTTP.StatusCode)),
+			StatusCode:    i.HTTP.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header(i.HTTP.Header).Clone(),
+			Body:          ioutil.NopCloser(strings.NewReader(i.HTTP.Body)),
+			ContentLength: int64(len(i.HTTP.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || t.r.mode != Record {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	header := map[string][]string{}
+	for k, v := range resp.Header {
+		if !skippedHeaders[k] {
+			header[k] = v
+		}
+	}
+	t.r.record(&Interaction{
+		Method:  req.Method,
+		Request: request,
+		HTTP:    &HTTPResponse{StatusCode: resp.StatusCode, Header: header, Body: string(body)},
+	})
+	return resp, nil
+}
+
+// requestTree returns the parts of HTTP request used for matching: labels of host (it holds bucket name
+// for virtual-hosted style S3 requests), segments of path, query and form values (YMQ sends everything in form).
+// Other bodies, i.e. XML documents of S3, are not matched.
+func requestTree(req *http.Request) (interface{}, error) {
+	tree := map[string]interface{}{
+		"host":  stringsTree(strings.Split(req.URL.Hostname(), ".")),
+		"path":  stringsTree(strings.Split(strings.Trim(req.URL.Path, "/"), "/")),
+		"query": valuesTree(req.URL.Query()),
+	}
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return tree, nil
+	}
+	if mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type")); mediaType != "application/x-www-form-urlencoded" {
+		return tree, nil
+	}
+
+	// Body is read from a copy, so that the request can still be sent.
+	body, err := ioutil.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	tree["form"] = valuesTree(form)
+	return tree, nil
+}
+
+func stringsTree(values []string) []interface{} {
+	out := make([]interface{}, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
+
+func valuesTree(values url.Values) map[string]interface{} {
+	out := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		out[k] = stringsTree(v)
+	}
+	return out
+}
@@ -0,0 +1,133 @@
+package cassette
+
+import (
+	"sort"
+	"strings"
+)
+
+// minSubstitutedLen is the minimal length of differing part of generated values.
+const minSubstitutedLen = 4
+
+// substitutions maps strings of recorded requests to the ones sent during replay.
+type substitutions map[string]string
+
+func (s substitutions) clone() substitutions {
+	c := make(substitutions, len(s))
+	for k, v := range s {
+		c[k] = v
+	}
+	return c
+}
+
+// apply replaces every known recorded value in v, longer values first.
+func (s substitutions) apply(v string) string {
+	if len(s) == 0 || v == "" {
+		return v
+	}
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	oldnew := make([]string, 0, 2*len(keys))
+	for _, k := range keys {
+		oldnew = append(oldnew, k, s[k])
+	}
+	return strings.NewReplacer(oldnew...).Replace(v)
+}
+
+// applyTree returns copy of decoded JSON value with substitutions applied to all strings.
+func (s substitutions) applyTree(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			out[k] = s.applyTree(item)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = s.applyTree(item)
+		}
+		return out
+	case string:
+		return s.apply(v)
+	}
+	return v
+}
+
+// match reports whether recorded request matches actual one, both are decoded JSON values.
+// They must have the same structure and values, except strings which may be substituted,
+// new substitutions are added to s.
+func (s substitutions) match(recorded, actual interface{}) bool {
+	switch r := recorded.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok || len(a) != len(r) {
+			return false
+		}
+		keys := make([]string, 0, len(r))
+		for k := range r {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			av, ok := a[k]
+			if !ok || !s.match(r[k], av) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(r) {
+			return false
+		}
+		for i := range r {
+			if !s.match(r[i], a[i]) {
+				return false
+			}
+		}
+		return true
+	case string:
+		a, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		if s.apply(r) == a {
+			return true
+		}
+		if _, known := s[r]; known || !substitutable(r, a) {
+			return false
+		}
+		s[r] = a
+		return true
+	}
+	return recorded == actual
+}
+
+// substitutable reports whether the values look like generated ones, i.e. they differ in more than a few characters.
+// Numbers, i.e. sizes of resources, and values like zones which differ in a letter must match exactly.
+func substitutable(recorded, actual string) bool {
+	if strings.Trim(recorded, "0123456789") == "" || strings.Trim(actual, "0123456789") == "" {
+		return false
+	}
+
+	prefix := 0
+	for prefix < len(recorded) && prefix < len(actual) && recorded[prefix] == actual[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(recorded)-prefix && suffix < len(actual)-prefix &&
+		recorded[len(recorded)-1-suffix] == actual[len(actual)-1-suffix] {
+		suffix++
+	}
+	return len(recorded)-prefix-suffix >= minSubstitutedLen && len(actual)-prefix-suffix >= minSubstitutedLen
+}
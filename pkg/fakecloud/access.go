@@ -0,0 +1,100 @@
+package fakecloud
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+)
+
+// accessBindings implements access binding methods shared by every service of resources with IAM policies.
+// Bindings are kept by resource ID, exists reports whether the resource exists. Must be called with s.mu held.
+type accessBindings struct {
+	s      *Server
+	kind   string
+	exists func(id string) bool
+}
+
+func (a accessBindings) list(req *access.ListAccessBindingsRequest) (*access.ListAccessBindingsResponse, error) {
+	if !a.exists(req.ResourceId) {
+		return nil, notFound(a.kind, req.ResourceId)
+	}
+
+	bindings := a.s.accessBindings[req.ResourceId]
+	start, end, next, err := a.s.pageBounds(len(bindings), req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &access.ListAccessBindingsResponse{NextPageToken: next}
+	for _, b := range bindings[start:end] {
+		resp.AccessBindings = append(resp.AccessBindings, proto.Clone(b).(*access.AccessBinding))
+	}
+	return resp, nil
+}
+
+func (a accessBindings) set(req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	if !a.exists(req.ResourceId) {
+		return nil, notFound(a.kind, req.ResourceId)
+	}
+
+	var bindings []*access.AccessBinding
+	for _, b := range req.AccessBindings {
+		if err := validateAccessBinding(b); err != nil {
+			return nil, err
+		}
+		if indexOfAccessBinding(bindings, b) < 0 {
+			bindings = append(bindings, b)
+		}
+	}
+	a.s.accessBindings[req.ResourceId] = bindings
+
+	return a.s.newOperation("Set access bindings", &access.SetAccessBindingsMetadata{ResourceId: req.ResourceId}, nil)
+}
+
+func (a accessBindings) update(req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	if !a.exists(req.ResourceId) {
+		return nil, notFound(a.kind, req.ResourceId)
+	}
+
+	bindings := a.s.accessBindings[req.ResourceId]
+	for _, delta := range req.AccessBindingDeltas {
+		if err := validateAccessBinding(delta.AccessBinding); err != nil {
+			return nil, err
+		}
+		i := indexOfAccessBinding(bindings, delta.AccessBinding)
+		switch delta.Action {
+		case access.AccessBindingAction_ADD:
+			if i < 0 {
+				bindings = append(bindings, delta.AccessBinding)
+			}
+		case access.AccessBindingAction_REMOVE:
+			if i >= 0 {
+				bindings = append(bindings[:i:i], bindings[i+1:]...)
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid access binding action %s", delta.Action)
+		}
+	}
+	a.s.accessBindings[req.ResourceId] = bindings
+
+	return a.s.newOperation("Update access bindings", &access.UpdateAccessBindingsMetadata{ResourceId: req.ResourceId}, nil)
+}
+
+func validateAccessBinding(b *access.AccessBinding) error {
+	if b.GetRoleId() == "" || b.GetSubject().GetId() == "" || b.GetSubject().GetType() == "" {
+		return status.Error(codes.InvalidArgument, "access binding must have role_id, subject.id and subject.type")
+	}
+	return nil
+}
+
+func indexOfAccessBinding(bindings []*access.AccessBinding, b *access.AccessBinding) int {
+	for i, existing := range bindings {
+		if proto.Equal(existing, b) {
+			return i
+		}
+	}
+	return -1
+}
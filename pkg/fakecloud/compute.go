@@ -0,0 +1,726 @@
+package fakecloud
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+)
+
+const (
+	defaultDiskTypeID    = "network-hdd"
+	defaultDiskBlockSize = 4096
+
+	// reservedSubnetAddresses is number of addresses at the beginning of a subnet used by the cloud:
+	// network address, gateway and DNS server.
+	reservedSubnetAddresses = 3
+)
+
+type diskService struct {
+	compute.UnimplementedDiskServiceServer
+	s *Server
+}
+
+func (ds *diskService) Get(_ context.Context, req *compute.GetDiskRequest) (*compute.Disk, error) {
+	ds.s.mu.Lock()
+	defer ds.s.mu.Unlock()
+
+	disk, ok := ds.s.disks[req.DiskId]
+	if !ok {
+		return nil, notFound("Disk", req.DiskId)
+	}
+	return proto.Clone(disk).(*compute.Disk), nil
+}
+
+func (ds *diskService) List(_ context.Context, req *compute.ListDisksRequest) (*compute.ListDisksResponse, error) {
+	ds.s.mu.Lock()
+	defer ds.s.mu.Unlock()
+
+	var ids []string
+	for id, disk := range ds.s.disks {
+		match, err := matchFilter(req.Filter, disk.Name)
+		if err != nil {
+			return nil, err
+		}
+		if disk.FolderId == req.FolderId && match {
+			ids = append(ids, id)
+		}
+	}
+	page, next, err := ds.s.paginate(ids, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &compute.ListDisksResponse{NextPageToken: next}
+	for _, id := range page {
+		resp.Disks = append(resp.Disks, proto.Clone(ds.s.disks[id]).(*compute.Disk))
+	}
+	return resp, nil
+}
+
+func (ds *diskService) Create(_ context.Context, req *compute.CreateDiskRequest) (*operation.Operation, error) {
+	ds.s.mu.Lock()
+	defer ds.s.mu.Unlock()
+
+	if err := ds.s.requireFolder(req.FolderId); err != nil {
+		return nil, err
+	}
+	if req.ZoneId == "" {
+		return nil, status.Error(codes.InvalidArgument, "zone_id is required")
+	}
+
+	disk := &compute.Disk{
+		FolderId:            req.FolderId,
+		Name:                req.Name,
+		Description:         req.Description,
+		Labels:              req.Labels,
+		TypeId:              req.TypeId,
+		ZoneId:              req.ZoneId,
+		Size:                req.Size,
+		BlockSize:           req.BlockSize,
+		DiskPlacementPolicy: req.DiskPlacementPolicy,
+	}
+	switch source := req.Source.(type) {
+	case *compute.CreateDiskRequest_ImageId:
+		disk.Source = &compute.Disk_SourceImageId{SourceImageId: source.ImageId}
+	case *compute.CreateDiskRequest_SnapshotId:
+		disk.Source = &compute.Disk_SourceSnapshotId{SourceSnapshotId: source.SnapshotId}
+	}
+	if err := ds.s.addDisk(disk); err != nil {
+		return nil, err
+	}
+
+	return ds.s.newOperation("Create disk", &compute.CreateDiskMetadata{DiskId: disk.Id}, disk)
+}
+
+func (ds *diskService) Update(_ context.Context, req *compute.UpdateDiskRequest) (*operation.Operation, error) {
+	ds.s.mu.Lock()
+	defer ds.s.mu.Unlock()
+
+	disk, ok := ds.s.disks[req.DiskId]
+	if !ok {
+		return nil, notFound("Disk", req.DiskId)
+	}
+	for _, path := range req.UpdateMask.GetPaths() {
+		if path == "size" && req.Size < disk.Size {
+			return nil, status.Errorf(codes.InvalidArgument, "disk size can't be decreased from %d to %d", disk.Size, req.Size)
+		}
+	}
+	if err := applyUpdateMask(disk, req, req.UpdateMask); err != nil {
+		return nil, err
+	}
+
+	return ds.s.newOperation("Update disk", &compute.UpdateDiskMetadata{DiskId: disk.Id}, disk)
+}
+
+func (ds *diskService) Delete(_ context.Context, req *compute.DeleteDiskRequest) (*operation.Operation, error) {
+	ds.s.mu.Lock()
+	defer ds.s.mu.Unlock()
+
+	disk, ok := ds.s.disks[req.DiskId]
+	if !ok {
+		return nil, notFound("Disk", req.DiskId)
+	}
+	if len(disk.InstanceIds) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Disk %s is attached to instance %s", disk.Id, disk.InstanceIds[0])
+	}
+	delete(ds.s.disks, req.DiskId)
+
+	return ds.s.newOperation("Delete disk", &compute.DeleteDiskMetadata{DiskId: req.DiskId}, nil)
+}
+
+// addDisk validates the disk, fills defaults and stores it with new ID. Must be called with s.mu held.
+func (s *Server) addDisk(disk *compute.Disk) error {
+	if disk.Size <= 0 {
+		return status.Error(codes.InvalidArgument, "disk size must be positive")
+	}
+	if imageID := disk.GetSourceImageId(); imageID != "" {
+		if err := s.checkDiskImage(imageID, disk.Size); err != nil {
+			return err
+		}
+	}
+	if disk.TypeId == "" {
+		disk.TypeId = defaultDiskTypeID
+	}
+	if disk.BlockSize == 0 {
+		disk.BlockSize = defaultDiskBlockSize
+	}
+	// Real API always returns placement policy, even an empty one.
+	if disk.DiskPlacementPolicy == nil {
+		disk.DiskPlacementPolicy = &compute.DiskPlacementPolicy{}
+	}
+	disk.Id = s.newID("epd")
+	disk.CreatedAt = now()
+	disk.Status = compute.Disk_READY
+	s.disks[disk.Id] = disk
+	return nil
+}
+
+// defaultImageMinDiskSize is used for images created from URI without explicit minimal disk size.
+const defaultImageMinDiskSize = 1 << 30
+
+type imageService struct {
+	compute.UnimplementedImageServiceServer
+	s *Server
+}
+
+func (im *imageService) Get(_ context.Context, req *compute.GetImageRequest) (*compute.Image, error) {
+	im.s.mu.Lock()
+	defer im.s.mu.Unlock()
+
+	image, ok := im.s.images[req.ImageId]
+	if !ok {
+		return nil, notFound("Image", req.ImageId)
+	}
+	return proto.Clone(image).(*compute.Image), nil
+}
+
+func (im *imageService) GetLatestByFamily(_ context.Context, req *compute.GetImageLatestByFamilyRequest) (*compute.Image, error) {
+	im.s.mu.Lock()
+	defer im.s.mu.Unlock()
+
+	var latest *compute.Image
+	for _, image := range im.s.images {
+		if image.FolderId != req.FolderId || image.Family != req.Family {
+			continue
+		}
+		// IDs grow monotonically, so the greatest one belongs to the latest image.
+		if latest == nil || image.Id > latest.Id {
+			latest = image
+		}
+	}
+	if latest == nil {
+		return nil, notFound("Image family", req.Family)
+	}
+	return proto.Clone(latest).(*compute.Image), nil
+}
+
+func (im *imageService) List(_ context.Context, req *compute.ListImagesRequest) (*compute.ListImagesResponse, error) {
+	im.s.mu.Lock()
+	defer im.s.mu.Unlock()
+
+	var ids []string
+	for id, image := range im.s.images {
+		match, err := matchFilter(req.Filter, image.Name)
+		if err != nil {
+			return nil, err
+		}
+		if image.FolderId == req.FolderId && match {
+			ids = append(ids, id)
+		}
+	}
+	page, next, err := im.s.paginate(ids, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &compute.ListImagesResponse{NextPageToken: next}
+	for _, id := range page {
+		resp.Images = append(resp.Images, proto.Clone(im.s.images[id]).(*compute.Image))
+	}
+	return resp, nil
+}
+
+// Create accepts any URI and snapshot ID, while source image and disk must exist.
+func (im *imageService) Create(_ context.Context, req *compute.CreateImageRequest) (*operation.Operation, error) {
+	im.s.mu.Lock()
+	defer im.s.mu.Unlock()
+
+	if err := im.s.requireFolder(req.FolderId); err != nil {
+		return nil, err
+	}
+
+	var sourceSize int64
+	switch source := req.Source.(type) {
+	case *compute.CreateImageRequest_ImageId:
+		image, ok := im.s.images[source.ImageId]
+		if !ok {
+			return nil, notFound("Image", source.ImageId)
+		}
+		sourceSize = image.MinDiskSize
+	case *compute.CreateImageRequest_DiskId:
+		disk, ok := im.s.disks[source.DiskId]
+		if !ok {
+			return nil, notFound("Disk", source.DiskId)
+		}
+		sourceSize = disk.Size
+	case *compute.CreateImageRequest_SnapshotId, *compute.CreateImageRequest_Uri:
+		sourceSize = defaultImageMinDiskSize
+	default:
+		return nil, status.Error(codes.InvalidArgument, "image source is required")
+	}
+
+	image := &compute.Image{
+		Id:          im.s.newID("fd8"),
+		FolderId:    req.FolderId,
+		CreatedAt:   now(),
+		Name:        req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+		Family:      req.Family,
+		StorageSize: sourceSize,
+		MinDiskSize: req.MinDiskSize,
+		ProductIds:  req.ProductIds,
+		Status:      compute.Image_READY,
+		Os:          req.Os,
+		Pooled:      req.Pooled,
+	}
+	if image.MinDiskSize == 0 {
+		image.MinDiskSize = sourceSize
+	}
+	if image.Os == nil {
+		image.Os = &compute.Os{}
+	}
+	im.s.images[image.Id] = image
+
+	return im.s.newOperation("Create image", &compute.CreateImageMetadata{ImageId: image.Id}, image)
+}
+
+func (im *imageService) Update(_ context.Context, req *compute.UpdateImageRequest) (*operation.Operation, error) {
+	im.s.mu.Lock()
+	defer im.s.mu.Unlock()
+
+	image, ok := im.s.images[req.ImageId]
+	if !ok {
+		return nil, notFound("Image", req.ImageId)
+	}
+	if err := applyUpdateMask(image, req, req.UpdateMask); err != nil {
+		return nil, err
+	}
+
+	return im.s.newOperation("Update image", &compute.UpdateImageMetadata{ImageId: image.Id}, image)
+}
+
+func (im *imageService) Delete(_ context.Context, req *compute.DeleteImageRequest) (*operation.Operation, error) {
+	im.s.mu.Lock()
+	defer im.s.mu.Unlock()
+
+	if _, ok := im.s.images[req.ImageId]; !ok {
+		return nil, notFound("Image", req.ImageId)
+	}
+	delete(im.s.images, req.ImageId)
+
+	return im.s.newOperation("Delete image", &compute.DeleteImageMetadata{ImageId: req.ImageId}, nil)
+}
+
+type instanceService struct {
+	compute.UnimplementedInstanceServiceServer
+	s *Server
+}
+
+func (is *instanceService) Get(_ context.Context, req *compute.GetInstanceRequest) (*compute.Instance, error) {
+	is.s.mu.Lock()
+	defer is.s.mu.Unlock()
+
+	instance, ok := is.s.instances[req.InstanceId]
+	if !ok {
+		return nil, notFound("Instance", req.InstanceId)
+	}
+	return proto.Clone(instance).(*compute.Instance), nil
+}
+
+func (is *instanceService) List(_ context.Context, req *compute.ListInstancesRequest) (*compute.ListInstancesResponse, error) {
+	is.s.mu.Lock()
+	defer is.s.mu.Unlock()
+
+	var ids []string
+	for id, instance := range is.s.instances {
+		match, err := matchFilter(req.Filter, instance.Name)
+		if err != nil {
+			return nil, err
+		}
+		if instance.FolderId == req.FolderId && match {
+			ids = append(ids, id)
+		}
+	}
+	page, next, err := is.s.paginate(ids, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &compute.ListInstancesResponse{NextPageToken: next}
+	for _, id := range page {
+		resp.Instances = append(resp.Instances, proto.Clone(is.s.instances[id]).(*compute.Instance))
+	}
+	return resp, nil
+}
+
+func (is *instanceService) Create(_ context.Context, req *compute.CreateInstanceRequest) (*operation.Operation, error) {
+	is.s.mu.Lock()
+	defer is.s.mu.Unlock()
+
+	if err := is.s.requireFolder(req.FolderId); err != nil {
+		return nil, err
+	}
+	if req.ZoneId == "" {
+		return nil, status.Error(codes.InvalidArgument, "zone_id is required")
+	}
+	if req.BootDiskSpec == nil {
+		return nil, status.Error(codes.InvalidArgument, "boot_disk_spec is required")
+	}
+
+	instance := &compute.Instance{
+		Id:               is.s.newID("fhm"),
+		FolderId:         req.FolderId,
+		CreatedAt:        now(),
+		Name:             req.Name,
+		Description:      req.Description,
+		Labels:           req.Labels,
+		ZoneId:           req.ZoneId,
+		PlatformId:       req.PlatformId,
+		Status:           compute.Instance_RUNNING,
+		Metadata:         req.Metadata,
+		MetadataOptions:  req.MetadataOptions,
+		SchedulingPolicy: req.SchedulingPolicy,
+		ServiceAccountId: req.ServiceAccountId,
+		NetworkSettings:  req.NetworkSettings,
+		PlacementPolicy:  req.PlacementPolicy,
+	}
+	if spec := req.ResourcesSpec; spec != nil {
+		instance.Resources = &compute.Resources{
+			Memory:       spec.Memory,
+			Cores:        spec.Cores,
+			CoreFraction: spec.CoreFraction,
+			Gpus:         spec.Gpus,
+		}
+	}
+	// Real API always returns these settings, even empty ones.
+	if instance.Resources == nil {
+		instance.Resources = &compute.Resources{}
+	}
+	if instance.SchedulingPolicy == nil {
+		instance.SchedulingPolicy = &compute.SchedulingPolicy{}
+	}
+	if instance.PlacementPolicy == nil {
+		instance.PlacementPolicy = &compute.PlacementPolicy{}
+	}
+	if instance.NetworkSettings == nil {
+		instance.NetworkSettings = &compute.NetworkSettings{Type: compute.NetworkSettings_STANDARD}
+	}
+	if instance.MetadataOptions == nil {
+		instance.MetadataOptions = &compute.MetadataOptions{}
+	}
+	instance.Fqdn = instance.Id + ".auto.internal"
+	if req.Hostname != "" {
+		instance.Fqdn = req.Hostname + ".ru-central1.internal"
+	}
+
+	// Validate everything before changing state, so that failed call leaves no garbage behind.
+	if err := is.s.validateAttachedDiskSpec(req.BootDiskSpec, req.ZoneId); err != nil {
+		return nil, err
+	}
+	for _, spec := range req.SecondaryDiskSpecs {
+		if err := is.s.validateAttachedDiskSpec(spec, req.ZoneId); err != nil {
+			return nil, err
+		}
+	}
+	for _, spec := range req.NetworkInterfaceSpecs {
+		subnet, ok := is.s.subnets[spec.SubnetId]
+		if !ok {
+			return nil, notFound("Subnet", spec.SubnetId)
+		}
+		if subnet.ZoneId != req.ZoneId {
+			return nil, status.Errorf(codes.InvalidArgument, "subnet %s is in zone %s, not in %s", subnet.Id, subnet.ZoneId, req.ZoneId)
+		}
+	}
+
+	for i, spec := range req.NetworkInterfaceSpecs {
+		iface, err := is.s.newNetworkInterface(i, spec)
+		if err != nil {
+			is.s.releaseAddresses(instance)
+			return nil, err
+		}
+		instance.NetworkInterfaces = append(instance.NetworkInterfaces, iface)
+	}
+	instance.BootDisk = is.s.attachDisk(instance.Id, req.BootDiskSpec, req.FolderId, req.ZoneId)
+	for _, spec := range req.SecondaryDiskSpecs {
+		instance.SecondaryDisks = append(instance.SecondaryDisks, is.s.attachDisk(instance.Id, spec, req.FolderId, req.ZoneId))
+	}
+	is.s.instances[instance.Id] = instance
+
+	return is.s.newOperation("Create instance", &compute.CreateInstanceMetadata{InstanceId: instance.Id}, instance)
+}
+
+func (is *instanceService) Update(_ context.Context, req *compute.UpdateInstanceRequest) (*operation.Operation, error) {
+	is.s.mu.Lock()
+	defer is.s.mu.Unlock()
+
+	instance, ok := is.s.instances[req.InstanceId]
+	if !ok {
+		return nil, notFound("Instance", req.InstanceId)
+	}
+
+	mask := &fieldmaskpb.FieldMask{}
+	for _, path := range req.UpdateMask.GetPaths() {
+		switch path {
+		case "resources_spec", "platform_id":
+			if instance.Status != compute.Instance_STOPPED {
+				return nil, status.Errorf(codes.FailedPrecondition, "Instance %s must be stopped to update %s", instance.Id, path)
+			}
+		}
+		if path != "resources_spec" {
+			mask.Paths = append(mask.Paths, path)
+		}
+	}
+
+	updated := proto.Clone(instance).(*compute.Instance)
+	if err := applyUpdateMask(updated, req, mask); err != nil {
+		return nil, err
+	}
+	if len(mask.Paths) != len(req.UpdateMask.GetPaths()) {
+		spec := req.ResourcesSpec
+		updated.Resources = &compute.Resources{
+			Memory:       spec.GetMemory(),
+			Cores:        spec.GetCores(),
+			CoreFraction: spec.GetCoreFraction(),
+			Gpus:         spec.GetGpus(),
+		}
+	}
+	is.s.instances[instance.Id] = updated
+
+	return is.s.newOperation("Update instance", &compute.UpdateInstanceMetadata{InstanceId: instance.Id}, updated)
+}
+
+func (is *instanceService) UpdateMetadata(_ context.Context, req *compute.UpdateInstanceMetadataRequest) (*operation.Operation, error) {
+	is.s.mu.Lock()
+	defer is.s.mu.Unlock()
+
+	instance, ok := is.s.instances[req.InstanceId]
+	if !ok {
+		return nil, notFound("Instance", req.InstanceId)
+	}
+	if instance.Metadata == nil {
+		instance.Metadata = make(map[string]string)
+	}
+	for _, key := range req.Delete {
+		delete(instance.Metadata, key)
+	}
+	for key, value := range req.Upsert {
+		instance.Metadata[key] = value
+	}
+
+	return is.s.newOperation("Update instance metadata", &compute.UpdateInstanceMetadataMetadata{InstanceId: instance.Id}, instance)
+}
+
+func (is *instanceService) Delete(_ context.Context, req *compute.DeleteInstanceRequest) (*operation.Operation, error) {
+	is.s.mu.Lock()
+	defer is.s.mu.Unlock()
+
+	instance, ok := is.s.instances[req.InstanceId]
+	if !ok {
+		return nil, notFound("Instance", req.InstanceId)
+	}
+
+	for _, attached := range append([]*compute.AttachedDisk{instance.BootDisk}, instance.SecondaryDisks...) {
+		if attached == nil {
+			continue
+		}
+		if attached.AutoDelete {
+			delete(is.s.disks, attached.DiskId)
+		} else if disk, ok := is.s.disks[attached.DiskId]; ok {
+			disk.InstanceIds = nil
+		}
+	}
+	is.s.releaseAddresses(instance)
+	delete(is.s.instances, req.InstanceId)
+
+	return is.s.newOperation("Delete instance", &compute.DeleteInstanceMetadata{InstanceId: req.InstanceId}, nil)
+}
+
+func (is *instanceService) Stop(_ context.Context, req *compute.StopInstanceRequest) (*operation.Operation, error) {
+	is.s.mu.Lock()
+	defer is.s.mu.Unlock()
+
+	instance, ok := is.s.instances[req.InstanceId]
+	if !ok {
+		return nil, notFound("Instance", req.InstanceId)
+	}
+	instance.Status = compute.Instance_STOPPED
+
+	return is.s.newOperation("Stop instance", &compute.StopInstanceMetadata{InstanceId: instance.Id}, nil)
+}
+
+func (is *instanceService) Start(_ context.Context, req *compute.StartInstanceRequest) (*operation.Operation, error) {
+	is.s.mu.Lock()
+	defer is.s.mu.Unlock()
+
+	instance, ok := is.s.instances[req.InstanceId]
+	if !ok {
+		return nil, notFound("Instance", req.InstanceId)
+	}
+	instance.Status = compute.Instance_RUNNING
+
+	return is.s.newOperation("Start instance", &compute.StartInstanceMetadata{InstanceId: instance.Id}, instance)
+}
+
+func (is *instanceService) Restart(_ context.Context, req *compute.RestartInstanceRequest) (*operation.Operation, error) {
+	is.s.mu.Lock()
+	defer is.s.mu.Unlock()
+
+	instance, ok := is.s.instances[req.InstanceId]
+	if !ok {
+		return nil, notFound("Instance", req.InstanceId)
+	}
+	if instance.Status != compute.Instance_RUNNING {
+		return nil, status.Errorf(codes.FailedPrecondition, "Instance %s is not running", instance.Id)
+	}
+
+	return is.s.newOperation("Restart instance", &compute.RestartInstanceMetadata{InstanceId: instance.Id}, nil)
+}
+
+// validateAttachedDiskSpec checks that the disk can be created or attached in the zone. Must be called with s.mu held.
+func (s *Server) validateAttachedDiskSpec(spec *compute.AttachedDiskSpec, zoneID string) error {
+	if diskID := spec.GetDiskId(); diskID != "" {
+		disk, ok := s.disks[diskID]
+		if !ok {
+			return notFound("Disk", diskID)
+		}
+		if len(disk.InstanceIds) > 0 {
+			return status.Errorf(codes.FailedPrecondition, "Disk %s is already attached to instance %s", diskID, disk.InstanceIds[0])
+		}
+		if disk.ZoneId != zoneID {
+			return status.Errorf(codes.InvalidArgument, "disk %s is in zone %s, not in %s", diskID, disk.ZoneId, zoneID)
+		}
+		return nil
+	}
+	if spec.GetDiskSpec().GetSize() <= 0 {
+		return status.Error(codes.InvalidArgument, "disk size must be positive")
+	}
+	if imageID := spec.GetDiskSpec().GetImageId(); imageID != "" {
+		return s.checkDiskImage(imageID, spec.GetDiskSpec().GetSize())
+	}
+	return nil
+}
+
+// checkDiskImage checks that disk of the size can be created from the image. Must be called with s.mu held.
+func (s *Server) checkDiskImage(imageID string, size int64) error {
+	image, ok := s.images[imageID]
+	if !ok {
+		return notFound("Image", imageID)
+	}
+	if size < image.MinDiskSize {
+		return status.Errorf(codes.InvalidArgument, "disk size %d is less than minimal disk size %d of image %s", size, image.MinDiskSize, imageID)
+	}
+	return nil
+}
+
+// attachDisk creates the disk from spec if needed and attaches it to the instance.
+// The spec must be validated with validateAttachedDiskSpec. Must be called with s.mu held.
+func (s *Server) attachDisk(instanceID string, spec *compute.AttachedDiskSpec, folderID, zoneID string) *compute.AttachedDisk {
+	diskID := spec.GetDiskId()
+	if diskSpec := spec.GetDiskSpec(); diskSpec != nil {
+		disk := &compute.Disk{
+			FolderId:            folderID,
+			Name:                diskSpec.Name,
+			Description:         diskSpec.Description,
+			TypeId:              diskSpec.TypeId,
+			ZoneId:              zoneID,
+			Size:                diskSpec.Size,
+			BlockSize:           diskSpec.BlockSize,
+			DiskPlacementPolicy: diskSpec.DiskPlacementPolicy,
+		}
+		if imageID := diskSpec.GetImageId(); imageID != "" {
+			disk.Source = &compute.Disk_SourceImageId{SourceImageId: imageID}
+		} else if snapshotID := diskSpec.GetSnapshotId(); snapshotID != "" {
+			disk.Source = &compute.Disk_SourceSnapshotId{SourceSnapshotId: snapshotID}
+		}
+		_ = s.addDisk(disk)
+		diskID = disk.Id
+	}
+	s.disks[diskID].InstanceIds = []string{instanceID}
+
+	attached := &compute.AttachedDisk{
+		Mode:       compute.AttachedDisk_Mode(spec.Mode),
+		DeviceName: spec.DeviceName,
+		AutoDelete: spec.AutoDelete,
+		DiskId:     diskID,
+	}
+	if attached.Mode == compute.AttachedDisk_MODE_UNSPECIFIED {
+		attached.Mode = compute.AttachedDisk_READ_WRITE
+	}
+	if attached.DeviceName == "" {
+		attached.DeviceName = diskID
+	}
+	return attached
+}
+
+// newNetworkInterface allocates addresses for the interface in its subnet. Must be called with s.mu held.
+func (s *Server) newNetworkInterface(index int, spec *compute.NetworkInterfaceSpec) (*compute.NetworkInterface, error) {
+	subnet := s.subnets[spec.SubnetId]
+	used := s.usedAddresses[subnet.Id]
+	if used == nil {
+		used = make(map[string]bool)
+		s.usedAddresses[subnet.Id] = used
+	}
+
+	address := spec.GetPrimaryV4AddressSpec().GetAddress()
+	if address != "" {
+		if used[address] {
+			return nil, status.Errorf(codes.AlreadyExists, "address %s is already used in subnet %s", address, subnet.Id)
+		}
+	} else {
+		var err error
+		if address, err = freeAddress(subnet.V4CidrBlocks, used); err != nil {
+			return nil, err
+		}
+	}
+	used[address] = true
+
+	s.seq++
+	iface := &compute.NetworkInterface{
+		Index:            strconv.Itoa(index),
+		MacAddress:       fmt.Sprintf("d0:0d:%02x:%02x:%02x:%02x", byte(s.seq>>24), byte(s.seq>>16), byte(s.seq>>8), byte(s.seq)),
+		SubnetId:         subnet.Id,
+		PrimaryV4Address: &compute.PrimaryAddress{Address: address},
+		SecurityGroupIds: spec.SecurityGroupIds,
+	}
+	if natSpec := spec.GetPrimaryV4AddressSpec().GetOneToOneNatSpec(); natSpec != nil {
+		natAddress := natSpec.Address
+		if natAddress == "" {
+			// Addresses from TEST-NET-2 block, RFC 5737.
+			natAddress = fmt.Sprintf("198.51.100.%d", s.seq%254+1)
+		}
+		iface.PrimaryV4Address.OneToOneNat = &compute.OneToOneNat{
+			Address:   natAddress,
+			IpVersion: compute.IpVersion_IPV4,
+		}
+	}
+	return iface, nil
+}
+
+// releaseAddresses frees addresses of the instance network interfaces. Must be called with s.mu held.
+func (s *Server) releaseAddresses(instance *compute.Instance) {
+	for _, iface := range instance.NetworkInterfaces {
+		delete(s.usedAddresses[iface.SubnetId], iface.GetPrimaryV4Address().GetAddress())
+	}
+}
+
+func freeAddress(cidrBlocks []string, used map[string]bool) (string, error) {
+	for _, cidr := range cidrBlocks {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		base := binary.BigEndian.Uint32(ipNet.IP.To4())
+		ones, bits := ipNet.Mask.Size()
+		size := uint32(1) << uint(bits-ones)
+		// The last address of a subnet is broadcast one.
+		for offset := uint32(reservedSubnetAddresses); offset+1 < size; offset++ {
+			ip := make(net.IP, 4)
+			binary.BigEndian.PutUint32(ip, base+offset)
+			if !used[ip.String()] {
+				return ip.String(), nil
+			}
+		}
+	}
+	return "", status.Error(codes.ResourceExhausted, "no free addresses left in the subnet")
+}
@@ -0,0 +1,367 @@
+// Package fakecloud implements in-memory Yandex.Cloud API server, so that provider code
+// can be tested without network access and without real cloud resources.
+//
+// The server implements Operation, Compute (disks, images and instances), VPC (networks and subnets),
+// IAM (service accounts, their authorized and static access keys, predefined roles), ResourceManager
+// (clouds and folders), KMS (encryption), Lockbox (secrets and their payload) and OrganizationManager
+// (groups and their members, SAML federations, their certificates and federated users) services. Changes made by
+// an API call are visible right away, while the returned operation becomes done only after
+// it was polled, like long-running operations of the real API.
+package fakecloud
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1/awscompatibility"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1/saml"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+const (
+	// CloudID is ID of the cloud which exists in every server.
+	CloudID = "b1gfakecloud00000000"
+	// FolderID is ID of the folder which exists in every server.
+	FolderID = "b1gfakefolder0000000"
+	// OrganizationID is ID of the organization which exists in every server, groups can be created in it only.
+	OrganizationID = "bpffakeorganization0"
+	// Token is the only IAM token accepted by the server.
+	Token = "t1.fake.token"
+)
+
+// services are API endpoints announced by the server, all of them are served on the same address.
+var services = []string{"endpoint", "operation", "compute", "vpc", "iam", "resource-manager", "kms-crypto", "lockbox", "lockbox-payload", "organization-manager"}
+
+// Server is in-memory Yandex.Cloud API server listening on a local port.
+type Server struct {
+	grpcServer *grpc.Server
+	listener   net.Listener
+
+	operationPolls int
+	maxPageSize    int64
+
+	mu         sync.Mutex
+	seq        int
+	operations map[string]*pendingOperation
+	failures   map[string]error
+	calls      map[string]int
+
+	clouds          map[string]*resourcemanager.Cloud
+	folders         map[string]*resourcemanager.Folder
+	networks        map[string]*vpc.Network
+	subnets         map[string]*vpc.Subnet
+	disks           map[string]*compute.Disk
+	images          map[string]*compute.Image
+	instances       map[string]*compute.Instance
+	serviceAccounts map[string]*iam.ServiceAccount
+	keys            map[string]*iam.Key
+	accessKeys      map[string]*awscompatibility.AccessKey
+	accessBindings  map[string][]*access.AccessBinding
+	lockboxSecrets  map[string]*lockbox.Secret
+	// lockboxPayloads keeps entries of secret versions by version ID.
+	lockboxPayloads map[string][]*lockbox.Payload_Entry
+	groups          map[string]*organizationmanager.Group
+	// groupMembers keeps members of groups by group ID.
+	groupMembers     map[string][]*organizationmanager.GroupMember
+	samlFederations  map[string]*saml.Federation
+	samlCertificates map[string]*saml.Certificate
+	// federatedUsers keeps federated user accounts by user account ID.
+	federatedUsers map[string]*organizationmanager.SamlUserAccount
+
+	// usedAddresses keeps allocated addresses by subnet ID.
+	usedAddresses map[string]map[string]bool
+}
+
+// Option configures the server.
+type Option func(*Server)
+
+// WithOperationPolls sets how many times an operation should be polled before it is done.
+// Zero makes every operation done as soon as it is returned. Default is 1.
+func WithOperationPolls(polls int) Option {
+	return func(s *Server) {
+		s.operationPolls = polls
+	}
+}
+
+// WithMaxPageSize limits the number of items returned by List methods, larger page sizes are reduced to it.
+// Default is 1000.
+func WithMaxPageSize(size int64) Option {
+	return func(s *Server) {
+		s.maxPageSize = size
+	}
+}
+
+// Start creates the server with one cloud and one folder in it and starts serving on a local port.
+func Start(opts ...Option) (*Server, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		l, err = net.Listen("tcp6", "[::1]:0")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on local port: %s", err)
+	}
+
+	s := &Server{
+		listener:         l,
+		operationPolls:   1,
+		maxPageSize:      1000,
+		operations:       make(map[string]*pendingOperation),
+		failures:         make(map[string]error),
+		calls:            make(map[string]int),
+		clouds:           make(map[string]*resourcemanager.Cloud),
+		folders:          make(map[string]*resourcemanager.Folder),
+		networks:         make(map[string]*vpc.Network),
+		subnets:          make(map[string]*vpc.Subnet),
+		disks:            make(map[string]*compute.Disk),
+		images:           make(map[string]*compute.Image),
+		instances:        make(map[string]*compute.Instance),
+		serviceAccounts:  make(map[string]*iam.ServiceAccount),
+		keys:             make(map[string]*iam.Key),
+		accessKeys:       make(map[string]*awscompatibility.AccessKey),
+		accessBindings:   make(map[string][]*access.AccessBinding),
+		lockboxSecrets:   make(map[string]*lockbox.Secret),
+		lockboxPayloads:  make(map[string][]*lockbox.Payload_Entry),
+		groups:           make(map[string]*organizationmanager.Group),
+		groupMembers:     make(map[string][]*organizationmanager.GroupMember),
+		samlFederations:  make(map[string]*saml.Federation),
+		samlCertificates: make(map[string]*saml.Certificate),
+		federatedUsers:   make(map[string]*organizationmanager.SamlUserAccount),
+		usedAddresses:    make(map[string]map[string]bool),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.clouds[CloudID] = &resourcemanager.Cloud{
+		Id:        CloudID,
+		Name:      "fake-cloud",
+		CreatedAt: now(),
+	}
+	s.folders[FolderID] = &resourcemanager.Folder{
+		Id:        FolderID,
+		CloudId:   CloudID,
+		Name:      "fake-folder",
+		CreatedAt: now(),
+		Status:    resourcemanager.Folder_ACTIVE,
+	}
+
+	s.grpcServer = grpc.NewServer(grpc.UnaryInterceptor(s.intercept))
+	endpoint.RegisterApiEndpointServiceServer(s.grpcServer, &endpointService{s: s})
+	operation.RegisterOperationServiceServer(s.grpcServer, &operationService{s: s})
+	compute.RegisterDiskServiceServer(s.grpcServer, &diskService{s: s})
+	compute.RegisterImageServiceServer(s.grpcServer, &imageService{s: s})
+	compute.RegisterInstanceServiceServer(s.grpcServer, &instanceService{s: s})
+	vpc.RegisterNetworkServiceServer(s.grpcServer, &networkService{s: s})
+	vpc.RegisterSubnetServiceServer(s.grpcServer, &subnetService{s: s})
+	iam.RegisterServiceAccountServiceServer(s.grpcServer, &serviceAccountService{s: s})
+	iam.RegisterKeyServiceServer(s.grpcServer, &keyService{s: s})
+	iam.RegisterRoleServiceServer(s.grpcServer, &roleService{s: s})
+	awscompatibility.RegisterAccessKeyServiceServer(s.grpcServer, &accessKeyService{s: s})
+	resourcemanager.RegisterCloudServiceServer(s.grpcServer, &cloudService{s: s})
+	resourcemanager.RegisterFolderServiceServer(s.grpcServer, &folderService{s: s})
+	kms.RegisterSymmetricCryptoServiceServer(s.grpcServer, &symmetricCryptoService{s: s})
+	lockbox.RegisterSecretServiceServer(s.grpcServer, &lockboxSecretService{s: s})
+	lockbox.RegisterPayloadServiceServer(s.grpcServer, &lockboxPayloadService{s: s})
+	organizationmanager.RegisterGroupServiceServer(s.grpcServer, &groupService{s: s})
+	organizationmanager.RegisterUserServiceServer(s.grpcServer, &organizationUserService{s: s})
+	saml.RegisterFederationServiceServer(s.grpcServer, &samlFederationService{s: s})
+	saml.RegisterCertificateServiceServer(s.grpcServer, &samlCertificateService{s: s})
+	iam.RegisterUserAccountServiceServer(s.grpcServer, &userAccountService{s: s})
+
+	go func() { _ = s.grpcServer.Serve(l) }()
+	return s, nil
+}
+
+// Addr returns address the server listens on, it should be used as API endpoint with plaintext connection.
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Stop stops the server and closes all connections.
+func (s *Server) Stop() {
+	s.grpcServer.Stop()
+}
+
+// FailNext makes the next call of the method fail with the error. Method is full gRPC method name,
+// i.e. "/yandex.cloud.vpc.v1.NetworkService/Create".
+func (s *Server) FailNext(method string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[method] = err
+}
+
+// Calls returns number of calls of the method made so far.
+func (s *Server) Calls(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+func (s *Server) intercept(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	s.mu.Lock()
+	s.calls[info.FullMethod]++
+	err, fail := s.failures[info.FullMethod]
+	delete(s.failures, info.FullMethod)
+	s.mu.Unlock()
+
+	if fail {
+		return nil, err
+	}
+
+	// SDK doesn't authenticate endpoint discovery.
+	if !strings.HasPrefix(info.FullMethod, "/yandex.cloud.endpoint.") {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if auth := md.Get("authorization"); len(auth) == 0 || auth[0] != "Bearer "+Token {
+			return nil, status.Error(codes.Unauthenticated, "the request is missing valid IAM token")
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+type endpointService struct {
+	endpoint.UnimplementedApiEndpointServiceServer
+	s *Server
+}
+
+func (e *endpointService) Get(_ context.Context, req *endpoint.GetApiEndpointRequest) (*endpoint.ApiEndpoint, error) {
+	for _, id := range services {
+		if id == req.ApiEndpointId {
+			return &endpoint.ApiEndpoint{Id: id, Address: e.s.Addr()}, nil
+		}
+	}
+	return nil, notFound("API endpoint", req.ApiEndpointId)
+}
+
+func (e *endpointService) List(context.Context, *endpoint.ListApiEndpointsRequest) (*endpoint.ListApiEndpointsResponse, error) {
+	resp := &endpoint.ListApiEndpointsResponse{}
+	for _, id := range services {
+		resp.Endpoints = append(resp.Endpoints, &endpoint.ApiEndpoint{Id: id, Address: e.s.Addr()})
+	}
+	return resp, nil
+}
+
+// newID returns unique ID with the prefix, it has the length of real IDs. Must be called with s.mu held.
+func (s *Server) newID(prefix string) string {
+	s.seq++
+	return fmt.Sprintf("%s%0*d", prefix, 20-len(prefix), s.seq)
+}
+
+// requireFolder returns error if the folder doesn't exist. Must be called with s.mu held.
+func (s *Server) requireFolder(folderID string) error {
+	if folderID == "" {
+		return status.Error(codes.InvalidArgument, "folder_id is required")
+	}
+	if _, ok := s.folders[folderID]; !ok {
+		return notFound("Folder", folderID)
+	}
+	return nil
+}
+
+func notFound(kind, id string) error {
+	return status.Errorf(codes.NotFound, "%s %s not found", kind, id)
+}
+
+// applyUpdateMask copies fields listed in the mask from an update request into the resource.
+// Fields are matched by name, so only top-level fields having the same name and type are supported.
+func applyUpdateMask(resource, request proto.Message, mask *fieldmaskpb.FieldMask) error {
+	dst := resource.ProtoReflect()
+	src := proto.Clone(request).ProtoReflect()
+	for _, path := range mask.GetPaths() {
+		srcField := src.Descriptor().Fields().ByName(protoreflect.Name(path))
+		dstField := dst.Descriptor().Fields().ByName(protoreflect.Name(path))
+		if srcField == nil || dstField == nil || !sameFieldType(srcField, dstField) {
+			return status.Errorf(codes.InvalidArgument, "unsupported update mask path %q", path)
+		}
+		if src.Has(srcField) {
+			dst.Set(dstField, src.Get(srcField))
+		} else {
+			dst.Clear(dstField)
+		}
+	}
+	return nil
+}
+
+func sameFieldType(a, b protoreflect.FieldDescriptor) bool {
+	if a.Kind() != b.Kind() || a.Cardinality() != b.Cardinality() || a.IsMap() != b.IsMap() {
+		return false
+	}
+	if a.IsMap() {
+		return sameFieldType(a.MapKey(), b.MapKey()) && sameFieldType(a.MapValue(), b.MapValue())
+	}
+	if a.Message() != nil {
+		return a.Message().FullName() == b.Message().FullName()
+	}
+	if a.Enum() != nil {
+		return a.Enum().FullName() == b.Enum().FullName()
+	}
+	return true
+}
+
+// matchFilter supports the only filter used by the provider: `name = "<name>"`. Empty filter matches everything.
+func matchFilter(filter, name string) (bool, error) {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return true, nil
+	}
+	parts := strings.SplitN(filter, "=", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) != "name" {
+		return false, status.Errorf(codes.InvalidArgument, "unsupported filter %q", filter)
+	}
+	value, err := strconv.Unquote(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return false, status.Errorf(codes.InvalidArgument, "invalid filter value in %q", filter)
+	}
+	return value == name, nil
+}
+
+// paginate returns the page of sorted IDs and the token of the next page.
+func (s *Server) paginate(ids []string, pageSize int64, pageToken string) ([]string, string, error) {
+	sort.Strings(ids)
+	start, end, next, err := s.pageBounds(len(ids), pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return ids[start:end], next, nil
+}
+
+// pageBounds returns bounds of the requested page of total items and the token of the next page.
+func (s *Server) pageBounds(total int, pageSize int64, pageToken string) (start, end int, next string, err error) {
+	if pageToken != "" {
+		start, err = strconv.Atoi(pageToken)
+		if err != nil || start < 0 || start > total {
+			return 0, 0, "", status.Errorf(codes.InvalidArgument, "invalid page token %q", pageToken)
+		}
+	}
+	if pageSize <= 0 || pageSize > s.maxPageSize {
+		pageSize = s.maxPageSize
+	}
+
+	end = start + int(pageSize)
+	if end >= total {
+		return start, total, "", nil
+	}
+	return start, end, strconv.Itoa(end), nil
+}
@@ -0,0 +1,270 @@
+package fakecloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+func startServer(t *testing.T, token string, opts ...Option) (*Server, *ycsdk.SDK) {
+	s, err := Start(opts...)
+	require.NoError(t, err)
+	t.Cleanup(s.Stop)
+
+	sdk, err := ycsdk.Build(context.Background(), ycsdk.Config{
+		Credentials: ycsdk.NewIAMTokenCredentials(token),
+		Endpoint:    s.Addr(),
+		Plaintext:   true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sdk.Shutdown(context.Background()) })
+	return s, sdk
+}
+
+func requireCode(t *testing.T, code codes.Code, err error) {
+	require.Error(t, err)
+	assert.Equal(t, code, status.Code(err), "unexpected error: %s", err)
+}
+
+func TestOperationIsDoneAfterPolls(t *testing.T) {
+	ctx := context.Background()
+	s, sdk := startServer(t, Token, WithOperationPolls(2))
+
+	op, err := sdk.WrapOperation(sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{FolderId: FolderID, Name: "net"}))
+	require.NoError(t, err)
+	assert.False(t, op.Done())
+
+	md, err := op.Metadata()
+	require.NoError(t, err)
+	networkID := md.(*vpc.CreateNetworkMetadata).NetworkId
+
+	// Changes are visible before the operation is done.
+	_, err = sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{NetworkId: networkID})
+	require.NoError(t, err)
+
+	require.NoError(t, op.Wait(ctx))
+	assert.Equal(t, 2, s.Calls("/yandex.cloud.operation.OperationService/Get"))
+
+	resp, err := op.Response()
+	require.NoError(t, err)
+	assert.Equal(t, networkID, resp.(*vpc.Network).Id)
+}
+
+func TestNetworkLifecycle(t *testing.T) {
+	ctx := context.Background()
+	_, sdk := startServer(t, Token)
+
+	op, err := sdk.WrapOperation(sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{FolderId: FolderID, Name: "net"}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	md, _ := op.Metadata()
+	networkID := md.(*vpc.CreateNetworkMetadata).NetworkId
+
+	op, err = sdk.WrapOperation(sdk.VPC().Subnet().Create(ctx, &vpc.CreateSubnetRequest{
+		FolderId:     FolderID,
+		NetworkId:    networkID,
+		ZoneId:       "ru-central1-a",
+		V4CidrBlocks: []string{"10.1.0.0/24"},
+	}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	md, _ = op.Metadata()
+	subnetID := md.(*vpc.CreateSubnetMetadata).SubnetId
+
+	_, err = sdk.VPC().Network().Delete(ctx, &vpc.DeleteNetworkRequest{NetworkId: networkID})
+	requireCode(t, codes.FailedPrecondition, err)
+
+	op, err = sdk.WrapOperation(sdk.VPC().Network().Update(ctx, &vpc.UpdateNetworkRequest{
+		NetworkId:  networkID,
+		Name:       "renamed",
+		Labels:     map[string]string{"env": "test"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "labels"}},
+	}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+
+	list, err := sdk.VPC().Network().List(ctx, &vpc.ListNetworksRequest{FolderId: FolderID, Filter: `name = "renamed"`})
+	require.NoError(t, err)
+	require.Len(t, list.Networks, 1)
+	assert.Equal(t, map[string]string{"env": "test"}, list.Networks[0].Labels)
+
+	subnets, err := sdk.VPC().Network().ListSubnets(ctx, &vpc.ListNetworkSubnetsRequest{NetworkId: networkID})
+	require.NoError(t, err)
+	require.Len(t, subnets.Subnets, 1)
+	assert.Equal(t, subnetID, subnets.Subnets[0].Id)
+
+	op, err = sdk.WrapOperation(sdk.VPC().Subnet().Delete(ctx, &vpc.DeleteSubnetRequest{SubnetId: subnetID}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	op, err = sdk.WrapOperation(sdk.VPC().Network().Delete(ctx, &vpc.DeleteNetworkRequest{NetworkId: networkID}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+
+	_, err = sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{NetworkId: networkID})
+	requireCode(t, codes.NotFound, err)
+}
+
+func TestInstanceAttachesDisksAndAddresses(t *testing.T) {
+	ctx := context.Background()
+	_, sdk := startServer(t, Token, WithOperationPolls(0))
+
+	op, _ := sdk.WrapOperation(sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{FolderId: FolderID}))
+	md, _ := op.Metadata()
+	op, err := sdk.WrapOperation(sdk.VPC().Subnet().Create(ctx, &vpc.CreateSubnetRequest{
+		FolderId:     FolderID,
+		NetworkId:    md.(*vpc.CreateNetworkMetadata).NetworkId,
+		ZoneId:       "ru-central1-a",
+		V4CidrBlocks: []string{"10.2.0.0/24"},
+	}))
+	require.NoError(t, err)
+	md, _ = op.Metadata()
+	subnetID := md.(*vpc.CreateSubnetMetadata).SubnetId
+
+	op, err = sdk.WrapOperation(sdk.Compute().Image().Create(ctx, &compute.CreateImageRequest{
+		FolderId:    FolderID,
+		Family:      "ubuntu",
+		MinDiskSize: 5 << 30,
+		Source:      &compute.CreateImageRequest_Uri{Uri: "https://storage.example.com/ubuntu.qcow2"},
+	}))
+	require.NoError(t, err)
+	image, err := sdk.Compute().Image().GetLatestByFamily(ctx, &compute.GetImageLatestByFamilyRequest{FolderId: FolderID, Family: "ubuntu"})
+	require.NoError(t, err)
+
+	op, err = sdk.WrapOperation(sdk.Compute().Instance().Create(ctx, &compute.CreateInstanceRequest{
+		FolderId:      FolderID,
+		Name:          "vm",
+		ZoneId:        "ru-central1-a",
+		ResourcesSpec: &compute.ResourcesSpec{Cores: 2, Memory: 2 << 30},
+		BootDiskSpec: &compute.AttachedDiskSpec{
+			AutoDelete: true,
+			Disk: &compute.AttachedDiskSpec_DiskSpec_{DiskSpec: &compute.AttachedDiskSpec_DiskSpec{
+				Size:   10 << 30,
+				Source: &compute.AttachedDiskSpec_DiskSpec_ImageId{ImageId: image.Id},
+			}},
+		},
+		NetworkInterfaceSpecs: []*compute.NetworkInterfaceSpec{{
+			SubnetId:             subnetID,
+			PrimaryV4AddressSpec: &compute.PrimaryAddressSpec{OneToOneNatSpec: &compute.OneToOneNatSpec{}},
+		}},
+	}))
+	require.NoError(t, err)
+	require.True(t, op.Done())
+	resp, err := op.Response()
+	require.NoError(t, err)
+	instance := resp.(*compute.Instance)
+
+	assert.Equal(t, compute.Instance_RUNNING, instance.Status)
+	assert.Equal(t, "10.2.0.3", instance.NetworkInterfaces[0].PrimaryV4Address.Address)
+	assert.NotEmpty(t, instance.NetworkInterfaces[0].PrimaryV4Address.OneToOneNat.Address)
+
+	bootDiskID := instance.BootDisk.DiskId
+	disk, err := sdk.Compute().Disk().Get(ctx, &compute.GetDiskRequest{DiskId: bootDiskID})
+	require.NoError(t, err)
+	assert.Equal(t, []string{instance.Id}, disk.InstanceIds)
+	assert.Equal(t, image.Id, disk.GetSourceImageId())
+
+	_, err = sdk.Compute().Disk().Delete(ctx, &compute.DeleteDiskRequest{DiskId: bootDiskID})
+	requireCode(t, codes.FailedPrecondition, err)
+
+	_, err = sdk.Compute().Instance().Update(ctx, &compute.UpdateInstanceRequest{
+		InstanceId:    instance.Id,
+		ResourcesSpec: &compute.ResourcesSpec{Cores: 4, Memory: 4 << 30},
+		UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"resources_spec"}},
+	})
+	requireCode(t, codes.FailedPrecondition, err)
+
+	_, err = sdk.Compute().Instance().Delete(ctx, &compute.DeleteInstanceRequest{InstanceId: instance.Id})
+	require.NoError(t, err)
+	_, err = sdk.Compute().Disk().Get(ctx, &compute.GetDiskRequest{DiskId: bootDiskID})
+	requireCode(t, codes.NotFound, err)
+}
+
+func TestAccessBindings(t *testing.T) {
+	ctx := context.Background()
+	_, sdk := startServer(t, Token, WithOperationPolls(0))
+
+	binding := func(role string) *access.AccessBinding {
+		return &access.AccessBinding{RoleId: role, Subject: &access.Subject{Id: "aje1", Type: "serviceAccount"}}
+	}
+
+	_, err := sdk.ResourceManager().Folder().UpdateAccessBindings(ctx, &access.UpdateAccessBindingsRequest{
+		ResourceId: FolderID,
+		AccessBindingDeltas: []*access.AccessBindingDelta{
+			{Action: access.AccessBindingAction_ADD, AccessBinding: binding("viewer")},
+			{Action: access.AccessBindingAction_ADD, AccessBinding: binding("editor")},
+			{Action: access.AccessBindingAction_ADD, AccessBinding: binding("viewer")},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = sdk.ResourceManager().Folder().UpdateAccessBindings(ctx, &access.UpdateAccessBindingsRequest{
+		ResourceId: FolderID,
+		AccessBindingDeltas: []*access.AccessBindingDelta{
+			{Action: access.AccessBindingAction_REMOVE, AccessBinding: binding("viewer")},
+		},
+	})
+	require.NoError(t, err)
+
+	list, err := sdk.ResourceManager().Folder().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{ResourceId: FolderID})
+	require.NoError(t, err)
+	require.Len(t, list.AccessBindings, 1)
+	assert.Equal(t, "editor", list.AccessBindings[0].RoleId)
+
+	_, err = sdk.ResourceManager().Cloud().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{ResourceId: "unknown"})
+	requireCode(t, codes.NotFound, err)
+}
+
+func TestFoldersPagination(t *testing.T) {
+	ctx := context.Background()
+	_, sdk := startServer(t, Token, WithOperationPolls(0))
+
+	for _, name := range []string{"a", "b"} {
+		_, err := sdk.ResourceManager().Folder().Create(ctx, &resourcemanager.CreateFolderRequest{CloudId: CloudID, Name: name})
+		require.NoError(t, err)
+	}
+
+	var names []string
+	req := &resourcemanager.ListFoldersRequest{CloudId: CloudID, PageSize: 2}
+	for {
+		resp, err := sdk.ResourceManager().Folder().List(ctx, req)
+		require.NoError(t, err)
+		for _, f := range resp.Folders {
+			names = append(names, f.Name)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	assert.ElementsMatch(t, []string{"fake-folder", "a", "b"}, names)
+}
+
+func TestFailNext(t *testing.T) {
+	ctx := context.Background()
+	s, sdk := startServer(t, Token)
+
+	s.FailNext("/yandex.cloud.resourcemanager.v1.FolderService/Get", status.Error(codes.Unavailable, "try again"))
+	_, err := sdk.ResourceManager().Folder().Get(ctx, &resourcemanager.GetFolderRequest{FolderId: FolderID})
+	requireCode(t, codes.Unavailable, err)
+
+	_, err = sdk.ResourceManager().Folder().Get(ctx, &resourcemanager.GetFolderRequest{FolderId: FolderID})
+	require.NoError(t, err)
+}
+
+func TestRejectsUnknownToken(t *testing.T) {
+	_, sdk := startServer(t, "t1.other.token")
+
+	_, err := sdk.ResourceManager().Folder().Get(context.Background(), &resourcemanager.GetFolderRequest{FolderId: FolderID})
+	requireCode(t, codes.Unauthenticated, err)
+}
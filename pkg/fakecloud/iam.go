@@ -0,0 +1,366 @@
+package fakecloud
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1/awscompatibility"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+)
+
+type serviceAccountService struct {
+	iam.UnimplementedServiceAccountServiceServer
+	s *Server
+}
+
+func (sa *serviceAccountService) Get(_ context.Context, req *iam.GetServiceAccountRequest) (*iam.ServiceAccount, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+
+	account, ok := sa.s.serviceAccounts[req.ServiceAccountId]
+	if !ok {
+		return nil, notFound("Service account", req.ServiceAccountId)
+	}
+	return proto.Clone(account).(*iam.ServiceAccount), nil
+}
+
+func (sa *serviceAccountService) List(_ context.Context, req *iam.ListServiceAccountsRequest) (*iam.ListServiceAccountsResponse, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+
+	var ids []string
+	for id, account := range sa.s.serviceAccounts {
+		match, err := matchFilter(req.Filter, account.Name)
+		if err != nil {
+			return nil, err
+		}
+		if account.FolderId == req.FolderId && match {
+			ids = append(ids, id)
+		}
+	}
+	page, next, err := sa.s.paginate(ids, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &iam.ListServiceAccountsResponse{NextPageToken: next}
+	for _, id := range page {
+		resp.ServiceAccounts = append(resp.ServiceAccounts, proto.Clone(sa.s.serviceAccounts[id]).(*iam.ServiceAccount))
+	}
+	return resp, nil
+}
+
+func (sa *serviceAccountService) Create(_ context.Context, req *iam.CreateServiceAccountRequest) (*operation.Operation, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+
+	if err := sa.s.requireFolder(req.FolderId); err != nil {
+		return nil, err
+	}
+
+	account := &iam.ServiceAccount{
+		Id:          sa.s.newID("aje"),
+		FolderId:    req.FolderId,
+		CreatedAt:   now(),
+		Name:        req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+	}
+	sa.s.serviceAccounts[account.Id] = account
+
+	return sa.s.newOperation("Create service account", &iam.CreateServiceAccountMetadata{ServiceAccountId: account.Id}, account)
+}
+
+func (sa *serviceAccountService) Update(_ context.Context, req *iam.UpdateServiceAccountRequest) (*operation.Operation, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+
+	account, ok := sa.s.serviceAccounts[req.ServiceAccountId]
+	if !ok {
+		return nil, notFound("Service account", req.ServiceAccountId)
+	}
+	if err := applyUpdateMask(account, req, req.UpdateMask); err != nil {
+		return nil, err
+	}
+
+	return sa.s.newOperation("Update service account", &iam.UpdateServiceAccountMetadata{ServiceAccountId: account.Id}, account)
+}
+
+func (sa *serviceAccountService) Delete(_ context.Context, req *iam.DeleteServiceAccountRequest) (*operation.Operation, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+
+	if _, ok := sa.s.serviceAccounts[req.ServiceAccountId]; !ok {
+		return nil, notFound("Service account", req.ServiceAccountId)
+	}
+	delete(sa.s.serviceAccounts, req.ServiceAccountId)
+	delete(sa.s.accessBindings, req.ServiceAccountId)
+	for id, key := range sa.s.keys {
+		if key.GetServiceAccountId() == req.ServiceAccountId {
+			delete(sa.s.keys, id)
+		}
+	}
+	for id, key := range sa.s.accessKeys {
+		if key.ServiceAccountId == req.ServiceAccountId {
+			delete(sa.s.accessKeys, id)
+		}
+	}
+
+	return sa.s.newOperation("Delete service account", &iam.DeleteServiceAccountMetadata{ServiceAccountId: req.ServiceAccountId}, nil)
+}
+
+func (sa *serviceAccountService) ListAccessBindings(_ context.Context, req *access.ListAccessBindingsRequest) (*access.ListAccessBindingsResponse, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+	return sa.bindings().list(req)
+}
+
+func (sa *serviceAccountService) SetAccessBindings(_ context.Context, req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+	return sa.bindings().set(req)
+}
+
+func (sa *serviceAccountService) UpdateAccessBindings(_ context.Context, req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+	return sa.bindings().update(req)
+}
+
+func (sa *serviceAccountService) bindings() accessBindings {
+	return accessBindings{s: sa.s, kind: "Service account", exists: func(id string) bool {
+		_, ok := sa.s.serviceAccounts[id]
+		return ok
+	}}
+}
+
+type keyService struct {
+	iam.UnimplementedKeyServiceServer
+	s *Server
+}
+
+func (ks *keyService) Get(_ context.Context, req *iam.GetKeyRequest) (*iam.Key, error) {
+	ks.s.mu.Lock()
+	defer ks.s.mu.Unlock()
+
+	key, ok := ks.s.keys[req.KeyId]
+	if !ok {
+		return nil, notFound("Key", req.KeyId)
+	}
+	return proto.Clone(key).(*iam.Key), nil
+}
+
+func (ks *keyService) List(_ context.Context, req *iam.ListKeysRequest) (*iam.ListKeysResponse, error) {
+	ks.s.mu.Lock()
+	defer ks.s.mu.Unlock()
+
+	var ids []string
+	for id, key := range ks.s.keys {
+		if key.GetServiceAccountId() == req.ServiceAccountId {
+			ids = append(ids, id)
+		}
+	}
+	page, next, err := ks.s.paginate(ids, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &iam.ListKeysResponse{NextPageToken: next}
+	for _, id := range page {
+		resp.Keys = append(resp.Keys, proto.Clone(ks.s.keys[id]).(*iam.Key))
+	}
+	return resp, nil
+}
+
+// Create returns the key right away, like the real API does. Generated keys are not valid key pairs.
+func (ks *keyService) Create(_ context.Context, req *iam.CreateKeyRequest) (*iam.CreateKeyResponse, error) {
+	ks.s.mu.Lock()
+	defer ks.s.mu.Unlock()
+
+	if _, ok := ks.s.serviceAccounts[req.ServiceAccountId]; !ok {
+		return nil, notFound("Service account", req.ServiceAccountId)
+	}
+
+	key := &iam.Key{
+		Id:           ks.s.newID("aje"),
+		Subject:      &iam.Key_ServiceAccountId{ServiceAccountId: req.ServiceAccountId},
+		CreatedAt:    now(),
+		Description:  req.Description,
+		KeyAlgorithm: req.KeyAlgorithm,
+	}
+	key.PublicKey = fmt.Sprintf("fake public key %s", key.Id)
+	ks.s.keys[key.Id] = key
+
+	return &iam.CreateKeyResponse{
+		Key:        proto.Clone(key).(*iam.Key),
+		PrivateKey: fmt.Sprintf("fake private key %s", key.Id),
+	}, nil
+}
+
+func (ks *keyService) Update(_ context.Context, req *iam.UpdateKeyRequest) (*operation.Operation, error) {
+	ks.s.mu.Lock()
+	defer ks.s.mu.Unlock()
+
+	key, ok := ks.s.keys[req.KeyId]
+	if !ok {
+		return nil, notFound("Key", req.KeyId)
+	}
+	if err := applyUpdateMask(key, req, req.UpdateMask); err != nil {
+		return nil, err
+	}
+
+	return ks.s.newOperation("Update key", &iam.UpdateKeyMetadata{KeyId: key.Id}, key)
+}
+
+func (ks *keyService) Delete(_ context.Context, req *iam.DeleteKeyRequest) (*operation.Operation, error) {
+	ks.s.mu.Lock()
+	defer ks.s.mu.Unlock()
+
+	if _, ok := ks.s.keys[req.KeyId]; !ok {
+		return nil, notFound("Key", req.KeyId)
+	}
+	delete(ks.s.keys, req.KeyId)
+
+	return ks.s.newOperation("Delete key", &iam.DeleteKeyMetadata{KeyId: req.KeyId}, nil)
+}
+
+type accessKeyService struct {
+	awscompatibility.UnimplementedAccessKeyServiceServer
+	s *Server
+}
+
+func (ak *accessKeyService) Get(_ context.Context, req *awscompatibility.GetAccessKeyRequest) (*awscompatibility.AccessKey, error) {
+	ak.s.mu.Lock()
+	defer ak.s.mu.Unlock()
+
+	key, ok := ak.s.accessKeys[req.AccessKeyId]
+	if !ok {
+		return nil, notFound("Access key", req.AccessKeyId)
+	}
+	return proto.Clone(key).(*awscompatibility.AccessKey), nil
+}
+
+func (ak *accessKeyService) List(_ context.Context, req *awscompatibility.ListAccessKeysRequest) (*awscompatibility.ListAccessKeysResponse, error) {
+	ak.s.mu.Lock()
+	defer ak.s.mu.Unlock()
+
+	var ids []string
+	for id, key := range ak.s.accessKeys {
+		if key.ServiceAccountId == req.ServiceAccountId {
+			ids = append(ids, id)
+		}
+	}
+	page, next, err := ak.s.paginate(ids, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &awscompatibility.ListAccessKeysResponse{NextPageToken: next}
+	for _, id := range page {
+		resp.AccessKeys = append(resp.AccessKeys, proto.Clone(ak.s.accessKeys[id]).(*awscompatibility.AccessKey))
+	}
+	return resp, nil
+}
+
+// Create returns the key right away, like the real API does.
+func (ak *accessKeyService) Create(_ context.Context, req *awscompatibility.CreateAccessKeyRequest) (*awscompatibility.CreateAccessKeyResponse, error) {
+	ak.s.mu.Lock()
+	defer ak.s.mu.Unlock()
+
+	if _, ok := ak.s.serviceAccounts[req.ServiceAccountId]; !ok {
+		return nil, notFound("Service account", req.ServiceAccountId)
+	}
+
+	key := &awscompatibility.AccessKey{
+		Id:               ak.s.newID("aje"),
+		ServiceAccountId: req.ServiceAccountId,
+		CreatedAt:        now(),
+		Description:      req.Description,
+	}
+	key.KeyId = fmt.Sprintf("YCA%s", key.Id)
+	ak.s.accessKeys[key.Id] = key
+
+	return &awscompatibility.CreateAccessKeyResponse{
+		AccessKey: proto.Clone(key).(*awscompatibility.AccessKey),
+		Secret:    fmt.Sprintf("fake secret %s", key.Id),
+	}, nil
+}
+
+func (ak *accessKeyService) Update(_ context.Context, req *awscompatibility.UpdateAccessKeyRequest) (*operation.Operation, error) {
+	ak.s.mu.Lock()
+	defer ak.s.mu.Unlock()
+
+	key, ok := ak.s.accessKeys[req.AccessKeyId]
+	if !ok {
+		return nil, notFound("Access key", req.AccessKeyId)
+	}
+	if err := applyUpdateMask(key, req, req.UpdateMask); err != nil {
+		return nil, err
+	}
+
+	return ak.s.newOperation("Update access key", &awscompatibility.UpdateAccessKeyMetadata{AccessKeyId: key.Id}, key)
+}
+
+func (ak *accessKeyService) Delete(_ context.Context, req *awscompatibility.DeleteAccessKeyRequest) (*operation.Operation, error) {
+	ak.s.mu.Lock()
+	defer ak.s.mu.Unlock()
+
+	if _, ok := ak.s.accessKeys[req.AccessKeyId]; !ok {
+		return nil, notFound("Access key", req.AccessKeyId)
+	}
+	delete(ak.s.accessKeys, req.AccessKeyId)
+
+	return ak.s.newOperation("Delete access key", &awscompatibility.DeleteAccessKeyMetadata{AccessKeyId: req.AccessKeyId}, nil)
+}
+
+// roles is the catalog of predefined roles returned by the role service, sorted by ID.
+// It is a small subset of the real catalog which covers roles used in tests.
+var roles = []*iam.Role{
+	{Id: "admin", Description: "Allows managing the resource and access to it"},
+	{Id: "compute.admin", Description: "Allows managing Compute Cloud resources and access to them"},
+	{Id: "compute.editor", Description: "Allows managing Compute Cloud resources"},
+	{Id: "compute.viewer", Description: "Allows viewing Compute Cloud resources"},
+	{Id: "editor", Description: "Allows managing the resource"},
+	{Id: "iam.serviceAccounts.user", Description: "Allows using service accounts"},
+	{Id: "kms.keys.encrypterDecrypter", Description: "Allows encrypting and decrypting data with KMS keys"},
+	{Id: "lockbox.payloadViewer", Description: "Allows viewing the contents of Lockbox secrets"},
+	{Id: "resource-manager.clouds.member", Description: "Allows accessing resources of the cloud"},
+	{Id: "viewer", Description: "Allows viewing the resource"},
+	{Id: "vpc.publicAdmin", Description: "Allows managing public addresses and external connectivity of networks"},
+}
+
+type roleService struct {
+	iam.UnimplementedRoleServiceServer
+	s *Server
+}
+
+func (rs *roleService) Get(_ context.Context, req *iam.GetRoleRequest) (*iam.Role, error) {
+	for _, role := range roles {
+		if role.Id == req.RoleId {
+			return proto.Clone(role).(*iam.Role), nil
+		}
+	}
+	return nil, notFound("Role", req.RoleId)
+}
+
+func (rs *roleService) List(_ context.Context, req *iam.ListRolesRequest) (*iam.ListRolesResponse, error) {
+	if req.Filter != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported filter %q", req.Filter)
+	}
+	start, end, next, err := rs.s.pageBounds(len(roles), req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &iam.ListRolesResponse{NextPageToken: next}
+	for _, role := range roles[start:end] {
+		resp.Roles = append(resp.Roles, proto.Clone(role).(*iam.Role))
+	}
+	return resp, nil
+}
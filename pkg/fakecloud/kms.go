@@ -0,0 +1,46 @@
+package fakecloud
+
+import (
+	"bytes"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+)
+
+type symmetricCryptoService struct {
+	kms.UnimplementedSymmetricCryptoServiceServer
+	s *Server
+}
+
+// fakeCiphertext is not encryption at all, it only binds the plaintext to the key and the context,
+// so that decryption with another key or context fails like with the real API. Keys are not checked for existence.
+func fakeCiphertext(keyID string, aadContext, plaintext []byte) []byte {
+	header := []byte("fake:" + keyID + ":" + string(aadContext) + ":")
+	return append(header, plaintext...)
+}
+
+func (sc *symmetricCryptoService) Encrypt(_ context.Context, req *kms.SymmetricEncryptRequest) (*kms.SymmetricEncryptResponse, error) {
+	if req.KeyId == "" {
+		return nil, status.Error(codes.InvalidArgument, "key_id is required")
+	}
+	return &kms.SymmetricEncryptResponse{
+		KeyId:      req.KeyId,
+		VersionId:  req.KeyId,
+		Ciphertext: fakeCiphertext(req.KeyId, req.AadContext, req.Plaintext),
+	}, nil
+}
+
+func (sc *symmetricCryptoService) Decrypt(_ context.Context, req *kms.SymmetricDecryptRequest) (*kms.SymmetricDecryptResponse, error) {
+	header := fakeCiphertext(req.KeyId, req.AadContext, nil)
+	if !bytes.HasPrefix(req.Ciphertext, header) {
+		return nil, status.Error(codes.InvalidArgument, "ciphertext was not encrypted with the key and context")
+	}
+	return &kms.SymmetricDecryptResponse{
+		KeyId:     req.KeyId,
+		VersionId: req.KeyId,
+		Plaintext: req.Ciphertext[len(header):],
+	}, nil
+}
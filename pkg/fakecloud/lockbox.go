@@ -0,0 +1,135 @@
+package fakecloud
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+)
+
+type lockboxSecretService struct {
+	lockbox.UnimplementedSecretServiceServer
+	s *Server
+}
+
+func (ls *lockboxSecretService) Get(_ context.Context, req *lockbox.GetSecretRequest) (*lockbox.Secret, error) {
+	ls.s.mu.Lock()
+	defer ls.s.mu.Unlock()
+
+	secret, ok := ls.s.lockboxSecrets[req.SecretId]
+	if !ok {
+		return nil, notFound("Secret", req.SecretId)
+	}
+	return proto.Clone(secret).(*lockbox.Secret), nil
+}
+
+func (ls *lockboxSecretService) Create(_ context.Context, req *lockbox.CreateSecretRequest) (*operation.Operation, error) {
+	ls.s.mu.Lock()
+	defer ls.s.mu.Unlock()
+
+	if err := ls.s.requireFolder(req.FolderId); err != nil {
+		return nil, err
+	}
+
+	secret := &lockbox.Secret{
+		Id:          ls.s.newID("e6q"),
+		FolderId:    req.FolderId,
+		CreatedAt:   now(),
+		Name:        req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+		KmsKeyId:    req.KmsKeyId,
+		Status:      lockbox.Secret_ACTIVE,
+	}
+	ls.s.lockboxSecrets[secret.Id] = secret
+	version := ls.addVersion(secret, req.VersionDescription, req.VersionPayloadEntries)
+
+	return ls.s.newOperation("Create secret", &lockbox.CreateSecretMetadata{SecretId: secret.Id, VersionId: version.Id}, secret)
+}
+
+func (ls *lockboxSecretService) AddVersion(_ context.Context, req *lockbox.AddVersionRequest) (*operation.Operation, error) {
+	ls.s.mu.Lock()
+	defer ls.s.mu.Unlock()
+
+	secret, ok := ls.s.lockboxSecrets[req.SecretId]
+	if !ok {
+		return nil, notFound("Secret", req.SecretId)
+	}
+	version := ls.addVersion(secret, req.Description, req.PayloadEntries)
+
+	return ls.s.newOperation("Add secret version", &lockbox.AddVersionMetadata{SecretId: secret.Id, VersionId: version.Id}, version)
+}
+
+// addVersion makes a new current version of the secret, entries of the current version are kept unless changed.
+// Must be called with s.mu held.
+func (ls *lockboxSecretService) addVersion(secret *lockbox.Secret, description string, changes []*lockbox.PayloadEntryChange) *lockbox.Version {
+	var entries []*lockbox.Payload_Entry
+	if secret.CurrentVersion != nil {
+		entries = append(entries, ls.s.lockboxPayloads[secret.CurrentVersion.Id]...)
+	}
+	for _, change := range changes {
+		entry := &lockbox.Payload_Entry{Key: change.Key}
+		switch v := change.Value.(type) {
+		case *lockbox.PayloadEntryChange_TextValue:
+			entry.Value = &lockbox.Payload_Entry_TextValue{TextValue: v.TextValue}
+		case *lockbox.PayloadEntryChange_BinaryValue:
+			entry.Value = &lockbox.Payload_Entry_BinaryValue{BinaryValue: v.BinaryValue}
+		}
+
+		replaced := false
+		for i := range entries {
+			if entries[i].Key == entry.Key {
+				entries[i] = entry
+				replaced = true
+			}
+		}
+		if !replaced {
+			entries = append(entries, entry)
+		}
+	}
+
+	version := &lockbox.Version{
+		Id:          ls.s.newID("e6q"),
+		SecretId:    secret.Id,
+		CreatedAt:   now(),
+		Description: description,
+		Status:      lockbox.Version_ACTIVE,
+	}
+	for _, entry := range entries {
+		version.PayloadEntryKeys = append(version.PayloadEntryKeys, entry.Key)
+	}
+	secret.CurrentVersion = version
+	ls.s.lockboxPayloads[version.Id] = entries
+	return version
+}
+
+type lockboxPayloadService struct {
+	lockbox.UnimplementedPayloadServiceServer
+	s *Server
+}
+
+func (lp *lockboxPayloadService) Get(_ context.Context, req *lockbox.GetPayloadRequest) (*lockbox.Payload, error) {
+	lp.s.mu.Lock()
+	defer lp.s.mu.Unlock()
+
+	secret, ok := lp.s.lockboxSecrets[req.SecretId]
+	if !ok {
+		return nil, notFound("Secret", req.SecretId)
+	}
+	versionID := req.VersionId
+	if versionID == "" && secret.CurrentVersion != nil {
+		versionID = secret.CurrentVersion.Id
+	}
+	entries, ok := lp.s.lockboxPayloads[versionID]
+	if !ok {
+		return nil, notFound("Version", versionID)
+	}
+
+	payload := &lockbox.Payload{VersionId: versionID}
+	for _, entry := range entries {
+		payload.Entries = append(payload.Entries, proto.Clone(entry).(*lockbox.Payload_Entry))
+	}
+	return payload, nil
+}
@@ -0,0 +1,93 @@
+package fakecloud
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+)
+
+// pollIntervalHeader tells SDK how long to wait between operation polls, in seconds.
+const pollIntervalHeader = "x-operation-poll-interval"
+
+type pendingOperation struct {
+	op        *operation.Operation
+	response  *anypb.Any
+	pollsLeft int
+}
+
+// newOperation registers operation which finishes with the response after configured number of polls.
+// Nil response means google.protobuf.Empty. Must be called with s.mu held.
+func (s *Server) newOperation(description string, md, response proto.Message) (*operation.Operation, error) {
+	if response == nil {
+		response = &emptypb.Empty{}
+	}
+	mdAny, err := anypb.New(md)
+	if err != nil {
+		return nil, err
+	}
+	responseAny, err := anypb.New(response)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pendingOperation{
+		op: &operation.Operation{
+			Id:          s.newID("fakeop"),
+			Description: description,
+			CreatedAt:   now(),
+			CreatedBy:   "fake-user",
+			ModifiedAt:  now(),
+			Metadata:    mdAny,
+		},
+		response:  responseAny,
+		pollsLeft: s.operationPolls,
+	}
+	if p.pollsLeft <= 0 {
+		p.finish()
+	}
+	s.operations[p.op.Id] = p
+	return proto.Clone(p.op).(*operation.Operation), nil
+}
+
+func (p *pendingOperation) finish() {
+	p.op.Done = true
+	p.op.ModifiedAt = now()
+	p.op.Result = &operation.Operation_Response{Response: p.response}
+}
+
+type operationService struct {
+	operation.UnimplementedOperationServiceServer
+	s *Server
+}
+
+func (o *operationService) Get(ctx context.Context, req *operation.GetOperationRequest) (*operation.Operation, error) {
+	o.s.mu.Lock()
+	defer o.s.mu.Unlock()
+
+	p, ok := o.s.operations[req.OperationId]
+	if !ok {
+		return nil, notFound("Operation", req.OperationId)
+	}
+
+	if !p.op.Done {
+		p.pollsLeft--
+		if p.pollsLeft <= 0 {
+			p.finish()
+		}
+	}
+
+	// There is no reason to wait between polls of in-memory operations.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(pollIntervalHeader, "0"))
+	return proto.Clone(p.op).(*operation.Operation), nil
+}
+
+func now() *timestamppb.Timestamp {
+	return timestamppb.Now()
+}
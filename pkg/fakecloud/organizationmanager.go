@@ -0,0 +1,169 @@
+package fakecloud
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+)
+
+type groupService struct {
+	organizationmanager.UnimplementedGroupServiceServer
+	s *Server
+}
+
+func (gs *groupService) Get(_ context.Context, req *organizationmanager.GetGroupRequest) (*organizationmanager.Group, error) {
+	gs.s.mu.Lock()
+	defer gs.s.mu.Unlock()
+
+	group, ok := gs.s.groups[req.GroupId]
+	if !ok {
+		return nil, notFound("Group", req.GroupId)
+	}
+	return proto.Clone(group).(*organizationmanager.Group), nil
+}
+
+func (gs *groupService) List(_ context.Context, req *organizationmanager.ListGroupsRequest) (*organizationmanager.ListGroupsResponse, error) {
+	gs.s.mu.Lock()
+	defer gs.s.mu.Unlock()
+
+	var ids []string
+	for id, group := range gs.s.groups {
+		match, err := matchFilter(req.Filter, group.Name)
+		if err != nil {
+			return nil, err
+		}
+		if group.OrganizationId == req.OrganizationId && match {
+			ids = append(ids, id)
+		}
+	}
+	page, next, err := gs.s.paginate(ids, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &organizationmanager.ListGroupsResponse{NextPageToken: next}
+	for _, id := range page {
+		resp.Groups = append(resp.Groups, proto.Clone(gs.s.groups[id]).(*organizationmanager.Group))
+	}
+	return resp, nil
+}
+
+func (gs *groupService) Create(_ context.Context, req *organizationmanager.CreateGroupRequest) (*operation.Operation, error) {
+	gs.s.mu.Lock()
+	defer gs.s.mu.Unlock()
+
+	if req.OrganizationId != OrganizationID {
+		return nil, notFound("Organization", req.OrganizationId)
+	}
+	for _, group := range gs.s.groups {
+		if group.OrganizationId == req.OrganizationId && group.Name == req.Name {
+			return nil, status.Errorf(codes.AlreadyExists, "Group with name %s already exists", req.Name)
+		}
+	}
+
+	group := &organizationmanager.Group{
+		Id:             gs.s.newID("aje"),
+		OrganizationId: req.OrganizationId,
+		CreatedAt:      now(),
+		Name:           req.Name,
+		Description:    req.Description,
+	}
+	gs.s.groups[group.Id] = group
+
+	return gs.s.newOperation("Create group", &organizationmanager.CreateGroupMetadata{GroupId: group.Id}, group)
+}
+
+func (gs *groupService) Update(_ context.Context, req *organizationmanager.UpdateGroupRequest) (*operation.Operation, error) {
+	gs.s.mu.Lock()
+	defer gs.s.mu.Unlock()
+
+	group, ok := gs.s.groups[req.GroupId]
+	if !ok {
+		return nil, notFound("Group", req.GroupId)
+	}
+	if err := applyUpdateMask(group, req, req.UpdateMask); err != nil {
+		return nil, err
+	}
+
+	return gs.s.newOperation("Update group", &organizationmanager.UpdateGroupMetadata{GroupId: group.Id}, group)
+}
+
+func (gs *groupService) Delete(_ context.Context, req *organizationmanager.DeleteGroupRequest) (*operation.Operation, error) {
+	gs.s.mu.Lock()
+	defer gs.s.mu.Unlock()
+
+	if _, ok := gs.s.groups[req.GroupId]; !ok {
+		return nil, notFound("Group", req.GroupId)
+	}
+	delete(gs.s.groups, req.GroupId)
+	delete(gs.s.groupMembers, req.GroupId)
+
+	return gs.s.newOperation("Delete group", &organizationmanager.DeleteGroupMetadata{GroupId: req.GroupId}, nil)
+}
+
+func (gs *groupService) ListMembers(_ context.Context, req *organizationmanager.ListGroupMembersRequest) (*organizationmanager.ListGroupMembersResponse, error) {
+	gs.s.mu.Lock()
+	defer gs.s.mu.Unlock()
+
+	if _, ok := gs.s.groups[req.GroupId]; !ok {
+		return nil, notFound("Group", req.GroupId)
+	}
+	members := gs.s.groupMembers[req.GroupId]
+	start, end, next, err := gs.s.pageBounds(len(members), req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &organizationmanager.ListGroupMembersResponse{NextPageToken: next}
+	for _, member := range members[start:end] {
+		resp.Members = append(resp.Members, proto.Clone(member).(*organizationmanager.GroupMember))
+	}
+	return resp, nil
+}
+
+// UpdateMembers applies the deltas in order. Adding an existing member and removing a missing one are no-ops.
+// Members which are service accounts of the server have `serviceAccount` type, the others are user accounts.
+func (gs *groupService) UpdateMembers(_ context.Context, req *organizationmanager.UpdateGroupMembersRequest) (*operation.Operation, error) {
+	gs.s.mu.Lock()
+	defer gs.s.mu.Unlock()
+
+	if _, ok := gs.s.groups[req.GroupId]; !ok {
+		return nil, notFound("Group", req.GroupId)
+	}
+
+	members := gs.s.groupMembers[req.GroupId]
+	for _, delta := range req.MemberDeltas {
+		pos := -1
+		for i, member := range members {
+			if member.SubjectId == delta.SubjectId {
+				pos = i
+			}
+		}
+
+		switch delta.Action {
+		case organizationmanager.MemberDelta_ADD:
+			if pos >= 0 {
+				continue
+			}
+			member := &organizationmanager.GroupMember{SubjectId: delta.SubjectId, SubjectType: "userAccount"}
+			if _, ok := gs.s.serviceAccounts[delta.SubjectId]; ok {
+				member.SubjectType = "serviceAccount"
+			}
+			members = append(members, member)
+		case organizationmanager.MemberDelta_REMOVE:
+			if pos >= 0 {
+				members = append(members[:pos:pos], members[pos+1:]...)
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid member action %v", delta.Action)
+		}
+	}
+	gs.s.groupMembers[req.GroupId] = members
+
+	return gs.s.newOperation("Update group members", &organizationmanager.UpdateGroupMembersMetadata{GroupId: req.GroupId}, nil)
+}
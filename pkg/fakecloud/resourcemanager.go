@@ -0,0 +1,237 @@
+package fakecloud
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+)
+
+type cloudService struct {
+	resourcemanager.UnimplementedCloudServiceServer
+	s *Server
+}
+
+func (c *cloudService) Get(_ context.Context, req *resourcemanager.GetCloudRequest) (*resourcemanager.Cloud, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	cloud, ok := c.s.clouds[req.CloudId]
+	if !ok {
+		return nil, notFound("Cloud", req.CloudId)
+	}
+	return proto.Clone(cloud).(*resourcemanager.Cloud), nil
+}
+
+func (c *cloudService) List(_ context.Context, req *resourcemanager.ListCloudsRequest) (*resourcemanager.ListCloudsResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	var ids []string
+	for id, cloud := range c.s.clouds {
+		match, err := matchFilter(req.Filter, cloud.Name)
+		if err != nil {
+			return nil, err
+		}
+		if match && (req.OrganizationId == "" || req.OrganizationId == cloud.OrganizationId) {
+			ids = append(ids, id)
+		}
+	}
+	page, next, err := c.s.paginate(ids, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &resourcemanager.ListCloudsResponse{NextPageToken: next}
+	for _, id := range page {
+		resp.Clouds = append(resp.Clouds, proto.Clone(c.s.clouds[id]).(*resourcemanager.Cloud))
+	}
+	return resp, nil
+}
+
+func (c *cloudService) ListAccessBindings(_ context.Context, req *access.ListAccessBindingsRequest) (*access.ListAccessBindingsResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	return c.bindings().list(req)
+}
+
+func (c *cloudService) SetAccessBindings(_ context.Context, req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	return c.bindings().set(req)
+}
+
+func (c *cloudService) UpdateAccessBindings(_ context.Context, req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	return c.bindings().update(req)
+}
+
+func (c *cloudService) bindings() accessBindings {
+	return accessBindings{s: c.s, kind: "Cloud", exists: func(id string) bool {
+		_, ok := c.s.clouds[id]
+		return ok
+	}}
+}
+
+type folderService struct {
+	resourcemanager.UnimplementedFolderServiceServer
+	s *Server
+}
+
+func (f *folderService) Get(_ context.Context, req *resourcemanager.GetFolderRequest) (*resourcemanager.Folder, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+
+	folder, ok := f.s.folders[req.FolderId]
+	if !ok {
+		return nil, notFound("Folder", req.FolderId)
+	}
+	return proto.Clone(folder).(*resourcemanager.Folder), nil
+}
+
+func (f *folderService) List(_ context.Context, req *resourcemanager.ListFoldersRequest) (*resourcemanager.ListFoldersResponse, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+
+	var ids []string
+	for id, folder := range f.s.folders {
+		match, err := matchFilter(req.Filter, folder.Name)
+		if err != nil {
+			return nil, err
+		}
+		if folder.CloudId == req.CloudId && match {
+			ids = append(ids, id)
+		}
+	}
+	page, next, err := f.s.paginate(ids, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &resourcemanager.ListFoldersResponse{NextPageToken: next}
+	for _, id := range page {
+		resp.Folders = append(resp.Folders, proto.Clone(f.s.folders[id]).(*resourcemanager.Folder))
+	}
+	return resp, nil
+}
+
+func (f *folderService) Create(_ context.Context, req *resourcemanager.CreateFolderRequest) (*operation.Operation, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+
+	if _, ok := f.s.clouds[req.CloudId]; !ok {
+		return nil, notFound("Cloud", req.CloudId)
+	}
+
+	folder := &resourcemanager.Folder{
+		Id:          f.s.newID("b1g"),
+		CloudId:     req.CloudId,
+		CreatedAt:   now(),
+		Name:        req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+		Status:      resourcemanager.Folder_ACTIVE,
+	}
+	f.s.folders[folder.Id] = folder
+
+	return f.s.newOperation("Create folder", &resourcemanager.CreateFolderMetadata{FolderId: folder.Id}, folder)
+}
+
+func (f *folderService) Update(_ context.Context, req *resourcemanager.UpdateFolderRequest) (*operation.Operation, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+
+	folder, ok := f.s.folders[req.FolderId]
+	if !ok {
+		return nil, notFound("Folder", req.FolderId)
+	}
+	if err := applyUpdateMask(folder, req, req.UpdateMask); err != nil {
+		return nil, err
+	}
+
+	return f.s.newOperation("Update folder", &resourcemanager.UpdateFolderMetadata{FolderId: folder.Id}, folder)
+}
+
+// Delete removes the folder right away, unlike the real API which keeps it in PENDING_DELETION status for a while.
+// Folders which still have resources are not deleted.
+func (f *folderService) Delete(_ context.Context, req *resourcemanager.DeleteFolderRequest) (*operation.Operation, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+
+	if _, ok := f.s.folders[req.FolderId]; !ok {
+		return nil, notFound("Folder", req.FolderId)
+	}
+	if f.s.folderInUse(req.FolderId) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Folder %s is not empty", req.FolderId)
+	}
+	delete(f.s.folders, req.FolderId)
+	delete(f.s.accessBindings, req.FolderId)
+
+	return f.s.newOperation("Delete folder", &resourcemanager.DeleteFolderMetadata{FolderId: req.FolderId}, nil)
+}
+
+func (f *folderService) ListAccessBindings(_ context.Context, req *access.ListAccessBindingsRequest) (*access.ListAccessBindingsResponse, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+	return f.bindings().list(req)
+}
+
+func (f *folderService) SetAccessBindings(_ context.Context, req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+	return f.bindings().set(req)
+}
+
+func (f *folderService) UpdateAccessBindings(_ context.Context, req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+	return f.bindings().update(req)
+}
+
+func (f *folderService) bindings() accessBindings {
+	return accessBindings{s: f.s, kind: "Folder", exists: func(id string) bool {
+		_, ok := f.s.folders[id]
+		return ok
+	}}
+}
+
+// folderInUse reports whether any resource belongs to the folder. Must be called with s.mu held.
+func (s *Server) folderInUse(folderID string) bool {
+	for _, n := range s.networks {
+		if n.FolderId == folderID {
+			return true
+		}
+	}
+	for _, sn := range s.subnets {
+		if sn.FolderId == folderID {
+			return true
+		}
+	}
+	for _, d := range s.disks {
+		if d.FolderId == folderID {
+			return true
+		}
+	}
+	for _, i := range s.images {
+		if i.FolderId == folderID {
+			return true
+		}
+	}
+	for _, i := range s.instances {
+		if i.FolderId == folderID {
+			return true
+		}
+	}
+	for _, sa := range s.serviceAccounts {
+		if sa.FolderId == folderID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,285 @@
+package fakecloud
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1/saml"
+)
+
+type samlFederationService struct {
+	saml.UnimplementedFederationServiceServer
+	s *Server
+}
+
+func (fs *samlFederationService) Get(_ context.Context, req *saml.GetFederationRequest) (*saml.Federation, error) {
+	fs.s.mu.Lock()
+	defer fs.s.mu.Unlock()
+
+	federation, ok := fs.s.samlFederations[req.FederationId]
+	if !ok {
+		return nil, notFound("Federation", req.FederationId)
+	}
+	return proto.Clone(federation).(*saml.Federation), nil
+}
+
+func (fs *samlFederationService) Create(_ context.Context, req *saml.CreateFederationRequest) (*operation.Operation, error) {
+	fs.s.mu.Lock()
+	defer fs.s.mu.Unlock()
+
+	if req.OrganizationId != OrganizationID {
+		return nil, notFound("Organization", req.OrganizationId)
+	}
+
+	federation := &saml.Federation{
+		Id:                       fs.s.newID("bpf"),
+		OrganizationId:           req.OrganizationId,
+		Name:                     req.Name,
+		Description:              req.Description,
+		CreatedAt:                now(),
+		CookieMaxAge:             req.CookieMaxAge,
+		AutoCreateAccountOnLogin: req.AutoCreateAccountOnLogin,
+		Issuer:                   req.Issuer,
+		SsoBinding:               req.SsoBinding,
+		SsoUrl:                   req.SsoUrl,
+		SecuritySettings:         req.SecuritySettings,
+		CaseInsensitiveNameIds:   req.CaseInsensitiveNameIds,
+		Labels:                   req.Labels,
+	}
+	fs.s.samlFederations[federation.Id] = federation
+
+	return fs.s.newOperation("Create federation", &saml.CreateFederationMetadata{FederationId: federation.Id}, federation)
+}
+
+func (fs *samlFederationService) Delete(_ context.Context, req *saml.DeleteFederationRequest) (*operation.Operation, error) {
+	fs.s.mu.Lock()
+	defer fs.s.mu.Unlock()
+
+	if _, ok := fs.s.samlFederations[req.FederationId]; !ok {
+		return nil, notFound("Federation", req.FederationId)
+	}
+	delete(fs.s.samlFederations, req.FederationId)
+	for id, certificate := range fs.s.samlCertificates {
+		if certificate.FederationId == req.FederationId {
+			delete(fs.s.samlCertificates, id)
+		}
+	}
+	for id, account := range fs.s.federatedUsers {
+		if account.FederationId == req.FederationId {
+			delete(fs.s.federatedUsers, id)
+		}
+	}
+
+	return fs.s.newOperation("Delete federation", &saml.DeleteFederationMetadata{FederationId: req.FederationId}, nil)
+}
+
+// AddUserAccounts returns existing accounts for known name IDs, like the real API does.
+func (fs *samlFederationService) AddUserAccounts(_ context.Context, req *saml.AddFederatedUserAccountsRequest) (*operation.Operation, error) {
+	fs.s.mu.Lock()
+	defer fs.s.mu.Unlock()
+
+	if _, ok := fs.s.samlFederations[req.FederationId]; !ok {
+		return nil, notFound("Federation", req.FederationId)
+	}
+
+	resp := &saml.AddFederatedUserAccountsResponse{}
+	for _, nameID := range req.NameIds {
+		if nameID == "" {
+			return nil, status.Error(codes.InvalidArgument, "name_ids must not be empty")
+		}
+
+		id := ""
+		for accountID, account := range fs.s.federatedUsers {
+			if account.FederationId == req.FederationId && account.NameId == nameID {
+				id = accountID
+			}
+		}
+		if id == "" {
+			id = fs.s.newID("aje")
+			fs.s.federatedUsers[id] = &organizationmanager.SamlUserAccount{FederationId: req.FederationId, NameId: nameID}
+		}
+		resp.UserAccounts = append(resp.UserAccounts, fs.s.federatedUserAccount(id))
+	}
+
+	return fs.s.newOperation("Add federated user accounts", &saml.AddFederatedUserAccountsMetadata{FederationId: req.FederationId}, resp)
+}
+
+func (fs *samlFederationService) ListUserAccounts(_ context.Context, req *saml.ListFederatedUserAccountsRequest) (*saml.ListFederatedUserAccountsResponse, error) {
+	fs.s.mu.Lock()
+	defer fs.s.mu.Unlock()
+
+	if _, ok := fs.s.samlFederations[req.FederationId]; !ok {
+		return nil, notFound("Federation", req.FederationId)
+	}
+
+	var ids []string
+	for id, account := range fs.s.federatedUsers {
+		if account.FederationId == req.FederationId {
+			ids = append(ids, id)
+		}
+	}
+	page, next, err := fs.s.paginate(ids, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &saml.ListFederatedUserAccountsResponse{NextPageToken: next}
+	for _, id := range page {
+		resp.UserAccounts = append(resp.UserAccounts, fs.s.federatedUserAccount(id))
+	}
+	return resp, nil
+}
+
+// federatedUserAccount returns the federated user account by ID. Must be called with s.mu held.
+func (s *Server) federatedUserAccount(id string) *organizationmanager.UserAccount {
+	return &organizationmanager.UserAccount{
+		Id: id,
+		UserAccount: &organizationmanager.UserAccount_SamlUserAccount{
+			SamlUserAccount: proto.Clone(s.federatedUsers[id]).(*organizationmanager.SamlUserAccount),
+		},
+	}
+}
+
+type samlCertificateService struct {
+	saml.UnimplementedCertificateServiceServer
+	s *Server
+}
+
+func (cs *samlCertificateService) Get(_ context.Context, req *saml.GetCertificateRequest) (*saml.Certificate, error) {
+	cs.s.mu.Lock()
+	defer cs.s.mu.Unlock()
+
+	certificate, ok := cs.s.samlCertificates[req.CertificateId]
+	if !ok {
+		return nil, notFound("Certificate", req.CertificateId)
+	}
+	return proto.Clone(certificate).(*saml.Certificate), nil
+}
+
+func (cs *samlCertificateService) List(_ context.Context, req *saml.ListCertificatesRequest) (*saml.ListCertificatesResponse, error) {
+	cs.s.mu.Lock()
+	defer cs.s.mu.Unlock()
+
+	var ids []string
+	for id, certificate := range cs.s.samlCertificates {
+		match, err := matchFilter(req.Filter, certificate.Name)
+		if err != nil {
+			return nil, err
+		}
+		if certificate.FederationId == req.FederationId && match {
+			ids = append(ids, id)
+		}
+	}
+	page, next, err := cs.s.paginate(ids, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &saml.ListCertificatesResponse{NextPageToken: next}
+	for _, id := range page {
+		resp.Certificates = append(resp.Certificates, proto.Clone(cs.s.samlCertificates[id]).(*saml.Certificate))
+	}
+	return resp, nil
+}
+
+// Create accepts any non-empty data, certificates are not parsed.
+func (cs *samlCertificateService) Create(_ context.Context, req *saml.CreateCertificateRequest) (*operation.Operation, error) {
+	cs.s.mu.Lock()
+	defer cs.s.mu.Unlock()
+
+	if _, ok := cs.s.samlFederations[req.FederationId]; !ok {
+		return nil, notFound("Federation", req.FederationId)
+	}
+	if req.Data == "" {
+		return nil, status.Error(codes.InvalidArgument, "data is required")
+	}
+
+	certificate := &saml.Certificate{
+		Id:           cs.s.newID("bpf"),
+		FederationId: req.FederationId,
+		Name:         req.Name,
+		Description:  req.Description,
+		CreatedAt:    now(),
+		Data:         req.Data,
+	}
+	cs.s.samlCertificates[certificate.Id] = certificate
+
+	return cs.s.newOperation("Create certificate", &saml.CreateCertificateMetadata{CertificateId: certificate.Id}, certificate)
+}
+
+func (cs *samlCertificateService) Update(_ context.Context, req *saml.UpdateCertificateRequest) (*operation.Operation, error) {
+	cs.s.mu.Lock()
+	defer cs.s.mu.Unlock()
+
+	certificate, ok := cs.s.samlCertificates[req.CertificateId]
+	if !ok {
+		return nil, notFound("Certificate", req.CertificateId)
+	}
+	if err := applyUpdateMask(certificate, req, req.UpdateMask); err != nil {
+		return nil, err
+	}
+
+	return cs.s.newOperation("Update certificate", &saml.UpdateCertificateMetadata{CertificateId: certificate.Id}, certificate)
+}
+
+func (cs *samlCertificateService) Delete(_ context.Context, req *saml.DeleteCertificateRequest) (*operation.Operation, error) {
+	cs.s.mu.Lock()
+	defer cs.s.mu.Unlock()
+
+	if _, ok := cs.s.samlCertificates[req.CertificateId]; !ok {
+		return nil, notFound("Certificate", req.CertificateId)
+	}
+	delete(cs.s.samlCertificates, req.CertificateId)
+
+	return cs.s.newOperation("Delete certificate", &saml.DeleteCertificateMetadata{CertificateId: req.CertificateId}, nil)
+}
+
+type organizationUserService struct {
+	organizationmanager.UnimplementedUserServiceServer
+	s *Server
+}
+
+// DeleteMembership supports federated users only, their accounts are deleted along with the membership.
+func (us *organizationUserService) DeleteMembership(_ context.Context, req *organizationmanager.DeleteMembershipRequest) (*operation.Operation, error) {
+	us.s.mu.Lock()
+	defer us.s.mu.Unlock()
+
+	if req.OrganizationId != OrganizationID {
+		return nil, notFound("Organization", req.OrganizationId)
+	}
+	if _, ok := us.s.federatedUsers[req.SubjectId]; !ok {
+		return nil, notFound("Membership of subject", req.SubjectId)
+	}
+	delete(us.s.federatedUsers, req.SubjectId)
+
+	md := &organizationmanager.DeleteMembershipMetadata{OrganizationId: req.OrganizationId, SubjectId: req.SubjectId}
+	return us.s.newOperation("Delete membership", md, &organizationmanager.DeleteMembershipResponse{OrganizationId: req.OrganizationId, SubjectId: req.SubjectId})
+}
+
+type userAccountService struct {
+	iam.UnimplementedUserAccountServiceServer
+	s *Server
+}
+
+// Get returns federated user accounts only, the server has no Yandex Passport accounts.
+func (ua *userAccountService) Get(_ context.Context, req *iam.GetUserAccountRequest) (*iam.UserAccount, error) {
+	ua.s.mu.Lock()
+	defer ua.s.mu.Unlock()
+
+	account, ok := ua.s.federatedUsers[req.UserAccountId]
+	if !ok {
+		return nil, notFound("User account", req.UserAccountId)
+	}
+	return &iam.UserAccount{
+		Id: req.UserAccountId,
+		UserAccount: &iam.UserAccount_SamlUserAccount{
+			SamlUserAccount: &iam.SamlUserAccount{FederationId: account.FederationId, NameId: account.NameId},
+		},
+	}, nil
+}
@@ -0,0 +1,247 @@
+package fakecloud
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+type networkService struct {
+	vpc.UnimplementedNetworkServiceServer
+	s *Server
+}
+
+func (n *networkService) Get(_ context.Context, req *vpc.GetNetworkRequest) (*vpc.Network, error) {
+	n.s.mu.Lock()
+	defer n.s.mu.Unlock()
+
+	network, ok := n.s.networks[req.NetworkId]
+	if !ok {
+		return nil, notFound("Network", req.NetworkId)
+	}
+	return proto.Clone(network).(*vpc.Network), nil
+}
+
+func (n *networkService) List(_ context.Context, req *vpc.ListNetworksRequest) (*vpc.ListNetworksResponse, error) {
+	n.s.mu.Lock()
+	defer n.s.mu.Unlock()
+
+	var ids []string
+	for id, network := range n.s.networks {
+		match, err := matchFilter(req.Filter, network.Name)
+		if err != nil {
+			return nil, err
+		}
+		if network.FolderId == req.FolderId && match {
+			ids = append(ids, id)
+		}
+	}
+	page, next, err := n.s.paginate(ids, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &vpc.ListNetworksResponse{NextPageToken: next}
+	for _, id := range page {
+		resp.Networks = append(resp.Networks, proto.Clone(n.s.networks[id]).(*vpc.Network))
+	}
+	return resp, nil
+}
+
+func (n *networkService) Create(_ context.Context, req *vpc.CreateNetworkRequest) (*operation.Operation, error) {
+	n.s.mu.Lock()
+	defer n.s.mu.Unlock()
+
+	if err := n.s.requireFolder(req.FolderId); err != nil {
+		return nil, err
+	}
+
+	network := &vpc.Network{
+		Id:          n.s.newID("enp"),
+		FolderId:    req.FolderId,
+		CreatedAt:   now(),
+		Name:        req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+	}
+	n.s.networks[network.Id] = network
+
+	return n.s.newOperation("Create network", &vpc.CreateNetworkMetadata{NetworkId: network.Id}, network)
+}
+
+func (n *networkService) Update(_ context.Context, req *vpc.UpdateNetworkRequest) (*operation.Operation, error) {
+	n.s.mu.Lock()
+	defer n.s.mu.Unlock()
+
+	network, ok := n.s.networks[req.NetworkId]
+	if !ok {
+		return nil, notFound("Network", req.NetworkId)
+	}
+	if err := applyUpdateMask(network, req, req.UpdateMask); err != nil {
+		return nil, err
+	}
+
+	return n.s.newOperation("Update network", &vpc.UpdateNetworkMetadata{NetworkId: network.Id}, network)
+}
+
+func (n *networkService) Delete(_ context.Context, req *vpc.DeleteNetworkRequest) (*operation.Operation, error) {
+	n.s.mu.Lock()
+	defer n.s.mu.Unlock()
+
+	if _, ok := n.s.networks[req.NetworkId]; !ok {
+		return nil, notFound("Network", req.NetworkId)
+	}
+	for _, subnet := range n.s.subnets {
+		if subnet.NetworkId == req.NetworkId {
+			return nil, status.Errorf(codes.FailedPrecondition, "Network %s is not empty", req.NetworkId)
+		}
+	}
+	delete(n.s.networks, req.NetworkId)
+
+	return n.s.newOperation("Delete network", &vpc.DeleteNetworkMetadata{NetworkId: req.NetworkId}, nil)
+}
+
+func (n *networkService) ListSubnets(_ context.Context, req *vpc.ListNetworkSubnetsRequest) (*vpc.ListNetworkSubnetsResponse, error) {
+	n.s.mu.Lock()
+	defer n.s.mu.Unlock()
+
+	if _, ok := n.s.networks[req.NetworkId]; !ok {
+		return nil, notFound("Network", req.NetworkId)
+	}
+
+	var ids []string
+	for id, subnet := range n.s.subnets {
+		if subnet.NetworkId == req.NetworkId {
+			ids = append(ids, id)
+		}
+	}
+	page, next, err := n.s.paginate(ids, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &vpc.ListNetworkSubnetsResponse{NextPageToken: next}
+	for _, id := range page {
+		resp.Subnets = append(resp.Subnets, proto.Clone(n.s.subnets[id]).(*vpc.Subnet))
+	}
+	return resp, nil
+}
+
+type subnetService struct {
+	vpc.UnimplementedSubnetServiceServer
+	s *Server
+}
+
+func (sn *subnetService) Get(_ context.Context, req *vpc.GetSubnetRequest) (*vpc.Subnet, error) {
+	sn.s.mu.Lock()
+	defer sn.s.mu.Unlock()
+
+	subnet, ok := sn.s.subnets[req.SubnetId]
+	if !ok {
+		return nil, notFound("Subnet", req.SubnetId)
+	}
+	return proto.Clone(subnet).(*vpc.Subnet), nil
+}
+
+func (sn *subnetService) List(_ context.Context, req *vpc.ListSubnetsRequest) (*vpc.ListSubnetsResponse, error) {
+	sn.s.mu.Lock()
+	defer sn.s.mu.Unlock()
+
+	var ids []string
+	for id, subnet := range sn.s.subnets {
+		match, err := matchFilter(req.Filter, subnet.Name)
+		if err != nil {
+			return nil, err
+		}
+		if subnet.FolderId == req.FolderId && match {
+			ids = append(ids, id)
+		}
+	}
+	page, next, err := sn.s.paginate(ids, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &vpc.ListSubnetsResponse{NextPageToken: next}
+	for _, id := range page {
+		resp.Subnets = append(resp.Subnets, proto.Clone(sn.s.subnets[id]).(*vpc.Subnet))
+	}
+	return resp, nil
+}
+
+func (sn *subnetService) Create(_ context.Context, req *vpc.CreateSubnetRequest) (*operation.Operation, error) {
+	sn.s.mu.Lock()
+	defer sn.s.mu.Unlock()
+
+	if err := sn.s.requireFolder(req.FolderId); err != nil {
+		return nil, err
+	}
+	if _, ok := sn.s.networks[req.NetworkId]; !ok {
+		return nil, notFound("Network", req.NetworkId)
+	}
+	if req.ZoneId == "" {
+		return nil, status.Error(codes.InvalidArgument, "zone_id is required")
+	}
+	if len(req.V4CidrBlocks) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "v4_cidr_blocks is required")
+	}
+	for _, cidr := range req.V4CidrBlocks {
+		if ip, _, err := net.ParseCIDR(cidr); err != nil || ip.To4() == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid IPv4 CIDR block %q", cidr)
+		}
+	}
+
+	subnet := &vpc.Subnet{
+		Id:           sn.s.newID("e9b"),
+		FolderId:     req.FolderId,
+		CreatedAt:    now(),
+		Name:         req.Name,
+		Description:  req.Description,
+		Labels:       req.Labels,
+		NetworkId:    req.NetworkId,
+		ZoneId:       req.ZoneId,
+		V4CidrBlocks: req.V4CidrBlocks,
+		RouteTableId: req.RouteTableId,
+		DhcpOptions:  req.DhcpOptions,
+	}
+	sn.s.subnets[subnet.Id] = subnet
+
+	return sn.s.newOperation("Create subnet", &vpc.CreateSubnetMetadata{SubnetId: subnet.Id}, subnet)
+}
+
+func (sn *subnetService) Update(_ context.Context, req *vpc.UpdateSubnetRequest) (*operation.Operation, error) {
+	sn.s.mu.Lock()
+	defer sn.s.mu.Unlock()
+
+	subnet, ok := sn.s.subnets[req.SubnetId]
+	if !ok {
+		return nil, notFound("Subnet", req.SubnetId)
+	}
+	if err := applyUpdateMask(subnet, req, req.UpdateMask); err != nil {
+		return nil, err
+	}
+
+	return sn.s.newOperation("Update subnet", &vpc.UpdateSubnetMetadata{SubnetId: subnet.Id}, subnet)
+}
+
+func (sn *subnetService) Delete(_ context.Context, req *vpc.DeleteSubnetRequest) (*operation.Operation, error) {
+	sn.s.mu.Lock()
+	defer sn.s.mu.Unlock()
+
+	if _, ok := sn.s.subnets[req.SubnetId]; !ok {
+		return nil, notFound("Subnet", req.SubnetId)
+	}
+	if len(sn.s.usedAddresses[req.SubnetId]) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Subnet %s is in use", req.SubnetId)
+	}
+	delete(sn.s.subnets, req.SubnetId)
+	delete(sn.s.usedAddresses, req.SubnetId)
+
+	return sn.s.newOperation("Delete subnet", &vpc.DeleteSubnetMetadata{SubnetId: req.SubnetId}, nil)
+}
@@ -0,0 +1,236 @@
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	clientTraceIDHeader   = "x-client-trace-id"
+	clientRequestIDHeader = "x-client-request-id"
+	serverRequestIDHeader = "x-request-id"
+)
+
+// APILogOptions describes API call log written into a separate file as JSON lines.
+type APILogOptions struct {
+	// Path of the log file. Log is disabled if it is empty. Entries are appended to existing file.
+	Path string
+
+	// AllowedMethods and DeniedMethods are patterns of full method names, like
+	// "yandex.cloud.compute.v1.InstanceService/Create", where '*' matches any sequence of characters.
+	// Empty AllowedMethods allows every method, DeniedMethods take precedence over AllowedMethods.
+	AllowedMethods []string
+	DeniedMethods  []string
+}
+
+// Enabled reports whether API call log is requested.
+func (o APILogOptions) Enabled() bool {
+	return o.Path != ""
+}
+
+// NewAPILogSinkUnaryInterceptor opens log file and returns interceptor writing a line per API call into it,
+// and closer of the file. Calls made after the file is closed are not logged.
+// It should be placed below the request id interceptor to have client request id in the log.
+func NewAPILogSinkUnaryInterceptor(opts APILogOptions) (grpc.UnaryClientInterceptor, io.Closer, error) {
+	f, err := os.OpenFile(opts.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open API log file: %s", err)
+	}
+	sink, err := newAPILogSink(f, opts)
+	if err != nil {
+		_ = f.Close()
+		return nil, nil, err
+	}
+	return sink.InterceptUnary, sink, nil
+}
+
+func newAPILogSink(w io.Writer, opts APILogOptions) (*apiLogSink, error) {
+	allowed, err := compileMethodPatterns(opts.AllowedMethods)
+	if err != nil {
+		return nil, err
+	}
+	denied, err := compileMethodPatterns(opts.DeniedMethods)
+	if err != nil {
+		return nil, err
+	}
+	return &apiLogSink{
+		w:       w,
+		allowed: allowed,
+		denied:  denied,
+	}, nil
+}
+
+type apiLogSink struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closed bool
+
+	allowed *regexp.Regexp
+	denied  *regexp.Regexp
+}
+
+// Close flushes and closes the log file, entries of calls made after that are dropped.
+func (s *apiLogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	if f, ok := s.w.(*os.File); ok {
+		if err := f.Sync(); err != nil {
+			_ = f.Close()
+			return fmt.Errorf("failed to flush API log file: %s", err)
+		}
+	}
+	if c, ok := s.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+type apiLogEntry struct {
+	Time            string          `json:"time"`
+	Method          string          `json:"method"`
+	DurationMs      int64           `json:"duration_ms"`
+	ClientTraceID   string          `json:"client_trace_id,omitempty"`
+	ClientRequestID string          `json:"client_request_id,omitempty"`
+	ServerRequestID string          `json:"server_request_id,omitempty"`
+	OperationID     string          `json:"operation_id,omitempty"`
+	StatusCode      string          `json:"status_code"`
+	Error           string          `json:"error,omitempty"`
+	Request         json.RawMessage `json:"request,omitempty"`
+	Response        json.RawMessage `json:"response,omitempty"`
+}
+
+func (s *apiLogSink) InterceptUnary(
+	ctx context.Context,
+	method string,
+	req, resp interface{},
+	conn *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if !s.logged(method) {
+		return invoker(ctx, method, req, resp, conn, opts...)
+	}
+
+	var header metadata.MD
+	opts = append(opts, grpc.Header(&header))
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	start := time.Now()
+	err := invoker(ctx, method, req, resp, conn, opts...)
+	duration := time.Since(start)
+
+	st, _ := statusFromError(err)
+	entry := apiLogEntry{
+		Time:            start.UTC().Format(time.RFC3339Nano),
+		Method:          method,
+		DurationMs:      duration.Milliseconds(),
+		ClientTraceID:   firstValue(md, clientTraceIDHeader),
+		ClientRequestID: firstValue(md, clientRequestIDHeader),
+		ServerRequestID: firstValue(header, serverRequestIDHeader),
+		OperationID:     operationID(req, resp, err),
+		StatusCode:      codeString(st.Code()),
+		Request:         marshalPayload(req),
+	}
+	if err != nil {
+		entry.Error = st.Message()
+	} else {
+		entry.Response = marshalPayload(resp)
+	}
+	s.write(entry)
+
+	return err
+}
+
+func (s *apiLogSink) logged(method string) bool {
+	name := strings.TrimPrefix(method, "/")
+	if s.denied != nil && s.denied.MatchString(name) {
+		return false
+	}
+	return s.allowed == nil || s.allowed.MatchString(name)
+}
+
+func (s *apiLogSink) write(entry apiLogEntry) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		log.Print("[DEBUG] Failed to marshal API log entry", err)
+		return
+	}
+	b = append(b, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	if _, err := s.w.Write(b); err != nil {
+		log.Print("[WARN] Failed to write API log entry", err)
+	}
+}
+
+// compileMethodPatterns joins patterns into single regexp, nil regexp is returned for empty list.
+func compileMethodPatterns(patterns []string) (*regexp.Regexp, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+	exprs := make([]string, 0, len(patterns))
+	for _, p := range patterns {
+		p = strings.TrimPrefix(p, "/")
+		exprs = append(exprs, strings.ReplaceAll(regexp.QuoteMeta(p), `\*`, ".*"))
+	}
+	re, err := regexp.Compile("^(?:" + strings.Join(exprs, "|") + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid method pattern: %s", err)
+	}
+	return re, nil
+}
+
+func marshalPayload(m interface{}) json.RawMessage {
+	if IsNil(m) {
+		return nil
+	}
+	p, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+	b, err := JSONHidingSensitiveValuesMarshaller(p)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+func operationID(req, resp interface{}, err error) string {
+	if op, ok := resp.(*operation.Operation); ok && err == nil {
+		return op.GetId()
+	}
+	// OperationService calls
+	if r, ok := req.(interface{ GetOperationId() string }); ok {
+		return r.GetOperationId()
+	}
+	return ""
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
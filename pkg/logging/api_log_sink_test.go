@@ -0,0 +1,94 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAPILogSink(t *testing.T) {
+	buf := &bytes.Buffer{}
+	sink, err := newAPILogSink(buf, APILogOptions{
+		AllowedMethods: []string{"yandex.cloud.vpc.*"},
+		DeniedMethods:  []string{"*/List"},
+	})
+	require.NoError(t, err)
+	interceptor := sink.InterceptUnary
+
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		if op, ok := reply.(*operation.Operation); ok {
+			op.Id = "op-id"
+			return nil
+		}
+		return status.Error(codes.NotFound, "network not found")
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), clientRequestIDHeader, "request-id")
+
+	require.NoError(t, interceptor(ctx, "/yandex.cloud.vpc.v1.NetworkService/Create",
+		&vpc.CreateNetworkRequest{Name: "net"}, &operation.Operation{}, nil, invoker))
+	require.Error(t, interceptor(ctx, "/yandex.cloud.vpc.v1.NetworkService/Get",
+		&vpc.GetNetworkRequest{NetworkId: "id"}, &vpc.Network{}, nil, invoker))
+	// denied and not allowed methods are not logged
+	require.Error(t, interceptor(ctx, "/yandex.cloud.vpc.v1.NetworkService/List",
+		&vpc.ListNetworksRequest{}, &vpc.ListNetworksResponse{}, nil, invoker))
+	require.Error(t, interceptor(ctx, "/yandex.cloud.compute.v1.DiskService/Get",
+		&vpc.GetNetworkRequest{}, &vpc.Network{}, nil, invoker))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var create, get apiLogEntry
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &create))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &get))
+
+	assert.Equal(t, "/yandex.cloud.vpc.v1.NetworkService/Create", create.Method)
+	assert.Equal(t, "request-id", create.ClientRequestID)
+	assert.Equal(t, "op-id", create.OperationID)
+	assert.Equal(t, "OK", create.StatusCode)
+	assert.JSONEq(t, `{"name": "net"}`, string(create.Request))
+
+	assert.Equal(t, "NOT_FOUND", get.StatusCode)
+	assert.Equal(t, "network not found", get.Error)
+	assert.Empty(t, get.Response)
+
+	// calls made after the sink is closed are not logged
+	require.NoError(t, sink.Close())
+	require.NoError(t, interceptor(ctx, "/yandex.cloud.vpc.v1.NetworkService/Create",
+		&vpc.CreateNetworkRequest{Name: "net"}, &operation.Operation{}, nil, invoker))
+	assert.Len(t, strings.Split(strings.TrimSpace(buf.String()), "\n"), 2)
+}
+
+func TestAPILogSinkClosesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api.log")
+	_, closer, err := NewAPILogSinkUnaryInterceptor(APILogOptions{Path: path})
+	require.NoError(t, err)
+
+	require.NoError(t, closer.Close())
+	// the second close is a no-op, the file is closed once
+	assert.NoError(t, closer.Close())
+	assert.FileExists(t, path)
+}
+
+func TestCompileMethodPatterns(t *testing.T) {
+	re, err := compileMethodPatterns(nil)
+	require.NoError(t, err)
+	assert.Nil(t, re)
+
+	re, err = compileMethodPatterns([]string{"/yandex.cloud.iam.v1.*", "*InstanceService/Create"})
+	require.NoError(t, err)
+	assert.True(t, re.MatchString("yandex.cloud.iam.v1.ServiceAccountService/Get"))
+	assert.True(t, re.MatchString("yandex.cloud.compute.v1.InstanceService/Create"))
+	assert.False(t, re.MatchString("yandex.cloud.compute.v1.InstanceService/CreateSnapshot"))
+}
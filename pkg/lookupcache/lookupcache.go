@@ -0,0 +1,109 @@
+package lookupcache
+
+import (
+	"container/list"
+	"log"
+	"sync"
+	"time"
+)
+
+// Cache keeps results of lookups which don't change during a single Terraform run,
+// e.g. the cloud of a folder or image metadata. Entries expire after TTL, the least
+// recently used entries are evicted when the cache is full. Errors are never cached.
+type Cache struct {
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	hits    int64
+	misses  int64
+}
+
+type entry struct {
+	key     string
+	value   interface{}
+	expires time.Time
+}
+
+// New creates cache keeping at most maxEntries entries for ttl each.
+func New(ttl time.Duration, maxEntries int) *Cache {
+	return &Cache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// GetOrLoad returns cached value of the key, or calls load and caches its result.
+// Nil cache calls load every time.
+func (c *Cache) GetOrLoad(key string, load func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return load()
+	}
+
+	if value, ok := c.get(key); ok {
+		return value, nil
+	}
+
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+	c.set(key, value)
+	return value, nil
+}
+
+// Stats returns number of cache hits and misses.
+func (c *Cache) Stats() (hits, misses int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits, c.misses
+}
+
+func (c *Cache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		e := el.Value.(*entry)
+		if c.now().Before(e.expires) {
+			c.lru.MoveToFront(el)
+			c.hits++
+			log.Printf("[DEBUG] Lookup cache hit for %q (hits: %d, misses: %d)", key, c.hits, c.misses)
+			return e.value, true
+		}
+		c.remove(el)
+	}
+
+	c.misses++
+	log.Printf("[DEBUG] Lookup cache miss for %q (hits: %d, misses: %d)", key, c.hits, c.misses)
+	return nil, false
+}
+
+func (c *Cache) set(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expires := c.now().Add(c.ttl)
+	if el, ok := c.entries[key]; ok {
+		e := el.Value.(*entry)
+		e.value, e.expires = value, expires
+		c.lru.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&entry{key: key, value: value, expires: expires})
+	for c.maxEntries > 0 && c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *Cache) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*entry).key)
+}
@@ -0,0 +1,92 @@
+package lookupcache
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func countingLoader(calls *int, value string) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		*calls++
+		return value, nil
+	}
+}
+
+func TestGetOrLoadCachesValue(t *testing.T) {
+	c := New(time.Minute, 10)
+	calls := 0
+
+	for i := 0; i < 3; i++ {
+		v, err := c.GetOrLoad("folder/b1g", countingLoader(&calls, "cloud"))
+		require.NoError(t, err)
+		assert.Equal(t, "cloud", v)
+	}
+	assert.Equal(t, 1, calls)
+
+	hits, misses := c.Stats()
+	assert.Equal(t, int64(2), hits)
+	assert.Equal(t, int64(1), misses)
+}
+
+func TestGetOrLoadExpiresEntries(t *testing.T) {
+	now := time.Unix(0, 0)
+	c := New(time.Minute, 10)
+	c.now = func() time.Time { return now }
+	calls := 0
+
+	_, _ = c.GetOrLoad("key", countingLoader(&calls, "v1"))
+	now = now.Add(30 * time.Second)
+	v, _ := c.GetOrLoad("key", countingLoader(&calls, "v2"))
+	assert.Equal(t, "v1", v)
+
+	now = now.Add(time.Minute)
+	v, _ = c.GetOrLoad("key", countingLoader(&calls, "v2"))
+	assert.Equal(t, "v2", v)
+	assert.Equal(t, 2, calls)
+}
+
+func TestGetOrLoadEvictsLeastRecentlyUsed(t *testing.T) {
+	c := New(time.Minute, 2)
+	calls := 0
+
+	_, _ = c.GetOrLoad("a", countingLoader(&calls, "a"))
+	_, _ = c.GetOrLoad("b", countingLoader(&calls, "b"))
+	_, _ = c.GetOrLoad("a", countingLoader(&calls, "a"))
+	_, _ = c.GetOrLoad("c", countingLoader(&calls, "c"))
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, 2, c.lru.Len())
+
+	// "b" was evicted, "a" was kept
+	_, _ = c.GetOrLoad("a", countingLoader(&calls, "a"))
+	assert.Equal(t, 3, calls)
+	_, _ = c.GetOrLoad("b", countingLoader(&calls, "b"))
+	assert.Equal(t, 4, calls)
+}
+
+func TestGetOrLoadDoesNotCacheErrors(t *testing.T) {
+	c := New(time.Minute, 10)
+	calls := 0
+	failing := func() (interface{}, error) {
+		calls++
+		return nil, fmt.Errorf("unavailable")
+	}
+
+	_, err := c.GetOrLoad("key", failing)
+	require.Error(t, err)
+	_, err = c.GetOrLoad("key", failing)
+	require.Error(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestNilCacheLoadsEveryTime(t *testing.T) {
+	var c *Cache
+	calls := 0
+
+	_, _ = c.GetOrLoad("key", countingLoader(&calls, "v"))
+	_, _ = c.GetOrLoad("key", countingLoader(&calls, "v"))
+	assert.Equal(t, 2, calls)
+}
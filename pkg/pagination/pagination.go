@@ -0,0 +1,219 @@
+package pagination
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// PageSize is the page size to request from List RPCs. It is the maximum most services accept.
+const PageSize = 1000
+
+// ListFunc calls a List RPC for the page with the token, the request must set PageToken from it.
+// Response must have "next_page_token" field and a single repeated message field with items.
+// Nil response is an empty last page, e.g. for a parent whose items the caller isn't allowed to list.
+type ListFunc func(ctx context.Context, pageToken string) (proto.Message, error)
+
+// Iterator walks all items returned by a List RPC page by page.
+// The next page is requested in background while the items of the current one are consumed.
+//
+//	it := pagination.New(ctx, list)
+//	defer it.Close()
+//	for it.Next() {
+//		item := it.Value().(*vpc.Network)
+//	}
+//	if err := it.Err(); err != nil { ... }
+type Iterator struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	list   ListFunc
+
+	started bool
+	pending chan page
+	items   []proto.Message
+	value   proto.Message
+	err     error
+}
+
+type page struct {
+	items         []proto.Message
+	nextPageToken string
+	err           error
+}
+
+// New creates iterator, the first page is requested on the first call of Next.
+func New(ctx context.Context, list ListFunc) *Iterator {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Iterator{ctx: ctx, cancel: cancel, list: list}
+}
+
+// Next advances to the next item, it returns false when there are no more items or a call failed.
+func (it *Iterator) Next() bool {
+	if !it.started {
+		it.started = true
+		it.fetch("")
+	}
+
+	for len(it.items) == 0 {
+		if it.err != nil || it.pending == nil {
+			it.value = nil
+			return false
+		}
+
+		p := <-it.pending
+		it.pending = nil
+		if p.err != nil {
+			it.err = p.err
+			continue
+		}
+		it.items = p.items
+		if p.nextPageToken != "" {
+			it.fetch(p.nextPageToken)
+		}
+	}
+
+	it.value, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Value returns the current item.
+func (it *Iterator) Value() proto.Message {
+	return it.value
+}
+
+// Err returns the error of a failed call.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close stops prefetching of the next page. It must be called if the iteration is stopped before Next returns false.
+func (it *Iterator) Close() {
+	it.cancel()
+}
+
+// All returns all remaining items.
+func (it *Iterator) All() ([]proto.Message, error) {
+	defer it.Close()
+
+	var items []proto.Message
+	for it.Next() {
+		items = append(items, it.Value())
+	}
+	return items, it.Err()
+}
+
+func (it *Iterator) fetch(pageToken string) {
+	// Buffered, so the request doesn't leak if iterator is abandoned.
+	it.pending = make(chan page, 1)
+	go func(pending chan<- page) {
+		resp, err := it.list(it.ctx, pageToken)
+		if err != nil {
+			pending <- page{err: err}
+			return
+		}
+		if resp == nil {
+			pending <- page{}
+			return
+		}
+		items, nextPageToken, err := splitPage(resp)
+		if err == nil && nextPageToken != "" && nextPageToken == pageToken {
+			err = fmt.Errorf("%s returned the same page token %q", resp.ProtoReflect().Descriptor().FullName(), pageToken)
+		}
+		pending <- page{items: items, nextPageToken: nextPageToken, err: err}
+	}(it.pending)
+}
+
+// List returns all items of a List RPC.
+func List(ctx context.Context, list ListFunc) ([]proto.Message, error) {
+	return New(ctx, list).All()
+}
+
+// ListEach lists items for each of the parents, i.e. hosts of clusters, calling at most concurrency
+// List RPCs at once. Items are returned in the order of parents; the first error cancels other calls.
+func ListEach(ctx context.Context, parents []string, concurrency int, list func(parent string) ListFunc) ([][]proto.Message, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		sem      = make(chan struct{}, concurrency)
+		result   = make([][]proto.Message, len(parents))
+	)
+	for i, parent := range parents {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, parent string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			items, err := List(ctx, list(parent))
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			result[i] = items
+		}(i, parent)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// splitPage extracts items and the next page token from the response of List RPC.
+func splitPage(resp proto.Message) ([]proto.Message, string, error) {
+	m := resp.ProtoReflect()
+	fields := m.Descriptor().Fields()
+
+	token := fields.ByName("next_page_token")
+	if token == nil || token.Kind() != protoreflect.StringKind {
+		return nil, "", fmt.Errorf("%s has no next_page_token field", m.Descriptor().FullName())
+	}
+
+	var itemsField protoreflect.FieldDescriptor
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.Get(i)
+		if f.IsList() && f.Kind() == protoreflect.MessageKind {
+			if itemsField != nil {
+				return nil, "", fmt.Errorf("%s has more than one repeated field", m.Descriptor().FullName())
+			}
+			itemsField = f
+		}
+	}
+	if itemsField == nil {
+		return nil, "", fmt.Errorf("%s has no repeated field", m.Descriptor().FullName())
+	}
+
+	list := m.Get(itemsField).List()
+	items := make([]proto.Message, list.Len())
+	for i := range items {
+		items[i] = list.Get(i).Message().Interface()
+	}
+	return items, m.Get(token).String(), nil
+}
@@ -0,0 +1,121 @@
+package pagination
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+// listNetworks returns pages of networks named "<parent>-<n>", sizes are sizes of consecutive pages.
+func listNetworks(parent string, calls *int32, sizes ...int) ListFunc {
+	return func(ctx context.Context, pageToken string) (proto.Message, error) {
+		atomic.AddInt32(calls, 1)
+		n := 0
+		if pageToken != "" {
+			n, _ = strconv.Atoi(pageToken)
+		}
+
+		resp := &vpc.ListNetworksResponse{}
+		offset := 0
+		for i := 0; i < n; i++ {
+			offset += sizes[i]
+		}
+		for i := 0; i < sizes[n]; i++ {
+			resp.Networks = append(resp.Networks, &vpc.Network{Name: fmt.Sprintf("%s-%d", parent, offset+i)})
+		}
+		if n+1 < len(sizes) {
+			resp.NextPageToken = strconv.Itoa(n + 1)
+		}
+		return resp, nil
+	}
+}
+
+func names(items []proto.Message) []string {
+	var result []string
+	for _, item := range items {
+		result = append(result, item.(*vpc.Network).Name)
+	}
+	return result
+}
+
+func TestIterator(t *testing.T) {
+	var calls int32
+	it := New(context.Background(), listNetworks("net", &calls, 2, 0, 1))
+	defer it.Close()
+
+	var got []string
+	for it.Next() {
+		got = append(got, it.Value().(*vpc.Network).Name)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"net-0", "net-1", "net-2"}, got)
+	assert.Equal(t, int32(3), calls)
+	assert.False(t, it.Next())
+
+	items, err := List(context.Background(), listNetworks("net", &calls, 0))
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	// nil response is an empty last page
+	items, err = List(context.Background(), func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
+
+func TestIteratorError(t *testing.T) {
+	var calls int32
+	pages := listNetworks("net", &calls, 2, 2)
+	items, err := List(context.Background(), func(ctx context.Context, pageToken string) (proto.Message, error) {
+		if pageToken != "" {
+			return nil, errors.New("unavailable")
+		}
+		return pages(ctx, pageToken)
+	})
+	assert.EqualError(t, err, "unavailable")
+	assert.Len(t, items, 2)
+
+	_, err = List(context.Background(), func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return &vpc.ListNetworksResponse{Networks: []*vpc.Network{{}}, NextPageToken: "same"}, nil
+	})
+	assert.EqualError(t, err, `yandex.cloud.vpc.v1.ListNetworksResponse returned the same page token "same"`)
+
+	_, err = List(context.Background(), func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return &vpc.Network{}, nil
+	})
+	assert.EqualError(t, err, "yandex.cloud.vpc.v1.Network has no next_page_token field")
+}
+
+func TestListEach(t *testing.T) {
+	var calls int32
+	parents := []string{"a", "b", "c", "d"}
+	result, err := ListEach(context.Background(), parents, 2, func(parent string) ListFunc {
+		return listNetworks(parent, &calls, 1, 1)
+	})
+	require.NoError(t, err)
+	require.Len(t, result, len(parents))
+	for i, parent := range parents {
+		assert.Equal(t, []string{parent + "-0", parent + "-1"}, names(result[i]))
+	}
+	assert.Equal(t, int32(8), calls)
+
+	_, err = ListEach(context.Background(), parents, 2, func(parent string) ListFunc {
+		if parent == "b" {
+			return func(ctx context.Context, pageToken string) (proto.Message, error) {
+				return nil, errors.New("not found")
+			}
+		}
+		return listNetworks(parent, &calls, 1)
+	})
+	assert.EqualError(t, err, "not found")
+}
@@ -0,0 +1,244 @@
+package ratelimit
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/readonly"
+)
+
+// Options describes client-side limits applied to outgoing API calls.
+// Zero values mean "no limit".
+type Options struct {
+	// RequestsPerSecond and Burst limit all calls.
+	RequestsPerSecond float64
+	Burst             int
+
+	// MutatingRequestsPerSecond and MutatingBurst limit calls that change
+	// resources (everything except Get* and List* methods). Mutating calls
+	// consume tokens from this bucket instead of the common one.
+	MutatingRequestsPerSecond float64
+	MutatingBurst             int
+
+	// MaxConcurrentRequests caps the number of in-flight calls per service.
+	MaxConcurrentRequests int
+
+	// Services overrides limits for particular services. Keys are short
+	// service names as returned by ServiceName, e.g. "compute" or "mdb.postgresql".
+	Services map[string]ServiceOptions
+}
+
+// ServiceOptions describes limits applied to a single service in addition to global ones.
+type ServiceOptions struct {
+	RequestsPerSecond     float64
+	Burst                 int
+	MaxConcurrentRequests int
+}
+
+// Enabled reports whether any limit is configured.
+func (o Options) Enabled() bool {
+	return o.RequestsPerSecond > 0 || o.MutatingRequestsPerSecond > 0 || o.MaxConcurrentRequests > 0 || len(o.Services) > 0
+}
+
+// NewUnaryInterceptor returns interceptor which delays outgoing calls so that configured
+// rates and concurrency caps are never exceeded. Calls wait instead of failing with ResourceExhausted.
+func NewUnaryInterceptor(opts Options) grpc.UnaryClientInterceptor {
+	l := newLimiter(opts)
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+		release, err := l.acquire(ctx, method)
+		if err != nil {
+			return err
+		}
+		defer release()
+		return invoker(ctx, method, req, reply, cc, callOpts...)
+	}
+}
+
+// ServiceName returns short service name for full gRPC method name:
+// "/yandex.cloud.mdb.postgresql.v1.ClusterService/Get" -> "mdb.postgresql".
+// "/yandex.cloud.organizationmanager.v1.saml.FederationService/Get" -> "organizationmanager.saml".
+func ServiceName(method string) string {
+	method = strings.TrimPrefix(method, "/")
+	if i := strings.IndexByte(method, '/'); i >= 0 {
+		method = method[:i]
+	}
+	method = strings.TrimPrefix(method, "yandex.cloud.")
+
+	parts := strings.Split(method, ".")
+	// drop service type name
+	if len(parts) > 1 {
+		parts = parts[:len(parts)-1]
+	}
+	// drop API version
+	name := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if !isVersion(p) {
+			name = append(name, p)
+		}
+	}
+	return strings.Join(name, ".")
+}
+
+func isVersion(s string) bool {
+	return len(s) > 1 && s[0] == 'v' && s[1] >= '0' && s[1] <= '9'
+}
+
+type limiter struct {
+	opts     Options
+	common   *tokenBucket
+	mutating *tokenBucket
+
+	mu         sync.Mutex
+	services   map[string]*tokenBucket
+	semaphores map[string]chan struct{}
+}
+
+func newLimiter(opts Options) *limiter {
+	l := &limiter{
+		opts:       opts,
+		common:     newTokenBucket(opts.RequestsPerSecond, opts.Burst),
+		mutating:   newTokenBucket(opts.MutatingRequestsPerSecond, opts.MutatingBurst),
+		services:   make(map[string]*tokenBucket),
+		semaphores: make(map[string]chan struct{}),
+	}
+	for name, s := range opts.Services {
+		l.services[name] = newTokenBucket(s.RequestsPerSecond, s.Burst)
+	}
+	return l
+}
+
+// acquire blocks until method may be called and returns func that must be called once the call is finished.
+func (l *limiter) acquire(ctx context.Context, method string) (func(), error) {
+	service := ServiceName(method)
+
+	release := func() {}
+	if sem := l.semaphore(service); sem != nil {
+		select {
+		case sem <- struct{}{}:
+			release = func() { <-sem }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	bucket := l.common
+	if !readonly.IsReadOnlyMethod(method) && l.mutating != nil {
+		bucket = l.mutating
+	}
+
+	if err := bucket.wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	// The call is not made, so the token taken from the common or mutating bucket is given back.
+	if err := l.services[service].wait(ctx); err != nil {
+		bucket.cancel()
+		release()
+		return nil, err
+	}
+
+	return release, nil
+}
+
+func (l *limiter) semaphore(service string) chan struct{} {
+	limit := l.opts.MaxConcurrentRequests
+	if s, ok := l.opts.Services[service]; ok && s.MaxConcurrentRequests > 0 {
+		limit = s.MaxConcurrentRequests
+	}
+	if limit <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	sem, ok := l.semaphores[service]
+	if !ok {
+		sem = make(chan struct{}, limit)
+		l.semaphores[service] = sem
+	}
+	return sem
+}
+
+// tokenBucket is a classic token bucket: it is refilled with rate tokens per second
+// up to burst tokens, and every call takes one token.
+type tokenBucket struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// newTokenBucket returns nil for non-positive rate, nil bucket never blocks.
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if rate <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// reserve takes one token and returns how long caller should wait before using it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns token taken by reserve back to the bucket, it does nothing for nil bucket.
+func (b *tokenBucket) cancel() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens++
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+
+	delay := b.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,117 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceName(t *testing.T) {
+	cases := map[string]string{
+		"/yandex.cloud.compute.v1.InstanceService/Get":                    "compute",
+		"/yandex.cloud.mdb.postgresql.v1.ClusterService/Create":           "mdb.postgresql",
+		"/yandex.cloud.operation.OperationService/Get":                    "operation",
+		"/yandex.cloud.containerregistry.v1.RegistryService/List":         "containerregistry",
+		"/yandex.cloud.organizationmanager.v1.saml.FederationService/Get": "organizationmanager.saml",
+		"/unknown.Service/Get":                                            "unknown",
+	}
+	for method, expected := range cases {
+		assert.Equal(t, expected, ServiceName(method), method)
+	}
+}
+
+func TestTokenBucketReserve(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := newTokenBucket(2, 2)
+	b.now = func() time.Time { return now }
+
+	assert.Equal(t, time.Duration(0), b.reserve())
+	assert.Equal(t, time.Duration(0), b.reserve())
+	assert.Equal(t, 500*time.Millisecond, b.reserve())
+
+	now = now.Add(10 * time.Second)
+	// bucket is refilled up to burst only
+	assert.Equal(t, time.Duration(0), b.reserve())
+	assert.Equal(t, time.Duration(0), b.reserve())
+	assert.Equal(t, 500*time.Millisecond, b.reserve())
+}
+
+func TestNilTokenBucketNeverBlocks(t *testing.T) {
+	b := newTokenBucket(0, 10)
+	require.Nil(t, b)
+	assert.NoError(t, b.wait(context.Background()))
+}
+
+func TestLimiterConcurrency(t *testing.T) {
+	l := newLimiter(Options{
+		MaxConcurrentRequests: 5,
+		Services: map[string]ServiceOptions{
+			"compute": {MaxConcurrentRequests: 1},
+		},
+	})
+
+	release, err := l.acquire(context.Background(), "/yandex.cloud.compute.v1.InstanceService/Get")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = l.acquire(ctx, "/yandex.cloud.compute.v1.DiskService/Get")
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// other services are capped by global limit only
+	otherRelease, err := l.acquire(context.Background(), "/yandex.cloud.vpc.v1.NetworkService/Get")
+	require.NoError(t, err)
+	otherRelease()
+
+	release()
+	release, err = l.acquire(context.Background(), "/yandex.cloud.compute.v1.DiskService/Get")
+	require.NoError(t, err)
+	release()
+}
+
+func TestLimiterMutatingBucket(t *testing.T) {
+	l := newLimiter(Options{
+		RequestsPerSecond:         1000,
+		Burst:                     1000,
+		MutatingRequestsPerSecond: 0.001,
+		MutatingBurst:             1,
+	})
+
+	_, err := l.acquire(context.Background(), "/yandex.cloud.compute.v1.InstanceService/Create")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = l.acquire(ctx, "/yandex.cloud.compute.v1.InstanceService/Delete")
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	_, err = l.acquire(context.Background(), "/yandex.cloud.compute.v1.InstanceService/Get")
+	assert.NoError(t, err)
+}
+
+func TestLimiterServiceWaitGivesCommonTokenBack(t *testing.T) {
+	l := newLimiter(Options{
+		RequestsPerSecond: 0.001,
+		Burst:             2,
+		Services: map[string]ServiceOptions{
+			"compute": {RequestsPerSecond: 0.001, Burst: 1},
+		},
+	})
+
+	_, err := l.acquire(context.Background(), "/yandex.cloud.compute.v1.InstanceService/Get")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = l.acquire(ctx, "/yandex.cloud.compute.v1.DiskService/Get")
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// the call above is not made, so its common token is still available
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = l.acquire(ctx, "/yandex.cloud.vpc.v1.NetworkService/Get")
+	assert.NoError(t, err)
+}
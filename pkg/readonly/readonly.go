@@ -0,0 +1,71 @@
+package readonly
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrCode is the code of errors returned by AWS SDK handler for rejected requests.
+const ErrCode = "ReadOnlyMode"
+
+// allowedMethods are calls which don't follow Get/List naming, but don't change anything either.
+var allowedMethods = map[string]bool{
+	// SDK exchanges credentials for IAM token via this call.
+	"/yandex.cloud.iam.v1.IamTokenService/Create": true,
+}
+
+// IsReadOnlyMethod reports whether full gRPC method name refers to a call that doesn't change anything,
+// i.e. "/yandex.cloud.compute.v1.InstanceService/Get" or ".../ListOperations".
+func IsReadOnlyMethod(method string) bool {
+	if allowedMethods[method] {
+		return true
+	}
+	name := method[strings.LastIndexByte(method, '/')+1:]
+	return strings.HasPrefix(name, "Get") || strings.HasPrefix(name, "List")
+}
+
+// NewUnaryInterceptor returns interceptor which rejects every call that may change something.
+func NewUnaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !IsReadOnlyMethod(method) {
+			return status.Errorf(codes.PermissionDenied,
+				"provider is in read-only mode, call of %s is not allowed", shortMethod(method))
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// shortMethod strips common package prefix: "/yandex.cloud.compute.v1.InstanceService/Create" -> "compute.v1.InstanceService/Create".
+func shortMethod(method string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(method, "/"), "yandex.cloud.")
+}
+
+// IsReadOnlyOperation reports whether AWS SDK operation (S3 or YMQ) doesn't change anything.
+func IsReadOnlyOperation(op *request.Operation) bool {
+	if op == nil {
+		return false
+	}
+	for _, prefix := range []string{"Get", "List", "Head"} {
+		if strings.HasPrefix(op.Name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// AWSHandler rejects AWS SDK requests which may change something. It should be added to Validate handlers.
+var AWSHandler = request.NamedHandler{
+	Name: "yandex.ReadOnlyHandler",
+	Fn: func(r *request.Request) {
+		if !IsReadOnlyOperation(r.Operation) {
+			r.Error = awserr.New(ErrCode,
+				"provider is in read-only mode, call of "+r.ClientInfo.ServiceName+" "+r.Operation.Name+" is not allowed", nil)
+		}
+	},
+}
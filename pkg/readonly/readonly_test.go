@@ -0,0 +1,66 @@
+package readonly
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsReadOnlyMethod(t *testing.T) {
+	assert.True(t, IsReadOnlyMethod("/yandex.cloud.compute.v1.InstanceService/Get"))
+	assert.True(t, IsReadOnlyMethod("/yandex.cloud.compute.v1.InstanceService/ListOperations"))
+	assert.True(t, IsReadOnlyMethod("/yandex.cloud.compute.v1.InstanceService/GetSerialPortOutput"))
+	assert.True(t, IsReadOnlyMethod("/yandex.cloud.iam.v1.IamTokenService/Create"))
+	assert.False(t, IsReadOnlyMethod("/yandex.cloud.compute.v1.InstanceService/Create"))
+	assert.False(t, IsReadOnlyMethod("/yandex.cloud.compute.v1.InstanceService/SetAccessBindings"))
+	assert.False(t, IsReadOnlyMethod("/yandex.cloud.iam.v1.IamTokenService/CreateForServiceAccount"))
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	interceptor := NewUnaryInterceptor()
+	invoked := 0
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invoked++
+		return nil
+	}
+
+	err := interceptor(context.Background(), "/yandex.cloud.vpc.v1.NetworkService/Get", nil, nil, nil, invoker)
+	require.NoError(t, err)
+	assert.Equal(t, 1, invoked)
+
+	err = interceptor(context.Background(), "/yandex.cloud.vpc.v1.NetworkService/Delete", nil, nil, nil, invoker)
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "vpc.v1.NetworkService/Delete")
+	assert.Equal(t, 1, invoked)
+}
+
+func TestAWSHandler(t *testing.T) {
+	newRequest := func(name string) *request.Request {
+		return &request.Request{
+			ClientInfo: metadata.ClientInfo{ServiceName: "s3"},
+			Operation:  &request.Operation{Name: name},
+		}
+	}
+
+	for _, name := range []string{"GetObject", "ListObjects", "HeadBucket", "GetQueueUrl"} {
+		r := newRequest(name)
+		AWSHandler.Fn(r)
+		assert.NoError(t, r.Error, name)
+	}
+
+	for _, name := range []string{"PutObject", "DeleteBucket", "CreateQueue"} {
+		r := newRequest(name)
+		AWSHandler.Fn(r)
+		require.Error(t, r.Error, name)
+		assert.Equal(t, ErrCode, r.Error.(awserr.Error).Code())
+	}
+}
@@ -0,0 +1,80 @@
+package yandex
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/cassette"
+)
+
+// testAccSuiteCassette keeps calls made before tests start, i.e. by setTestIDs, and environment of the recorded run.
+const testAccSuiteCassette = "TestMain"
+
+// testAccCassetteEnvVars are saved into suite cassette and restored for replay. Token is never saved,
+// cassette.ReplayToken is used instead.
+var testAccCassetteEnvVars = []string{
+	"YC_CLOUD_ID",
+	"YC_ORGANIZATION_ID",
+	"YC_FOLDER_ID",
+	"YC_ZONE",
+	"YC_LOGIN",
+	"YC_LOGIN_2",
+	"YC_STORAGE_ENDPOINT_URL",
+	"YC_MESSAGE_QUEUE_ENDPOINT",
+}
+
+// testAccStartSuiteCassette turns on recording or replay of API calls if YC_CASSETTE is "record" or "replay".
+// Cassettes are kept in test-fixtures/cassettes unless YC_CASSETTE_DIR is set.
+func testAccStartSuiteCassette() error {
+	mode, err := cassette.ParseMode(os.Getenv("YC_CASSETTE"))
+	if err != nil || mode == cassette.Off {
+		return err
+	}
+
+	dir := os.Getenv("YC_CASSETTE_DIR")
+	if dir == "" {
+		dir = filepath.Join("test-fixtures", "cassettes")
+	}
+
+	apiCassette = cassette.New(mode, dir)
+	if err := apiCassette.Start(testAccSuiteCassette); err != nil {
+		return err
+	}
+	if err := apiCassette.Env(testAccCassetteEnvVars...); err != nil {
+		return err
+	}
+	if mode == cassette.Replay {
+		return os.Setenv("YC_TOKEN", cassette.ReplayToken)
+	}
+	return nil
+}
+
+func testAccStopSuiteCassette() error {
+	if apiCassette == nil {
+		return nil
+	}
+	return apiCassette.Stop()
+}
+
+// testAccStartCassette switches to the cassette of the test until it finishes.
+// Tests which have nothing recorded are skipped in replay mode.
+func testAccStartCassette(t *testing.T) {
+	if apiCassette == nil {
+		return
+	}
+
+	err := apiCassette.Start(t.Name())
+	if errors.Is(err, os.ErrNotExist) {
+		t.Skipf("no cassette recorded for %s", t.Name())
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := apiCassette.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+}
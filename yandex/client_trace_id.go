@@ -0,0 +1,44 @@
+package yandex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// addClientTraceID adds client trace id of the run to contexts passed into CRUD functions of every resource
+// and Read of every data source, so all API calls made during a run share the same x-client-trace-id.
+func addClientTraceID(resources map[string]*schema.Resource, dataSources map[string]*schema.Resource) {
+	for _, r := range resources {
+		withClientTraceID(r)
+	}
+	for _, r := range dataSources {
+		withClientTraceID(r)
+	}
+}
+
+func withClientTraceID(r *schema.Resource) *schema.Resource {
+	if r.CreateContext != nil {
+		r.CreateContext = wrapClientTraceIDContext(r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = wrapClientTraceIDContext(r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrapClientTraceIDContext(r.UpdateContext)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = wrapClientTraceIDContext(r.DeleteContext)
+	}
+	return r
+}
+
+func wrapClientTraceIDContext(f crudContextFunc) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if config, ok := meta.(*Config); ok {
+			ctx = config.ContextWithClientTraceID(ctx)
+		}
+		return f(ctx, d, meta)
+	}
+}
@@ -0,0 +1,39 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestWithClientTraceID(t *testing.T) {
+	var traceIDs []string
+	crud := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		traceIDs = append(traceIDs, md.Get("x-client-trace-id")...)
+		return nil
+	}
+	r := withClientTraceID(&schema.Resource{
+		Schema:        map[string]*schema.Schema{},
+		CreateContext: crud,
+		ReadContext:   crud,
+		DeleteContext: crud,
+	})
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+
+	config := &Config{clientTraceID: "trace-id"}
+	require.Empty(t, r.CreateContext(context.Background(), d, config))
+	require.Empty(t, r.ReadContext(context.Background(), d, config))
+	require.Empty(t, r.DeleteContext(context.Background(), d, config))
+	assert.Equal(t, []string{"trace-id", "trace-id", "trace-id"}, traceIDs)
+
+	// Config which wasn't initialized has no trace id.
+	traceIDs = nil
+	require.Empty(t, r.ReadContext(context.Background(), d, &Config{}))
+	assert.Empty(t, traceIDs)
+}
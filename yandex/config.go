@@ -5,16 +5,19 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"math"
 	"math/rand"
 	"net"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/google/uuid"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -27,14 +30,24 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/cassette"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/lookupcache"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/ratelimit"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/readonly"
 )
 
 const (
 	defaultExponentialBackoffBase = 50 * time.Millisecond
 	defaultExponentialBackoffCap  = 1 * time.Minute
+
+	defaultLookupCacheTTL        = 10 * time.Minute
+	defaultLookupCacheMaxEntries = 4096
 )
 
+// apiCassette records or replays API calls of acceptance tests, it is set by tests only.
+var apiCassette *cassette.Recorder
+
 type Config struct {
 	Endpoint                       string
 	FolderID                       string
@@ -45,6 +58,10 @@ type Config struct {
 	ServiceAccountKeyFileOrContent string
 	Plaintext                      bool
 	Insecure                       bool
+	CABundleFile                   string
+	ProxyURL                       string
+	ClientCertificateFile          string
+	ClientKeyFile                  string
 	MaxRetries                     int
 	StorageEndpoint                string
 	YMQEndpoint                    string
@@ -60,13 +77,44 @@ type Config struct {
 	YMQAccessKey string
 	YMQSecretKey string
 
+	// ReadOnly forbids every API call which may change resources.
+	ReadOnly bool
+
+	// DefaultLabels are merged into labels of every resource supporting labels.
+	DefaultLabels map[string]string
+
+	// RateLimit configures client-side limiting of API calls, disabled by default.
+	RateLimit ratelimit.Options
+
+	// APILog configures API call log written into a separate file, disabled by default.
+	APILog logging.APILogOptions
+
 	// contextWithClientTraceID is a context that has client-trace-id in its metadata
 	// It is initialized from stopContext at the same time as ycsdk.SDK
 	contextWithClientTraceID context.Context
+	// clientTraceID is the client-trace-id of this run, see ContextWithClientTraceID
+	clientTraceID string
 
 	userAgent       string
 	sdk             *ycsdk.SDK
 	defaultS3Client *s3.S3
+
+	// tlsConfig and proxyURL are shared by gRPC, S3 and YMQ clients.
+	tlsConfig *tls.Config
+	proxyURL  *url.URL
+
+	// lookupCache keeps results of lookups which don't change during a run, see cachedLookup.
+	lookupCache *lookupcache.Cache
+
+	// accessBindingBatcher coalesces changes of access bindings made with this configuration.
+	accessBindingBatcher *accessBindingDeltaBatcher
+
+	// dialOptions are passed to the SDK, they are kept for connections to services the SDK has no clients for.
+	dialOptions             []grpc.DialOption
+	organizationManagerConn *lazyEndpointConn
+
+	// apiLogCloser closes API call log file, it is nil if the log is not requested.
+	apiLogCloser io.Closer
 }
 
 // this function return context with added client trace id
@@ -79,22 +127,43 @@ func (c *Config) ContextWithTimeout(timeout time.Duration) (context.Context, con
 	return context.WithTimeout(c.contextWithClientTraceID, timeout)
 }
 
+// ContextWithClientTraceID adds client trace id of this run to the context, it is used for contexts passed
+// by Terraform into CRUD functions, so their API calls are traced the same way as calls made with Context()
+func (c *Config) ContextWithClientTraceID(ctx context.Context) context.Context {
+	if c.clientTraceID == "" {
+		return ctx
+	}
+	return requestid.ContextWithClientTraceID(ctx, c.clientTraceID)
+}
+
+// cachedLookup returns result of the lookup made earlier during this run, or calls load.
+// It should be used only for values which can't change while Terraform runs.
+// Zones and platforms have no lookups to cache: they are taken from the configuration as is.
+func (c *Config) cachedLookup(key string, load func() (interface{}, error)) (interface{}, error) {
+	return c.lookupCache.GetOrLoad(key, load)
+}
+
 // Client configures and returns a fully initialized Yandex.Cloud sdk
 func (c *Config) initAndValidate(stopContext context.Context, terraformVersion string, sweeper bool) error {
-	c.contextWithClientTraceID = requestid.ContextWithClientTraceID(stopContext, uuid.New().String())
+	c.clientTraceID = uuid.New().String()
+	c.contextWithClientTraceID = requestid.ContextWithClientTraceID(stopContext, c.clientTraceID)
+	c.lookupCache = lookupcache.New(defaultLookupCacheTTL, defaultLookupCacheMaxEntries)
+	c.accessBindingBatcher = newAccessBindingDeltaBatcher(yandexIAMAccessBindingBatchWindow)
 
 	credentials, err := c.credentials()
 	if err != nil {
 		return err
 	}
 
+	if err := c.initTransport(); err != nil {
+		return err
+	}
+
 	yandexSDKConfig := &ycsdk.Config{
 		Credentials: credentials,
 		Endpoint:    c.Endpoint,
 		Plaintext:   c.Plaintext,
-		TLSConfig: &tls.Config{
-			InsecureSkipVerify: c.Insecure,
-		},
+		TLSConfig:   c.tlsConfig,
 	}
 
 	providerNameAndVersion := getProviderNameAndVersion()
@@ -118,7 +187,30 @@ func (c *Config) initAndValidate(stopContext context.Context, terraformVersion s
 
 	var interceptors = []grpc.UnaryClientInterceptor{
 		retryInterceptor,
-		requestIDInterceptor,
+	}
+
+	// Rate limiter is below retry interceptor, so every retry attempt waits for its own token.
+	if c.RateLimit.Enabled() {
+		log.Print("[INFO] API rate limiting has been requested, turning on")
+		interceptors = append(interceptors, ratelimit.NewUnaryInterceptor(c.RateLimit))
+	}
+
+	interceptors = append(interceptors, requestIDInterceptor)
+
+	// API call log is below id interceptor to have request ids in it.
+	if c.APILog.Enabled() {
+		log.Printf("[INFO] API call log has been requested, writing it into %s", c.APILog.Path)
+		apiLogInterceptor, apiLogCloser, err := logging.NewAPILogSinkUnaryInterceptor(c.APILog)
+		if err != nil {
+			return err
+		}
+		c.apiLogCloser = apiLogCloser
+		interceptors = append(interceptors, apiLogInterceptor)
+	}
+
+	if c.ReadOnly {
+		log.Print("[INFO] Read-only mode has been requested, turning on")
+		interceptors = append(interceptors, readonly.NewUnaryInterceptor())
 	}
 
 	// Support deep API logging in case user has requested it.
@@ -131,16 +223,56 @@ func (c *Config) initAndValidate(stopContext context.Context, terraformVersion s
 	// Now we will have new request id for every retry attempt.
 	interceptorChain := grpc_middleware.ChainUnaryClient(interceptors...)
 
-	c.sdk, err = ycsdk.Build(c.contextWithClientTraceID, *yandexSDKConfig,
+	dialOptions := []grpc.DialOption{
 		grpc.WithUserAgent(c.userAgent),
 		grpc.WithDefaultCallOptions(grpc.Header(&headerMD)),
-		grpc.WithUnaryInterceptor(interceptorChain))
+		grpc.WithUnaryInterceptor(interceptorChain),
+	}
 
-	if err == nil {
-		err = c.initializeDefaultS3Client()
+	// Explicit proxy replaces the SDK dialer, which takes proxy from environment only.
+	if c.proxyURL != nil {
+		dialOptions = append(dialOptions, grpc.WithContextDialer(newProxyDialer(c.proxyURL, c.tlsConfig)))
 	}
 
-	return err
+	// Cassette goes last to see calls exactly as they are sent, in replay mode it replaces the dialer as well.
+	if apiCassette != nil {
+		dialOptions = append(dialOptions, apiCassette.DialOptions()...)
+	}
+
+	c.dialOptions = dialOptions
+	c.organizationManagerConn = newLazyEndpointConn(ycsdk.OrganizationManagementServiceID)
+	c.sdk, err = ycsdk.Build(c.contextWithClientTraceID, *yandexSDKConfig, dialOptions...)
+	if err != nil {
+		return err
+	}
+
+	// Connections are closed once Terraform stops the provider, stop context of sweepers and tests is never done.
+	if stopContext.Done() != nil {
+		go func() {
+			<-stopContext.Done()
+			if err := c.shutdown(context.Background()); err != nil {
+				log.Printf("[WARN] failed to close Yandex.Cloud connections: %s", err)
+			}
+		}()
+	}
+
+	return c.initializeDefaultS3Client()
+}
+
+// shutdown closes connections of the SDK and connections to services the SDK has no clients for,
+// API call log is closed after them to have all calls in it.
+func (c *Config) shutdown(ctx context.Context) error {
+	connErr := c.organizationManagerConn.close()
+	sdkErr := c.sdk.Shutdown(ctx)
+	if c.apiLogCloser != nil {
+		if err := c.apiLogCloser.Close(); err != nil {
+			return err
+		}
+	}
+	if sdkErr != nil {
+		return sdkErr
+	}
+	return connErr
 }
 
 func (c *Config) initializeDefaultS3Client() (err error) {
@@ -152,11 +284,18 @@ func (c *Config) initializeDefaultS3Client() (err error) {
 		return fmt.Errorf("both storage access key and storage secret key should be specified or not specified")
 	}
 
-	c.defaultS3Client, err = newS3Client(c.StorageEndpoint, c.StorageAccessKey, c.StorageSecretKey)
+	c.defaultS3Client, err = c.newS3Client(c.StorageAccessKey, c.StorageSecretKey)
 
 	return err
 }
 
+// initAWSHandlers adds provider-wide handlers to S3 and YMQ clients.
+func (c *Config) initAWSHandlers(handlers *request.Handlers) {
+	if c.ReadOnly {
+		handlers.Validate.PushBackNamed(readonly.AWSHandler)
+	}
+}
+
 func (c *Config) credentials() (ycsdk.Credentials, error) {
 	if c.ServiceAccountKeyFileOrContent != "" {
 		contents, _, err := pathOrContents(c.ServiceAccountKeyFileOrContent)
@@ -3,6 +3,7 @@ package yandex
 import (
 	"io/ioutil"
 	"net"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,6 +13,8 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
 )
 
 const testConfigToken = "some_special_secured_token"
@@ -92,6 +95,41 @@ func TestConfigUserAgent(t *testing.T) {
 	assert.Contains(t, mockServerImpl.userAgent, "Terraform/")
 }
 
+func TestConfigAPILog(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	l := localListener(t)
+	endpoint.RegisterApiEndpointServiceServer(grpcServer, &userAgentMockServerAPIEndpoint{addr: l.Addr().String()})
+
+	go func() { _ = grpcServer.Serve(l) }()
+	defer grpcServer.Stop()
+
+	logPath := filepath.Join(t.TempDir(), "api.log")
+	config := Config{
+		Endpoint:  l.Addr().String(),
+		FolderID:  testConfigFolder,
+		Token:     testConfigToken,
+		Plaintext: true,
+		APILog: logging.APILogOptions{
+			Path:           logPath,
+			AllowedMethods: []string{"*ApiEndpointService/List"},
+		},
+	}
+
+	err := config.initAndValidate(context.Background(), testTerraformVersion, false)
+	require.NoError(t, err)
+
+	_, err = config.sdk.ApiEndpoint().ApiEndpoint().List(context.Background(), &endpoint.ListApiEndpointsRequest{})
+	require.NoError(t, err)
+	// the log file is closed together with connections
+	require.NoError(t, config.shutdown(context.Background()))
+	require.NoError(t, config.apiLogCloser.Close())
+
+	content, err := ioutil.ReadFile(logPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"method":"/yandex.cloud.endpoint.ApiEndpointService/List"`)
+	assert.Contains(t, string(content), `"client_request_id":`)
+}
+
 type userAgentMockServerAPIEndpoint struct {
 	userAgent string
 	addr      string
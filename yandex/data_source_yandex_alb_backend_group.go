@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
@@ -11,7 +13,7 @@ import (
 
 func dataSourceYandexALBBackendGroup() *schema.Resource {
 	return &schema.Resource{
-		Read:          dataSourceYandexALBBackendGroupRead,
+		ReadContext:   dataSourceYandexALBBackendGroupRead,
 		SchemaVersion: 0,
 
 		Schema: map[string]*schema.Schema{
@@ -424,13 +426,12 @@ func dataSourceTLS() *schema.Schema {
 	}
 }
 
-func dataSourceYandexALBBackendGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexALBBackendGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "backend_group_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bgID := d.Get("backend_group_id").(string)
@@ -439,7 +440,7 @@ func dataSourceYandexALBBackendGroupRead(d *schema.ResourceData, meta interface{
 	if bgNameOk {
 		bgID, err = resolveObjectID(ctx, config, d, sdkresolvers.ALBBackendGroupResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source ALB Backend Group by name: %v", err)
+			return diag.Errorf("failed to resolve data source ALB Backend Group by name: %v", err)
 		}
 	}
 
@@ -448,7 +449,7 @@ func dataSourceYandexALBBackendGroupRead(d *schema.ResourceData, meta interface{
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ALB Backend Group with ID %q", bgID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("ALB Backend Group with ID %q", bgID)))
 	}
 
 	d.Set("backend_group_id", bg.Id)
@@ -461,53 +462,53 @@ func dataSourceYandexALBBackendGroupRead(d *schema.ResourceData, meta interface{
 	case *apploadbalancer.BackendGroup_Http:
 		backends, err := flattenALBHTTPBackends(bg)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("http_backend", backends); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		affinity, err := flattenALBHTTPSessionAffinity(bg.GetHttp())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("session_affinity", affinity); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	case *apploadbalancer.BackendGroup_Grpc:
 		backends, err := flattenALBGRPCBackends(bg)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("grpc_backend", backends); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		affinity, err := flattenALBGRPCSessionAffinity(bg.GetGrpc())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("session_affinity", affinity); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	case *apploadbalancer.BackendGroup_Stream:
 		backends, err := flattenALBStreamBackends(bg)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("stream_backend", backends); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		affinity, err := flattenALBStreamSessionAffinity(bg.GetStream())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("session_affinity", affinity); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if err := d.Set("labels", bg.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(bg.Id)
@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
@@ -10,7 +12,7 @@ import (
 
 func dataSourceYandexALBHTTPRouter() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexALBHTTPRouterRead,
+		ReadContext: dataSourceYandexALBHTTPRouterRead,
 		Schema: map[string]*schema.Schema{
 			"http_router_id": {
 				Type:     schema.TypeString,
@@ -50,13 +52,12 @@ func dataSourceYandexALBHTTPRouter() *schema.Resource {
 	}
 }
 
-func dataSourceYandexALBHTTPRouterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexALBHTTPRouterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "http_router_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routerID := d.Get("http_router_id").(string)
@@ -65,7 +66,7 @@ func dataSourceYandexALBHTTPRouterRead(d *schema.ResourceData, meta interface{})
 	if routerNameOk {
 		routerID, err = resolveObjectID(ctx, config, d, sdkresolvers.ALBHTTPRouterResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Http Router by name: %v", err)
+			return diag.Errorf("failed to resolve data source Http Router by name: %v", err)
 		}
 	}
 
@@ -74,7 +75,7 @@ func dataSourceYandexALBHTTPRouterRead(d *schema.ResourceData, meta interface{})
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Http Router with ID %q", routerID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Http Router with ID %q", routerID)))
 	}
 
 	d.Set("http_router_id", router.Id)
@@ -84,7 +85,7 @@ func dataSourceYandexALBHTTPRouterRead(d *schema.ResourceData, meta interface{})
 	d.Set("folder_id", router.FolderId)
 
 	if err := d.Set("labels", router.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(router.Id)
@@ -1,9 +1,11 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
@@ -11,9 +13,9 @@ import (
 
 func dataSourceYandexALBLoadBalancer() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexALBLoadBalancerRead,
+		ReadContext: dataSourceYandexALBLoadBalancerRead,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -316,13 +318,12 @@ func dataSourceStreamHandler() *schema.Schema {
 	}
 }
 
-func dataSourceYandexALBLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexALBLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "load_balancer_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	albID := d.Get("load_balancer_id").(string)
@@ -331,7 +332,7 @@ func dataSourceYandexALBLoadBalancerRead(d *schema.ResourceData, meta interface{
 	if albNameOk {
 		albID, err = resolveObjectID(ctx, config, d, sdkresolvers.ApplicationLoadBalancerResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Load Balancerby name: %v", err)
+			return diag.Errorf("failed to resolve data source Load Balancerby name: %v", err)
 		}
 	}
 
@@ -340,7 +341,7 @@ func dataSourceYandexALBLoadBalancerRead(d *schema.ResourceData, meta interface{
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ALB Load Balancer %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("ALB Load Balancer %q", d.Get("name").(string))))
 	}
 
 	d.Set("load_balancer_id", alb.Id)
@@ -355,22 +356,22 @@ func dataSourceYandexALBLoadBalancerRead(d *schema.ResourceData, meta interface{
 
 	allocationPolicy, err := flattenALBAllocationPolicy(alb)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("allocation_policy", allocationPolicy); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	listeners, err := flattenALBListeners(alb)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("listener", listeners); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("labels", alb.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(alb.Id)
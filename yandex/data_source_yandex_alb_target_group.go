@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
@@ -10,7 +12,7 @@ import (
 
 func dataSourceYandexALBTargetGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexALBTargetGroupRead,
+		ReadContext: dataSourceYandexALBTargetGroupRead,
 		Schema: map[string]*schema.Schema{
 			"target_group_id": {
 				Type:     schema.TypeString,
@@ -71,13 +73,12 @@ func dataSourceYandexALBTargetGroup() *schema.Resource {
 	}
 }
 
-func dataSourceYandexALBTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexALBTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "target_group_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tgID := d.Get("target_group_id").(string)
@@ -86,7 +87,7 @@ func dataSourceYandexALBTargetGroupRead(d *schema.ResourceData, meta interface{}
 	if tgNameOk {
 		tgID, err = resolveObjectID(ctx, config, d, sdkresolvers.ALBTargetGroupResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source target group by name: %v", err)
+			return diag.Errorf("failed to resolve data source target group by name: %v", err)
 		}
 	}
 
@@ -95,7 +96,7 @@ func dataSourceYandexALBTargetGroupRead(d *schema.ResourceData, meta interface{}
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("target group with ID %q", tgID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("target group with ID %q", tgID)))
 	}
 
 	targets := flattenALBTargets(tg)
@@ -107,11 +108,11 @@ func dataSourceYandexALBTargetGroupRead(d *schema.ResourceData, meta interface{}
 	d.Set("folder_id", tg.FolderId)
 
 	if err := d.Set("labels", tg.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("target", targets); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(tg.Id)
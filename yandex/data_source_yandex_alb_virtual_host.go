@@ -1,16 +1,18 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
 )
 
 func dataSourceYandexALBVirtualHost() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexALBVirtualHostRead,
+		ReadContext: dataSourceYandexALBVirtualHostRead,
 
 		Schema: map[string]*schema.Schema{
 			"virtual_host_id": {
@@ -276,18 +278,17 @@ func retrieveDataFromVirtualHostID(id string) (string, string) {
 	return attrs[0], attrs[1]
 }
 
-func dataSourceYandexALBVirtualHostRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexALBVirtualHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "virtual_host_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = checkOneOf(d, "virtual_host_id", "http_router_id")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	virtualHostName := d.Get("name").(string)
@@ -306,22 +307,22 @@ func dataSourceYandexALBVirtualHostRead(d *schema.ResourceData, meta interface{}
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Application Virtual Host %q", virtualHostName))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Application Virtual Host %q", virtualHostName)))
 	}
 
 	requestHeaderModification, err := flattenALBHeaderModification(virtualHost.ModifyRequestHeaders)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	responseHeaderModification, err := flattenALBHeaderModification(virtualHost.ModifyResponseHeaders)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routes, err := flattenALBRoutes(virtualHost.Routes)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("virtual_host_id", virtualHostID.(string))
@@ -329,15 +330,15 @@ func dataSourceYandexALBVirtualHostRead(d *schema.ResourceData, meta interface{}
 	d.Set("authority", virtualHost.Authority)
 
 	if err := d.Set("modify_request_headers", requestHeaderModification); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("modify_response_headers", responseHeaderModification); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("route", routes); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(virtualHostID.(string))
@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/apigateway/v1"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
@@ -10,7 +12,7 @@ import (
 
 func dataSourceYandexApiGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexApiGatewayRead,
+		ReadContext: dataSourceYandexApiGatewayRead,
 
 		SchemaVersion: 0,
 
@@ -72,15 +74,12 @@ func dataSourceYandexApiGateway() *schema.Resource {
 	}
 }
 
-func dataSourceYandexApiGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexApiGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	err := checkOneOf(d, "api_gateway_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	apiGatewayID := d.Get("api_gateway_id").(string)
@@ -89,7 +88,7 @@ func dataSourceYandexApiGatewayRead(d *schema.ResourceData, meta interface{}) er
 	if tgNameOk {
 		apiGatewayID, err = resolveObjectID(ctx, config, d, sdkresolvers.APIGatewayResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Yandex Cloud API Gateway by name: %v", err)
+			return diag.Errorf("failed to resolve data source Yandex Cloud API Gateway by name: %v", err)
 		}
 	}
 
@@ -99,10 +98,10 @@ func dataSourceYandexApiGatewayRead(d *schema.ResourceData, meta interface{}) er
 
 	apiGateway, err := config.sdk.Serverless().APIGateway().ApiGateway().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud API Gateway %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud API Gateway %q", d.Id())))
 	}
 
 	d.SetId(apiGateway.Id)
 	d.Set("api_gateway_id", apiGateway.Id)
-	return flattenYandexApiGateway(d, apiGateway)
+	return diag.FromErr(flattenYandexApiGateway(d, apiGateway))
 }
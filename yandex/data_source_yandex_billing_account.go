@@ -1,15 +1,16 @@
 package yandex
 
-
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/billing/v1"
 )
 
 func dataSourceYandexBillingAccountContent() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexBillingAccountContentRead,
+		ReadContext: dataSourceYandexBillingAccountContentRead,
 		Schema: map[string]*schema.Schema{
 			"billing_id": {
 				Type:     schema.TypeString,
@@ -23,9 +24,8 @@ func dataSourceYandexBillingAccountContent() *schema.Resource {
 	}
 }
 
-func dataSourceYandexBillingAccountContentRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexBillingAccountContentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	billingId := d.Get("billing_id").(string)
 	d.SetId(billingId)
@@ -33,12 +33,12 @@ func dataSourceYandexBillingAccountContentRead(d *schema.ResourceData, meta inte
 	data, err := config.sdk.Billing().BillingAccount().Get(ctx, &billing.GetBillingAccountRequest{Id: billingId})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Billing Id %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Billing Id %q", d.Id())))
 	}
 
 	if err := d.Set("balance", data.Balance); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("billing_id", billingId)
+	return diag.FromErr(d.Set("billing_id", billingId))
 }
@@ -5,14 +5,18 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/cdn/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/pagination"
 )
 
 func dataSourceYandexCDNOriginGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexCDNOriginGroupRead,
+		ReadContext: dataSourceYandexCDNOriginGroupRead,
 		Schema: map[string]*schema.Schema{
 			"origin_group_id": {
 				Type:     schema.TypeInt,
@@ -64,17 +68,14 @@ func dataSourceYandexCDNOriginGroup() *schema.Resource {
 	}
 }
 
-func dataSourceYandexCDNOriginGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexCDNOriginGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Reading CDN Origin Group %q", d.Id())
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("error getting folder ID while reading CDN origin group: %s", err)
+		return diag.Errorf("error getting folder ID while reading CDN origin group: %s", err)
 	}
 
 	originGroupID := int64(d.Get("origin_group_id").(int))
@@ -88,7 +89,7 @@ func dataSourceYandexCDNOriginGroupRead(d *schema.ResourceData, meta interface{}
 		groupName := d.Get("name").(string)
 		originGroupID, err = resolveCDNOriginGroupID(ctx, config, folderID, groupName)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source cdn origin group by name: %v", err)
+			return diag.Errorf("failed to resolve data source cdn origin group by name: %v", err)
 		}
 	}
 
@@ -100,18 +101,18 @@ func dataSourceYandexCDNOriginGroupRead(d *schema.ResourceData, meta interface{}
 	}()
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	originGroup, err := config.sdk.CDN().OriginGroup().Get(ctx, request)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("origin group %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("origin group %q", d.Id())))
 	}
 
 	log.Printf("[DEBUG] Completed Reading CDN Origin Group %q", d.Id())
 
 	if err := flattenYandexCDNOriginGroup(d, originGroup); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -122,16 +123,24 @@ func resolveCDNOriginGroupID(ctx context.Context, config *Config, folderID, name
 		return 0, fmt.Errorf("empty name for origin group")
 	}
 
-	iterator := config.sdk.CDN().OriginGroup().OriginGroupIterator(ctx, &cdn.ListOriginGroupsRequest{
-		FolderId: folderID,
+	iterator := pagination.New(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.CDN().OriginGroup().List(ctx, &cdn.ListOriginGroupsRequest{
+			FolderId:  folderID,
+			PageSize:  pagination.PageSize,
+			PageToken: pageToken,
+		})
 	})
+	defer iterator.Close()
 
 	for iterator.Next() {
-		originGroup := iterator.Value()
+		originGroup := iterator.Value().(*cdn.OriginGroup)
 		if name == originGroup.Name {
 			return originGroup.Id, nil
 		}
 	}
+	if err := iterator.Err(); err != nil {
+		return 0, err
+	}
 
 	return 0, fmt.Errorf("origin name %q not found", name)
 }
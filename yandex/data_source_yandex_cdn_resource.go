@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/cdn/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/pagination"
 )
 
 func dataSourceYandexCDNResource() *schema.Resource {
 	resourceSchema := defineYandexCDNResourceBaseSchema()
 
-	resourceSchema.Read = dataSourceYandexCDNResourceRead
+	resourceSchema.ReadContext = dataSourceYandexCDNResourceRead
 	resourceSchema.Schema["resource_id"] = &schema.Schema{
 		Type: schema.TypeString,
 
@@ -35,27 +39,34 @@ func resolveCDNResourceID(ctx context.Context, config *Config, d *schema.Resourc
 		return "", err
 	}
 
-	iterator := config.sdk.CDN().Resource().ResourceIterator(ctx, &cdn.ListResourcesRequest{
-		FolderId: folderID,
+	iterator := pagination.New(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.CDN().Resource().List(ctx, &cdn.ListResourcesRequest{
+			FolderId:  folderID,
+			PageSize:  pagination.PageSize,
+			PageToken: pageToken,
+		})
 	})
+	defer iterator.Close()
 
 	for iterator.Next() {
-		cdnResource := iterator.Value()
+		cdnResource := iterator.Value().(*cdn.Resource)
 		if cname == cdnResource.Cname {
 			return cdnResource.Id, nil
 		}
 	}
+	if err := iterator.Err(); err != nil {
+		return "", err
+	}
 
 	return "", fmt.Errorf("resource with cname %q not found", cname)
 }
 
-func dataSourceYandexCDNResourceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexCDNResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "resource_id", "cname")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resourceID := d.Get("resource_id").(string)
@@ -64,7 +75,7 @@ func dataSourceYandexCDNResourceRead(d *schema.ResourceData, meta interface{}) e
 	if resourceCNameOk {
 		resourceID, err = resolveCDNResourceID(ctx, config, d)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source cdn resource by name: %v", err)
+			return diag.Errorf("failed to resolve data source cdn resource by name: %v", err)
 		}
 	}
 
@@ -73,11 +84,11 @@ func dataSourceYandexCDNResourceRead(d *schema.ResourceData, meta interface{}) e
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("cdn resource with ID %q", resourceID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("cdn resource with ID %q", resourceID)))
 	}
 
 	if err := flattenYandexCDNResource(d, resource); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("resource_id", resource.Id)
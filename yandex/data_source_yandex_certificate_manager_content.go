@@ -1,7 +1,9 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/certificatemanager/v1"
 	"strings"
@@ -9,10 +11,10 @@ import (
 
 func dataSourceYandexCertificateManagerContent() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexCertificateManagerContentRead,
+		ReadContext: dataSourceYandexCertificateManagerContentRead,
 		Schema: map[string]*schema.Schema{
 			"certificate_id": {
-				Type: schema.TypeString,
+				Type:     schema.TypeString,
 				Required: true,
 			},
 			"private_key": {
@@ -23,27 +25,25 @@ func dataSourceYandexCertificateManagerContent() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-
 		},
 	}
 }
 
-func dataSourceYandexCertificateManagerContentRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexCertificateManagerContentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	certificateId := d.Get("certificate_id").(string)
 	d.SetId(certificateId)
 
-	data, err := config.sdk.CertificatesData().CertificateContent().Get(ctx, &certificatemanager.GetCertificateContentRequest{CertificateId: certificateId })
+	data, err := config.sdk.CertificatesData().CertificateContent().Get(ctx, &certificatemanager.GetCertificateContentRequest{CertificateId: certificateId})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Certificate Id %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Certificate Id %q", d.Id())))
 	}
 	if err := d.Set("private_key", data.PrivateKey); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("certificate_chain", strings.Join(data.CertificateChain, "\n")); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return d.Set("certificate_id", certificateId)
-}
\ No newline at end of file
+	return diag.FromErr(d.Set("certificate_id", certificateId))
+}
@@ -1,35 +1,42 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/proto"
+
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/certificatemanager/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/pagination"
 )
 
-//	Type CertificateType `protobuf:"varint,7,opt,name=type,proto3,enum=yandex.cloud.certificatemanager.v1.CertificateType" json:"type,omitempty"`
-//	// Fully qualified domain names of the certificate.
-//	Status Certificate_Status `protobuf:"varint,9,opt,name=status,proto3,enum=yandex.cloud.certificatemanager.v1.Certificate_Status" json:"status,omitempty"`
-//	// [Distinguished Name](https://tools.ietf.org/html/rfc1779) of the certificate authority that issued the certificate.
-//	Issuer string `protobuf:"bytes,10,opt,name=issuer,proto3" json:"issuer,omitempty"`
-//	// [Distinguished Name](https://tools.ietf.org/html/rfc1779) of the entity that is associated with the public key contained in the certificate.
-//	Subject string `protobuf:"bytes,11,opt,name=subject,proto3" json:"subject,omitempty"`
-//	// Serial number of the certificate.
-//	Serial string `protobuf:"bytes,12,opt,name=serial,proto3" json:"serial,omitempty"`
-//	// Time when the certificate is updated.
-//	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-//	// Time when the certificate is issued.
-//	IssuedAt *timestamp.Timestamp `protobuf:"bytes,14,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
-//	// Time after which the certificate is not valid.
-//	NotAfter *timestamp.Timestamp `protobuf:"bytes,15,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
-//	// Time before which the certificate is not valid.
-//	NotBefore *timestamp.Timestamp `protobuf:"bytes,16,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
-//	// Domains validation challenges of the certificate. Used only for managed certificates.
-//	Challenges []*Challenge `protobuf:"bytes,17,rep,name=challenges,proto3" json:"challenges,omitempty"`
+// Type CertificateType `protobuf:"varint,7,opt,name=type,proto3,enum=yandex.cloud.certificatemanager.v1.CertificateType" json:"type,omitempty"`
+// // Fully qualified domain names of the certificate.
+// Status Certificate_Status `protobuf:"varint,9,opt,name=status,proto3,enum=yandex.cloud.certificatemanager.v1.Certificate_Status" json:"status,omitempty"`
+// // [Distinguished Name](https://tools.ietf.org/html/rfc1779) of the certificate authority that issued the certificate.
+// Issuer string `protobuf:"bytes,10,opt,name=issuer,proto3" json:"issuer,omitempty"`
+// // [Distinguished Name](https://tools.ietf.org/html/rfc1779) of the entity that is associated with the public key contained in the certificate.
+// Subject string `protobuf:"bytes,11,opt,name=subject,proto3" json:"subject,omitempty"`
+// // Serial number of the certificate.
+// Serial string `protobuf:"bytes,12,opt,name=serial,proto3" json:"serial,omitempty"`
+// // Time when the certificate is updated.
+// UpdatedAt *timestamp.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+// // Time when the certificate is issued.
+// IssuedAt *timestamp.Timestamp `protobuf:"bytes,14,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+// // Time after which the certificate is not valid.
+// NotAfter *timestamp.Timestamp `protobuf:"bytes,15,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+// // Time before which the certificate is not valid.
+// NotBefore *timestamp.Timestamp `protobuf:"bytes,16,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+// // Domains validation challenges of the certificate. Used only for managed certificates.
+// Challenges []*Challenge `protobuf:"bytes,17,rep,name=challenges,proto3" json:"challenges,omitempty"`
 type M map[string]interface{}
 
 func dataSourceYandexCertificateManagerList() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexCertificateManagerListRead,
+		ReadContext: dataSourceYandexCertificateManagerListRead,
 		Schema: map[string]*schema.Schema{
 			"values": {
 				Type:     schema.TypeList,
@@ -78,20 +85,26 @@ func dataSourceYandexCertificateManagerList() *schema.Resource {
 	}
 }
 
-func dataSourceYandexCertificateManagerListRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexCertificateManagerListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	d.SetId(config.FolderID)
 
-	list, err := config.sdk.Certificates().Certificate().List(ctx, &certificatemanager.ListCertificatesRequest{FolderId: config.FolderID, PageSize: 1000})
+	list, err := pagination.List(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.Certificates().Certificate().List(ctx, &certificatemanager.ListCertificatesRequest{
+			FolderId:  config.FolderID,
+			PageSize:  pagination.PageSize,
+			PageToken: pageToken,
+		})
+	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Secret %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Secret %q", d.Id())))
 	}
 
 	var values []M
 
-	for _, v := range list.Certificates {
+	for _, item := range list {
+		v := item.(*certificatemanager.Certificate)
 
 		values = append(values, M{
 			"id":          v.Id,
@@ -104,5 +117,5 @@ func dataSourceYandexCertificateManagerListRead(d *schema.ResourceData, meta int
 		})
 	}
 
-	return d.Set("values", values)
+	return diag.FromErr(d.Set("values", values))
 }
@@ -1,15 +1,17 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceYandexClientConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexClientConfigRead,
+		ReadContext: dataSourceYandexClientConfigRead,
 		Schema: map[string]*schema.Schema{
 			"cloud_id": {
 				Type:     schema.TypeString,
@@ -32,13 +34,12 @@ func dataSourceYandexClientConfig() *schema.Resource {
 	}
 }
 
-func dataSourceYandexClientConfigRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexClientConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	response, err := config.sdk.CreateIAMToken(ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	iamToken := response.GetIamToken()
@@ -1,9 +1,11 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
@@ -12,7 +14,7 @@ import (
 
 func dataSourceYandexComputeDisk() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexComputeDiskRead,
+		ReadContext: dataSourceYandexComputeDiskRead,
 		Schema: map[string]*schema.Schema{
 			"disk_id": {
 				Type:     schema.TypeString,
@@ -99,13 +101,12 @@ func dataSourceYandexComputeDisk() *schema.Resource {
 	}
 }
 
-func dataSourceYandexComputeDiskRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexComputeDiskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "disk_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	diskID := d.Get("disk_id").(string)
@@ -114,7 +115,7 @@ func dataSourceYandexComputeDiskRead(d *schema.ResourceData, meta interface{}) e
 	if diskNameOk {
 		diskID, err = resolveObjectID(ctx, config, d, sdkresolvers.DiskResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source disk by name: %v", err)
+			return diag.Errorf("failed to resolve data source disk by name: %v", err)
 		}
 	}
 
@@ -123,12 +124,12 @@ func dataSourceYandexComputeDiskRead(d *schema.ResourceData, meta interface{}) e
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("disk with ID %q", diskID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("disk with ID %q", diskID)))
 	}
 
 	diskPlacementPolicy, err := flattenDiskPlacementPolicy(disk)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("disk_id", disk.Id)
@@ -146,15 +147,15 @@ func dataSourceYandexComputeDiskRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("disk_placement_policy", diskPlacementPolicy)
 
 	if err := d.Set("instance_ids", disk.InstanceIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("labels", disk.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("product_ids", disk.ProductIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(disk.Id)
@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
@@ -11,7 +13,7 @@ import (
 
 func dataSourceYandexComputeDiskPlacementGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexComputeDiskPlacementGroupRead,
+		ReadContext: dataSourceYandexComputeDiskPlacementGroupRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -63,13 +65,12 @@ func dataSourceYandexComputeDiskPlacementGroup() *schema.Resource {
 
 }
 
-func dataSourceYandexComputeDiskPlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexComputeDiskPlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "group_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	groupID := d.Get("group_id").(string)
@@ -78,7 +79,7 @@ func dataSourceYandexComputeDiskPlacementGroupRead(d *schema.ResourceData, meta
 	if groupNameOk {
 		groupID, err = resolveObjectID(ctx, config, d, sdkresolvers.DiskPlacementGroupResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Placement Group by name: %v", err)
+			return diag.Errorf("failed to resolve data source Placement Group by name: %v", err)
 		}
 	}
 
@@ -87,7 +88,7 @@ func dataSourceYandexComputeDiskPlacementGroupRead(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("snapshot with ID %q", groupID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("snapshot with ID %q", groupID)))
 	}
 
 	d.Set("group_id", group.Id)
@@ -99,7 +100,7 @@ func dataSourceYandexComputeDiskPlacementGroupRead(d *schema.ResourceData, meta
 	d.Set("status", group.Status.String())
 
 	if err := d.Set("labels", group.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(group.Id)
@@ -1,9 +1,11 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
@@ -12,7 +14,7 @@ import (
 
 func dataSourceYandexComputeImage() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexComputeImageRead,
+		ReadContext: dataSourceYandexComputeImageRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -78,14 +80,13 @@ func dataSourceYandexComputeImage() *schema.Resource {
 	}
 }
 
-func dataSourceYandexComputeImageRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexComputeImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 	var image *compute.Image
 
 	err := checkOneOf(d, "name", "image_id", "family")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok := d.GetOk("family"); ok {
@@ -96,13 +97,10 @@ func dataSourceYandexComputeImageRead(d *schema.ResourceData, meta interface{})
 			folderID = f.(string)
 		}
 
-		image, err = config.sdk.Compute().Image().GetLatestByFamily(ctx, &compute.GetImageLatestByFamilyRequest{
-			FolderId: folderID,
-			Family:   familyName,
-		})
+		image, err = getLatestImageByFamily(ctx, config, folderID, familyName)
 
 		if err != nil {
-			return fmt.Errorf("failed to find latest image with family \"%s\": %s", familyName, err)
+			return diag.Errorf("failed to find latest image with family \"%s\": %s", familyName, err)
 		}
 	} else {
 		imageID := d.Get("image_id").(string)
@@ -111,7 +109,7 @@ func dataSourceYandexComputeImageRead(d *schema.ResourceData, meta interface{})
 		if imageNameOk {
 			imageID, err = resolveObjectID(ctx, config, d, sdkresolvers.ImageResolver)
 			if err != nil {
-				return fmt.Errorf("failed to resolve data source image by name: %v", err)
+				return diag.Errorf("failed to resolve data source image by name: %v", err)
 			}
 		}
 
@@ -120,7 +118,7 @@ func dataSourceYandexComputeImageRead(d *schema.ResourceData, meta interface{})
 		})
 
 		if err != nil {
-			return handleNotFoundError(err, d, fmt.Sprintf("image with ID %q", imageID))
+			return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("image with ID %q", imageID)))
 		}
 	}
 
@@ -137,11 +135,11 @@ func dataSourceYandexComputeImageRead(d *schema.ResourceData, meta interface{})
 	d.Set("pooled", image.Pooled)
 
 	if err := d.Set("labels", image.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("product_ids", image.ProductIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(image.Id)
@@ -1,9 +1,11 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
@@ -12,7 +14,7 @@ import (
 
 func dataSourceYandexComputeInstance() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexComputeInstanceRead,
+		ReadContext: dataSourceYandexComputeInstanceRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -384,13 +386,12 @@ func dataSourceYandexComputeInstance() *schema.Resource {
 
 }
 
-func dataSourceYandexComputeInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexComputeInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "instance_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get("instance_id").(string)
@@ -399,7 +400,7 @@ func dataSourceYandexComputeInstanceRead(d *schema.ResourceData, meta interface{
 	if instanceNameOk {
 		instanceID, err = resolveObjectID(ctx, config, d, sdkresolvers.InstanceResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source instance by name: %v", err)
+			return diag.Errorf("failed to resolve data source instance by name: %v", err)
 		}
 	}
 
@@ -409,37 +410,37 @@ func dataSourceYandexComputeInstanceRead(d *schema.ResourceData, meta interface{
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("instance with ID %q", instanceID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("instance with ID %q", instanceID)))
 	}
 
 	resources, err := flattenInstanceResources(instance)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bootDisk, err := flattenInstanceBootDisk(ctx, instance, config.sdk.Compute().Disk())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	networkInterfaces, _, _, err := flattenInstanceNetworkInterfaces(instance)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	secondaryDisks, err := flattenInstanceSecondaryDisks(instance)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	schedulingPolicy, err := flattenInstanceSchedulingPolicy(instance)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	placementPolicy, err := flattenInstancePlacementPolicy(instance)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	localDisks := flattenLocalDisks(instance)
@@ -456,19 +457,19 @@ func dataSourceYandexComputeInstanceRead(d *schema.ResourceData, meta interface{
 	d.Set("status", strings.ToLower(instance.Status.String()))
 
 	if err := d.Set("metadata", instance.Metadata); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("labels", instance.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("resources", resources); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("boot_disk", bootDisk); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance.NetworkSettings != nil {
@@ -476,23 +477,23 @@ func dataSourceYandexComputeInstanceRead(d *schema.ResourceData, meta interface{
 	}
 
 	if err := d.Set("network_interface", networkInterfaces); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("secondary_disk", secondaryDisks); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("scheduling_policy", schedulingPolicy); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("placement_policy", placementPolicy); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("local_disk", localDisks); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instance.Id)
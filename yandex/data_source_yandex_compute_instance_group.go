@@ -1,16 +1,21 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/pagination"
 )
 
 func dataSourceYandexComputeInstanceGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexComputeInstanceGroupRead,
+		ReadContext: dataSourceYandexComputeInstanceGroupRead,
 
 		SchemaVersion: 0,
 
@@ -893,14 +898,13 @@ func dataSourceYandexComputeInstanceGroup() *schema.Resource {
 	}
 }
 
-func dataSourceYandexComputeInstanceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexComputeInstanceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	instanceGroupID := d.Get("instance_group_id").(string)
 
 	if instanceGroupID == "" {
-		return fmt.Errorf("instance_group_id should be provided")
+		return diag.Errorf("instance_group_id should be provided")
 	}
 
 	instanceGroup, err := config.sdk.InstanceGroup().InstanceGroup().Get(ctx, &instancegroup.GetInstanceGroupRequest{
@@ -909,18 +913,27 @@ func dataSourceYandexComputeInstanceGroupRead(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Instance group %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Instance group %q", d.Get("name").(string))))
 	}
 
-	instances, err := config.sdk.InstanceGroup().InstanceGroup().ListInstances(ctx, &instancegroup.ListInstanceGroupInstancesRequest{
-		InstanceGroupId: instanceGroupID,
+	items, err := pagination.List(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.InstanceGroup().InstanceGroup().ListInstances(ctx, &instancegroup.ListInstanceGroupInstancesRequest{
+			InstanceGroupId: instanceGroupID,
+			PageSize:        pagination.PageSize,
+			PageToken:       pageToken,
+		})
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Can't read instances for instance group with ID %q", instanceGroupID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Can't read instances for instance group with ID %q", instanceGroupID)))
+	}
+
+	instances := make([]*instancegroup.ManagedInstance, len(items))
+	for i, item := range items {
+		instances[i] = item.(*instancegroup.ManagedInstance)
 	}
 
-	return flattenInstanceGroupDataSource(d, instanceGroup, instances.GetInstances())
+	return diag.FromErr(flattenInstanceGroupDataSource(d, instanceGroup, instances))
 }
 
 func flattenInstanceGroupDataSource(d *schema.ResourceData, instanceGroup *instancegroup.InstanceGroup, instances []*instancegroup.ManagedInstance) error {
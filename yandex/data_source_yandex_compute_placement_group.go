@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
@@ -11,7 +13,7 @@ import (
 
 func dataSourceYandexComputePlacementGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexComputePlacementGroupRead,
+		ReadContext: dataSourceYandexComputePlacementGroupRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -52,13 +54,12 @@ func dataSourceYandexComputePlacementGroup() *schema.Resource {
 
 }
 
-func dataSourceYandexComputePlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexComputePlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "group_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	groupID := d.Get("group_id").(string)
@@ -67,7 +68,7 @@ func dataSourceYandexComputePlacementGroupRead(d *schema.ResourceData, meta inte
 	if groupNameOk {
 		groupID, err = resolveObjectID(ctx, config, d, sdkresolvers.PlacementGroupResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Placement Group by name: %v", err)
+			return diag.Errorf("failed to resolve data source Placement Group by name: %v", err)
 		}
 	}
 
@@ -76,7 +77,7 @@ func dataSourceYandexComputePlacementGroupRead(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("snapshot with ID %q", groupID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("snapshot with ID %q", groupID)))
 	}
 
 	d.Set("group_id", group.Id)
@@ -86,7 +87,7 @@ func dataSourceYandexComputePlacementGroupRead(d *schema.ResourceData, meta inte
 	d.Set("description", group.Description)
 
 	if err := d.Set("labels", group.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(group.Id)
@@ -1,9 +1,11 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
@@ -12,7 +14,7 @@ import (
 
 func dataSourceYandexComputeSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexComputeSnapshotRead,
+		ReadContext: dataSourceYandexComputeSnapshotRead,
 		Schema: map[string]*schema.Schema{
 			"snapshot_id": {
 				Type:     schema.TypeString,
@@ -68,13 +70,12 @@ func dataSourceYandexComputeSnapshot() *schema.Resource {
 
 }
 
-func dataSourceYandexComputeSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexComputeSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "snapshot_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	snapshotID := d.Get("snapshot_id").(string)
@@ -83,7 +84,7 @@ func dataSourceYandexComputeSnapshotRead(d *schema.ResourceData, meta interface{
 	if snapshotNameOk {
 		snapshotID, err = resolveObjectID(ctx, config, d, sdkresolvers.SnapshotResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source snapshot by name: %v", err)
+			return diag.Errorf("failed to resolve data source snapshot by name: %v", err)
 		}
 	}
 
@@ -92,7 +93,7 @@ func dataSourceYandexComputeSnapshotRead(d *schema.ResourceData, meta interface{
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("snapshot with ID %q", snapshotID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("snapshot with ID %q", snapshotID)))
 	}
 
 	d.Set("snapshot_id", snapshot.Id)
@@ -106,11 +107,11 @@ func dataSourceYandexComputeSnapshotRead(d *schema.ResourceData, meta interface{
 	d.Set("source_disk_id", snapshot.GetSourceDiskId())
 
 	if err := d.Set("labels", snapshot.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("product_ids", snapshot.ProductIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(snapshot.Id)
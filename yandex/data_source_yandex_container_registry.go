@@ -1,9 +1,10 @@
 package yandex
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/grpc/codes"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceYandexContainerRegistry() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexContainerRegistryRead,
+		ReadContext: dataSourceYandexContainerRegistryRead,
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Type:     schema.TypeString,
@@ -53,13 +54,12 @@ func dataSourceYandexContainerRegistry() *schema.Resource {
 	}
 }
 
-func dataSourceYandexContainerRegistryRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexContainerRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "registry_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	registryID := d.Get("registry_id").(string)
@@ -68,7 +68,7 @@ func dataSourceYandexContainerRegistryRead(d *schema.ResourceData, meta interfac
 	if registryNameOk {
 		registryID, err = resolveObjectID(ctx, config, d, sdkresolvers.RegistryResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Container Registry by name: %v", err)
+			return diag.Errorf("failed to resolve data source Container Registry by name: %v", err)
 		}
 	}
 
@@ -79,9 +79,9 @@ func dataSourceYandexContainerRegistryRead(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		if isStatusWithCode(err, codes.NotFound) {
-			return fmt.Errorf("Сontainer Registry not found: %s", registryID)
+			return diag.Errorf("Сontainer Registry not found: %s", registryID)
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("registry_id", registry.Id)
@@ -90,7 +90,7 @@ func dataSourceYandexContainerRegistryRead(d *schema.ResourceData, meta interfac
 	d.Set("status", strings.ToLower(registry.Status.String()))
 	d.Set("created_at", getTimestamp(registry.CreatedAt))
 	if err := d.Set("labels", registry.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(registry.Id)
@@ -1,8 +1,9 @@
 package yandex
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/grpc/codes"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceYandexContainerRepository() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexContainerRepositoryRead,
+		ReadContext: dataSourceYandexContainerRepositoryRead,
 		Schema: map[string]*schema.Schema{
 			"repository_id": {
 				Type:     schema.TypeString,
@@ -29,13 +30,12 @@ func dataSourceYandexContainerRepository() *schema.Resource {
 	}
 }
 
-func dataSourceYandexContainerRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexContainerRepositoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "repository_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	repositoryID := d.Get("repository_id").(string)
@@ -44,7 +44,7 @@ func dataSourceYandexContainerRepositoryRead(d *schema.ResourceData, meta interf
 	if repositoryNameOk {
 		repositoryID, err = resolveObjectID(ctx, config, d, sdkresolvers.RepositoryResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Сontainer Repository by name: %v", err)
+			return diag.Errorf("failed to resolve data source Сontainer Repository by name: %v", err)
 		}
 	}
 
@@ -55,9 +55,9 @@ func dataSourceYandexContainerRepositoryRead(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		if isStatusWithCode(err, codes.NotFound) {
-			return fmt.Errorf("Container Repository not found: %s", repositoryID)
+			return diag.Errorf("Container Repository not found: %s", repositoryID)
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("repository_id", repository.Id)
@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/dataproc/v1"
@@ -19,17 +21,16 @@ func dataSourceYandexDataprocCluster() *schema.Resource {
 		Computed: true,
 		Optional: true,
 	}
-	dataSource.Read = dataSourceYandexDataprocClusterRead
+	dataSource.ReadContext = dataSourceYandexDataprocClusterRead
 	return dataSource
 }
 
-func dataSourceYandexDataprocClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexDataprocClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "cluster_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -38,7 +39,7 @@ func dataSourceYandexDataprocClusterRead(d *schema.ResourceData, meta interface{
 	if clusterNameOk {
 		clusterID, err = resolveObjectID(ctx, config, d, sdkresolvers.DataprocClusterResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Data Proc Cluster by name: %v", err)
+			return diag.Errorf("failed to resolve data source Data Proc Cluster by name: %v", err)
 		}
 	}
 
@@ -46,9 +47,9 @@ func dataSourceYandexDataprocClusterRead(d *schema.ResourceData, meta interface{
 		ClusterId: clusterID,
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", clusterID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", clusterID)))
 	}
 
 	d.SetId(cluster.Id)
-	return populateDataprocClusterResourceData(d, config, cluster)
+	return diag.FromErr(populateDataprocClusterResourceData(ctx, d, config, cluster))
 }
@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
@@ -10,7 +12,7 @@ import (
 
 func dataSourceYandexDnsZone() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexDnsZoneRead,
+		ReadContext: dataSourceYandexDnsZoneRead,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexDnsDefaultTimeout),
@@ -78,31 +80,31 @@ func dataSourceYandexDnsZone() *schema.Resource {
 	}
 }
 
-func dataSourceYandexDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexDnsZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	sdk := getSDK(config)
 
 	err := checkOneOf(d, "dns_zone_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Get("dns_zone_id").(string)
 	_, zoneNameOk := d.GetOk("name")
 
 	if zoneNameOk {
-		id, err = resolveObjectID(config.Context(), config, d, sdkresolvers.DNSZoneResolver)
+		id, err = resolveObjectID(ctx, config, d, sdkresolvers.DNSZoneResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source dns zone by name: %v", err)
+			return diag.Errorf("failed to resolve data source dns zone by name: %v", err)
 		}
 	}
 
-	dnsZone, err := sdk.DNS().DnsZone().Get(config.Context(), &dns.GetDnsZoneRequest{
+	dnsZone, err := sdk.DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
 		DnsZoneId: id,
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", d.Get("name").(string))))
 	}
 
 	d.Set("created_at", getTimestamp(dnsZone.CreatedAt))
@@ -116,8 +118,8 @@ func dataSourceYandexDnsZoneRead(d *schema.ResourceData, meta interface{}) error
 
 	if dnsZone.PrivateVisibility != nil {
 		if err := d.Set("private_networks", convertStringArrToInterface(dnsZone.PrivateVisibility.GetNetworkIds())); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return d.Set("labels", dnsZone.Labels)
+	return diag.FromErr(d.Set("labels", dnsZone.Labels))
 }
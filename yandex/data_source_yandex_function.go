@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/grpc/codes"
 
@@ -12,7 +14,7 @@ import (
 
 func dataSourceYandexFunction() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexFunctionRead,
+		ReadContext: dataSourceYandexFunctionRead,
 
 		SchemaVersion: 0,
 
@@ -106,15 +108,12 @@ func dataSourceYandexFunction() *schema.Resource {
 	}
 }
 
-func dataSourceYandexFunctionRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexFunctionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	err := checkOneOf(d, "function_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	functionID := d.Get("function_id").(string)
@@ -123,7 +122,7 @@ func dataSourceYandexFunctionRead(d *schema.ResourceData, meta interface{}) erro
 	if tgNameOk {
 		functionID, err = resolveObjectID(ctx, config, d, sdkresolvers.FunctionResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Yandex Cloud Function by name: %v", err)
+			return diag.Errorf("failed to resolve data source Yandex Cloud Function by name: %v", err)
 		}
 	}
 
@@ -133,7 +132,7 @@ func dataSourceYandexFunctionRead(d *schema.ResourceData, meta interface{}) erro
 
 	function, err := config.sdk.Serverless().Functions().Function().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Function %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Function %q", d.Id())))
 	}
 
 	versionReq := functions.GetFunctionVersionByTagRequest{
@@ -146,10 +145,10 @@ func dataSourceYandexFunctionRead(d *schema.ResourceData, meta interface{}) erro
 		if isStatusWithCode(err, codes.NotFound) {
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(function.Id)
 	d.Set("function_id", function.Id)
-	return flattenYandexFunction(d, function, version)
+	return diag.FromErr(flattenYandexFunction(d, function, version))
 }
@@ -1,14 +1,16 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceYandexFunctionScalingPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexFunctionScalingPolicyRead,
+		ReadContext: dataSourceYandexFunctionScalingPolicyRead,
 
 		SchemaVersion: 0,
 
@@ -45,16 +47,15 @@ func dataSourceYandexFunctionScalingPolicy() *schema.Resource {
 	}
 }
 
-func dataSourceYandexFunctionScalingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexFunctionScalingPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 	functionID := d.Get("function_id").(string)
 
 	policies, err := fetchFunctionScalingPolicies(ctx, config, functionID)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Function %s Scaling Policy", functionID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Function %s Scaling Policy", functionID)))
 	}
 
 	d.SetId(functionID)
-	return flattenYandexFunctionScalingPolicy(d, policies)
+	return diag.FromErr(flattenYandexFunctionScalingPolicy(d, policies))
 }
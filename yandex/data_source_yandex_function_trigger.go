@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/triggers/v1"
@@ -11,7 +13,7 @@ import (
 
 func dataSourceYandexFunctionTrigger() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexFunctionTriggerRead,
+		ReadContext: dataSourceYandexFunctionTriggerRead,
 
 		SchemaVersion: 0,
 
@@ -281,15 +283,12 @@ func dataSourceYandexFunctionTrigger() *schema.Resource {
 	}
 }
 
-func dataSourceYandexFunctionTriggerRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexFunctionTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	err := checkOneOf(d, "trigger_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	triggerID := d.Get("trigger_id").(string)
@@ -298,7 +297,7 @@ func dataSourceYandexFunctionTriggerRead(d *schema.ResourceData, meta interface{
 	if tgNameOk {
 		triggerID, err = resolveObjectID(ctx, config, d, sdkresolvers.TriggerResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Yandex Cloud Functions Trigger by name: %v", err)
+			return diag.Errorf("failed to resolve data source Yandex Cloud Functions Trigger by name: %v", err)
 		}
 	}
 
@@ -308,10 +307,10 @@ func dataSourceYandexFunctionTriggerRead(d *schema.ResourceData, meta interface{
 
 	trig, err := config.sdk.Serverless().Triggers().Trigger().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Functions Trigger %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Functions Trigger %q", d.Id())))
 	}
 
 	d.SetId(trig.Id)
 	d.Set("trigger_id", trig.Id)
-	return flattenYandexFunctionTrigger(d, trig)
+	return diag.FromErr(flattenYandexFunctionTrigger(d, trig))
 }
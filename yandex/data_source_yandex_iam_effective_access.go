@@ -0,0 +1,499 @@
+package yandex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/containerregistry/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/containers/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/functions/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/ydb/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/pagination"
+)
+
+const (
+	yandexIAMEffectiveAccessDefaultTimeout = 10 * time.Minute
+	// effectiveAccessListConcurrency limits number of resources whose children or access bindings are listed at once.
+	effectiveAccessListConcurrency = 8
+)
+
+const (
+	effectiveAccessOrganization = "organization-manager.organization"
+	effectiveAccessCloud        = "resource-manager.cloud"
+	effectiveAccessFolder       = "resource-manager.folder"
+)
+
+// effectiveAccessFolderResource is a type of IAM-enabled resources of a folder.
+type effectiveAccessFolderResource struct {
+	resourceType string
+	list         func(config *Config, folderID string) pagination.ListFunc
+	newUpdater   func(config *Config, id string) ResourceIamUpdater
+}
+
+var effectiveAccessFolderResources = []effectiveAccessFolderResource{
+	{
+		resourceType: "iam.serviceAccount",
+		list: func(config *Config, folderID string) pagination.ListFunc {
+			return func(ctx context.Context, pageToken string) (proto.Message, error) {
+				return config.sdk.IAM().ServiceAccount().List(ctx, &iam.ListServiceAccountsRequest{
+					FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken,
+				})
+			}
+		},
+		newUpdater: func(config *Config, id string) ResourceIamUpdater {
+			return &ServiceAccountIamUpdater{serviceAccountID: id, Config: config}
+		},
+	},
+	{
+		resourceType: "serverless.function",
+		list: func(config *Config, folderID string) pagination.ListFunc {
+			return func(ctx context.Context, pageToken string) (proto.Message, error) {
+				return config.sdk.Serverless().Functions().Function().List(ctx, &functions.ListFunctionsRequest{
+					FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken,
+				})
+			}
+		},
+		newUpdater: func(config *Config, id string) ResourceIamUpdater {
+			return &FunctionIamUpdater{functionID: id, Config: config}
+		},
+	},
+	{
+		resourceType: "serverless.container",
+		list: func(config *Config, folderID string) pagination.ListFunc {
+			return func(ctx context.Context, pageToken string) (proto.Message, error) {
+				return config.sdk.Serverless().Containers().Container().List(ctx, &containers.ListContainersRequest{
+					FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken,
+				})
+			}
+		},
+		newUpdater: func(config *Config, id string) ResourceIamUpdater {
+			return &ServerlessContainerIamUpdater{containerID: id, Config: config}
+		},
+	},
+	{
+		resourceType: "container-registry.registry",
+		list: func(config *Config, folderID string) pagination.ListFunc {
+			return func(ctx context.Context, pageToken string) (proto.Message, error) {
+				return config.sdk.ContainerRegistry().Registry().List(ctx, &containerregistry.ListRegistriesRequest{
+					FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken,
+				})
+			}
+		},
+		newUpdater: func(config *Config, id string) ResourceIamUpdater {
+			return &ContainerRegistryIamUpdater{registryID: id, Config: config}
+		},
+	},
+	{
+		resourceType: "container-registry.repository",
+		list: func(config *Config, folderID string) pagination.ListFunc {
+			return func(ctx context.Context, pageToken string) (proto.Message, error) {
+				return config.sdk.ContainerRegistry().Repository().List(ctx, &containerregistry.ListRepositoriesRequest{
+					FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken,
+				})
+			}
+		},
+		newUpdater: func(config *Config, id string) ResourceIamUpdater {
+			return &ContainerRepositoryIamUpdater{repositoryID: id, Config: config}
+		},
+	},
+	{
+		resourceType: "kms.symmetricKey",
+		list: func(config *Config, folderID string) pagination.ListFunc {
+			return func(ctx context.Context, pageToken string) (proto.Message, error) {
+				return config.sdk.KMS().SymmetricKey().List(ctx, &kms.ListSymmetricKeysRequest{
+					FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken,
+				})
+			}
+		},
+		newUpdater: func(config *Config, id string) ResourceIamUpdater {
+			return &KMSSymmetricKeyIamUpdater{symmetricKeyID: id, Config: config}
+		},
+	},
+	{
+		resourceType: "ydb.database",
+		list: func(config *Config, folderID string) pagination.ListFunc {
+			return func(ctx context.Context, pageToken string) (proto.Message, error) {
+				return config.sdk.YDB().Database().List(ctx, &ydb.ListDatabasesRequest{
+					FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken,
+				})
+			}
+		},
+		newUpdater: func(config *Config, id string) ResourceIamUpdater {
+			return &YDBDatabaseIamUpdater{databaseID: id, Config: config}
+		},
+	},
+}
+
+func effectiveAccessResourceTypes() []string {
+	types := []string{effectiveAccessOrganization, effectiveAccessCloud, effectiveAccessFolder}
+	for _, r := range effectiveAccessFolderResources {
+		types = append(types, r.resourceType)
+	}
+	return types
+}
+
+func dataSourceYandexIAMEffectiveAccess() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceYandexIAMEffectiveAccessRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(yandexIAMEffectiveAccessDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"member": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIamMember,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cloud_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(effectiveAccessResourceTypes(), false),
+				},
+				Set: schema.HashString,
+			},
+			"skipped_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"bindings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// effectiveAccessTarget is a resource whose access bindings are checked.
+type effectiveAccessTarget struct {
+	resourceType string
+	updater      ResourceIamUpdater
+}
+
+// effectiveAccessSkipped collects resources whose access bindings or child resources the caller isn't allowed
+// to view, they are reported in "skipped_resources" instead of failing the data source.
+type effectiveAccessSkipped struct {
+	mu        sync.Mutex
+	seen      map[string]bool
+	resources []map[string]interface{}
+}
+
+func (s *effectiveAccessSkipped) add(resourceType, resourceID string, err error) {
+	log.Printf("[WARN] Skipping %s %q, it can't be viewed: %s", resourceType, resourceID, err)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := resourceType + "/" + resourceID
+	if s.seen[key] {
+		return
+	}
+	if s.seen == nil {
+		s.seen = map[string]bool{}
+	}
+	s.seen[key] = true
+	s.resources = append(s.resources, map[string]interface{}{
+		"resource_type": resourceType,
+		"resource_id":   resourceID,
+	})
+}
+
+func (s *effectiveAccessSkipped) list() []map[string]interface{} {
+	if s.resources == nil {
+		return []map[string]interface{}{}
+	}
+	return s.resources
+}
+
+// effectiveAccessVisible wraps the list call of children of the parent, the parent is skipped
+// if the caller isn't allowed to list them.
+func effectiveAccessVisible(skipped *effectiveAccessSkipped, parentType, parentID string, list pagination.ListFunc) pagination.ListFunc {
+	return func(ctx context.Context, pageToken string) (proto.Message, error) {
+		resp, err := list(ctx, pageToken)
+		if isPermissionDenied(err) {
+			skipped.add(parentType, parentID, err)
+			return nil, nil
+		}
+		return resp, err
+	}
+}
+
+func dataSourceYandexIAMEffectiveAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	member := d.Get("member").(string)
+
+	organizationID := d.Get("organization_id").(string)
+	if organizationID == "" {
+		organizationID = config.OrganizationID
+	}
+
+	wanted := map[string]bool{}
+	if v, ok := d.GetOk("resource_types"); ok {
+		for _, t := range convertStringSet(v.(*schema.Set)) {
+			wanted[t] = true
+		}
+	} else {
+		for _, t := range effectiveAccessResourceTypes() {
+			wanted[t] = true
+		}
+	}
+
+	var targets []effectiveAccessTarget
+	if organizationID != "" && wanted[effectiveAccessOrganization] {
+		targets = append(targets, effectiveAccessTarget{effectiveAccessOrganization, &OrganizationIamUpdater{organizationID: organizationID, Config: config}})
+	}
+
+	skipped := &effectiveAccessSkipped{}
+	cloudIDs, err := effectiveAccessCloudIDs(ctx, d, config, organizationID, skipped)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if wanted[effectiveAccessCloud] {
+		for _, id := range cloudIDs {
+			targets = append(targets, effectiveAccessTarget{effectiveAccessCloud, &CloudIamUpdater{cloudID: id, Config: config}})
+		}
+	}
+
+	folderTargets, err := effectiveAccessFolderTargets(ctx, config, cloudIDs, wanted, skipped)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targets = append(targets, folderTargets...)
+
+	bindings, err := effectiveAccessBindings(ctx, targets, member, skipped)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(member)
+	if err := d.Set("organization_id", organizationID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("bindings", bindings); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("skipped_resources", skipped.list()); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func effectiveAccessCloudIDs(ctx context.Context, d *schema.ResourceData, config *Config, organizationID string, skipped *effectiveAccessSkipped) ([]string, error) {
+	if v, ok := d.GetOk("cloud_ids"); ok {
+		return convertStringSet(v.(*schema.Set)), nil
+	}
+
+	clouds, err := pagination.List(ctx, effectiveAccessVisible(skipped, effectiveAccessOrganization, organizationID, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.ResourceManager().Cloud().List(ctx, &resourcemanager.ListCloudsRequest{
+			OrganizationId: organizationID,
+			PageSize:       pagination.PageSize,
+			PageToken:      pageToken,
+		})
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("Error listing clouds: %s", err)
+	}
+
+	ids := make([]string, len(clouds))
+	for i, c := range clouds {
+		ids[i] = c.(*resourcemanager.Cloud).Id
+	}
+	return ids, nil
+}
+
+// effectiveAccessFolderTargets returns folders of the clouds and their IAM-enabled resources of wanted types.
+func effectiveAccessFolderTargets(ctx context.Context, config *Config, cloudIDs []string, wanted map[string]bool, skipped *effectiveAccessSkipped) ([]effectiveAccessTarget, error) {
+	var resources []effectiveAccessFolderResource
+	for _, r := range effectiveAccessFolderResources {
+		if wanted[r.resourceType] {
+			resources = append(resources, r)
+		}
+	}
+	if !wanted[effectiveAccessFolder] && len(resources) == 0 {
+		return nil, nil
+	}
+
+	folders, err := pagination.ListEach(ctx, cloudIDs, effectiveAccessListConcurrency, func(cloudID string) pagination.ListFunc {
+		return effectiveAccessVisible(skipped, effectiveAccessCloud, cloudID, func(ctx context.Context, pageToken string) (proto.Message, error) {
+			return config.sdk.ResourceManager().Folder().List(ctx, &resourcemanager.ListFoldersRequest{
+				CloudId:   cloudID,
+				PageSize:  pagination.PageSize,
+				PageToken: pageToken,
+			})
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error listing folders: %s", err)
+	}
+
+	var folderIDs []string
+	for _, items := range folders {
+		for _, f := range items {
+			folderIDs = append(folderIDs, f.(*resourcemanager.Folder).Id)
+		}
+	}
+
+	var targets []effectiveAccessTarget
+	if wanted[effectiveAccessFolder] {
+		for _, id := range folderIDs {
+			targets = append(targets, effectiveAccessTarget{effectiveAccessFolder, &FolderIamUpdater{folderID: id, Config: config}})
+		}
+	}
+
+	for _, r := range resources {
+		r := r
+		children, err := pagination.ListEach(ctx, folderIDs, effectiveAccessListConcurrency, func(folderID string) pagination.ListFunc {
+			return effectiveAccessVisible(skipped, effectiveAccessFolder, folderID, r.list(config, folderID))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error listing %s resources: %s", r.resourceType, err)
+		}
+		for _, items := range children {
+			for _, item := range items {
+				id := item.(interface{ GetId() string }).GetId()
+				targets = append(targets, effectiveAccessTarget{r.resourceType, r.newUpdater(config, id)})
+			}
+		}
+	}
+	return targets, nil
+}
+
+// effectiveAccessBindings reads access bindings of the targets, at most effectiveAccessListConcurrency at once,
+// and returns ones of the member. Targets whose access bindings the caller isn't allowed to view are added
+// to skipped, any other error cancels the remaining reads.
+func effectiveAccessBindings(ctx context.Context, targets []effectiveAccessTarget, member string, skipped *effectiveAccessSkipped) ([]map[string]interface{}, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		sem      = make(chan struct{}, effectiveAccessListConcurrency)
+		policies = make([]*Policy, len(targets))
+		denied   = make([]error, len(targets))
+	)
+	for i := range targets {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			policy, err := targets[i].updater.GetResourceIamPolicy(ctx)
+			if isPermissionDenied(err) {
+				denied[i] = err
+				return
+			}
+			if err != nil {
+				once.Do(func() {
+					firstErr = fmt.Errorf("Error reading access bindings of %s: %s", targets[i].updater.DescribeResource(), err)
+					cancel()
+				})
+				return
+			}
+			policies[i] = policy
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	bindings := []map[string]interface{}{}
+	for i, target := range targets {
+		if denied[i] != nil {
+			skipped.add(target.resourceType, target.updater.GetResourceID(), denied[i])
+			continue
+		}
+
+		roles := map[string]bool{}
+		for _, b := range policies[i].Bindings {
+			if canonicalMember(b) != member || roles[b.RoleId] {
+				continue
+			}
+			roles[b.RoleId] = true
+			bindings = append(bindings, map[string]interface{}{
+				"resource_type": target.resourceType,
+				"resource_id":   target.updater.GetResourceID(),
+				"role":          b.RoleId,
+			})
+		}
+	}
+	return bindings, nil
+}
+
+// isPermissionDenied reports whether the call failed with PermissionDenied, the status may be wrapped.
+func isPermissionDenied(err error) bool {
+	var st interface{ GRPCStatus() *status.Status }
+	return errors.As(err, &st) && st.GRPCStatus().Code() == codes.PermissionDenied
+}
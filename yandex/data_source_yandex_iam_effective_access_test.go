@@ -0,0 +1,80 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+func TestDataSourceIAMEffectiveAccess_fakeCloud(t *testing.T) {
+	config, server := newFakeCloudConfig(t, fakecloud.WithMaxPageSize(1))
+
+	sa := fakeCloudResource(t, "yandex_iam_service_account")
+	robot := fakeCloudApply(t, config, sa, nil, map[string]interface{}{"name": "robot"})
+	deployer := fakeCloudApply(t, config, sa, nil, map[string]interface{}{"name": "deployer"})
+	member := "serviceAccount:" + robot.ID
+
+	grant := func(resource, parentKey, parentID, role, member string) {
+		fakeCloudApply(t, config, fakeCloudResource(t, resource), nil, map[string]interface{}{
+			parentKey: parentID,
+			"role":    role,
+			"member":  member,
+		})
+	}
+	grant("yandex_resourcemanager_cloud_iam_member", "cloud_id", fakecloud.CloudID, "viewer", member)
+	grant("yandex_resourcemanager_folder_iam_member", "folder_id", fakecloud.FolderID, "editor", member)
+	grant("yandex_resourcemanager_folder_iam_member", "folder_id", fakecloud.FolderID, "admin", "userAccount:alice")
+	grant("yandex_iam_service_account_iam_member", "service_account_id", deployer.ID, "iam.serviceAccounts.user", member)
+
+	ds := Provider().DataSourcesMap["yandex_iam_effective_access"]
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"member": member,
+		// The fake cloud has no other IAM-enabled resources.
+		"resource_types": []interface{}{"resource-manager.cloud", "resource-manager.folder", "iam.serviceAccount"},
+	})
+	require.Empty(t, ds.ReadContext(context.Background(), d, config))
+
+	assert.Equal(t, member, d.Id())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"resource_type": "resource-manager.cloud", "resource_id": fakecloud.CloudID, "role": "viewer"},
+		map[string]interface{}{"resource_type": "resource-manager.folder", "resource_id": fakecloud.FolderID, "role": "editor"},
+		map[string]interface{}{"resource_type": "iam.serviceAccount", "resource_id": deployer.ID, "role": "iam.serviceAccounts.user"},
+	}, d.Get("bindings"))
+	assert.Empty(t, d.Get("skipped_resources"))
+
+	// Resources whose access bindings can't be viewed are skipped.
+	server.FailNext("/yandex.cloud.resourcemanager.v1.CloudService/ListAccessBindings", status.Error(codes.PermissionDenied, "Permission denied"))
+	require.Empty(t, ds.ReadContext(context.Background(), d, config))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"resource_type": "resource-manager.cloud", "resource_id": fakecloud.CloudID},
+	}, d.Get("skipped_resources"))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"resource_type": "resource-manager.folder", "resource_id": fakecloud.FolderID, "role": "editor"},
+		map[string]interface{}{"resource_type": "iam.serviceAccount", "resource_id": deployer.ID, "role": "iam.serviceAccounts.user"},
+	}, d.Get("bindings"))
+
+	// Parents whose children can't be listed are skipped too.
+	server.FailNext("/yandex.cloud.iam.v1.ServiceAccountService/List", status.Error(codes.PermissionDenied, "Permission denied"))
+	require.Empty(t, ds.ReadContext(context.Background(), d, config))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"resource_type": "resource-manager.folder", "resource_id": fakecloud.FolderID},
+	}, d.Get("skipped_resources"))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"resource_type": "resource-manager.cloud", "resource_id": fakecloud.CloudID, "role": "viewer"},
+		map[string]interface{}{"resource_type": "resource-manager.folder", "resource_id": fakecloud.FolderID, "role": "editor"},
+	}, d.Get("bindings"))
+
+	// Other errors fail the read.
+	server.FailNext("/yandex.cloud.resourcemanager.v1.FolderService/ListAccessBindings", status.Error(codes.Unavailable, "try again later"))
+	diags := ds.ReadContext(context.Background(), d, config)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, fmt.Sprintf("Error reading access bindings of folder %q", fakecloud.FolderID))
+}
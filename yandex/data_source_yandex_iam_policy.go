@@ -1,11 +1,13 @@
 package yandex
 
 import (
+	"context"
 	"encoding/json"
 	"strconv"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/hashcode"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -53,11 +55,11 @@ func dataSourceYandexIAMPolicy() *schema.Resource {
 				Computed: true,
 			},
 		},
-		Read: dataSourceYandexIAMPolicyRead,
+		ReadContext: dataSourceYandexIAMPolicyRead,
 	}
 }
 
-func dataSourceYandexIAMPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexIAMPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var policy Policy
 
 	// The schema supports multiple binding{} blocks
@@ -76,7 +78,7 @@ func dataSourceYandexIAMPolicyRead(d *schema.ResourceData, meta interface{}) err
 	jsonPolicy, err := json.Marshal(&policy)
 	if err != nil {
 		// should never happen if the above code is correct
-		return err
+		return diag.FromErr(err)
 	}
 	stringPolicy := string(jsonPolicy)
 
@@ -1,8 +1,9 @@
 package yandex
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/grpc/codes"
 
@@ -11,7 +12,7 @@ import (
 
 func dataSourceYandexIAMRole() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexIAMRoleRead,
+		ReadContext: dataSourceYandexIAMRoleRead,
 		Schema: map[string]*schema.Schema{
 			"role_id": {
 				Type:     schema.TypeString,
@@ -26,15 +27,14 @@ func dataSourceYandexIAMRole() *schema.Resource {
 	}
 }
 
-func dataSourceYandexIAMRoleRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexIAMRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	var role *iam.Role
 
 	v, ok := d.GetOk("role_id")
 	if !ok {
-		return fmt.Errorf("'role_id' must be set")
+		return diag.Errorf("'role_id' must be set")
 	}
 
 	resp, err := config.sdk.IAM().Role().Get(ctx, &iam.GetRoleRequest{
@@ -43,9 +43,9 @@ func dataSourceYandexIAMRoleRead(d *schema.ResourceData, meta interface{}) error
 
 	if err != nil {
 		if isStatusWithCode(err, codes.NotFound) {
-			return fmt.Errorf("role not found: %s", v)
+			return diag.Errorf("role not found: %s", v)
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	role = resp
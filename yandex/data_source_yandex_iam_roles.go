@@ -0,0 +1,90 @@
+package yandex
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceYandexIAMRoles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceYandexIAMRolesRead,
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIamRole,
+			},
+			"search": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"role_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexIAMRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	roles, err := listIamRoles(ctx, config)
+	if err != nil {
+		return diag.Errorf("Error listing roles: %s", err)
+	}
+
+	service := d.Get("service").(string)
+	search := strings.ToLower(d.Get("search").(string))
+
+	roleIDs := []string{}
+	flattened := []map[string]interface{}{}
+	for _, role := range roles {
+		// A service matches its own roles and roles of its resources, i.e. `iam` matches `iam.serviceAccounts.user`.
+		if service != "" && !strings.HasPrefix(role.Id, service+".") {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(role.Id), search) &&
+			!strings.Contains(strings.ToLower(role.Description), search) {
+			continue
+		}
+
+		roleIDs = append(roleIDs, role.Id)
+		flattened = append(flattened, map[string]interface{}{
+			"role_id":     role.Id,
+			"description": role.Description,
+		})
+	}
+
+	if err := d.Set("role_ids", roleIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("roles", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(service + "/" + search)
+	return nil
+}
@@ -0,0 +1,48 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+func TestDataSourceIAMRoles_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t, fakecloud.WithMaxPageSize(4))
+
+	ds := Provider().DataSourcesMap["yandex_iam_roles"]
+	read := func(raw map[string]interface{}) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, ds.Schema, raw)
+		require.Empty(t, ds.ReadContext(context.Background(), d, config))
+		return d
+	}
+
+	// All pages are listed.
+	d := read(map[string]interface{}{})
+	assert.Contains(t, d.Get("role_ids"), "viewer")
+	assert.Contains(t, d.Get("role_ids"), "vpc.publicAdmin")
+
+	d = read(map[string]interface{}{"service": "compute"})
+	assert.Equal(t, []interface{}{"compute.admin", "compute.editor", "compute.viewer"}, d.Get("role_ids"))
+	assert.Equal(t, map[string]interface{}{
+		"role_id":     "compute.admin",
+		"description": "Allows managing Compute Cloud resources and access to them",
+	}, d.Get("roles.0"))
+
+	// The search matches both IDs and descriptions regardless of case.
+	d = read(map[string]interface{}{"search": "VIEW"})
+	assert.Equal(t, []interface{}{"compute.viewer", "lockbox.payloadViewer", "viewer"}, d.Get("role_ids"))
+
+	d = read(map[string]interface{}{"service": "compute", "search": "access"})
+	assert.Equal(t, []interface{}{"compute.admin"}, d.Get("role_ids"))
+
+	d = read(map[string]interface{}{"service": "storage"})
+	assert.Empty(t, d.Get("role_ids"))
+
+	_, errs := ds.Schema["service"].ValidateFunc("compute.", "service")
+	assert.NotEmpty(t, errs)
+}
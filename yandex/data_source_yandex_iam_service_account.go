@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
@@ -11,7 +13,7 @@ import (
 
 func dataSourceYandexIAMServiceAccount() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexIAMServiceAccountRead,
+		ReadContext: dataSourceYandexIAMServiceAccountRead,
 		Schema: map[string]*schema.Schema{
 			"service_account_id": {
 				Type:          schema.TypeString,
@@ -42,14 +44,13 @@ func dataSourceYandexIAMServiceAccount() *schema.Resource {
 	}
 }
 
-func dataSourceYandexIAMServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexIAMServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 	var sa *iam.ServiceAccount
 
 	err := checkOneOf(d, "service_account_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceAccountID := d.Get("service_account_id").(string)
@@ -58,7 +59,7 @@ func dataSourceYandexIAMServiceAccountRead(d *schema.ResourceData, meta interfac
 	if serviceAccountNameOk {
 		serviceAccountID, err = resolveObjectID(ctx, config, d, sdkresolvers.ServiceAccountResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve service account by name: %v", err)
+			return diag.Errorf("failed to resolve service account by name: %v", err)
 		}
 	}
 
@@ -67,7 +68,7 @@ func dataSourceYandexIAMServiceAccountRead(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("service account with ID %q", serviceAccountID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("service account with ID %q", serviceAccountID)))
 	}
 
 	d.Set("service_account_id", sa.Id)
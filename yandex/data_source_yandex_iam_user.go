@@ -1,8 +1,9 @@
 package yandex
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/grpc/codes"
 
@@ -11,7 +12,7 @@ import (
 
 func dataSourceYandexIAMUser() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexLoginRead,
+		ReadContext: dataSourceYandexLoginRead,
 		Schema: map[string]*schema.Schema{
 			"login": {
 				Type:          schema.TypeString,
@@ -33,9 +34,8 @@ func dataSourceYandexIAMUser() *schema.Resource {
 	}
 }
 
-func dataSourceYandexLoginRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexLoginRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 	var user *iam.UserAccount
 
 	if v, ok := d.GetOk("login"); ok {
@@ -46,9 +46,9 @@ func dataSourceYandexLoginRead(d *schema.ResourceData, meta interface{}) error {
 
 		if err != nil {
 			if isStatusWithCode(err, codes.NotFound) {
-				return fmt.Errorf("login not found: %s", login)
+				return diag.Errorf("login not found: %s", login)
 			}
-			return err
+			return diag.FromErr(err)
 		}
 
 		user = resp
@@ -60,12 +60,12 @@ func dataSourceYandexLoginRead(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if err != nil {
-			return fmt.Errorf("failed to find user with ID \"%s\": %s", userID, err)
+			return diag.Errorf("failed to find user with ID \"%s\": %s", userID, err)
 		}
 
 		user = resp
 	} else {
-		return fmt.Errorf("one of 'login' or 'user_id' must be set")
+		return diag.Errorf("one of 'login' or 'user_id' must be set")
 	}
 
 	d.Set("user_id", user.Id)
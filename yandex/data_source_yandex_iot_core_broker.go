@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	iot "github.com/yandex-cloud/go-genproto/yandex/cloud/iot/broker/v1"
@@ -11,7 +13,7 @@ import (
 
 func dataSourceYandexIoTCoreBroker() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexIotCoreBrokerRead,
+		ReadContext: dataSourceYandexIotCoreBrokerRead,
 
 		SchemaVersion: 0,
 
@@ -58,15 +60,15 @@ func dataSourceYandexIoTCoreBroker() *schema.Resource {
 	}
 }
 
-func dataSourceYandexIotCoreBrokerRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexIotCoreBrokerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
 	err := checkOneOf(d, "broker_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	brkID := d.Get("broker_id").(string)
@@ -75,7 +77,7 @@ func dataSourceYandexIotCoreBrokerRead(d *schema.ResourceData, meta interface{})
 	if tgNameOk {
 		brkID, err = resolveObjectID(ctx, config, d, sdkresolvers.BrokerResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source IoT Broker by name: %v", err)
+			return diag.Errorf("failed to resolve data source IoT Broker by name: %v", err)
 		}
 	}
 
@@ -85,12 +87,12 @@ func dataSourceYandexIotCoreBrokerRead(d *schema.ResourceData, meta interface{})
 
 	broker, err := config.sdk.IoT().Broker().Broker().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("IoT Broker %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("IoT Broker %q", d.Id())))
 	}
 
 	certsResp, err := config.sdk.IoT().Broker().Broker().ListCertificates(ctx, &iot.ListBrokerCertificatesRequest{BrokerId: brkID})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var certs []string
@@ -100,7 +102,7 @@ func dataSourceYandexIotCoreBrokerRead(d *schema.ResourceData, meta interface{})
 
 	passResp, err := config.sdk.IoT().Broker().Broker().ListPasswords(ctx, &iot.ListBrokerPasswordsRequest{BrokerId: brkID})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var passwords []string
@@ -111,7 +113,7 @@ func dataSourceYandexIotCoreBrokerRead(d *schema.ResourceData, meta interface{})
 	d.SetId(broker.Id)
 	d.Set("broker_id", broker.Id)
 	if err := flattenYandexIoTCoreBroker(d, broker); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return d.Set("certificates", flattenIoTSet(certs))
+	return diag.FromErr(d.Set("certificates", flattenIoTSet(certs)))
 }
@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	iot "github.com/yandex-cloud/go-genproto/yandex/cloud/iot/devices/v1"
@@ -11,7 +13,7 @@ import (
 
 func dataSourceYandexIoTCoreDevice() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexIotCoreDeviceRead,
+		ReadContext: dataSourceYandexIotCoreDeviceRead,
 
 		SchemaVersion: 0,
 
@@ -65,15 +67,15 @@ func dataSourceYandexIoTCoreDevice() *schema.Resource {
 	}
 }
 
-func dataSourceYandexIotCoreDeviceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexIotCoreDeviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
 	err := checkOneOf(d, "device_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	devID := d.Get("device_id").(string)
@@ -82,7 +84,7 @@ func dataSourceYandexIotCoreDeviceRead(d *schema.ResourceData, meta interface{})
 	if ok {
 		devID, err = resolveObjectID(ctx, config, d, sdkresolvers.DeviceResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source IoT Device by name: %v", err)
+			return diag.Errorf("failed to resolve data source IoT Device by name: %v", err)
 		}
 	}
 
@@ -92,12 +94,12 @@ func dataSourceYandexIotCoreDeviceRead(d *schema.ResourceData, meta interface{})
 
 	device, err := config.sdk.IoT().Devices().Device().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("IoT Device %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("IoT Device %q", d.Id())))
 	}
 
 	certsResp, err := config.sdk.IoT().Devices().Device().ListCertificates(ctx, &iot.ListDeviceCertificatesRequest{DeviceId: devID})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var certs []string
@@ -107,7 +109,7 @@ func dataSourceYandexIotCoreDeviceRead(d *schema.ResourceData, meta interface{})
 
 	passResp, err := config.sdk.IoT().Devices().Device().ListPasswords(ctx, &iot.ListDevicePasswordsRequest{DeviceId: devID})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var passwords []string
@@ -118,13 +120,13 @@ func dataSourceYandexIotCoreDeviceRead(d *schema.ResourceData, meta interface{})
 	d.SetId(device.Id)
 	d.Set("device_id", device.Id)
 	if err := flattenYandexIoTCoreDevice(d, device); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("aliases", device.TopicAliases); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("certificates", flattenIoTSet(certs)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return d.Set("passwords", flattenIoTSet(passwords))
+	return diag.FromErr(d.Set("passwords", flattenIoTSet(passwords)))
 }
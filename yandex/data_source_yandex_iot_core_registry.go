@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	iot "github.com/yandex-cloud/go-genproto/yandex/cloud/iot/devices/v1"
@@ -11,7 +13,7 @@ import (
 
 func dataSourceYandexIoTCoreRegistry() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexIotCoreRegistryRead,
+		ReadContext: dataSourceYandexIotCoreRegistryRead,
 
 		SchemaVersion: 0,
 
@@ -73,15 +75,15 @@ func flattenIoTSet(vars []string) *schema.Set {
 	return result
 }
 
-func dataSourceYandexIotCoreRegistryRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexIotCoreRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
 	err := checkOneOf(d, "registry_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	regID := d.Get("registry_id").(string)
@@ -90,7 +92,7 @@ func dataSourceYandexIotCoreRegistryRead(d *schema.ResourceData, meta interface{
 	if tgNameOk {
 		regID, err = resolveObjectID(ctx, config, d, sdkresolvers.DeviceRegistryResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source IoT Registry by name: %v", err)
+			return diag.Errorf("failed to resolve data source IoT Registry by name: %v", err)
 		}
 	}
 
@@ -100,12 +102,12 @@ func dataSourceYandexIotCoreRegistryRead(d *schema.ResourceData, meta interface{
 
 	registry, err := config.sdk.IoT().Devices().Registry().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("IoT Registry %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("IoT Registry %q", d.Id())))
 	}
 
 	certsResp, err := config.sdk.IoT().Devices().Registry().ListCertificates(ctx, &iot.ListRegistryCertificatesRequest{RegistryId: regID})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var certs []string
@@ -115,7 +117,7 @@ func dataSourceYandexIotCoreRegistryRead(d *schema.ResourceData, meta interface{
 
 	passResp, err := config.sdk.IoT().Devices().Registry().ListPasswords(ctx, &iot.ListRegistryPasswordsRequest{RegistryId: regID})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var passwords []string
@@ -126,10 +128,10 @@ func dataSourceYandexIotCoreRegistryRead(d *schema.ResourceData, meta interface{
 	d.SetId(registry.Id)
 	d.Set("registry_id", registry.Id)
 	if err := flattenYandexIoTCoreRegistry(d, registry); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("certificates", flattenIoTSet(certs)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return d.Set("passwords", flattenIoTSet(passwords))
+	return diag.FromErr(d.Set("passwords", flattenIoTSet(passwords)))
 }
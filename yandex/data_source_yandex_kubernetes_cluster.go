@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/k8s/v1"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
@@ -10,7 +12,7 @@ import (
 
 func dataSourceYandexKubernetesCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexKubernetesClusterRead,
+		ReadContext: dataSourceYandexKubernetesClusterRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -252,13 +254,12 @@ func dataSourceYandexKubernetesCluster() *schema.Resource {
 	}
 }
 
-func dataSourceYandexKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexKubernetesClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "cluster_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -267,7 +268,7 @@ func dataSourceYandexKubernetesClusterRead(d *schema.ResourceData, meta interfac
 	if clusterNameOk {
 		clusterID, err = resolveObjectID(ctx, config, d, sdkresolvers.KubernetesClusterResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve Kubernetes cluster by name: %v", err)
+			return diag.Errorf("failed to resolve Kubernetes cluster by name: %v", err)
 		}
 	}
 
@@ -276,12 +277,12 @@ func dataSourceYandexKubernetesClusterRead(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Kubernetes cluster with ID %q", clusterID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Kubernetes cluster with ID %q", clusterID)))
 	}
 
 	err = flattenKubernetesClusterAttributes(cluster, d, false)
 	if err != nil {
-		return fmt.Errorf("failed to fill Kubernetes cluster attributes: %v", err)
+		return diag.Errorf("failed to fill Kubernetes cluster attributes: %v", err)
 	}
 
 	return nil
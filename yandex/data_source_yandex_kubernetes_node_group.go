@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/k8s/v1"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
@@ -11,7 +12,7 @@ import (
 
 func dataSourceYandexKubernetesNodeGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexKubernetesNodeGroupRead,
+		ReadContext: dataSourceYandexKubernetesNodeGroupRead,
 		Schema: map[string]*schema.Schema{
 			"node_group_id": {
 				Type:     schema.TypeString,
@@ -408,13 +409,12 @@ func dataSourceYandexKubernetesNodeGroup() *schema.Resource {
 	}
 }
 
-func dataSourceYandexKubernetesNodeGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexKubernetesNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := context.Background()
 
 	err := checkOneOf(d, "node_group_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	nodeGroupID := d.Get("node_group_id").(string)
@@ -423,7 +423,7 @@ func dataSourceYandexKubernetesNodeGroupRead(d *schema.ResourceData, meta interf
 	if nodeGroupNameOk {
 		nodeGroupID, err = resolveObjectID(ctx, config, d, sdkresolvers.KubernetesNodeGroupResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source node-group by name: %v", err)
+			return diag.Errorf("failed to resolve data source node-group by name: %v", err)
 		}
 	}
 
@@ -432,12 +432,12 @@ func dataSourceYandexKubernetesNodeGroupRead(d *schema.ResourceData, meta interf
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Kubernetes node-group with ID %q", nodeGroupID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Kubernetes node-group with ID %q", nodeGroupID)))
 	}
 
 	err = flattenNodeGroupSchemaData(ng, d)
 	if err != nil {
-		return fmt.Errorf("failed to fill Kubernetes node-group shema: %v", err)
+		return diag.Errorf("failed to fill Kubernetes node-group shema: %v", err)
 	}
 
 	d.Set("node_group_id", ng.Id)
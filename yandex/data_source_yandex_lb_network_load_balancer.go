@@ -1,9 +1,11 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
@@ -12,7 +14,7 @@ import (
 
 func dataSourceYandexLBNetworkLoadBalancer() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexLBNetworkLoadBalancerRead,
+		ReadContext: dataSourceYandexLBNetworkLoadBalancerRead,
 		Schema: map[string]*schema.Schema{
 			"network_load_balancer_id": {
 				Type:     schema.TypeString,
@@ -190,13 +192,12 @@ func dataSourceYandexLBNetworkLoadBalancer() *schema.Resource {
 	}
 }
 
-func dataSourceYandexLBNetworkLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexLBNetworkLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "network_load_balancer_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	nlbID := d.Get("network_load_balancer_id").(string)
@@ -205,7 +206,7 @@ func dataSourceYandexLBNetworkLoadBalancerRead(d *schema.ResourceData, meta inte
 	if nlbNameOk {
 		nlbID, err = resolveObjectID(ctx, config, d, sdkresolvers.NetworkLoadBalancerResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source network load balancer by name: %v", err)
+			return diag.Errorf("failed to resolve data source network load balancer by name: %v", err)
 		}
 	}
 
@@ -214,17 +215,17 @@ func dataSourceYandexLBNetworkLoadBalancerRead(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("network load balancer with ID %q", nlbID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("network load balancer with ID %q", nlbID)))
 	}
 
 	ls, err := flattenLBListenerSpecs(nlb)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	atgs, err := flattenLBAttachedTargetGroups(nlb)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("network_load_balancer_id", nlb.Id)
@@ -236,15 +237,15 @@ func dataSourceYandexLBNetworkLoadBalancerRead(d *schema.ResourceData, meta inte
 	d.Set("folder_id", nlb.FolderId)
 
 	if err := d.Set("labels", nlb.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("listener", ls); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("attached_target_group", atgs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(nlb.Id)
@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
@@ -11,7 +13,7 @@ import (
 
 func dataSourceYandexLBTargetGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexLBTargetGroupRead,
+		ReadContext: dataSourceYandexLBTargetGroupRead,
 		Schema: map[string]*schema.Schema{
 			"target_group_id": {
 				Type:     schema.TypeString,
@@ -65,13 +67,12 @@ func dataSourceYandexLBTargetGroup() *schema.Resource {
 	}
 }
 
-func dataSourceYandexLBTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexLBTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "target_group_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tgID := d.Get("target_group_id").(string)
@@ -80,7 +81,7 @@ func dataSourceYandexLBTargetGroupRead(d *schema.ResourceData, meta interface{})
 	if tgNameOk {
 		tgID, err = resolveObjectID(ctx, config, d, sdkresolvers.TargetGroupResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source target group by name: %v", err)
+			return diag.Errorf("failed to resolve data source target group by name: %v", err)
 		}
 	}
 
@@ -89,12 +90,12 @@ func dataSourceYandexLBTargetGroupRead(d *schema.ResourceData, meta interface{})
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("target group with ID %q", tgID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("target group with ID %q", tgID)))
 	}
 
 	targets, err := flattenLBTargets(tg)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("target_group_id", tg.Id)
@@ -104,11 +105,11 @@ func dataSourceYandexLBTargetGroupRead(d *schema.ResourceData, meta interface{})
 	d.Set("folder_id", tg.FolderId)
 
 	if err := d.Set("labels", tg.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("target", targets); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(tg.Id)
@@ -1,24 +1,25 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
 	"log"
 )
 
-
 func dataSourceYandexLockBoxSecretPayload() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexLockBoxSecretPayloadRead,
+		ReadContext: dataSourceYandexLockBoxSecretPayloadRead,
 		Schema: map[string]*schema.Schema{
 			"secret_id": {
-				Type: schema.TypeString,
+				Type:     schema.TypeString,
 				Required: true,
 			},
 
 			"key": {
-				Type: schema.TypeString,
+				Type:     schema.TypeString,
 				Optional: true,
 			},
 
@@ -32,17 +33,15 @@ func dataSourceYandexLockBoxSecretPayload() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Computed: true,
 			},
-
 		},
 	}
 }
 
-func dataSourceYandexLockBoxSecretPayloadRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexLockBoxSecretPayloadRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	secretId := d.Get("secret_id").(string)
-	key, exists  := d.GetOkExists("key")
+	key, exists := d.GetOkExists("key")
 
 	d.SetId(secretId)
 
@@ -50,7 +49,7 @@ func dataSourceYandexLockBoxSecretPayloadRead(d *schema.ResourceData, meta inter
 
 	payload, err := config.sdk.LockboxPayload().Payload().Get(ctx, &lockbox.GetPayloadRequest{SecretId: secretId})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Secret %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Secret %q", d.Id())))
 	}
 
 	values := make(map[string]string)
@@ -61,18 +60,18 @@ func dataSourceYandexLockBoxSecretPayloadRead(d *schema.ResourceData, meta inter
 			log.Printf("[DEBUG] set => '%v'\n", v.GetTextValue())
 
 			if err := d.Set("value", v.GetTextValue()); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	if err := d.Set("secret_id", secretId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("key", key); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("values", values)
+	return diag.FromErr(d.Set("values", values))
 }
@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/logging/v1"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
@@ -10,7 +12,7 @@ import (
 
 func dataSourceYandexLoggingGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexLoggingGroupRead,
+		ReadContext: dataSourceYandexLoggingGroupRead,
 
 		SchemaVersion: 0,
 
@@ -68,15 +70,12 @@ func dataSourceYandexLoggingGroup() *schema.Resource {
 	}
 }
 
-func dataSourceYandexLoggingGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexLoggingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	err := checkOneOf(d, "group_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	groupID := d.Get("group_id").(string)
@@ -85,7 +84,7 @@ func dataSourceYandexLoggingGroupRead(d *schema.ResourceData, meta interface{})
 	if tgNameOk {
 		groupID, err = resolveObjectID(ctx, config, d, sdkresolvers.LogGroupResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Yandex Cloud Logging group by name: %v", err)
+			return diag.Errorf("failed to resolve data source Yandex Cloud Logging group by name: %v", err)
 		}
 	}
 
@@ -95,10 +94,10 @@ func dataSourceYandexLoggingGroupRead(d *schema.ResourceData, meta interface{})
 
 	group, err := config.sdk.Logging().LogGroup().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Logging group %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Logging group %q", d.Id())))
 	}
 
 	d.SetId(group.Id)
 	d.Set("group_id", group.Id)
-	return flattenYandexLoggingGroup(d, group)
+	return diag.FromErr(flattenYandexLoggingGroup(d, group))
 }
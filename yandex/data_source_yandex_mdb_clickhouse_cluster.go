@@ -2,8 +2,8 @@ package yandex
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
@@ -11,7 +11,7 @@ import (
 
 func dataSourceYandexMDBClickHouseCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexMDBClickHouseClusterRead,
+		ReadContext: dataSourceYandexMDBClickHouseClusterRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -636,13 +636,12 @@ func dataSourceYandexMDBClickHouseCluster() *schema.Resource {
 	}
 }
 
-func dataSourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexMDBClickHouseClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := context.Background()
 
 	err := checkOneOf(d, "cluster_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -651,12 +650,12 @@ func dataSourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta inter
 	if clusterNameOk {
 		clusterID, err = resolveObjectID(ctx, config, d, sdkresolvers.ClickhouseClusterResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source ClickHouse Cluster by name: %v", err)
+			return diag.Errorf("failed to resolve data source ClickHouse Cluster by name: %v", err)
 		}
 
 		d.Set("cluster_id", clusterID)
 	}
 
 	d.SetId(clusterID)
-	return resourceYandexMDBClickHouseClusterRead(d, meta)
+	return resourceYandexMDBClickHouseClusterRead(ctx, d, meta)
 }
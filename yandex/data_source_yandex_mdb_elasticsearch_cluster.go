@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/elasticsearch/v1"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceYandexMDBElasticsearchCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexMDBElasticsearchClusterRead,
+		ReadContext: dataSourceYandexMDBElasticsearchClusterRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -245,13 +246,12 @@ func dataSourceYandexMDBElasticsearchCluster() *schema.Resource {
 	}
 }
 
-func dataSourceYandexMDBElasticsearchClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexMDBElasticsearchClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := context.Background()
 
 	err := checkOneOf(d, "cluster_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -260,7 +260,7 @@ func dataSourceYandexMDBElasticsearchClusterRead(d *schema.ResourceData, meta in
 	if clusterNameOk {
 		clusterID, err = resolveObjectID(ctx, config, d, sdkresolvers.ElasticSearchClusterResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Elasticsearch Cluster by name: %v", err)
+			return diag.Errorf("failed to resolve data source Elasticsearch Cluster by name: %v", err)
 		}
 
 		d.Set("cluster_id", clusterID)
@@ -270,14 +270,14 @@ func dataSourceYandexMDBElasticsearchClusterRead(d *schema.ResourceData, meta in
 		ClusterId: clusterID,
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string))))
 	}
 
 	mw := flattenElasticsearchMaintenanceWindow(cluster.MaintenanceWindow)
 	if err := d.Set("maintenance_window", mw); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(clusterID)
-	return resourceYandexMDBElasticsearchClusterRead(d, meta)
+	return resourceYandexMDBElasticsearchClusterRead(ctx, d, meta)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceYandexMDBGreenplumCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexMDBGreenplumClusterRead,
+		ReadContext: dataSourceYandexMDBGreenplumClusterRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -282,13 +283,12 @@ func dataSourceYandexMDBGreenplumCluster() *schema.Resource {
 	}
 }
 
-func dataSourceYandexMDBGreenplumClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexMDBGreenplumClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := context.Background()
 
 	err := checkOneOf(d, "cluster_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -297,7 +297,7 @@ func dataSourceYandexMDBGreenplumClusterRead(d *schema.ResourceData, meta interf
 	if clusterNameOk {
 		clusterID, err = resolveObjectID(ctx, config, d, sdkresolvers.GreenplumClusterResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Greenplum Cluster by name: %v", err)
+			return diag.Errorf("failed to resolve data source Greenplum Cluster by name: %v", err)
 		}
 
 		d.Set("cluster_id", clusterID)
@@ -307,9 +307,9 @@ func dataSourceYandexMDBGreenplumClusterRead(d *schema.ResourceData, meta interf
 		ClusterId: clusterID,
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string))))
 	}
 
 	d.SetId(cluster.Id)
-	return resourceYandexMDBGreenplumClusterRead(d, meta)
+	return resourceYandexMDBGreenplumClusterRead(ctx, d, meta)
 }
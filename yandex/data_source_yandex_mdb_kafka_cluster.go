@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceYandexMDBKafkaCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexMDBKafkaClusterRead,
+		ReadContext: dataSourceYandexMDBKafkaClusterRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -140,13 +141,12 @@ func dataSourceYandexMDBKafkaCluster() *schema.Resource {
 	}
 }
 
-func dataSourceYandexMDBKafkaClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexMDBKafkaClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := context.Background()
 
 	err := checkOneOf(d, "cluster_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -155,7 +155,7 @@ func dataSourceYandexMDBKafkaClusterRead(d *schema.ResourceData, meta interface{
 	if clusterNameOk {
 		clusterID, err = resolveObjectID(ctx, config, d, sdkresolvers.KafkaClusterResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Kafka Cluster by name: %v", err)
+			return diag.Errorf("failed to resolve data source Kafka Cluster by name: %v", err)
 		}
 	}
 
@@ -163,11 +163,11 @@ func dataSourceYandexMDBKafkaClusterRead(d *schema.ResourceData, meta interface{
 		ClusterId: clusterID,
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string))))
 	}
 
 	if err := d.Set("labels", cluster.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("created_at", getTimestamp(cluster.CreatedAt))
@@ -182,52 +182,52 @@ func dataSourceYandexMDBKafkaClusterRead(d *schema.ResourceData, meta interface{
 
 	cfg, err := flattenKafkaConfig(cluster)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("config", cfg); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	topics, err := listKafkaTopics(ctx, config, clusterID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("topic", flattenKafkaTopics(topics)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	users, err := listKafkaUsers(ctx, config, clusterID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("user", flattenKafkaUsers(users, nil)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hosts, err := listKafkaHosts(ctx, config, clusterID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("host", flattenKafkaHosts(hosts)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("host_group_ids", cluster.HostGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("deletion_protection", cluster.DeletionProtection)
 
 	maintenanceWindow, err := flattenKafkaMaintenanceWindow(cluster.MaintenanceWindow)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("maintenance_window", maintenanceWindow); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(cluster.Id)
@@ -10,6 +10,6 @@ func dataSourceYandexMDBKafkaConnector() *schema.Resource {
 	dataSource.Schema["cluster_id"].Required = true
 	dataSource.Schema["name"].Computed = false
 	dataSource.Schema["name"].Required = true
-	dataSource.Read = resourceYandexMDBKafkaConnectorRead
+	dataSource.ReadContext = resourceYandexMDBKafkaConnectorRead
 	return dataSource
 }
@@ -1,6 +1,9 @@
 package yandex
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -10,14 +13,14 @@ func dataSourceYandexMDBKafkaTopic() *schema.Resource {
 	dataSource.Schema["cluster_id"].Required = true
 	dataSource.Schema["name"].Computed = false
 	dataSource.Schema["name"].Required = true
-	dataSource.Read = dataSourceYandexMDBKafkaTopicRead
+	dataSource.ReadContext = dataSourceYandexMDBKafkaTopicRead
 	return dataSource
 }
 
-func dataSourceYandexMDBKafkaTopicRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexMDBKafkaTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clusterID := d.Get("cluster_id").(string)
 	topicName := d.Get("name").(string)
 	topicID := constructResourceId(clusterID, topicName)
 	d.SetId(topicID)
-	return resourceYandexMDBKafkaTopicRead(d, meta)
+	return resourceYandexMDBKafkaTopicRead(ctx, d, meta)
 }
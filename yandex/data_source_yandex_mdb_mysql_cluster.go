@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceYandexMDBMySQLCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexMDBMySQLClusterRead,
+		ReadContext: dataSourceYandexMDBMySQLClusterRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -319,13 +320,12 @@ func dataSourceYandexMDBMySQLCluster() *schema.Resource {
 	}
 }
 
-func dataSourceYandexMDBMySQLClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexMDBMySQLClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := context.Background()
 
 	err := checkOneOf(d, "cluster_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -334,14 +334,14 @@ func dataSourceYandexMDBMySQLClusterRead(d *schema.ResourceData, meta interface{
 	if clusterNameOk {
 		clusterID, err = resolveObjectID(ctx, config, d, sdkresolvers.MySQLClusterResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source MySQL Cluster by name: %v", err)
+			return diag.Errorf("failed to resolve data source MySQL Cluster by name: %v", err)
 		}
 	}
 	cluster, err := config.sdk.MDB().MySQL().Cluster().Get(ctx, &mysql.GetClusterRequest{
 		ClusterId: clusterID,
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string))))
 	}
 
 	d.Set("folder_id", cluster.GetFolderId())
@@ -355,17 +355,17 @@ func dataSourceYandexMDBMySQLClusterRead(d *schema.ResourceData, meta interface{
 	d.Set("version", cluster.GetConfig().GetVersion())
 
 	if err := d.Set("labels", cluster.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hosts, err := listMysqlHosts(ctx, config, clusterID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	fHosts, err := flattenMysqlHosts(d, hosts, true)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] reading cluster:")
@@ -374,86 +374,86 @@ func dataSourceYandexMDBMySQLClusterRead(d *schema.ResourceData, meta interface{
 	}
 
 	if err := d.Set("host", fHosts); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	userSpecs, err := expandMySQLUsers(nil, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	passwords := mysqlUsersPasswords(userSpecs)
 	users, err := listMysqlUsers(ctx, config, clusterID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	fUsers, err := flattenMysqlUsers(users, passwords)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("user", fUsers); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	databases, err := listMysqlDatabases(ctx, config, clusterID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	fDatabases := flattenMysqlDatabases(databases)
 	if err := d.Set("database", fDatabases); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	mysqlResources, err := flattenMysqlResources(cluster.GetConfig().GetResources())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("resources", mysqlResources)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	backupWindowStart := flattenMDBBackupWindowStart(cluster.GetConfig().GetBackupWindowStart())
 	if err := d.Set("backup_window_start", backupWindowStart); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterConfig, err := flattenMySQLConfig(cluster.Config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("mysql_config", clusterConfig); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	access, err := flattenMySQLAccess(cluster.Config.Access)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("access", access); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	maintenanceWindow, err := flattenMysqlMaintenanceWindow(cluster.MaintenanceWindow)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("maintenance_window", maintenanceWindow); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("deletion_protection", cluster.DeletionProtection)
 
 	if err := d.Set("host_group_ids", cluster.HostGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("created_at", getTimestamp(cluster.CreatedAt))
@@ -1,12 +1,14 @@
 package yandex
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceYandexMDBMySQLDatabase() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexMDBMySQLDatabaseRead,
+		ReadContext: dataSourceYandexMDBMySQLDatabaseRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -20,10 +22,10 @@ func dataSourceYandexMDBMySQLDatabase() *schema.Resource {
 	}
 }
 
-func dataSourceYandexMDBMySQLDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexMDBMySQLDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clusterID := d.Get("cluster_id").(string)
 	dbname := d.Get("name").(string)
 	databaseID := constructResourceId(clusterID, dbname)
 	d.SetId(databaseID)
-	return resourceYandexMDBMySQLDatabaseRead(d, meta)
+	return resourceYandexMDBMySQLDatabaseRead(ctx, d, meta)
 }
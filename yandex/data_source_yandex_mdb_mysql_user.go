@@ -1,12 +1,14 @@
 package yandex
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceYandexMDBMySQLUser() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexMDBMySQLUserRead,
+		ReadContext: dataSourceYandexMDBMySQLUserRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -76,10 +78,10 @@ func dataSourceYandexMDBMySQLUser() *schema.Resource {
 	}
 }
 
-func dataSourceYandexMDBMySQLUserRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexMDBMySQLUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clusterID := d.Get("cluster_id").(string)
 	username := d.Get("name").(string)
 	userID := constructResourceId(clusterID, username)
 	d.SetId(userID)
-	return resourceYandexMDBMySQLUserRead(d, meta)
+	return resourceYandexMDBMySQLUserRead(ctx, d, meta)
 }
@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
@@ -11,7 +13,7 @@ import (
 
 func dataSourceYandexMDBPostgreSQLCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexMDBPostgreSQLClusterRead,
+		ReadContext: dataSourceYandexMDBPostgreSQLClusterRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -273,13 +275,12 @@ func dataSourceYandexMDBPostgreSQLClusterMaintenanceWindowBlock() *schema.Resour
 	}
 }
 
-func dataSourceYandexMDBPostgreSQLClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexMDBPostgreSQLClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "cluster_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -288,7 +289,7 @@ func dataSourceYandexMDBPostgreSQLClusterRead(d *schema.ResourceData, meta inter
 	if clusterNameOk {
 		clusterID, err = resolveObjectID(ctx, config, d, sdkresolvers.PostgreSQLClusterResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source PostgreSQL Cluster by name: %v", err)
+			return diag.Errorf("failed to resolve data source PostgreSQL Cluster by name: %v", err)
 		}
 	}
 
@@ -296,51 +297,51 @@ func dataSourceYandexMDBPostgreSQLClusterRead(d *schema.ResourceData, meta inter
 		ClusterId: clusterID,
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", clusterID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", clusterID)))
 	}
 
 	pgClusterConfig, err := flattenPGClusterConfig(cluster.Config, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("config", pgClusterConfig); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hosts, err := listPGHosts(ctx, config, clusterID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	orderedHostInfos, err := flattenPGHostsInfo(d, hosts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hs := flattenPGHostsFromHostInfos(orderedHostInfos, true)
 	if err := d.Set("host", hs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("labels", cluster.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	maintenanceWindow, err := flattenPGMaintenanceWindow(cluster.MaintenanceWindow)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("maintenance_window", maintenanceWindow); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("host_group_ids", cluster.HostGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("created_at", getTimestamp(cluster.CreatedAt))
@@ -1,12 +1,14 @@
 package yandex
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceYandexMDBPostgreSQLDatabase() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexMDBPostgreSQLDatabaseRead,
+		ReadContext: dataSourceYandexMDBPostgreSQLDatabaseRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -57,10 +59,10 @@ func dataSourceYandexMDBPostgreSQLDatabase() *schema.Resource {
 	}
 }
 
-func dataSourceYandexMDBPostgreSQLDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexMDBPostgreSQLDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clusterID := d.Get("cluster_id").(string)
 	dbname := d.Get("name").(string)
 	databaseID := constructResourceId(clusterID, dbname)
 	d.SetId(databaseID)
-	return resourceYandexMDBPostgreSQLDatabaseRead(d, meta)
+	return resourceYandexMDBPostgreSQLDatabaseRead(ctx, d, meta)
 }
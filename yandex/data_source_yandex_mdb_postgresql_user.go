@@ -1,12 +1,14 @@
 package yandex
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceYandexMDBPostgreSQLUser() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexMDBPostgreSQLUserRead,
+		ReadContext: dataSourceYandexMDBPostgreSQLUserRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -62,10 +64,10 @@ func dataSourceYandexMDBPostgreSQLUser() *schema.Resource {
 	}
 }
 
-func dataSourceYandexMDBPostgreSQLUserRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexMDBPostgreSQLUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clusterID := d.Get("cluster_id").(string)
 	username := d.Get("name").(string)
 	userID := constructResourceId(clusterID, username)
 	d.SetId(userID)
-	return resourceYandexMDBPostgreSQLUserRead(d, meta)
+	return resourceYandexMDBPostgreSQLUserRead(ctx, d, meta)
 }
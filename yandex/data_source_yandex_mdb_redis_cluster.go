@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/pagination"
 )
 
 func dataSourceYandexMDBRedisCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexMDBRedisClusterRead,
+		ReadContext: dataSourceYandexMDBRedisClusterRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -204,13 +208,12 @@ func dataSourceYandexMDBRedisCluster() *schema.Resource {
 	}
 }
 
-func dataSourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexMDBRedisClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := context.Background()
 
 	err := checkOneOf(d, "cluster_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -219,7 +222,7 @@ func dataSourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{
 	if clusterNameOk {
 		clusterID, err = resolveObjectID(ctx, config, d, sdkresolvers.RedisClusterResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Redis Cluster by name: %v", err)
+			return diag.Errorf("failed to resolve data source Redis Cluster by name: %v", err)
 		}
 	}
 
@@ -227,25 +230,22 @@ func dataSourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{
 		ClusterId: clusterID,
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string))))
 	}
 
-	hosts := []*redis.Host{}
-	pageToken := ""
-	for {
-		resp, err := config.sdk.MDB().Redis().Cluster().ListHosts(ctx, &redis.ListClusterHostsRequest{
+	items, err := pagination.List(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.MDB().Redis().Cluster().ListHosts(ctx, &redis.ListClusterHostsRequest{
 			ClusterId: clusterID,
 			PageSize:  defaultMDBPageSize,
 			PageToken: pageToken,
 		})
-		if err != nil {
-			return fmt.Errorf("Error while getting list of hosts for '%s': %s", clusterID, err)
-		}
-		hosts = append(hosts, resp.Hosts...)
-		if resp.NextPageToken == "" {
-			break
-		}
-		pageToken = resp.NextPageToken
+	})
+	if err != nil {
+		return diag.Errorf("Error while getting list of hosts for '%s': %s", clusterID, err)
+	}
+	hosts := make([]*redis.Host, len(items))
+	for i, item := range items {
+		hosts[i] = item.(*redis.Host)
 	}
 
 	d.Set("created_at", getTimestamp(cluster.CreatedAt))
@@ -261,7 +261,7 @@ func dataSourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{
 	d.Set("tls_enabled", cluster.TlsEnabled)
 	err = d.Set("persistence_mode", cluster.GetPersistenceMode().String())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	conf := extractRedisConfig(cluster.Config)
@@ -279,38 +279,38 @@ func dataSourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{
 		},
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resources, err := flattenRedisResources(cluster.Config.Resources)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hs, err := flattenRedisHosts(cluster.Sharded, hosts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("resources", resources); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("host", hs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("labels", cluster.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	mw := flattenRedisMaintenanceWindow(cluster.MaintenanceWindow)
 	if err := d.Set("maintenance_window", mw); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("deletion_protection", cluster.DeletionProtection)
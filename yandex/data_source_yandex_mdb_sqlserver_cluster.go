@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceYandexMDBSQLServerCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexMDBSQLServerClusterRead,
+		ReadContext: dataSourceYandexMDBSQLServerClusterRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -209,13 +210,12 @@ func dataSourceYandexMDBSQLServerCluster() *schema.Resource {
 	}
 }
 
-func dataSourceYandexMDBSQLServerClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexMDBSQLServerClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := context.Background()
 
 	err := checkOneOf(d, "cluster_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -224,14 +224,14 @@ func dataSourceYandexMDBSQLServerClusterRead(d *schema.ResourceData, meta interf
 	if clusterNameOk {
 		clusterID, err = resolveObjectID(ctx, config, d, sdkresolvers.SQLServerClusterResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source SQLServer Cluster by name: %v", err)
+			return diag.Errorf("failed to resolve data source SQLServer Cluster by name: %v", err)
 		}
 	}
 	cluster, err := config.sdk.MDB().SQLServer().Cluster().Get(ctx, &sqlserver.GetClusterRequest{
 		ClusterId: clusterID,
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string))))
 	}
 
 	d.Set("folder_id", cluster.GetFolderId())
@@ -245,16 +245,16 @@ func dataSourceYandexMDBSQLServerClusterRead(d *schema.ResourceData, meta interf
 	d.Set("version", cluster.GetConfig().GetVersion())
 
 	if err := d.Set("labels", cluster.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("resources", flattenSQLServerResources(cluster.Config.Resources)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	usersSpec, err := listSQLServerUsers(ctx, config, cluster.Id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	passwords := expandSQLServerUserPasswords(d)
@@ -262,55 +262,55 @@ func dataSourceYandexMDBSQLServerClusterRead(d *schema.ResourceData, meta interf
 	users, err := flattenSQLServerUsers(usersSpec, passwords)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("user", users); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("host_group_ids", cluster.HostGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hostsSpec, err := listSQLServerHosts(ctx, config, cluster.Id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	hosts, err := flattenSQLServerHosts(d, hostsSpec)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("host", hosts); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	databasesSpec, err := listSQLServerDatabases(ctx, config, cluster.Id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	databases := flattenSQLServerDatabases(databasesSpec)
 
 	if err = d.Set("database", databases); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	backupWindowStart := flattenMDBBackupWindowStart(cluster.GetConfig().GetBackupWindowStart())
 	if err = d.Set("backup_window_start", backupWindowStart); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterConfig, err := flattenSQLServerSettings(cluster.Config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("sqlserver_config", clusterConfig); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("deletion_protection", cluster.DeletionProtection)
@@ -1,19 +1,20 @@
 package yandex
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceYandexMessageQueue() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexMessageQueueRead,
+		ReadContext: dataSourceYandexMessageQueueRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -48,10 +49,10 @@ func dataSourceYandexMessageQueue() *schema.Resource {
 	}
 }
 
-func dataSourceYandexMessageQueueRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexMessageQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ymqClient, err := newYMQClient(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get("name").(string)
@@ -76,7 +77,7 @@ func dataSourceYandexMessageQueueRead(d *schema.ResourceData, meta interface{})
 	})
 
 	if err != nil || urlOutput.QueueUrl == nil {
-		return fmt.Errorf("Error getting queue url: %s", err)
+		return diag.Errorf("Error getting queue url: %s", err)
 	}
 
 	queueURL := aws.StringValue(urlOutput.QueueUrl)
@@ -99,7 +100,7 @@ func dataSourceYandexMessageQueueRead(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Error getting queue attributes: %s", err)
+		return diag.Errorf("Error getting queue attributes: %s", err)
 	}
 
 	d.Set("arn", aws.StringValue(attributesOutput.Attributes[sqs.QueueAttributeNameQueueArn]))
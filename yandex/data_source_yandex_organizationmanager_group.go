@@ -0,0 +1,144 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+)
+
+func dataSourceYandexOrganizationManagerGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceYandexOrganizationManagerGroupRead,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexOrganizationManagerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	err := checkOneOf(d, "group_id", "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupID := d.Get("group_id").(string)
+	groupName, ok := d.GetOk("name")
+
+	if ok {
+		organizationID, err := getOrganizationID(d, config)
+		if err != nil {
+			return diag.Errorf("Error getting organization ID while reading Group: %s", err)
+		}
+
+		groupID, err = resolveOrganizationManagerGroupIDByName(ctx, config, groupName.(string), organizationID)
+		if err != nil {
+			return diag.Errorf("failed to resolve data source Group by name: %v", err)
+		}
+	}
+
+	groups, err := config.organizationManagerGroups(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group, err := groups.Get(ctx, &organizationmanager.GetGroupRequest{GroupId: groupID})
+	if err != nil {
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Group with ID %q", groupID)))
+	}
+
+	members, err := listOrganizationManagerGroupMembersWithTypes(ctx, config, groupID)
+	if err != nil {
+		return diag.Errorf("Error reading members of Group %q: %s", groupID, err)
+	}
+
+	d.Set("group_id", group.Id)
+	d.Set("name", group.Name)
+	d.Set("organization_id", group.OrganizationId)
+	d.Set("description", group.Description)
+	d.Set("created_at", getTimestamp(group.CreatedAt))
+	if err := d.Set("members", flattenOrganizationManagerGroupMembers(members)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(group.Id)
+	return nil
+}
+
+// resolveOrganizationManagerGroupIDByName looks the group up by name, the SDK has no resolver for groups.
+func resolveOrganizationManagerGroupIDByName(ctx context.Context, config *Config, groupName, organizationID string) (string, error) {
+	groups, err := config.organizationManagerGroups(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := groups.List(ctx, &organizationmanager.ListGroupsRequest{
+		OrganizationId: organizationID,
+		Filter:         fmt.Sprintf("name = %q", groupName),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	switch len(resp.Groups) {
+	case 0:
+		return "", fmt.Errorf("Group with name %q not found in organization %q", groupName, organizationID)
+	case 1:
+		return resp.Groups[0].Id, nil
+	default:
+		return "", fmt.Errorf("multiple Groups with name %q found in organization %q", groupName, organizationID)
+	}
+}
+
+func flattenOrganizationManagerGroupMembers(members []*organizationmanager.GroupMember) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(members))
+	for _, member := range members {
+		result = append(result, map[string]interface{}{
+			"id":   member.SubjectId,
+			"type": member.SubjectType,
+		})
+	}
+	return result
+}
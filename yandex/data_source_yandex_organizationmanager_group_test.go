@@ -0,0 +1,49 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+func TestDataSourceOrganizationManagerGroup_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	config.OrganizationID = fakecloud.OrganizationID
+
+	group := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_organizationmanager_group"), nil, map[string]interface{}{
+		"name":        "developers",
+		"description": "created offline",
+	})
+	robot := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_iam_service_account"), nil, map[string]interface{}{
+		"name": "robot",
+	})
+	fakeCloudApply(t, config, fakeCloudResource(t, "yandex_organizationmanager_group_membership"), nil, map[string]interface{}{
+		"group_id": group.ID,
+		"members":  []interface{}{robot.ID},
+	})
+
+	ds := Provider().DataSourcesMap["yandex_organizationmanager_group"]
+	for _, raw := range []map[string]interface{}{
+		{"group_id": group.ID},
+		{"name": "developers"},
+	} {
+		d := schema.TestResourceDataRaw(t, ds.Schema, raw)
+		require.Empty(t, ds.ReadContext(context.Background(), d, config))
+
+		assert.Equal(t, group.ID, d.Id())
+		assert.Equal(t, "developers", d.Get("name"))
+		assert.Equal(t, "created offline", d.Get("description"))
+		assert.Equal(t, fakecloud.OrganizationID, d.Get("organization_id"))
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"id": robot.ID, "type": "serviceAccount"},
+		}, d.Get("members"))
+	}
+
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"name": "missing"})
+	assert.NotEmpty(t, ds.ReadContext(context.Background(), d, config))
+}
@@ -2,15 +2,15 @@ package yandex
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
 )
 
 func dataSourceYandexOrganizationManagerSamlFederation() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexOrganizationManagerSamlFederationRead,
+		ReadContext: dataSourceYandexOrganizationManagerSamlFederationRead,
 		Schema: map[string]*schema.Schema{
 			"federation_id": {
 				Type:     schema.TypeString,
@@ -80,18 +80,17 @@ func dataSourceYandexOrganizationManagerSamlFederation() *schema.Resource {
 	}
 }
 
-func dataSourceYandexOrganizationManagerSamlFederationRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexOrganizationManagerSamlFederationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "federation_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	organizationID, err := getOrganizationID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting organization ID while reading SAML Federation: %s", err)
+		return diag.Errorf("Error getting organization ID while reading SAML Federation: %s", err)
 	}
 
 	federationID := d.Get("federation_id").(string)
@@ -100,13 +99,13 @@ func dataSourceYandexOrganizationManagerSamlFederationRead(d *schema.ResourceDat
 	if ok {
 		federationID, err = resolveFederationIDByName(ctx, config, federationName.(string), organizationID)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source SAML Federation by name: %v", err)
+			return diag.Errorf("failed to resolve data source SAML Federation by name: %v", err)
 		}
 	}
 
-	err = flattenSamlFederation(federationID, d, meta)
+	err = flattenSamlFederation(ctx, federationID, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(federationID)
 	return nil
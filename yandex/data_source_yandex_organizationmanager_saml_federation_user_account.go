@@ -1,14 +1,22 @@
 package yandex
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1/saml"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/pagination"
 )
 
 func dataSourceYandexOrganizationManagerSamlFederationUserAccount() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexOrganizationManagerSamlFederationUserAccountRead,
+		ReadContext: dataSourceYandexOrganizationManagerSamlFederationUserAccountRead,
 		Schema: map[string]*schema.Schema{
 			"federation_id": {
 				Type:     schema.TypeString,
@@ -23,45 +31,32 @@ func dataSourceYandexOrganizationManagerSamlFederationUserAccount() *schema.Reso
 	}
 }
 
-func dataSourceYandexOrganizationManagerSamlFederationUserAccountRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexOrganizationManagerSamlFederationUserAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	federationID := d.Get("federation_id").(string)
 	nameID := d.Get("name_id").(string)
-	var nextPageToken string
-	for {
-		req := &saml.ListFederatedUserAccountsRequest{
+	accounts := pagination.New(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.OrganizationManagerSAML().Federation().ListUserAccounts(ctx, &saml.ListFederatedUserAccountsRequest{
 			FederationId: federationID,
-		}
-
-		if nextPageToken != "" {
-			req.PageToken = nextPageToken
-		}
+			PageToken:    pageToken,
+		})
+	})
+	defer accounts.Close()
 
-		listResp, err := config.sdk.OrganizationManagerSAML().Federation().ListUserAccounts(
-			config.Context(),
-			req,
-		)
-		if err != nil {
-			return err
+	for accounts.Next() {
+		account := accounts.Value().(*organizationmanager.UserAccount)
+		if account.GetSamlUserAccount().GetNameId() == nameID {
+			d.SetId(account.Id)
+			return nil
 		}
-
-		for _, account := range listResp.UserAccounts {
-			if account.GetSamlUserAccount().GetNameId() == nameID {
-				d.SetId(account.Id)
-				return nil
-			}
-		}
-
-		if listResp.NextPageToken == "" {
-			break
-		}
-
-		nextPageToken = listResp.NextPageToken
+	}
+	if err := accounts.Err(); err != nil {
+		return diag.FromErr(err)
 	}
 
 	op, err := config.sdk.WrapOperation(config.sdk.OrganizationManagerSAML().Federation().AddUserAccounts(
-		config.Context(),
+		ctx,
 		&saml.AddFederatedUserAccountsRequest{
 			FederationId: federationID,
 			NameIds:      []string{nameID},
@@ -69,17 +64,17 @@ func dataSourceYandexOrganizationManagerSamlFederationUserAccountRead(d *schema.
 	))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = op.Wait(config.Context())
+	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rawResp, err := op.Response()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	addResp := rawResp.(*saml.AddFederatedUserAccountsResponse)
 	d.SetId(addResp.UserAccounts[0].Id)
@@ -2,8 +2,8 @@ package yandex
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
@@ -12,7 +12,7 @@ import (
 
 func dataSourceYandexResourceManagerCloud() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexResourceManagerCloudRead,
+		ReadContext: dataSourceYandexResourceManagerCloudRead,
 		Schema: map[string]*schema.Schema{
 			"cloud_id": {
 				Type:     schema.TypeString,
@@ -36,13 +36,12 @@ func dataSourceYandexResourceManagerCloud() *schema.Resource {
 	}
 }
 
-func dataSourceYandexResourceManagerCloudRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexResourceManagerCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "cloud_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cloudID := d.Get("cloud_id").(string)
@@ -51,7 +50,7 @@ func dataSourceYandexResourceManagerCloudRead(d *schema.ResourceData, meta inter
 	if cloudNameOk {
 		cloudID, err = resolveCloudIDByName(ctx, config, cloudName.(string))
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source cloud by name: %v", err)
+			return diag.Errorf("failed to resolve data source cloud by name: %v", err)
 		}
 	}
 
@@ -60,7 +59,7 @@ func dataSourceYandexResourceManagerCloudRead(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return fmt.Errorf("failed to resolve data source cloud by id: %v", err)
+		return diag.Errorf("failed to resolve data source cloud by id: %v", err)
 	}
 
 	d.Set("cloud_id", cloud.Id)
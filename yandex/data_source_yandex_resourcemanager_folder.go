@@ -2,10 +2,10 @@ package yandex
 
 import (
 	"context"
-	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
@@ -16,7 +16,7 @@ const yandexResourceManagerFolderDefaultTimeout = 1 * time.Minute
 
 func dataSourceYandexResourceManagerFolder() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexResourceManagerFolderRead,
+		ReadContext: dataSourceYandexResourceManagerFolderRead,
 		Schema: map[string]*schema.Schema{
 			"folder_id": {
 				Type:     schema.TypeString,
@@ -55,13 +55,12 @@ func dataSourceYandexResourceManagerFolder() *schema.Resource {
 	}
 }
 
-func dataSourceYandexResourceManagerFolderRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexResourceManagerFolderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "folder_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	folderID := d.Get("folder_id").(string)
@@ -70,12 +69,12 @@ func dataSourceYandexResourceManagerFolderRead(d *schema.ResourceData, meta inte
 	if folderNameOk {
 		dsCloudID, err := getCloudID(d, config)
 		if err != nil {
-			return fmt.Errorf("error getting cloud ID to resolve data source for folder: %s", err)
+			return diag.Errorf("error getting cloud ID to resolve data source for folder: %s", err)
 		}
 
 		folderID, err = resolveFolderIDByName(ctx, config, folderName.(string), dsCloudID)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source folder by name: %v", err)
+			return diag.Errorf("failed to resolve data source folder by name: %v", err)
 		}
 	}
 
@@ -84,7 +83,7 @@ func dataSourceYandexResourceManagerFolderRead(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return fmt.Errorf("failed to resolve data source folder by ID: %v", err)
+		return diag.Errorf("failed to resolve data source folder by ID: %v", err)
 	}
 
 	d.Set("folder_id", folder.Id)
@@ -95,7 +94,7 @@ func dataSourceYandexResourceManagerFolderRead(d *schema.ResourceData, meta inte
 	d.Set("created_at", getTimestamp(folder.CreatedAt))
 
 	if err := d.Set("labels", folder.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(folder.Id)
@@ -2,18 +2,20 @@ package yandex
 
 import (
 	"context"
-	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/proto"
+
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
-	"log"
-)
 
-const yandexResourcesComputeCloudLoadDisksLimit = 1000
-const yandexResourcesComputeCloudLoadInstancesLimit = 1000
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/pagination"
+)
 
 func dataSourceYandexResourcesComputeCloudContent() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexResourcesComputeCloudContentRead,
+		ReadContext: dataSourceYandexResourcesComputeCloudContentRead,
 		Schema: map[string]*schema.Schema{
 			"folder_id": {
 				Type:     schema.TypeString,
@@ -68,9 +70,8 @@ func dataSourceYandexResourcesComputeCloudContent() *schema.Resource {
 	}
 }
 
-func dataSourceYandexResourcesComputeCloudContentRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexResourcesComputeCloudContentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	var cpuResult []map[string]interface{}
 	var totalNetworkSSD int64 = 0
@@ -78,17 +79,17 @@ func dataSourceYandexResourcesComputeCloudContentRead(d *schema.ResourceData, me
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating network load balancer: %s", err)
+		return diag.Errorf("Error getting folder ID while creating network load balancer: %s", err)
 	}
 
 	d.SetId(folderID)
 
-	disks, err := yandexResourcesComputeCloudLoadDisks(ctx, config, folderID, "")
+	disks, err := yandexResourcesComputeCloudLoadDisks(ctx, config, folderID)
 
 	log.Printf("[DEBUG] Got disks size - %v", len(disks))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, item := range disks {
@@ -106,16 +107,16 @@ func dataSourceYandexResourcesComputeCloudContentRead(d *schema.ResourceData, me
 	log.Printf("[DEBUG] HDD total size is - %v", totalNetworkHDD)
 
 	if err := d.Set("network_ssd", totalNetworkSSD); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("network_hdd", totalNetworkHDD); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	instances, err := yandexResourcesComputeCloudLoadInstances(ctx, config, folderID, "")
+	instances, err := yandexResourcesComputeCloudLoadInstances(ctx, config, folderID)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, platformId := range []string{"standard-v1", "standard-v2", "standard-v3"} {
@@ -165,69 +166,47 @@ func dataSourceYandexResourcesComputeCloudContentRead(d *schema.ResourceData, me
 	}
 
 	if err := d.Set("folder_id", folderID); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return d.Set("cpu", cpuResult)
+	return diag.FromErr(d.Set("cpu", cpuResult))
 }
 
-func yandexResourcesComputeCloudLoadInstances(ctx context.Context, config *Config, folderId string, nextPageToken string) ([]*compute.Instance, error) {
-	var instances []*compute.Instance
-	disk, err := config.sdk.Compute().Instance().List(ctx, &compute.ListInstancesRequest{
-		PageSize:  yandexResourcesComputeCloudLoadInstancesLimit,
-		FolderId:  folderId,
-		PageToken: nextPageToken,
+func yandexResourcesComputeCloudLoadInstances(ctx context.Context, config *Config, folderId string) ([]*compute.Instance, error) {
+	items, err := pagination.List(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.Compute().Instance().List(ctx, &compute.ListInstancesRequest{
+			FolderId:  folderId,
+			PageSize:  pagination.PageSize,
+			PageToken: pageToken,
+		})
 	})
-
 	if err != nil {
-		return instances, err
-	}
-
-	for _, item := range disk.Instances {
-		if item.Status == compute.Instance_RUNNING {
-			instances = append(instances, item)
-		}
+		return nil, err
 	}
 
-	if len(disk.Instances) == yandexResourcesComputeCloudLoadDisksLimit {
-		data, err := yandexResourcesComputeCloudLoadInstances(ctx, config, folderId, disk.NextPageToken)
-
-		if len(data) > 0 {
-			return append(instances, data...), nil
-		}
-		if err != nil {
-			return instances, err
+	var instances []*compute.Instance
+	for _, item := range items {
+		if instance := item.(*compute.Instance); instance.Status == compute.Instance_RUNNING {
+			instances = append(instances, instance)
 		}
 	}
-
 	return instances, nil
 }
 
-func yandexResourcesComputeCloudLoadDisks(ctx context.Context, config *Config, folderId string, nextPageToken string) ([]*compute.Disk, error) {
-	var disks []*compute.Disk
-	disk, err := config.sdk.Compute().Disk().List(ctx, &compute.ListDisksRequest{
-		PageSize:  yandexResourcesComputeCloudLoadDisksLimit,
-		FolderId:  folderId,
-		PageToken: nextPageToken,
+func yandexResourcesComputeCloudLoadDisks(ctx context.Context, config *Config, folderId string) ([]*compute.Disk, error) {
+	items, err := pagination.List(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.Compute().Disk().List(ctx, &compute.ListDisksRequest{
+			FolderId:  folderId,
+			PageSize:  pagination.PageSize,
+			PageToken: pageToken,
+		})
 	})
-
 	if err != nil {
-		return disks, err
+		return nil, err
 	}
 
-	for _, item := range disk.Disks {
-		disks = append(disks, item)
+	disks := make([]*compute.Disk, len(items))
+	for i, item := range items {
+		disks[i] = item.(*compute.Disk)
 	}
-
-	if len(disk.Disks) == yandexResourcesComputeCloudLoadDisksLimit {
-		data, err := yandexResourcesComputeCloudLoadDisks(ctx, config, folderId, disk.NextPageToken)
-
-		if len(data) > 0 {
-			return append(disks, data...), nil
-		}
-		if err != nil {
-			return disks, err
-		}
-	}
-
 	return disks, nil
 }
@@ -0,0 +1,30 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+func TestDataSourceResourcesComputeCloud_fakeCloud(t *testing.T) {
+	// Disks don't fit into one page, all of them must be counted.
+	config, _ := newFakeCloudConfig(t, fakecloud.WithMaxPageSize(2))
+	disk := fakeCloudResource(t, "yandex_compute_disk")
+	for _, size := range []int{10, 20, 30} {
+		fakeCloudApply(t, config, disk, nil, map[string]interface{}{"size": size})
+	}
+	fakeCloudApply(t, config, disk, nil, map[string]interface{}{"size": 40, "type": "network-ssd"})
+
+	ds := Provider().DataSourcesMap["yandex_resource_compute_cloud"]
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{})
+	require.Empty(t, ds.ReadContext(context.Background(), d, config))
+
+	assert.Equal(t, fakecloud.FolderID, d.Id())
+	assert.Equal(t, int(toBytes(60)), d.Get("network_hdd"))
+	assert.Equal(t, int(toBytes(40)), d.Get("network_ssd"))
+}
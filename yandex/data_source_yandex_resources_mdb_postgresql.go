@@ -1,17 +1,22 @@
 package yandex
 
 import (
-	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"context"
 	"log"
 	"regexp"
-)
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/pagination"
+)
 
 func dataSourceYandexResourcesMdbPostgreSqlContent() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexResourcesMdbPostgreSqlContentRead,
+		ReadContext: dataSourceYandexResourcesMdbPostgreSqlContentRead,
 		Schema: map[string]*schema.Schema{
 			"folder_id": {
 				Type:     schema.TypeString,
@@ -50,9 +55,8 @@ func dataSourceYandexResourcesMdbPostgreSqlContent() *schema.Resource {
 	}
 }
 
-func dataSourceYandexResourcesMdbPostgreSqlContentRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexResourcesMdbPostgreSqlContentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	var result []MDBResourceItem
 	var clusterIds []string
@@ -60,29 +64,40 @@ func dataSourceYandexResourcesMdbPostgreSqlContentRead(d *schema.ResourceData, m
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating network load balancer: %s", err)
+		return diag.Errorf("Error getting folder ID while creating network load balancer: %s", err)
 	}
 
-	clusters, err := config.sdk.MDB().PostgreSQL().Cluster().List(ctx, &postgresql.ListClustersRequest{
-		FolderId: folderID,
-		PageSize: 1000,
+	clusters, err := pagination.List(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.MDB().PostgreSQL().Cluster().List(ctx, &postgresql.ListClustersRequest{
+			FolderId:  folderID,
+			PageSize:  pagination.PageSize,
+			PageToken: pageToken,
+		})
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	for _, cluster := range clusters.Clusters {
-		clusterIds = append(clusterIds, cluster.Id)
+	for _, cluster := range clusters {
+		clusterIds = append(clusterIds, cluster.(*postgresql.Cluster).Id)
 	}
 
-	presets, err := config.sdk.MDB().PostgreSQL().ResourcePreset().List(ctx, &postgresql.ListResourcePresetsRequest{PageSize: 1000})
+	presets, err := config.cachedLookup("mdb.postgresql.resource_presets", func() (interface{}, error) {
+		return pagination.List(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+			return config.sdk.MDB().PostgreSQL().ResourcePreset().List(ctx, &postgresql.ListResourcePresetsRequest{
+				PageSize:  pagination.PageSize,
+				PageToken: pageToken,
+			})
+		})
+	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	for _, preset := range presets.ResourcePresets {
+	for _, p := range presets.([]proto.Message) {
+		preset := p.(*postgresql.ResourcePreset)
 		item := MDBResourcePreset{
 			Cores:        preset.Cores,
 			Memory:       preset.Memory,
@@ -112,18 +127,23 @@ func dataSourceYandexResourcesMdbPostgreSqlContentRead(d *schema.ResourceData, m
 		resourcesPreset[preset.Id] = item
 	}
 
-	for _, clusterId := range clusterIds {
-
-		cluster, err := config.sdk.MDB().PostgreSQL().Cluster().ListHosts(ctx, &postgresql.ListClusterHostsRequest{
-			ClusterId: clusterId,
-			PageSize:  1000,
-		})
-
-		if err != nil {
-			return err
+	clusterHosts, err := pagination.ListEach(ctx, clusterIds, mdbResourcesListConcurrency, func(clusterId string) pagination.ListFunc {
+		return func(ctx context.Context, pageToken string) (proto.Message, error) {
+			return config.sdk.MDB().PostgreSQL().Cluster().ListHosts(ctx, &postgresql.ListClusterHostsRequest{
+				ClusterId: clusterId,
+				PageSize:  pagination.PageSize,
+				PageToken: pageToken,
+			})
 		}
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-		for _, host := range cluster.Hosts {
+	for _, hosts := range clusterHosts {
+		for _, h := range hosts {
+			host := h.(*postgresql.Host)
 			preset := resourcesPreset[host.Resources.ResourcePresetId]
 
 			resourceItem := MDBResourceItem{
@@ -183,14 +203,14 @@ func dataSourceYandexResourcesMdbPostgreSqlContentRead(d *schema.ResourceData, m
 	}
 
 	if err := d.Set("network_ssd", totalNetworkSSD); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("network_hdd", totalNetworkHDD); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("folder_id", folderID); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("cpu", cpuResult)
+	return diag.FromErr(d.Set("cpu", cpuResult))
 }
@@ -1,16 +1,22 @@
 package yandex
 
 import (
-	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+	"context"
 	"log"
 	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/pagination"
 )
 
 func dataSourceYandexResourcesMdbRedisContent() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexResourcesMdbRedisContentRead,
+		ReadContext: dataSourceYandexResourcesMdbRedisContentRead,
 		Schema: map[string]*schema.Schema{
 			"folder_id": {
 				Type:     schema.TypeString,
@@ -49,9 +55,8 @@ func dataSourceYandexResourcesMdbRedisContent() *schema.Resource {
 	}
 }
 
-func dataSourceYandexResourcesMdbRedisContentRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexResourcesMdbRedisContentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	var result []MDBResourceItem
 	var clusterIds []string
@@ -59,29 +64,40 @@ func dataSourceYandexResourcesMdbRedisContentRead(d *schema.ResourceData, meta i
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating network load balancer: %s", err)
+		return diag.Errorf("Error getting folder ID while creating network load balancer: %s", err)
 	}
 
-	clusters, err := config.sdk.MDB().Redis().Cluster().List(ctx, &redis.ListClustersRequest{
-		FolderId: folderID,
-		PageSize: 1000,
+	clusters, err := pagination.List(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.MDB().Redis().Cluster().List(ctx, &redis.ListClustersRequest{
+			FolderId:  folderID,
+			PageSize:  pagination.PageSize,
+			PageToken: pageToken,
+		})
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	for _, cluster := range clusters.Clusters {
-		clusterIds = append(clusterIds, cluster.Id)
+	for _, cluster := range clusters {
+		clusterIds = append(clusterIds, cluster.(*redis.Cluster).Id)
 	}
 
-	presets, err := config.sdk.MDB().Redis().ResourcePreset().List(ctx, &redis.ListResourcePresetsRequest{PageSize: 1000})
+	presets, err := config.cachedLookup("mdb.redis.resource_presets", func() (interface{}, error) {
+		return pagination.List(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+			return config.sdk.MDB().Redis().ResourcePreset().List(ctx, &redis.ListResourcePresetsRequest{
+				PageSize:  pagination.PageSize,
+				PageToken: pageToken,
+			})
+		})
+	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	for _, preset := range presets.ResourcePresets {
+	for _, p := range presets.([]proto.Message) {
+		preset := p.(*redis.ResourcePreset)
 		item := MDBResourcePreset{
 			Cores:        preset.Cores,
 			Memory:       preset.Memory,
@@ -111,18 +127,23 @@ func dataSourceYandexResourcesMdbRedisContentRead(d *schema.ResourceData, meta i
 		resourcesPreset[preset.Id] = item
 	}
 
-	for _, clusterId := range clusterIds {
-
-		cluster, err := config.sdk.MDB().Redis().Cluster().ListHosts(ctx, &redis.ListClusterHostsRequest{
-			ClusterId: clusterId,
-			PageSize:  1000,
-		})
-
-		if err != nil {
-			return err
+	clusterHosts, err := pagination.ListEach(ctx, clusterIds, mdbResourcesListConcurrency, func(clusterId string) pagination.ListFunc {
+		return func(ctx context.Context, pageToken string) (proto.Message, error) {
+			return config.sdk.MDB().Redis().Cluster().ListHosts(ctx, &redis.ListClusterHostsRequest{
+				ClusterId: clusterId,
+				PageSize:  pagination.PageSize,
+				PageToken: pageToken,
+			})
 		}
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-		for _, host := range cluster.Hosts {
+	for _, hosts := range clusterHosts {
+		for _, h := range hosts {
+			host := h.(*redis.Host)
 			preset := resourcesPreset[host.Resources.ResourcePresetId]
 
 			resourceItem := MDBResourceItem{
@@ -182,14 +203,14 @@ func dataSourceYandexResourcesMdbRedisContentRead(d *schema.ResourceData, meta i
 	}
 
 	if err := d.Set("network_ssd", totalNetworkSSD); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("network_hdd", totalNetworkHDD); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("folder_id", folderID); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("cpu", cpuResult)
+	return diag.FromErr(d.Set("cpu", cpuResult))
 }
@@ -1,7 +1,9 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/containers/v1"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
@@ -9,7 +11,7 @@ import (
 
 func dataSourceYandexServerlessContainer() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexServerlessContainerRead,
+		ReadContext: dataSourceYandexServerlessContainerRead,
 
 		SchemaVersion: 0,
 
@@ -127,21 +129,19 @@ func dataSourceYandexServerlessContainer() *schema.Resource {
 	}
 }
 
-func dataSourceYandexServerlessContainerRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexServerlessContainerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
 
 	err := checkOneOf(d, "container_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	containerID := d.Get("container_id").(string)
 
 	if _, ok := d.GetOk("name"); ok {
 		containerID, err = resolveObjectID(ctx, config, d, sdkresolvers.ContainerResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source Yandex Cloud Serverless Container by name: %v", err)
+			return diag.Errorf("failed to resolve data source Yandex Cloud Serverless Container by name: %v", err)
 		}
 	}
 
@@ -151,15 +151,15 @@ func dataSourceYandexServerlessContainerRead(d *schema.ResourceData, meta interf
 
 	container, err := config.sdk.Serverless().Containers().Container().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Container %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Container %q", d.Id())))
 	}
 
 	revision, err := resolveContainerLastRevision(ctx, config, containerID)
 	if err != nil {
-		return fmt.Errorf("Failed to resolve last revision of data source Yandex Cloud Container: %s", err)
+		return diag.Errorf("Failed to resolve last revision of data source Yandex Cloud Container: %s", err)
 	}
 
 	d.SetId(container.Id)
 	d.Set("container_id", container.Id)
-	return flattenYandexServerlessContainer(d, container, revision)
+	return diag.FromErr(flattenYandexServerlessContainer(d, container, revision))
 }
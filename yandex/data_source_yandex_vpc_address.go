@@ -1,13 +1,15 @@
 package yandex
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
 )
 
 func dataSourceYandexVPCAddress() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexVPCAddressRead,
+		ReadContext: dataSourceYandexVPCAddressRead,
 		Schema: map[string]*schema.Schema{
 			"address_id": {
 				Type:     schema.TypeString,
@@ -74,29 +76,29 @@ func dataSourceYandexVPCAddress() *schema.Resource {
 	}
 }
 
-func dataSourceYandexVPCAddressRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexVPCAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	err := checkOneOf(d, "address_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	addressID := d.Get("address_id").(string)
 	_, nameOk := d.GetOk("name")
 
 	if nameOk {
-		addressID, err = resolveObjectID(config.Context(), config, d, sdkresolvers.AddressResolver)
+		addressID, err = resolveObjectID(ctx, config, d, sdkresolvers.AddressResolver)
 		if err != nil {
-			return addressError("failed to resolve data source address by name: %v", err)
+			return diag.FromErr(addressError("failed to resolve data source address by name: %v", err))
 		}
 	}
 
-	if err := yandexVPCAddressRead(d, meta, addressID); err != nil {
-		return err
+	if err := yandexVPCAddressRead(ctx, d, meta, addressID); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(addressID)
 
-	return d.Set("address_id", addressID)
+	return diag.FromErr(d.Set("address_id", addressID))
 }
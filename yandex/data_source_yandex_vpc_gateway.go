@@ -1,8 +1,9 @@
 package yandex
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
@@ -10,7 +11,7 @@ import (
 
 func dataSourceYandexVPCGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexVPCGatewayRead,
+		ReadContext: dataSourceYandexVPCGatewayRead,
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Type:     schema.TypeString,
@@ -53,13 +54,12 @@ func dataSourceYandexVPCGateway() *schema.Resource {
 	}
 }
 
-func dataSourceYandexVPCGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexVPCGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "gateway_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayID := d.Get("gateway_id").(string)
@@ -68,15 +68,15 @@ func dataSourceYandexVPCGatewayRead(d *schema.ResourceData, meta interface{}) er
 	if gatewayNameOk {
 		gatewayID, err = resolveObjectID(ctx, config, d, sdkresolvers.GatewayResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source gateway by name: %v", err)
+			return diag.Errorf("failed to resolve data source gateway by name: %v", err)
 		}
 	}
 
 	d.SetId(gatewayID)
 
 	if err := d.Set("gateway_id", gatewayID); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return yandexVPCGatewayRead(d, meta, gatewayID)
+	return diagnosticsFromError(yandexVPCGatewayRead(ctx, d, meta, gatewayID))
 }
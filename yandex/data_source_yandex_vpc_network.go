@@ -1,8 +1,9 @@
 package yandex
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
@@ -10,7 +11,7 @@ import (
 
 func dataSourceYandexVPCNetwork() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexVPCNetworkRead,
+		ReadContext: dataSourceYandexVPCNetworkRead,
 		Schema: map[string]*schema.Schema{
 			"network_id": {
 				Type:     schema.TypeString,
@@ -56,13 +57,12 @@ func dataSourceYandexVPCNetwork() *schema.Resource {
 	}
 }
 
-func dataSourceYandexVPCNetworkRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexVPCNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "network_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	networkID := d.Get("network_id").(string)
@@ -71,15 +71,15 @@ func dataSourceYandexVPCNetworkRead(d *schema.ResourceData, meta interface{}) er
 	if networkNameOk {
 		networkID, err = resolveObjectID(ctx, config, d, sdkresolvers.NetworkResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source network by name: %v", err)
+			return diag.Errorf("failed to resolve data source network by name: %v", err)
 		}
 	}
 
 	d.SetId(networkID)
 
 	if err := d.Set("network_id", networkID); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return yandexVPCNetworkRead(d, meta, networkID)
+	return diagnosticsFromError(yandexVPCNetworkRead(ctx, d, meta, networkID))
 }
@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
@@ -11,7 +13,7 @@ import (
 
 func dataSourceYandexVPCRouteTable() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexVPCRouteTableRead,
+		ReadContext: dataSourceYandexVPCRouteTableRead,
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
 				Type:     schema.TypeString,
@@ -72,13 +74,12 @@ func dataSourceYandexVPCRouteTable() *schema.Resource {
 	}
 }
 
-func dataSourceYandexVPCRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexVPCRouteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "route_table_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routeTableID := d.Get("route_table_id").(string)
@@ -87,7 +88,7 @@ func dataSourceYandexVPCRouteTableRead(d *schema.ResourceData, meta interface{})
 	if routeTableNameOk {
 		routeTableID, err = resolveObjectID(ctx, config, d, sdkresolvers.RouteTableResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source route table by name: %v", err)
+			return diag.Errorf("failed to resolve data source route table by name: %v", err)
 		}
 	}
 
@@ -96,7 +97,7 @@ func dataSourceYandexVPCRouteTableRead(d *schema.ResourceData, meta interface{})
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("route table with ID %q", routeTableID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("route table with ID %q", routeTableID)))
 	}
 
 	d.Set("route_table_id", routeTable.Id)
@@ -106,12 +107,12 @@ func dataSourceYandexVPCRouteTableRead(d *schema.ResourceData, meta interface{})
 	d.Set("created_at", getTimestamp(routeTable.CreatedAt))
 	d.Set("network_id", routeTable.NetworkId)
 	if err := d.Set("labels", routeTable.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	staticRoutes := flattenStaticRoutes(routeTable)
 	if err := d.Set("static_route", staticRoutes); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(routeTable.Id)
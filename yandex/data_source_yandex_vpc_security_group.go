@@ -1,16 +1,17 @@
 package yandex
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceYandexVPCSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexVPCSecurityGroupRead,
+		ReadContext: dataSourceYandexVPCSecurityGroupRead,
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
 				Type:     schema.TypeString,
@@ -130,29 +131,29 @@ func dataSourceYandexSecurityGroupRule() *schema.Resource {
 	}
 }
 
-func dataSourceYandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexVPCSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	err := checkOneOf(d, "security_group_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	sgID := d.Get("security_group_id").(string)
 	_, nameOk := d.GetOk("name")
 
 	if nameOk {
-		sgID, err = resolveObjectID(config.Context(), config, d, sdkresolvers.SecurityGroupResolver)
+		sgID, err = resolveObjectID(ctx, config, d, sdkresolvers.SecurityGroupResolver)
 		if err != nil {
-			return fmt.Errorf("VPC security group: failed to resolve data source security group by name: %v", err)
+			return diag.Errorf("VPC security group: failed to resolve data source security group by name: %v", err)
 		}
 	}
 
-	if err := yandexVPCSecurityGroupRead(d, meta, sgID); err != nil {
-		return err
+	if err := yandexVPCSecurityGroupRead(ctx, d, meta, sgID); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(sgID)
 
-	return d.Set("security_group_id", sgID)
+	return diag.FromErr(d.Set("security_group_id", sgID))
 }
@@ -3,12 +3,13 @@ package yandex
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceYandexVPCSecurityGroupRule() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexVPCSecurityGroupRuleRead,
+		ReadContext: dataSourceYandexVPCSecurityGroupRuleRead,
 		Schema: map[string]*schema.Schema{
 			"rule_id": {
 				Type:     schema.TypeString,
@@ -70,20 +71,20 @@ func dataSourceYandexVPCSecurityGroupRule() *schema.Resource {
 	}
 }
 
-func dataSourceYandexVPCSecurityGroupRuleRead(data *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexVPCSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ruleId := data.Get("rule_id").(string)
-	sgId := data.Get("security_group_binding").(string)
+	ruleId := d.Get("rule_id").(string)
+	sgId := d.Get("security_group_binding").(string)
 
-	ctx, cancel := context.WithTimeout(config.Context(), yandexVPCSecurityGroupDefaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexVPCSecurityGroupDefaultTimeout)
 	defer cancel()
 
-	rule, err := findRule(data, config, ctx, sgId, ruleId)
+	rule, err := findRule(d, config, ctx, sgId, ruleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	data.SetId(ruleId)
+	d.SetId(ruleId)
 
-	return writeSecurityGroupRuleToData(rule, data)
+	return diag.FromErr(writeSecurityGroupRuleToData(rule, d))
 }
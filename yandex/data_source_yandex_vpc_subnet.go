@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
@@ -11,7 +13,7 @@ import (
 
 func dataSourceYandexVPCSubnet() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexVPCSubnetRead,
+		ReadContext: dataSourceYandexVPCSubnetRead,
 		Schema: map[string]*schema.Schema{
 			"subnet_id": {
 				Type:     schema.TypeString,
@@ -94,13 +96,12 @@ func dataSourceYandexVPCSubnet() *schema.Resource {
 	}
 }
 
-func dataSourceYandexVPCSubnetRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceYandexVPCSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	err := checkOneOf(d, "subnet_id", "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	subnetID := d.Get("subnet_id").(string)
@@ -109,7 +110,7 @@ func dataSourceYandexVPCSubnetRead(d *schema.ResourceData, meta interface{}) err
 	if subnetNameOk {
 		subnetID, err = resolveObjectID(ctx, config, d, sdkresolvers.SubnetResolver)
 		if err != nil {
-			return fmt.Errorf("failed to resolve data source subnet by name: %v", err)
+			return diag.Errorf("failed to resolve data source subnet by name: %v", err)
 		}
 	}
 
@@ -118,7 +119,7 @@ func dataSourceYandexVPCSubnetRead(d *schema.ResourceData, meta interface{}) err
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("subnet with ID %q", subnetID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("subnet with ID %q", subnetID)))
 	}
 
 	d.Set("subnet_id", subnet.Id)
@@ -130,16 +131,16 @@ func dataSourceYandexVPCSubnetRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("zone", subnet.ZoneId)
 	d.Set("route_table_id", subnet.RouteTableId)
 	if err := d.Set("labels", subnet.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("v4_cidr_blocks", subnet.V4CidrBlocks); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("v6_cidr_blocks", subnet.V6CidrBlocks); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("dhcp_options", flattenDhcpOptions(subnet.DhcpOptions)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(subnet.Id)
 
@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/ydb/v1"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
@@ -10,7 +12,7 @@ import (
 
 func dataSourceYandexYDBDatabaseDedicated() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexYDBDatabaseDedicatedRead,
+		ReadContext: dataSourceYandexYDBDatabaseDedicatedRead,
 
 		SchemaVersion: 0,
 
@@ -155,6 +157,11 @@ func dataSourceYandexYDBDatabaseDedicated() *schema.Resource {
 				Computed: true,
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"tls_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -173,21 +180,18 @@ func dataSourceYandexYDBDatabaseDedicated() *schema.Resource {
 	}
 }
 
-func dataSourceYandexYDBDatabaseDedicatedRead(d *schema.ResourceData, meta interface{}) error {
-	database, err := dataSourceYandexYDBDatabaseRead(d, meta)
+func dataSourceYandexYDBDatabaseDedicatedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	database, err := dataSourceYandexYDBDatabaseRead(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return flattenYandexYDBDatabaseDedicated(d, database)
+	return diag.FromErr(flattenYandexYDBDatabaseDedicated(d, database))
 }
 
-func dataSourceYandexYDBDatabaseRead(d *schema.ResourceData, meta interface{}) (*ydb.Database, error) {
+func dataSourceYandexYDBDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (*ydb.Database, error) {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	err := checkOneOf(d, "database_id", "name")
 	if err != nil {
 		return nil, err
@@ -1,12 +1,14 @@
 package yandex
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceYandexYDBDatabaseServerless() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceYandexYDBDatabaseServerlessRead,
+		ReadContext: dataSourceYandexYDBDatabaseServerlessRead,
 
 		SchemaVersion: 0,
 
@@ -64,6 +66,11 @@ func dataSourceYandexYDBDatabaseServerless() *schema.Resource {
 				Description: "",
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"tls_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -82,11 +89,11 @@ func dataSourceYandexYDBDatabaseServerless() *schema.Resource {
 	}
 }
 
-func dataSourceYandexYDBDatabaseServerlessRead(d *schema.ResourceData, meta interface{}) error {
-	database, err := dataSourceYandexYDBDatabaseRead(d, meta)
+func dataSourceYandexYDBDatabaseServerlessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	database, err := dataSourceYandexYDBDatabaseRead(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return flattenYandexYDBDatabaseServerless(d, database)
+	return diag.FromErr(flattenYandexYDBDatabaseServerless(d, database))
 }
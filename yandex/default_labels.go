@@ -0,0 +1,172 @@
+package yandex
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider-wide default labels are handled outside of resource implementations:
+//   - before Create/Update, "labels" is replaced with the merge of provider defaults and configured labels,
+//     so resources send the effective set to the API;
+//   - after Create/Update/Read, default labels are removed from "labels" unless they are configured explicitly,
+//     so they don't cause diffs, and the effective set is stored in the computed "labels_all" attribute;
+//   - CustomizeDiff plans "labels_all", so changes of the provider defaults lead to resource update;
+//   - resources detect changes of labels with hasChangeWithDefaultLabels, since a change of the provider defaults
+//     is seen only in "labels_all", and d.HasChange ignores labels replaced before Update.
+
+// addDefaultLabels wraps every resource with top-level "labels" map.
+func addDefaultLabels(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		if s, ok := r.Schema["labels"]; ok && s.Type == schema.TypeMap && (s.Optional || s.Required) {
+			withDefaultLabels(r)
+		}
+	}
+}
+
+func withDefaultLabels(r *schema.Resource) *schema.Resource {
+	r.Schema["labels_all"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "All labels of the resource, including labels inherited from the provider `default_labels`.",
+	}
+
+	if r.CreateContext != nil {
+		r.CreateContext = wrapCreateOrUpdateContextDefaultLabels(r.CreateContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrapCreateOrUpdateContextDefaultLabels(r.UpdateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = wrapReadContextDefaultLabels(r.ReadContext)
+	}
+
+	if r.CustomizeDiff != nil {
+		r.CustomizeDiff = customdiff.All(r.CustomizeDiff, defaultLabelsCustomizeDiff)
+	} else {
+		r.CustomizeDiff = defaultLabelsCustomizeDiff
+	}
+
+	return r
+}
+
+func wrapCreateOrUpdateContextDefaultLabels(f crudContextFunc) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		configured, err := setEffectiveLabels(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		diags := f(ctx, d, meta)
+		if err := flattenEffectiveLabels(d, meta, configured); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+func wrapReadContextDefaultLabels(f crudContextFunc) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		configured := labelsFromResourceData(d)
+		diags := f(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		if err := flattenEffectiveLabels(d, meta, configured); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+func defaultLabelsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("labels") {
+		return d.SetNewComputed("labels_all")
+	}
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return err
+	}
+	effective := mergeLabels(providerDefaultLabels(meta), labels)
+
+	current, err := expandLabels(d.Get("labels_all"))
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(effective, current) {
+		return nil
+	}
+	return d.SetNew("labels_all", effective)
+}
+
+// hasChangeWithDefaultLabels is d.HasChange, except that "labels" are also changed when the effective set
+// of labels is, e.g. when only the provider default labels have changed.
+func hasChangeWithDefaultLabels(d *schema.ResourceData, key string) bool {
+	if key == "labels" && d.HasChange("labels_all") {
+		return true
+	}
+	return d.HasChange(key)
+}
+
+// setEffectiveLabels replaces "labels" with the effective set of labels and returns the configured ones.
+func setEffectiveLabels(d *schema.ResourceData, meta interface{}) (map[string]string, error) {
+	configured := labelsFromResourceData(d)
+	defaults := providerDefaultLabels(meta)
+	if len(defaults) == 0 {
+		return configured, nil
+	}
+	return configured, d.Set("labels", mergeLabels(defaults, configured))
+}
+
+// flattenEffectiveLabels stores labels received from API into "labels_all" and
+// leaves in "labels" only labels which are not inherited from the provider.
+func flattenEffectiveLabels(d *schema.ResourceData, meta interface{}, configured map[string]string) error {
+	effective := labelsFromResourceData(d)
+	if err := d.Set("labels_all", effective); err != nil {
+		return err
+	}
+	return d.Set("labels", trimDefaultLabels(effective, providerDefaultLabels(meta), configured))
+}
+
+func labelsFromResourceData(d *schema.ResourceData) map[string]string {
+	// expandLabels never fails
+	labels, _ := expandLabels(d.Get("labels"))
+	return labels
+}
+
+func providerDefaultLabels(meta interface{}) map[string]string {
+	if config, ok := meta.(*Config); ok {
+		return config.DefaultLabels
+	}
+	return nil
+}
+
+// mergeLabels returns union of default and configured labels, configured values take precedence.
+func mergeLabels(defaults, configured map[string]string) map[string]string {
+	result := make(map[string]string, len(defaults)+len(configured))
+	for k, v := range defaults {
+		result[k] = v
+	}
+	for k, v := range configured {
+		result[k] = v
+	}
+	return result
+}
+
+// trimDefaultLabels removes labels equal to provider defaults, except ones that are configured explicitly.
+func trimDefaultLabels(effective, defaults, configured map[string]string) map[string]string {
+	result := make(map[string]string, len(effective))
+	for k, v := range effective {
+		if dv, ok := defaults[k]; ok && dv == v {
+			if _, ok := configured[k]; !ok {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
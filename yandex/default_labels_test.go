@@ -0,0 +1,99 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+func TestMergeLabels(t *testing.T) {
+	merged := mergeLabels(
+		map[string]string{"env": "prod", "team": "core"},
+		map[string]string{"env": "test", "app": "web"},
+	)
+	assert.Equal(t, map[string]string{"env": "test", "team": "core", "app": "web"}, merged)
+}
+
+func TestTrimDefaultLabels(t *testing.T) {
+	defaults := map[string]string{"env": "prod", "team": "core", "cost": "42"}
+	effective := map[string]string{"env": "prod", "team": "other", "cost": "42", "app": "web"}
+	configured := map[string]string{"cost": "42", "app": "web"}
+
+	trimmed := trimDefaultLabels(effective, defaults, configured)
+	// "env" is inherited, "team" differs from default and "cost" is set explicitly
+	assert.Equal(t, map[string]string{"team": "other", "cost": "42", "app": "web"}, trimmed)
+}
+
+func TestWithDefaultLabels(t *testing.T) {
+	var sent map[string]string
+	r := withDefaultLabels(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			sent, _ = expandLabels(d.Get("labels"))
+			d.SetId("id")
+			return diag.FromErr(d.Set("labels", sent))
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(d.Set("labels", sent))
+		},
+	})
+	require.Contains(t, r.Schema, "labels_all")
+
+	config := &Config{DefaultLabels: map[string]string{"env": "prod", "team": "core"}}
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"labels": map[string]interface{}{"app": "web", "team": "core"},
+	})
+
+	require.Empty(t, r.CreateContext(context.Background(), d, config))
+	assert.Equal(t, map[string]string{"env": "prod", "team": "core", "app": "web"}, sent)
+	assert.Equal(t, map[string]string{"team": "core", "app": "web"}, labelsFromResourceData(d))
+
+	labelsAll, _ := expandLabels(d.Get("labels_all"))
+	assert.Equal(t, sent, labelsAll)
+
+	require.Empty(t, r.ReadContext(context.Background(), d, config))
+	assert.Equal(t, map[string]string{"team": "core", "app": "web"}, labelsFromResourceData(d))
+}
+
+func TestDefaultLabelsUpdate_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	config.DefaultLabels = map[string]string{"env": "prod"}
+
+	r := fakeCloudResource(t, "yandex_vpc_network")
+	raw := map[string]interface{}{
+		"name":   "network",
+		"labels": map[string]interface{}{"app": "web"},
+	}
+	state := fakeCloudApply(t, config, r, nil, raw)
+	assert.Equal(t, "web", state.Attributes["labels_all.app"])
+	assert.Equal(t, "prod", state.Attributes["labels_all.env"])
+
+	getLabels := func() map[string]string {
+		network, err := config.sdk.VPC().Network().Get(context.Background(), &vpc.GetNetworkRequest{NetworkId: state.ID})
+		require.NoError(t, err)
+		return network.Labels
+	}
+	assert.Equal(t, map[string]string{"app": "web", "env": "prod"}, getLabels())
+
+	// Only the provider default labels change, configured labels stay the same.
+	config.DefaultLabels = map[string]string{"env": "test", "team": "core"}
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.Equal(t, map[string]string{"app": "web", "env": "test", "team": "core"}, getLabels())
+	assert.Equal(t, "1", state.Attributes["labels.%"])
+	assert.Equal(t, "3", state.Attributes["labels_all.%"])
+
+	// The next plan is empty.
+	assert.True(t, fakeCloudPlan(t, config, r, state, raw).Empty())
+}
@@ -0,0 +1,150 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// clientSideDeletionProtection lists stateful resources whose API has no deletion protection flag.
+// Their "deletion_protection" attribute is kept in state only and is enforced by the provider:
+// Delete fails while it is set, and so does the plan replacing the resource.
+var clientSideDeletionProtection = []string{
+	"yandex_container_registry",
+	"yandex_dns_zone",
+	"yandex_kubernetes_cluster",
+	"yandex_resourcemanager_folder",
+	"yandex_storage_bucket",
+	"yandex_vpc_network",
+	"yandex_vpc_subnet",
+}
+
+func addDeletionProtection(resources map[string]*schema.Resource) {
+	for _, name := range clientSideDeletionProtection {
+		if r, ok := resources[name]; ok {
+			withDeletionProtection(name, r)
+		}
+	}
+}
+
+func withDeletionProtection(name string, r *schema.Resource) *schema.Resource {
+	r.Schema["deletion_protection"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Forbids deletion and replacement of the resource. It is enforced by the provider, the value is not sent to the API.",
+	}
+
+	if r.ReadContext != nil {
+		r.ReadContext = wrapReadContextDeletionProtection(r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrapUpdateContextDeletionProtection(r.UpdateContext)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = wrapDeleteContextDeletionProtection(name, r.DeleteContext)
+	}
+
+	guard := deletionProtectionCustomizeDiff(name, r.Schema)
+	if r.CustomizeDiff != nil {
+		r.CustomizeDiff = customdiff.All(r.CustomizeDiff, guard)
+	} else {
+		r.CustomizeDiff = guard
+	}
+
+	return r
+}
+
+// wrapReadContextDeletionProtection keeps the attribute in state, so imported resources and
+// resources created by older provider versions get the default value instead of a diff.
+func wrapReadContextDeletionProtection(f crudContextFunc) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		protected := d.Get("deletion_protection").(bool)
+		diags := f(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		if err := d.Set("deletion_protection", protected); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+// wrapUpdateContextDeletionProtection skips the API call if only deletion protection is changed.
+func wrapUpdateContextDeletionProtection(f crudContextFunc) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if !d.HasChangesExcept("deletion_protection") {
+			return nil
+		}
+		return f(ctx, d, meta)
+	}
+}
+
+func wrapDeleteContextDeletionProtection(name string, f crudContextFunc) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if d.Get("deletion_protection").(bool) {
+			return diag.Errorf("%s %q can't be deleted while deletion_protection is enabled, "+
+				"set deletion_protection to false and apply the change first", name, d.Id())
+		}
+		return f(ctx, d, meta)
+	}
+}
+
+func deletionProtectionCustomizeDiff(name string, s map[string]*schema.Schema) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+		if protected, _ := d.GetChange("deletion_protection"); !protected.(bool) {
+			return nil
+		}
+
+		if key, ok := replacingKey(d, s); ok {
+			return fmt.Errorf("%s %q can't be replaced due to change of %q while deletion_protection is enabled, "+
+				"set deletion_protection to false and apply the change first", name, d.Id(), key)
+		}
+		return nil
+	}
+}
+
+// replacingKey returns changed attribute which forces replacement of the resource.
+func replacingKey(d *schema.ResourceDiff, s map[string]*schema.Schema) (string, bool) {
+	for k := range s {
+		if !d.HasChange(k) {
+			continue
+		}
+		for _, changed := range d.GetChangedKeysPrefix(k) {
+			if forcesNew(s, changed) {
+				return changed, true
+			}
+		}
+	}
+	return "", false
+}
+
+// forcesNew reports whether the attribute with the flatmap key, like "block.0.field", or any of its parents is ForceNew.
+func forcesNew(s map[string]*schema.Schema, key string) bool {
+	parts := strings.Split(key, ".")
+	for i := 0; i < len(parts); i++ {
+		field, ok := s[parts[i]]
+		if !ok {
+			return false
+		}
+		if field.ForceNew {
+			return true
+		}
+		elem, ok := field.Elem.(*schema.Resource)
+		if !ok {
+			return false
+		}
+		// Skip index of list or hash of set element.
+		s = elem.Schema
+		i++
+	}
+	return false
+}
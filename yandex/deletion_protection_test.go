@@ -0,0 +1,71 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForcesNew(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Optional: true},
+		"zone": {Type: schema.TypeString, Optional: true, ForceNew: true},
+		"spec": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"size": {Type: schema.TypeInt, Optional: true},
+					"type": {Type: schema.TypeString, Optional: true, ForceNew: true},
+				},
+			},
+		},
+	}
+
+	assert.False(t, forcesNew(s, "name"))
+	assert.True(t, forcesNew(s, "zone"))
+	assert.False(t, forcesNew(s, "spec.0.size"))
+	assert.True(t, forcesNew(s, "spec.0.type"))
+	assert.False(t, forcesNew(s, "spec.#"))
+}
+
+func TestDeletionProtection_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	network := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_vpc_network"), nil, map[string]interface{}{"name": "network"})
+
+	r := fakeCloudResource(t, "yandex_vpc_subnet")
+	raw := map[string]interface{}{
+		"name":                "subnet",
+		"network_id":          network.ID,
+		"v4_cidr_blocks":      []interface{}{"192.168.0.0/24"},
+		"deletion_protection": true,
+	}
+	state := fakeCloudApply(t, config, r, nil, raw)
+	assert.Equal(t, "true", state.Attributes["deletion_protection"])
+	assert.Empty(t, fakeCloudPlan(t, config, r, state, raw).Attributes)
+
+	imported := fakeCloudImport(t, config, r, state.ID)
+	assert.Equal(t, "false", imported.Attributes["deletion_protection"])
+
+	_, diags := r.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, config)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "can't be deleted while deletion_protection is enabled")
+
+	raw["v4_cidr_blocks"] = []interface{}{"192.168.1.0/24"}
+	_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), config)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `can't be replaced due to change of "v4_cidr_blocks.0"`)
+
+	// Update of the protection alone isn't sent to the API.
+	raw["v4_cidr_blocks"] = []interface{}{"192.168.0.0/24"}
+	raw["deletion_protection"] = false
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.Equal(t, "false", state.Attributes["deletion_protection"])
+
+	fakeCloudDestroy(t, config, r, state)
+	assert.Nil(t, fakeCloudRefresh(t, config, r, state))
+}
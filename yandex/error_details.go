@@ -0,0 +1,168 @@
+package yandex
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/quota"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// diagnosticsError carries diagnostics through helpers which return plain errors.
+// It is unpacked back into diagnostics by diagnosticsFromError.
+type diagnosticsError diag.Diagnostics
+
+func (e diagnosticsError) Error() string {
+	var messages []string
+	for _, d := range e {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if d.Detail != "" {
+			messages = append(messages, fmt.Sprintf("%s: %s", d.Summary, d.Detail))
+		} else {
+			messages = append(messages, d.Summary)
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+func diagnosticsFromError(err error) diag.Diagnostics {
+	var diags diagnosticsError
+	if errors.As(err, &diags) {
+		return diag.Diagnostics(diags)
+	}
+	return diag.FromErr(err)
+}
+
+// apiError is errorDiagnostics for helpers returning plain errors.
+func apiError(summary string, err error, fieldsMap map[string]string) error {
+	if err == nil {
+		return nil
+	}
+	return diagnosticsError(errorDiagnostics(summary, err, fieldsMap))
+}
+
+// errorDiagnostics converts API error into diagnostics. Field violations point at the attribute found in
+// fieldsMap, the same map of attribute names to update mask paths resources use to build update requests.
+// Quota violations name the quota and its limit.
+func errorDiagnostics(summary string, err error, fieldsMap map[string]string) diag.Diagnostics {
+	st, ok := status.FromError(err)
+	if !ok {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: summary, Detail: err.Error()}}
+	}
+
+	var requestID string
+	var diags diag.Diagnostics
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.RequestInfo:
+			requestID = detail.RequestId
+		case *errdetails.BadRequest:
+			for _, v := range detail.FieldViolations {
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       summary,
+					Detail:        fmt.Sprintf("invalid value of API field %q: %s", v.Field, v.Description),
+					AttributePath: attributePathByAPIField(v.Field, fieldsMap),
+				})
+			}
+		case *quota.QuotaFailure:
+			for _, v := range detail.Violations {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("%s: quota %s exceeded", summary, v.GetMetric().GetName()),
+					Detail: fmt.Sprintf("Current limit is %d, usage is %s, required limit is %d. %s",
+						v.GetMetric().GetLimit(), strconv.FormatFloat(v.GetMetric().GetUsage(), 'f', -1, 64), v.Required, st.Message()),
+				})
+			}
+		case *errdetails.QuotaFailure:
+			for _, v := range detail.Violations {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("%s: quota %s exceeded", summary, v.Subject),
+					Detail:   v.Description,
+				})
+			}
+		}
+	}
+
+	if len(diags) == 0 {
+		diags = diag.Diagnostics{{Severity: diag.Error, Summary: summary, Detail: st.Message()}}
+	}
+	if requestID != "" {
+		for i := range diags {
+			diags[i].Detail = fmt.Sprintf("%s (request id: %s)", diags[i].Detail, requestID)
+		}
+	}
+	return diags
+}
+
+var camelCaseBoundary = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// attributePathByAPIField finds attribute, which update mask path is the longest prefix of the API field.
+// Placeholders like "{version}" in mask paths match any path segment.
+func attributePathByAPIField(field string, fieldsMap map[string]string) cty.Path {
+	field = strings.ToLower(camelCaseBoundary.ReplaceAllString(field, "${1}_${2}"))
+	fieldSegments := strings.Split(field, ".")
+
+	attributes := make([]string, 0, len(fieldsMap))
+	for attribute := range fieldsMap {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes)
+
+	var found string
+	var foundLen int
+	for _, attribute := range attributes {
+		maskSegments := strings.Split(fieldsMap[attribute], ".")
+		if len(maskSegments) <= foundLen || len(maskSegments) > len(fieldSegments) {
+			continue
+		}
+		if matchMaskSegments(maskSegments, fieldSegments) {
+			found, foundLen = attribute, len(maskSegments)
+		}
+	}
+	if found == "" {
+		return nil
+	}
+	return attributePath(found)
+}
+
+func matchMaskSegments(mask, field []string) bool {
+	for i, segment := range mask {
+		if segment == field[i] {
+			continue
+		}
+		prefix := segment
+		if j := strings.Index(segment, "{"); j >= 0 && strings.HasSuffix(segment, "}") {
+			prefix = segment[:j]
+		} else {
+			return false
+		}
+		if !strings.HasPrefix(field[i], prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// attributePath converts flatmap attribute name, e.g. "config.0.resources.0.disk_size", into attribute path.
+func attributePath(attribute string) cty.Path {
+	var path cty.Path
+	for _, step := range strings.Split(attribute, ".") {
+		if i, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(i)
+		} else {
+			path = path.GetAttr(step)
+		}
+	}
+	return path
+}
@@ -0,0 +1,110 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/quota"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testUpdateFieldsMap = map[string]string{
+	"name":                                 "name",
+	"config.0.resources":                   "config_spec.resources",
+	"config.0.resources.0.disk_size":       "config_spec.resources.disk_size",
+	"config.0.kafka_config.0.compression":  "config_spec.kafka_config_{version}.compression",
+	"config.0.kafka.0.resources.0.disk_id": "config_spec.kafka.resources.disk_type_id",
+}
+
+func TestAttributePathByAPIField(t *testing.T) {
+	cases := map[string]cty.Path{
+		"name":                                     cty.GetAttrPath("name"),
+		"config_spec.resources.disk_size":          cty.GetAttrPath("config").IndexInt(0).GetAttr("resources").IndexInt(0).GetAttr("disk_size"),
+		"configSpec.resources.diskSize":            cty.GetAttrPath("config").IndexInt(0).GetAttr("resources").IndexInt(0).GetAttr("disk_size"),
+		"config_spec.resources.resource_preset_id": cty.GetAttrPath("config").IndexInt(0).GetAttr("resources"),
+		"config_spec.kafka_config_2_8.compression": cty.GetAttrPath("config").IndexInt(0).GetAttr("kafka_config").IndexInt(0).GetAttr("compression"),
+		"description":                              nil,
+	}
+	for field, expected := range cases {
+		assert.Equal(t, expected, attributePathByAPIField(field, testUpdateFieldsMap), field)
+	}
+}
+
+func TestErrorDiagnostics(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "Request validation error").WithDetails(
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "config_spec.resources.disk_size", Description: "Disk size should be at least 10 GB"},
+		}},
+		&errdetails.RequestInfo{RequestId: "request-id"},
+	)
+	require.NoError(t, err)
+
+	diags := errorDiagnostics("error updating cluster", st.Err(), testUpdateFieldsMap)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Error, diags[0].Severity)
+	assert.Equal(t, "error updating cluster", diags[0].Summary)
+	assert.Equal(t, `invalid value of API field "config_spec.resources.disk_size": Disk size should be at least 10 GB (request id: request-id)`, diags[0].Detail)
+	assert.Equal(t, cty.GetAttrPath("config").IndexInt(0).GetAttr("resources").IndexInt(0).GetAttr("disk_size"), diags[0].AttributePath)
+}
+
+func TestErrorDiagnosticsQuota(t *testing.T) {
+	st, err := status.New(codes.FailedPrecondition, "Quota limit exceeded").WithDetails(
+		&quota.QuotaFailure{Violations: []*quota.QuotaFailure_Violation{
+			{Metric: &quota.QuotaMetric{Name: "mdb.hdd.size", Limit: 100, Usage: 80}, Required: 120},
+		}},
+	)
+	require.NoError(t, err)
+
+	diags := errorDiagnostics("error creating cluster", st.Err(), nil)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "error creating cluster: quota mdb.hdd.size exceeded", diags[0].Summary)
+	assert.Equal(t, "Current limit is 100, usage is 80, required limit is 120. Quota limit exceeded", diags[0].Detail)
+}
+
+func TestErrorDiagnosticsPlainError(t *testing.T) {
+	diags := errorDiagnostics("error creating cluster", fmt.Errorf("boom"), nil)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "error creating cluster", diags[0].Summary)
+	assert.Equal(t, "boom", diags[0].Detail)
+}
+
+func TestDiagnosticsFromAPIError(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "Request validation error").WithDetails(
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "name", Description: "Name is too long"},
+		}},
+	)
+	require.NoError(t, err)
+
+	err = fmt.Errorf("error updating resource params: %w", apiError("error updating resource", st.Err(), testUpdateFieldsMap))
+	diags := diagnosticsFromError(err)
+	require.Len(t, diags, 1)
+	assert.Equal(t, cty.GetAttrPath("name"), diags[0].AttributePath)
+	assert.Equal(t, `error updating resource: invalid value of API field "name": Name is too long`, diagnosticsError(diags).Error())
+}
+
+func TestHandleNotFoundErrorDiagnostics(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.SetId("network-id")
+
+	st, err := status.New(codes.FailedPrecondition, "Network is not empty").WithDetails(
+		&errdetails.RequestInfo{RequestId: "request-id"},
+	)
+	require.NoError(t, err)
+
+	diags := diagnosticsFromError(handleNotFoundError(st.Err(), d, `Network "network-id"`))
+	require.Len(t, diags, 1)
+	assert.Equal(t, `error reading Network "network-id"`, diags[0].Summary)
+	assert.Equal(t, "Network is not empty (request id: request-id)", diags[0].Detail)
+	assert.Equal(t, "network-id", d.Id())
+
+	assert.NoError(t, handleNotFoundError(status.Error(codes.NotFound, "Network not found"), d, `Network "network-id"`))
+	assert.Equal(t, "", d.Id())
+}
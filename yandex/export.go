@@ -0,0 +1,430 @@
+package yandex
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	sdkcty "github.com/hashicorp/go-cty/cty"
+	sdkctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+const exportFileHeader = "# Generated by terraform-provider-yandex export. Review before applying.\n\n"
+
+// RunExportCommand implements "export" subcommand of the provider binary:
+// it lists resources of the folder and writes their configuration along with import blocks.
+// Credentials and endpoints are taken from the same environment variables as provider uses.
+func RunExportCommand(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	folderID := flags.String("folder-id", os.Getenv("YC_FOLDER_ID"), "ID of the folder to export, defaults to YC_FOLDER_ID")
+	types := flags.String("types", "", "comma separated resource groups (e.g. compute,vpc,mdb) or resource types to export, all by default")
+	outputDir := flags.String("output-dir", ".", "directory to write .tf files into")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *folderID == "" {
+		fmt.Fprintln(stderr, "Error: folder id should be specified with --folder-id or YC_FOLDER_ID")
+		return 2
+	}
+
+	resourceTypes, err := selectExportResourceTypes(*types)
+	if err != nil {
+		fmt.Fprintln(stderr, "Error:", err)
+		return 2
+	}
+
+	p := Provider()
+	diags := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"folder_id": *folderID,
+	}))
+	if diags.HasError() {
+		for _, d := range diags {
+			fmt.Fprintln(stderr, "Error:", d.Summary)
+		}
+		return 1
+	}
+
+	exporter := newFolderExporter(p, p.Meta().(*Config))
+	if err := exporter.export(ctx, *folderID, resourceTypes, stderr); err != nil {
+		fmt.Fprintln(stderr, "Error:", err)
+		return 1
+	}
+
+	files, err := exporter.writeFiles(*outputDir)
+	if err != nil {
+		fmt.Fprintln(stderr, "Error:", err)
+		return 1
+	}
+	for _, f := range files {
+		fmt.Fprintln(stdout, f)
+	}
+	return 0
+}
+
+// selectExportResourceTypes filters supported resource types by comma separated list of groups and types.
+func selectExportResourceTypes(filter string) ([]exportResourceType, error) {
+	if strings.TrimSpace(filter) == "" {
+		return exportResourceTypes, nil
+	}
+
+	requested := make(map[string]bool)
+	for _, v := range strings.Split(filter, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			requested[v] = true
+		}
+	}
+
+	var result []exportResourceType
+	for _, t := range exportResourceTypes {
+		if requested[t.Group] || requested[t.Type] {
+			result = append(result, t)
+			delete(requested, t.Type)
+		}
+	}
+	for _, t := range exportResourceTypes {
+		delete(requested, t.Group)
+	}
+	if len(requested) > 0 {
+		return nil, fmt.Errorf("unsupported resource groups or types: %s", strings.Join(sortedKeys(requested), ", "))
+	}
+	return result, nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type exportedResource struct {
+	Type   string
+	Group  string
+	Name   string
+	ID     string
+	Schema map[string]*schema.Schema
+	Value  cty.Value
+}
+
+type folderExporter struct {
+	provider *schema.Provider
+	config   *Config
+
+	resources []*exportedResource
+	// addresses maps ids of exported resources to their addresses, so that references can be generated
+	addresses map[string]hcl.Traversal
+	names     map[string]bool
+}
+
+func newFolderExporter(p *schema.Provider, config *Config) *folderExporter {
+	return &folderExporter{
+		provider:  p,
+		config:    config,
+		addresses: make(map[string]hcl.Traversal),
+		names:     make(map[string]bool),
+	}
+}
+
+func (e *folderExporter) export(ctx context.Context, folderID string, types []exportResourceType, log io.Writer) error {
+	for _, t := range types {
+		objects, err := t.Lister(ctx, e.config, folderID)
+		if err != nil {
+			return fmt.Errorf("failed to list %s: %s", t.Type, err)
+		}
+		fmt.Fprintf(log, "Found %d %s\n", len(objects), t.Type)
+
+		for _, obj := range objects {
+			if err := e.exportResource(ctx, t, obj); err != nil {
+				return fmt.Errorf("failed to read %s %q: %s", t.Type, obj.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// exportResource reads resource through its own Read function, so the resulting attributes match provider schema.
+func (e *folderExporter) exportResource(ctx context.Context, t exportResourceType, obj exportObject) error {
+	r := e.provider.ResourcesMap[t.Type]
+	state, diags := r.RefreshWithoutUpgrade(ctx, &terraform.InstanceState{
+		ID:         obj.ID,
+		Attributes: map[string]string{"id": obj.ID},
+	}, e.config)
+	if diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+	if state == nil || state.ID == "" {
+		// removed after listing
+		return nil
+	}
+
+	sdkValue, err := state.AttrsAsObjectValue(r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		return err
+	}
+	value, err := convertSDKValue(sdkValue)
+	if err != nil {
+		return err
+	}
+
+	name := e.uniqueName(t.Type, exportResourceName(obj.Name, obj.ID))
+	e.resources = append(e.resources, &exportedResource{
+		Type:   t.Type,
+		Group:  t.Group,
+		Name:   name,
+		ID:     obj.ID,
+		Schema: r.Schema,
+		Value:  value,
+	})
+	e.addresses[obj.ID] = hcl.Traversal{
+		hcl.TraverseRoot{Name: t.Type},
+		hcl.TraverseAttr{Name: name},
+		hcl.TraverseAttr{Name: "id"},
+	}
+	return nil
+}
+
+// convertSDKValue converts value from the cty fork used by plugin SDK to the one used by hclwrite.
+func convertSDKValue(v sdkcty.Value) (cty.Value, error) {
+	typeJSON, err := sdkctyjson.MarshalType(v.Type())
+	if err != nil {
+		return cty.NilVal, err
+	}
+	ty, err := ctyjson.UnmarshalType(typeJSON)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	valueJSON, err := sdkctyjson.Marshal(v, v.Type())
+	if err != nil {
+		return cty.NilVal, err
+	}
+	return ctyjson.Unmarshal(valueJSON, ty)
+}
+
+func (e *folderExporter) uniqueName(resourceType, name string) string {
+	result := name
+	for i := 2; e.names[resourceType+"."+result]; i++ {
+		result = fmt.Sprintf("%s_%d", name, i)
+	}
+	e.names[resourceType+"."+result] = true
+	return result
+}
+
+var exportNameInvalidChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// exportResourceName makes valid HCL identifier from the cloud resource name.
+func exportResourceName(name, id string) string {
+	result := exportNameInvalidChars.ReplaceAllString(strings.ToLower(name), "_")
+	result = strings.Trim(result, "_-")
+	if result == "" {
+		result = id
+	}
+	if c := result[0]; !(c >= 'a' && c <= 'z' || c == '_') {
+		result = "r_" + result
+	}
+	return result
+}
+
+// writeFiles writes a .tf file per resource group and imports.tf with import blocks.
+func (e *folderExporter) writeFiles(dir string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string]*hclwrite.File)
+	var groupNames []string
+	imports := hclwrite.NewEmptyFile()
+
+	for _, r := range e.resources {
+		f, ok := groups[r.Group]
+		if !ok {
+			f = hclwrite.NewEmptyFile()
+			groups[r.Group] = f
+			groupNames = append(groupNames, r.Group)
+		} else {
+			f.Body().AppendNewline()
+		}
+
+		block := f.Body().AppendNewBlock("resource", []string{r.Type, r.Name})
+		e.writeBody(block.Body(), r.Schema, r.Value, r.ID)
+
+		if len(imports.Body().Blocks()) > 0 {
+			imports.Body().AppendNewline()
+		}
+		importBlock := imports.Body().AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: r.Type},
+			hcl.TraverseAttr{Name: r.Name},
+		})
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(r.ID))
+	}
+
+	var written []string
+	write := func(name string, f *hclwrite.File) error {
+		path := filepath.Join(dir, name)
+		content := append([]byte(exportFileHeader), hclwrite.Format(f.Bytes())...)
+		if err := ioutil.WriteFile(path, content, 0644); err != nil {
+			return err
+		}
+		written = append(written, path)
+		return nil
+	}
+
+	for _, g := range groupNames {
+		if err := write(g+".tf", groups[g]); err != nil {
+			return nil, err
+		}
+	}
+	if len(e.resources) > 0 {
+		if err := write("imports.tf", imports); err != nil {
+			return nil, err
+		}
+	}
+	return written, nil
+}
+
+// writeBody writes configurable attributes and nested blocks of the value in schema order.
+func (e *folderExporter) writeBody(body *hclwrite.Body, s map[string]*schema.Schema, value cty.Value, selfID string) {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// attributes go before nested blocks
+	for _, name := range names {
+		attr := s[name]
+		if !isExportedAttribute(attr) || isNestedBlock(attr) {
+			continue
+		}
+		v := value.GetAttr(name)
+		if isEmptyExportValue(v) || isDefaultExportValue(attr, v) {
+			continue
+		}
+		if tokens, ok := e.referenceTokens(v, selfID); ok {
+			body.SetAttributeRaw(name, tokens)
+			continue
+		}
+		body.SetAttributeValue(name, v)
+	}
+
+	for _, name := range names {
+		attr := s[name]
+		if !isExportedAttribute(attr) || !isNestedBlock(attr) {
+			continue
+		}
+		v := value.GetAttr(name)
+		if v.IsNull() || !v.IsKnown() {
+			continue
+		}
+		elem := attr.Elem.(*schema.Resource)
+		for it := v.ElementIterator(); it.Next(); {
+			_, item := it.Element()
+			block := body.AppendNewBlock(name, nil)
+			e.writeBody(block.Body(), elem.Schema, item, selfID)
+		}
+	}
+}
+
+// referenceTokens replaces ids of other exported resources with references to them.
+func (e *folderExporter) referenceTokens(v cty.Value, selfID string) (hclwrite.Tokens, bool) {
+	switch {
+	case v.Type() == cty.String:
+		if traversal, ok := e.addresses[v.AsString()]; ok && v.AsString() != selfID {
+			return hclwrite.TokensForTraversal(traversal), true
+		}
+	case v.Type().IsListType() || v.Type().IsSetType():
+		if !v.Type().ElementType().Equals(cty.String) {
+			return nil, false
+		}
+		var elems []hclwrite.Tokens
+		found := false
+		for it := v.ElementIterator(); it.Next(); {
+			_, item := it.Element()
+			if traversal, ok := e.addresses[item.AsString()]; ok && item.AsString() != selfID {
+				elems = append(elems, hclwrite.TokensForTraversal(traversal))
+				found = true
+			} else {
+				elems = append(elems, hclwrite.TokensForValue(item))
+			}
+		}
+		if found {
+			return tokensForTuple(elems), true
+		}
+	}
+	return nil, false
+}
+
+func tokensForTuple(elems []hclwrite.Tokens) hclwrite.Tokens {
+	tokens := hclwrite.Tokens{{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")}}
+	for i, elem := range elems {
+		if i > 0 {
+			tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenComma, Bytes: []byte(",")})
+		}
+		tokens = append(tokens, elem...)
+	}
+	return append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")})
+}
+
+func isExportedAttribute(s *schema.Schema) bool {
+	if !s.Optional && !s.Required {
+		return false
+	}
+	// secrets can't be read back from API
+	return !s.Sensitive && s.Deprecated == ""
+}
+
+func isNestedBlock(s *schema.Schema) bool {
+	if s.Type != schema.TypeList && s.Type != schema.TypeSet {
+		return false
+	}
+	_, ok := s.Elem.(*schema.Resource)
+	return ok
+}
+
+func isEmptyExportValue(v cty.Value) bool {
+	if v.IsNull() || !v.IsKnown() {
+		return true
+	}
+	t := v.Type()
+	switch {
+	case t == cty.String:
+		return v.AsString() == ""
+	case t.IsListType() || t.IsSetType() || t.IsMapType():
+		return v.LengthInt() == 0
+	}
+	return false
+}
+
+func isDefaultExportValue(s *schema.Schema, v cty.Value) bool {
+	if s.Default == nil {
+		return false
+	}
+	switch d := s.Default.(type) {
+	case bool:
+		return v.Type() == cty.Bool && v.True() == d
+	case string:
+		return v.Type() == cty.String && v.AsString() == d
+	case int:
+		return v.Type() == cty.Number && v.Equals(cty.NumberIntVal(int64(d))).True()
+	case float64:
+		return v.Type() == cty.Number && v.Equals(cty.NumberFloatVal(d)).True()
+	}
+	return false
+}
@@ -0,0 +1,352 @@
+package yandex
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dataproc/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/elasticsearch/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/pagination"
+)
+
+// exportObject is a cloud resource found by lister.
+type exportObject struct {
+	ID   string
+	Name string
+}
+
+const (
+	// exportListConcurrency limits number of instance groups or clusters whose instances are listed at once.
+	exportListConcurrency = 8
+)
+
+// exportManagedInstanceLabels are labels of instances created for node groups of Kubernetes clusters.
+var exportManagedInstanceLabels = []string{"managed-kubernetes-cluster-id", "managed-kubernetes-node-group-id"}
+
+type exportLister func(ctx context.Context, config *Config, folderID string) ([]exportObject, error)
+
+type exportResourceType struct {
+	Type   string
+	Group  string
+	Lister exportLister
+}
+
+// exportResourceTypes lists resource types supported by export in the order of dependencies,
+// so that referenced resources are declared before referencing ones.
+var exportResourceTypes = []exportResourceType{
+	{"yandex_iam_service_account", "iam", listExportIAMServiceAccounts},
+	{"yandex_kms_symmetric_key", "kms", listExportKMSSymmetricKeys},
+	{"yandex_vpc_network", "vpc", listExportVPCNetworks},
+	{"yandex_vpc_route_table", "vpc", listExportVPCRouteTables},
+	{"yandex_vpc_subnet", "vpc", listExportVPCSubnets},
+	{"yandex_vpc_security_group", "vpc", listExportVPCSecurityGroups},
+	{"yandex_vpc_address", "vpc", listExportVPCAddresses},
+	{"yandex_vpc_gateway", "vpc", listExportVPCGateways},
+	{"yandex_dns_zone", "dns", listExportDNSZones},
+	{"yandex_compute_image", "compute", listExportComputeImages},
+	{"yandex_compute_snapshot", "compute", listExportComputeSnapshots},
+	{"yandex_compute_placement_group", "compute", listExportComputePlacementGroups},
+	{"yandex_compute_disk", "compute", listExportComputeDisks},
+	{"yandex_compute_instance", "compute", listExportComputeInstances},
+	{"yandex_compute_instance_group", "compute", listExportComputeInstanceGroups},
+	{"yandex_mdb_clickhouse_cluster", "mdb", listExportMDBClickHouseClusters},
+	{"yandex_mdb_elasticsearch_cluster", "mdb", listExportMDBElasticsearchClusters},
+	{"yandex_mdb_greenplum_cluster", "mdb", listExportMDBGreenplumClusters},
+	{"yandex_mdb_kafka_cluster", "mdb", listExportMDBKafkaClusters},
+	{"yandex_mdb_mongodb_cluster", "mdb", listExportMDBMongodbClusters},
+	{"yandex_mdb_mysql_cluster", "mdb", listExportMDBMySQLClusters},
+	{"yandex_mdb_postgresql_cluster", "mdb", listExportMDBPostgreSQLClusters},
+	{"yandex_mdb_redis_cluster", "mdb", listExportMDBRedisClusters},
+	{"yandex_mdb_sqlserver_cluster", "mdb", listExportMDBSQLServerClusters},
+}
+
+// exportNamedObject is an item of List RPC response, all supported resources have ID and name.
+type exportNamedObject interface {
+	GetId() string
+	GetName() string
+}
+
+// listExportObjects lists all pages of a List RPC, objects are skipped unless export returns true for them.
+func listExportObjects(ctx context.Context, list pagination.ListFunc, export func(item proto.Message) bool) ([]exportObject, error) {
+	items, err := pagination.List(ctx, list)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]exportObject, 0, len(items))
+	for _, item := range items {
+		if export != nil && !export(item) {
+			continue
+		}
+		v := item.(exportNamedObject)
+		objects = append(objects, exportObject{ID: v.GetId(), Name: v.GetName()})
+	}
+	return objects, nil
+}
+
+func listExportIAMServiceAccounts(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.IAM().ServiceAccount().List(ctx, &iam.ListServiceAccountsRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportKMSSymmetricKeys(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.KMS().SymmetricKey().List(ctx, &kms.ListSymmetricKeysRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportVPCNetworks(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.VPC().Network().List(ctx, &vpc.ListNetworksRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportVPCRouteTables(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.VPC().RouteTable().List(ctx, &vpc.ListRouteTablesRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportVPCSubnets(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.VPC().Subnet().List(ctx, &vpc.ListSubnetsRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportVPCSecurityGroups(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.VPC().SecurityGroup().List(ctx, &vpc.ListSecurityGroupsRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, func(item proto.Message) bool {
+		// default security groups are created along with networks
+		return !item.(*vpc.SecurityGroup).DefaultForNetwork
+	})
+}
+
+func listExportVPCAddresses(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.VPC().Address().List(ctx, &vpc.ListAddressesRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, func(item proto.Message) bool {
+		// ephemeral addresses belong to instances and load balancers
+		return item.(*vpc.Address).Reserved
+	})
+}
+
+func listExportVPCGateways(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.VPC().Gateway().List(ctx, &vpc.ListGatewaysRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportDNSZones(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.DNS().DnsZone().List(ctx, &dns.ListDnsZonesRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportComputeImages(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.Compute().Image().List(ctx, &compute.ListImagesRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportComputeSnapshots(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.Compute().Snapshot().List(ctx, &compute.ListSnapshotsRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportComputePlacementGroups(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.Compute().PlacementGroup().List(ctx, &compute.ListPlacementGroupsRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportComputeDisks(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	managed, err := listExportManagedInstanceIDs(ctx, config, folderID)
+	if err != nil {
+		return nil, err
+	}
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.Compute().Disk().List(ctx, &compute.ListDisksRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, func(item proto.Message) bool {
+		return !isExportManagedDisk(item.(*compute.Disk), managed)
+	})
+}
+
+func listExportComputeInstances(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	managed, err := listExportManagedInstanceIDs(ctx, config, folderID)
+	if err != nil {
+		return nil, err
+	}
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.Compute().Instance().List(ctx, &compute.ListInstancesRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, func(item proto.Message) bool {
+		return !isExportManagedInstance(item.(*compute.Instance), managed)
+	})
+}
+
+// listExportManagedInstanceIDs returns IDs of instances created by instance groups, including instance groups
+// of Kubernetes node groups, and by Data Proc clusters. These instances and their disks are recreated by their
+// owners, so they are exported only as part of the owning resource. Hosts of MDB clusters don't belong to the folder.
+func listExportManagedInstanceIDs(ctx context.Context, config *Config, folderID string) (map[string]bool, error) {
+	managed, err := config.cachedLookup("export.managed_instances."+folderID, func() (interface{}, error) {
+		managed := map[string]bool{}
+
+		groups, err := pagination.List(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+			return config.sdk.InstanceGroup().InstanceGroup().List(ctx, &instancegroup.ListInstanceGroupsRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+		})
+		if err != nil {
+			return nil, err
+		}
+		groupIDs := make([]string, len(groups))
+		for i, g := range groups {
+			groupIDs[i] = g.(*instancegroup.InstanceGroup).Id
+		}
+		groupInstances, err := pagination.ListEach(ctx, groupIDs, exportListConcurrency, func(groupID string) pagination.ListFunc {
+			return func(ctx context.Context, pageToken string) (proto.Message, error) {
+				return config.sdk.InstanceGroup().InstanceGroup().ListInstances(ctx, &instancegroup.ListInstanceGroupInstancesRequest{InstanceGroupId: groupID, PageSize: pagination.PageSize, PageToken: pageToken})
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, instances := range groupInstances {
+			for _, instance := range instances {
+				managed[instance.(*instancegroup.ManagedInstance).InstanceId] = true
+			}
+		}
+
+		clusters, err := pagination.List(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+			return config.sdk.Dataproc().Cluster().List(ctx, &dataproc.ListClustersRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+		})
+		if err != nil {
+			return nil, err
+		}
+		clusterIDs := make([]string, len(clusters))
+		for i, c := range clusters {
+			clusterIDs[i] = c.(*dataproc.Cluster).Id
+		}
+		clusterHosts, err := pagination.ListEach(ctx, clusterIDs, exportListConcurrency, func(clusterID string) pagination.ListFunc {
+			return func(ctx context.Context, pageToken string) (proto.Message, error) {
+				return config.sdk.Dataproc().Cluster().ListHosts(ctx, &dataproc.ListClusterHostsRequest{ClusterId: clusterID, PageSize: pagination.PageSize, PageToken: pageToken})
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, hosts := range clusterHosts {
+			for _, host := range hosts {
+				managed[host.(*dataproc.Host).ComputeInstanceId] = true
+			}
+		}
+
+		return managed, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return managed.(map[string]bool), nil
+}
+
+// isExportManagedInstance reports whether the instance is managed by another resource. Instances of node groups
+// are also recognized by labels, their instance groups may belong to another folder.
+func isExportManagedInstance(instance *compute.Instance, managed map[string]bool) bool {
+	if managed[instance.Id] {
+		return true
+	}
+	for _, label := range exportManagedInstanceLabels {
+		if _, ok := instance.Labels[label]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isExportManagedDisk reports whether the disk is attached only to managed instances.
+func isExportManagedDisk(disk *compute.Disk, managed map[string]bool) bool {
+	if len(disk.InstanceIds) == 0 {
+		return false
+	}
+	for _, id := range disk.InstanceIds {
+		if !managed[id] {
+			return false
+		}
+	}
+	return true
+}
+
+func listExportComputeInstanceGroups(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.InstanceGroup().InstanceGroup().List(ctx, &instancegroup.ListInstanceGroupsRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportMDBClickHouseClusters(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.MDB().Clickhouse().Cluster().List(ctx, &clickhouse.ListClustersRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportMDBElasticsearchClusters(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.MDB().ElasticSearch().Cluster().List(ctx, &elasticsearch.ListClustersRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportMDBGreenplumClusters(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.MDB().Greenplum().Cluster().List(ctx, &greenplum.ListClustersRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportMDBKafkaClusters(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.MDB().Kafka().Cluster().List(ctx, &kafka.ListClustersRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportMDBMongodbClusters(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.MDB().MongoDB().Cluster().List(ctx, &mongodb.ListClustersRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportMDBMySQLClusters(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.MDB().MySQL().Cluster().List(ctx, &mysql.ListClustersRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportMDBPostgreSQLClusters(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.MDB().PostgreSQL().Cluster().List(ctx, &postgresql.ListClustersRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportMDBRedisClusters(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.MDB().Redis().Cluster().List(ctx, &redis.ListClustersRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
+
+func listExportMDBSQLServerClusters(ctx context.Context, config *Config, folderID string) ([]exportObject, error) {
+	return listExportObjects(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return config.sdk.MDB().SQLServer().Cluster().List(ctx, &sqlserver.ListClustersRequest{FolderId: folderID, PageSize: pagination.PageSize, PageToken: pageToken})
+	}, nil)
+}
@@ -0,0 +1,167 @@
+package yandex
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+func TestExportResourceName(t *testing.T) {
+	assert.Equal(t, "my-network", exportResourceName("My-Network", "enp1"))
+	assert.Equal(t, "web_server_1", exportResourceName("web server.1", "fhm1"))
+	assert.Equal(t, "enp1", exportResourceName("", "enp1"))
+	assert.Equal(t, "r_1st", exportResourceName("1st", "enp1"))
+}
+
+func TestSelectExportResourceTypes(t *testing.T) {
+	types, err := selectExportResourceTypes("")
+	require.NoError(t, err)
+	assert.Equal(t, exportResourceTypes, types)
+
+	types, err = selectExportResourceTypes("vpc, yandex_compute_disk")
+	require.NoError(t, err)
+	require.NotEmpty(t, types)
+	for _, typ := range types {
+		assert.True(t, typ.Group == "vpc" || typ.Type == "yandex_compute_disk", typ.Type)
+	}
+
+	_, err = selectExportResourceTypes("vpc,unknown")
+	assert.EqualError(t, err, "unsupported resource groups or types: unknown")
+}
+
+func TestExportWriteFiles(t *testing.T) {
+	networkSchema := map[string]*schema.Schema{
+		"name":       {Type: schema.TypeString, Optional: true},
+		"labels":     {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"created_at": {Type: schema.TypeString, Computed: true},
+	}
+	subnetSchema := map[string]*schema.Schema{
+		"name":           {Type: schema.TypeString, Optional: true},
+		"network_id":     {Type: schema.TypeString, Required: true},
+		"v4_cidr_blocks": {Type: schema.TypeList, Required: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"description":    {Type: schema.TypeString, Optional: true},
+		"secret":         {Type: schema.TypeString, Optional: true, Sensitive: true},
+		"enabled":        {Type: schema.TypeBool, Optional: true, Default: true},
+		"dhcp_options": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+				"domain_name": {Type: schema.TypeString, Optional: true},
+			}},
+		},
+	}
+
+	e := newFolderExporter(nil, nil)
+	e.resources = []*exportedResource{
+		{
+			Type: "yandex_vpc_network", Group: "vpc", Name: "net", ID: "enp1", Schema: networkSchema,
+			Value: cty.ObjectVal(map[string]cty.Value{
+				"name":       cty.StringVal("net"),
+				"labels":     cty.MapVal(map[string]cty.Value{"env": cty.StringVal("prod")}),
+				"created_at": cty.StringVal("2022-01-01T00:00:00Z"),
+			}),
+		},
+		{
+			Type: "yandex_vpc_subnet", Group: "vpc", Name: "subnet", ID: "e9b1", Schema: subnetSchema,
+			Value: cty.ObjectVal(map[string]cty.Value{
+				"name":           cty.StringVal("subnet"),
+				"network_id":     cty.StringVal("enp1"),
+				"v4_cidr_blocks": cty.ListVal([]cty.Value{cty.StringVal("10.0.0.0/24")}),
+				"description":    cty.StringVal(""),
+				"secret":         cty.StringVal("secret"),
+				"enabled":        cty.True,
+				"dhcp_options": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"domain_name": cty.StringVal("example.com"),
+				})}),
+			}),
+		},
+	}
+	e.addresses["enp1"] = hcl.Traversal{
+		hcl.TraverseRoot{Name: "yandex_vpc_network"},
+		hcl.TraverseAttr{Name: "net"},
+		hcl.TraverseAttr{Name: "id"},
+	}
+
+	dir := t.TempDir()
+	files, err := e.writeFiles(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "vpc.tf"), filepath.Join(dir, "imports.tf")}, files)
+
+	vpc, err := ioutil.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, exportFileHeader+`resource "yandex_vpc_network" "net" {
+  labels = {
+    env = "prod"
+  }
+  name = "net"
+}
+
+resource "yandex_vpc_subnet" "subnet" {
+  name           = "subnet"
+  network_id     = yandex_vpc_network.net.id
+  v4_cidr_blocks = ["10.0.0.0/24"]
+  dhcp_options {
+    domain_name = "example.com"
+  }
+}
+`, string(vpc))
+
+	imports, err := ioutil.ReadFile(files[1])
+	require.NoError(t, err)
+	assert.Equal(t, exportFileHeader+`import {
+  to = yandex_vpc_network.net
+  id = "enp1"
+}
+
+import {
+  to = yandex_vpc_subnet.subnet
+  id = "e9b1"
+}
+`, string(imports))
+}
+
+func TestExportManagedComputeObjects(t *testing.T) {
+	managed := map[string]bool{"fhm-ig": true, "fhm-dataproc": true}
+
+	assert.True(t, isExportManagedInstance(&compute.Instance{Id: "fhm-ig"}, managed))
+	assert.True(t, isExportManagedInstance(&compute.Instance{Id: "fhm-k8s", Labels: map[string]string{"managed-kubernetes-node-group-id": "cat1"}}, managed))
+	assert.False(t, isExportManagedInstance(&compute.Instance{Id: "fhm-own", Labels: map[string]string{"env": "prod"}}, managed))
+
+	assert.True(t, isExportManagedDisk(&compute.Disk{Id: "epd1", InstanceIds: []string{"fhm-ig"}}, managed))
+	assert.True(t, isExportManagedDisk(&compute.Disk{Id: "epd2", InstanceIds: []string{"fhm-ig", "fhm-dataproc"}}, managed))
+	assert.False(t, isExportManagedDisk(&compute.Disk{Id: "epd3", InstanceIds: []string{"fhm-ig", "fhm-own"}}, managed))
+	assert.False(t, isExportManagedDisk(&compute.Disk{Id: "epd4"}, managed))
+}
+
+func TestExportListAllPages_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t, fakecloud.WithMaxPageSize(2))
+
+	for _, name := range []string{"net-a", "net-b", "net-c"} {
+		op, err := config.sdk.WrapOperation(config.sdk.VPC().Network().Create(context.Background(), &vpc.CreateNetworkRequest{
+			FolderId: fakecloud.FolderID,
+			Name:     name,
+		}))
+		require.NoError(t, err)
+		require.NoError(t, op.Wait(context.Background()))
+	}
+
+	objects, err := listExportVPCNetworks(context.Background(), config, fakecloud.FolderID)
+	require.NoError(t, err)
+	var names []string
+	for _, o := range objects {
+		names = append(names, o.Name)
+	}
+	assert.ElementsMatch(t, []string{"net-a", "net-b", "net-c"}, names)
+}
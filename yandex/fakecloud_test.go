@@ -0,0 +1,91 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+// newFakeCloudConfig starts in-memory API server and returns provider configuration using it,
+// so that resources can be tested without TF_ACC and network access.
+func newFakeCloudConfig(t *testing.T, opts ...fakecloud.Option) (*Config, *fakecloud.Server) {
+	server, err := fakecloud.Start(opts...)
+	require.NoError(t, err)
+	t.Cleanup(server.Stop)
+
+	config := &Config{
+		Endpoint:  server.Addr(),
+		Plaintext: true,
+		Token:     fakecloud.Token,
+		CloudID:   fakecloud.CloudID,
+		FolderID:  fakecloud.FolderID,
+		Zone:      testConfigZone,
+	}
+	require.NoError(t, config.initAndValidate(context.Background(), testTerraformVersion, false))
+	t.Cleanup(func() { _ = config.shutdown(context.Background()) })
+
+	return config, server
+}
+
+// fakeCloudResource returns the resource as it is registered in the provider, i.e. with all wrappers.
+func fakeCloudResource(t *testing.T, name string) *schema.Resource {
+	r, ok := Provider().ResourcesMap[name]
+	require.True(t, ok, "resource %s is not registered", name)
+	return r
+}
+
+// fakeCloudPlan returns the diff Terraform would plan to bring the state to the configuration.
+func fakeCloudPlan(t *testing.T, config *Config, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}) *terraform.InstanceDiff {
+	resourceConfig := terraform.NewResourceConfigRaw(raw)
+	require.Empty(t, r.Validate(resourceConfig))
+
+	diff, err := r.Diff(context.Background(), state, resourceConfig, config)
+	require.NoError(t, err)
+	if diff == nil {
+		diff = terraform.NewInstanceDiff()
+	}
+	return diff
+}
+
+// fakeCloudApply plans and applies the configuration like Terraform does, state is nil for new resources.
+func fakeCloudApply(t *testing.T, config *Config, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}) *terraform.InstanceState {
+	diff := fakeCloudPlan(t, config, r, state, raw)
+	if diff.Empty() {
+		return state
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, config)
+	require.False(t, diags.HasError(), "apply failed: %v", diags)
+	require.NotNil(t, newState)
+	return newState
+}
+
+// fakeCloudRefresh reads the resource, nil result means the resource is gone.
+func fakeCloudRefresh(t *testing.T, config *Config, r *schema.Resource, state *terraform.InstanceState) *terraform.InstanceState {
+	newState, diags := r.RefreshWithoutUpgrade(context.Background(), state, config)
+	require.False(t, diags.HasError(), "refresh failed: %v", diags)
+	return newState
+}
+
+// fakeCloudImport imports the resource by ID and reads it.
+func fakeCloudImport(t *testing.T, config *Config, r *schema.Resource, id string) *terraform.InstanceState {
+	require.NotNil(t, r.Importer, "resource doesn't support import")
+
+	data := r.Data(&terraform.InstanceState{ID: id})
+	imported, err := r.Importer.StateContext(context.Background(), data, config)
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+
+	return fakeCloudRefresh(t, config, r, imported[0].State())
+}
+
+// fakeCloudDestroy deletes the resource.
+func fakeCloudDestroy(t *testing.T, config *Config, r *schema.Resource, state *terraform.InstanceState) {
+	_, diags := r.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, config)
+	require.False(t, diags.HasError(), "destroy failed: %v", diags)
+}
@@ -1,20 +1,28 @@
 package yandex
 
 import (
-	"fmt"
-	"log"
+	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
 )
 
+const yandexIAMAccessBindingDefaultTimeout = 2 * time.Minute
+
 type ResourceIamUpdater interface {
 	// Fetch the existing IAM policy attached to a resource.
-	GetResourceIamPolicy() (*Policy, error)
+	GetResourceIamPolicy(ctx context.Context) (*Policy, error)
 
 	// Replaces the existing IAM Policy attached to a resource.
-	SetResourceIamPolicy(policy *Policy) error
+	SetResourceIamPolicy(ctx context.Context, policy *Policy) error
 
-	// A mutex guards against concurrent call to the SetResourceIamPolicy method.
+	// Adds and removes bindings of the IAM Policy attached to a resource, other bindings are preserved.
+	UpdateResourceIamPolicy(ctx context.Context, deltas []*access.AccessBindingDelta) error
+
+	// A mutex guards against concurrent call to the SetResourceIamPolicy method,
+	// concurrent UpdateResourceIamPolicy calls are batched by the key.
 	// The mutex key should be made of the resource type and resource id.
 	// For example: `iam-folder-{id}`.
 	GetMutexKey() string
@@ -28,37 +36,5 @@ type ResourceIamUpdater interface {
 }
 
 type newResourceIamUpdaterFunc func(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error)
-type iamPolicyModifyFunc func(p *Policy) error
-
-type resourceIDParserFunc func(d *schema.ResourceData, config *Config) error
-
-func iamPolicyReadModifyWrite(updater ResourceIamUpdater, modify iamPolicyModifyFunc) error {
-	mutexKey := updater.GetMutexKey()
-	mutexKV.Lock(mutexKey)
-	defer mutexKV.Unlock(mutexKey)
-
-	log.Printf("[DEBUG]: Retrieving policy for %s\n", updater.DescribeResource())
-
-	p, err := updater.GetResourceIamPolicy()
-	if err != nil {
-		return err
-	}
 
-	log.Printf("[DEBUG]: Retrieved policy for %s: %+v\n", updater.DescribeResource(), p)
-
-	err = modify(p)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("[DEBUG]: Setting policy for %s to %+v\n", updater.DescribeResource(), p)
-
-	err = updater.SetResourceIamPolicy(p)
-	if err != nil {
-		return fmt.Errorf("Error applying IAM policy for %s: %s", updater.DescribeResource(), err)
-	}
-
-	log.Printf("[DEBUG]: Set policy for %s", updater.DescribeResource())
-
-	return nil
-}
+type resourceIDParserFunc func(ctx context.Context, d *schema.ResourceData, config *Config) error
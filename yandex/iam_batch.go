@@ -0,0 +1,198 @@
+package yandex
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+const (
+	// yandexIAMAccessBindingBatchWindow is how long changes of access bindings of the same resource
+	// are collected before they are sent, Terraform applies independent resources concurrently.
+	yandexIAMAccessBindingBatchWindow = 500 * time.Millisecond
+	// yandexIAMAccessBindingMaxDeltas is the maximum number of deltas in one UpdateAccessBindings request.
+	yandexIAMAccessBindingMaxDeltas = 1000
+)
+
+// accessBindingDeltaBatcher coalesces access binding changes of the same resource, made by
+// `_iam_member` and `_iam_binding` resources applied at once, into a single UpdateAccessBindings call.
+// Every Config has its own batcher, so changes made with different credentials or endpoints are never merged.
+type accessBindingDeltaBatcher struct {
+	window time.Duration
+
+	mu      sync.Mutex
+	pending map[string]*accessBindingDeltaBatch
+}
+
+type accessBindingDeltaBatch struct {
+	updater  ResourceIamUpdater
+	requests []*accessBindingDeltaRequest
+}
+
+type accessBindingDeltaRequest struct {
+	ctx     context.Context
+	updater ResourceIamUpdater
+	deltas  []*access.AccessBindingDelta
+	done    chan struct{}
+	err     error
+}
+
+func newAccessBindingDeltaBatcher(window time.Duration) *accessBindingDeltaBatcher {
+	return &accessBindingDeltaBatcher{
+		window:  window,
+		pending: make(map[string]*accessBindingDeltaBatch),
+	}
+}
+
+// Update applies the deltas to the access bindings of the resource together with deltas of other
+// callers for the same resource, it returns when the batch is sent.
+func (b *accessBindingDeltaBatcher) Update(ctx context.Context, updater ResourceIamUpdater, deltas []*access.AccessBindingDelta) error {
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	request := &accessBindingDeltaRequest{ctx: ctx, updater: updater, deltas: deltas, done: make(chan struct{})}
+	key := updater.GetMutexKey()
+
+	b.mu.Lock()
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &accessBindingDeltaBatch{updater: updater}
+		b.pending[key] = batch
+		time.AfterFunc(b.window, func() { b.flush(key, batch) })
+	}
+	batch.requests = append(batch.requests, request)
+	b.mu.Unlock()
+
+	select {
+	case <-request.done:
+		return request.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *accessBindingDeltaBatcher) flush(key string, batch *accessBindingDeltaBatch) {
+	b.mu.Lock()
+	delete(b.pending, key)
+	b.mu.Unlock()
+
+	// Exclude concurrent read-modify-write of the whole policy.
+	mutexKV.Lock(key)
+	defer mutexKV.Unlock(key)
+
+	var deltas []*access.AccessBindingDelta
+	for _, r := range batch.requests {
+		deltas = append(deltas, r.deltas...)
+	}
+
+	log.Printf("[DEBUG]: Updating access bindings of %s with %d deltas of %d resources", batch.updater.DescribeResource(), len(deltas), len(batch.requests))
+	err := updateAccessBindings(batch.context(), batch.updater, deltas)
+	if err != nil && len(batch.requests) > 1 {
+		// Don't fail every resource of the batch because of one of them.
+		log.Printf("[DEBUG]: Batched update of access bindings of %s failed, retrying one by one: %s", batch.updater.DescribeResource(), err)
+		for _, r := range batch.requests {
+			r.err = updateAccessBindings(r.ctx, r.updater, r.deltas)
+			close(r.done)
+		}
+		return
+	}
+
+	for _, r := range batch.requests {
+		r.err = err
+		close(r.done)
+	}
+}
+
+// context returns context of a caller still waiting for the batch.
+func (batch *accessBindingDeltaBatch) context() context.Context {
+	for _, r := range batch.requests {
+		if r.ctx.Err() == nil {
+			return r.ctx
+		}
+	}
+	return batch.requests[0].ctx
+}
+
+// updateAccessBindings sends the deltas, removal of bindings which are already gone is skipped
+// the same way as when the whole policy was rewritten.
+func updateAccessBindings(ctx context.Context, updater ResourceIamUpdater, deltas []*access.AccessBindingDelta) error {
+	deltas, err := skipRemovalOfMissingAccessBindings(ctx, updater, compactAccessBindingDeltas(deltas))
+	if err != nil {
+		return err
+	}
+	return updateAccessBindingsInChunks(ctx, updater, deltas)
+}
+
+// skipRemovalOfMissingAccessBindings drops REMOVE deltas of bindings the resource doesn't have,
+// the policy is read only if there are such deltas.
+func skipRemovalOfMissingAccessBindings(ctx context.Context, updater ResourceIamUpdater, deltas []*access.AccessBindingDelta) ([]*access.AccessBindingDelta, error) {
+	hasRemovals := false
+	for _, delta := range deltas {
+		if delta.Action == access.AccessBindingAction_REMOVE {
+			hasRemovals = true
+			break
+		}
+	}
+	if !hasRemovals {
+		return deltas, nil
+	}
+
+	policy, err := updater.GetResourceIamPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current := rolesToMembersMap(policy.Bindings)
+
+	result := make([]*access.AccessBindingDelta, 0, len(deltas))
+	for _, delta := range deltas {
+		binding := delta.AccessBinding
+		if delta.Action == access.AccessBindingAction_REMOVE && !current[binding.RoleId][canonicalMember(binding)] {
+			log.Printf("[DEBUG]: Binding of %q to role %q is already removed from %s", canonicalMember(binding), binding.RoleId, updater.DescribeResource())
+			continue
+		}
+		result = append(result, delta)
+	}
+	return result, nil
+}
+
+func updateAccessBindingsInChunks(ctx context.Context, updater ResourceIamUpdater, deltas []*access.AccessBindingDelta) error {
+	for len(deltas) > 0 {
+		n := len(deltas)
+		if n > yandexIAMAccessBindingMaxDeltas {
+			n = yandexIAMAccessBindingMaxDeltas
+		}
+		if err := updater.UpdateResourceIamPolicy(ctx, deltas[:n]); err != nil {
+			return err
+		}
+		deltas = deltas[n:]
+	}
+	return nil
+}
+
+// compactAccessBindingDeltas leaves one delta per binding, the last one wins.
+func compactAccessBindingDeltas(deltas []*access.AccessBindingDelta) []*access.AccessBindingDelta {
+	positions := make(map[string]int, len(deltas))
+	var result []*access.AccessBindingDelta
+	for _, delta := range deltas {
+		key := delta.AccessBinding.RoleId + " " + canonicalMember(delta.AccessBinding)
+		if pos, ok := positions[key]; ok {
+			result[pos] = delta
+			continue
+		}
+		positions[key] = len(result)
+		result = append(result, delta)
+	}
+	return result
+}
+
+func accessBindingDeltas(action access.AccessBindingAction, bindings []*access.AccessBinding) []*access.AccessBindingDelta {
+	deltas := make([]*access.AccessBindingDelta, len(bindings))
+	for i, b := range bindings {
+		deltas[i] = &access.AccessBindingDelta{Action: action, AccessBinding: b}
+	}
+	return deltas
+}
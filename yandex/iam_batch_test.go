@@ -0,0 +1,148 @@
+package yandex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+const folderUpdateAccessBindingsMethod = "/yandex.cloud.resourcemanager.v1.FolderService/UpdateAccessBindings"
+
+func TestCompactAccessBindingDeltas(t *testing.T) {
+	add := func(role, member string) *access.AccessBindingDelta {
+		return &access.AccessBindingDelta{Action: access.AccessBindingAction_ADD, AccessBinding: roleMemberToAccessBinding(role, member)}
+	}
+	remove := func(role, member string) *access.AccessBindingDelta {
+		return &access.AccessBindingDelta{Action: access.AccessBindingAction_REMOVE, AccessBinding: roleMemberToAccessBinding(role, member)}
+	}
+
+	deltas := compactAccessBindingDeltas([]*access.AccessBindingDelta{
+		add("editor", "userAccount:alice"),
+		add("viewer", "userAccount:alice"),
+		remove("editor", "userAccount:alice"),
+		add("editor", "userAccount:bob"),
+		add("editor", "userAccount:bob"),
+	})
+	assert.Equal(t, []*access.AccessBindingDelta{
+		remove("editor", "userAccount:alice"),
+		add("viewer", "userAccount:alice"),
+		add("editor", "userAccount:bob"),
+	}, deltas)
+}
+
+// applyFolderIamMembers creates members of the role concurrently, like Terraform applies independent resources.
+func applyFolderIamMembers(t *testing.T, config *Config, role string, n int) []error {
+	r := fakeCloudResource(t, "yandex_resourcemanager_folder_iam_member")
+
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		diff := fakeCloudPlan(t, config, r, nil, map[string]interface{}{
+			"folder_id": fakecloud.FolderID,
+			"role":      role,
+			"member":    fmt.Sprintf("userAccount:user%d", i),
+		})
+
+		wg.Add(1)
+		go func(i int, diff *terraform.InstanceDiff) {
+			defer wg.Done()
+			if _, diags := r.Apply(context.Background(), nil, diff, config); diags.HasError() {
+				errs[i] = fmt.Errorf("%s", diags[0].Summary)
+			}
+		}(i, diff)
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestAccessBindingBatcher_fakeCloud(t *testing.T) {
+	config, server := newFakeCloudConfig(t)
+
+	for _, err := range applyFolderIamMembers(t, config, "editor", 20) {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, server.Calls(folderUpdateAccessBindingsMethod))
+
+	updater, err := newFolderIamUpdaterFromFolderID(fakecloud.FolderID, config)
+	require.NoError(t, err)
+	policy, err := updater.GetResourceIamPolicy(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, policy.Bindings, 20)
+
+	// Failed batch is retried one by one.
+	server.FailNext(folderUpdateAccessBindingsMethod, status.Error(codes.InvalidArgument, "invalid subject"))
+	for _, err := range applyFolderIamMembers(t, config, "viewer", 3) {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1+1+3, server.Calls(folderUpdateAccessBindingsMethod))
+
+	// Removal of a binding which is already gone succeeds, as it did when the whole policy was rewritten.
+	r := fakeCloudResource(t, "yandex_resourcemanager_folder_iam_member")
+	raw := map[string]interface{}{
+		"folder_id": fakecloud.FolderID,
+		"role":      "editor",
+		"member":    "userAccount:carol",
+	}
+	state := fakeCloudApply(t, config, r, nil, raw)
+	require.NoError(t, updater.UpdateResourceIamPolicy(context.Background(), accessBindingDeltas(access.AccessBindingAction_REMOVE,
+		[]*access.AccessBinding{roleMemberToAccessBinding("editor", "userAccount:carol")})))
+	calls := server.Calls(folderUpdateAccessBindingsMethod)
+	fakeCloudDestroy(t, config, r, state)
+	assert.Equal(t, calls, server.Calls(folderUpdateAccessBindingsMethod))
+}
+
+// recordingIamUpdater records deltas it is asked to send and fails batches of several deltas.
+type recordingIamUpdater struct {
+	ResourceIamUpdater
+	mu     sync.Mutex
+	deltas []*access.AccessBindingDelta
+}
+
+func (u *recordingIamUpdater) UpdateResourceIamPolicy(_ context.Context, deltas []*access.AccessBindingDelta) error {
+	if len(deltas) > 1 {
+		return errors.New("batch failed")
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.deltas = append(u.deltas, deltas...)
+	return nil
+}
+
+func (u *recordingIamUpdater) GetMutexKey() string      { return "iam-folder-batch-test" }
+func (u *recordingIamUpdater) DescribeResource() string { return "folder \"batch-test\"" }
+
+func TestAccessBindingBatcherRetriesWithOwnUpdaters(t *testing.T) {
+	batcher := newAccessBindingDeltaBatcher(50 * time.Millisecond)
+	updaters := []*recordingIamUpdater{{}, {}}
+
+	var wg sync.WaitGroup
+	for i, u := range updaters {
+		wg.Add(1)
+		go func(i int, u *recordingIamUpdater) {
+			defer wg.Done()
+			member := fmt.Sprintf("userAccount:user%d", i)
+			assert.NoError(t, batcher.Update(context.Background(), u, accessBindingDeltas(access.AccessBindingAction_ADD,
+				[]*access.AccessBinding{roleMemberToAccessBinding("viewer", member)})))
+		}(i, u)
+	}
+	wg.Wait()
+
+	// Deltas of every caller are sent with its own updater once the batch fails.
+	for i, u := range updaters {
+		require.Len(t, u.deltas, 1)
+		assert.Equal(t, fmt.Sprintf("userAccount:user%d", i), canonicalMember(u.deltas[0].AccessBinding))
+	}
+}
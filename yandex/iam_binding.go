@@ -1,11 +1,13 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/grpc/codes"
 
@@ -36,41 +38,48 @@ var accessBindingSchema = map[string]*schema.Schema{
 
 func resourceAccessBinding(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc) *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAccessBindingCreate(newUpdaterFunc),
-		Read:   resourceAccessBindingRead(newUpdaterFunc, true),
-		Update: resourceAccessBindingUpdate(newUpdaterFunc),
-		Delete: resourceAccessBindingDelete(newUpdaterFunc),
+		CreateContext: resourceAccessBindingCreate(newUpdaterFunc),
+		ReadContext:   resourceAccessBindingRead(newUpdaterFunc, true),
+		UpdateContext: resourceAccessBindingUpdate(newUpdaterFunc),
+		DeleteContext: resourceAccessBindingDelete(newUpdaterFunc),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexIAMAccessBindingDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexIAMAccessBindingDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexIAMAccessBindingDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexIAMAccessBindingDefaultTimeout),
+		},
+
 		Schema: mergeSchemas(accessBindingSchema, parentSpecificSchema),
+
+		CustomizeDiff: iamRoleExistsDiff,
 	}
 }
 
 func resourceIamBindingWithImport(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, resourceIDParser resourceIDParserFunc) *schema.Resource {
 	r := resourceAccessBinding(parentSpecificSchema, newUpdaterFunc)
 	r.Importer = &schema.ResourceImporter{
-		State: iamBindingImport(resourceIDParser),
+		StateContext: iamBindingImport(resourceIDParser),
 	}
 	return r
 }
 
-func resourceAccessBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceAccessBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		p := getResourceIamBindings(d)
-		err = iamPolicyReadModifyWrite(updater, func(ep *Policy) error {
-			// Creating a binding does not remove existing members if they are not in the provided members list.
-			// This prevents removing existing permission without the user's knowledge.
-			// Instead, a diff is shown in that case after creation. Subsequent calls to update will remove any
-			// existing members not present in the provided list.
-			ep.Bindings = mergeBindings(append(ep.Bindings, p...))
-			return nil
-		})
+		// Creating a binding does not remove existing members if they are not in the provided members list.
+		// This prevents removing existing permission without the user's knowledge.
+		// Instead, a diff is shown in that case after creation. Subsequent calls to update will remove any
+		// existing members not present in the provided list.
+		err = config.accessBindingBatcher.Update(ctx, updater, accessBindingDeltas(access.AccessBindingAction_ADD, p))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		role := p[0].RoleId
@@ -80,28 +89,28 @@ func resourceAccessBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc) schem
 			time.Sleep(time.Second * time.Duration(v.(int)))
 		}
 
-		return resourceAccessBindingRead(newUpdaterFunc, true)(d, meta)
+		return resourceAccessBindingRead(newUpdaterFunc, true)(ctx, d, meta)
 	}
 }
 
-func resourceAccessBindingRead(newUpdaterFunc newResourceIamUpdaterFunc, check bool) schema.ReadFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceAccessBindingRead(newUpdaterFunc newResourceIamUpdaterFunc, check bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		role := d.Get("role").(string)
 		eBindings := getResourceIamBindings(d)
 
-		p, err := updater.GetResourceIamPolicy()
+		p, err := updater.GetResourceIamPolicy(ctx)
 		if err != nil {
 			if isStatusWithCode(err, codes.NotFound) {
-				return fmt.Errorf("Binding for role %q not found for non-existent resource %s.", role, updater.DescribeResource())
+				return diag.Errorf("Binding for role %q not found for non-existent resource %s.", role, updater.DescribeResource())
 			}
 
-			return err
+			return diag.FromErr(err)
 		}
 		log.Printf("[DEBUG]: Retrieved policy for %s: %+v", updater.DescribeResource(), p)
 
@@ -123,70 +132,93 @@ func resourceAccessBindingRead(newUpdaterFunc newResourceIamUpdaterFunc, check b
 		}
 
 		if check && len(mBindings) == 0 {
-			return fmt.Errorf("Binding for role %q not found in policy for %s.", role, updater.DescribeResource())
+			return diag.Errorf("Binding for role %q not found in policy for %s.", role, updater.DescribeResource())
 		}
 
 		if err := d.Set("members", roleToMembersList(role, mBindings)); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		return nil
 	}
 }
 
-func resourceAccessBindingUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.UpdateFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceAccessBindingUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		bindings := getResourceIamBindings(d)
 		role := d.Get("role").(string)
 
-		err = iamPolicyReadModifyWrite(updater, func(p *Policy) error {
-			p.Bindings = removeRoleFromBindings(role, p.Bindings)
-			p.Bindings = append(p.Bindings, bindings...)
-			return nil
-		})
+		err = updateRoleMembers(ctx, config, updater, role, bindings)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		return resourceAccessBindingRead(newUpdaterFunc, true)(d, meta)
+		return resourceAccessBindingRead(newUpdaterFunc, true)(ctx, d, meta)
 	}
 }
 
-func resourceAccessBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.DeleteFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceAccessBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		binding := getResourceIamBindings(d)
 		role := binding[0].RoleId
 
-		err = iamPolicyReadModifyWrite(updater, func(p *Policy) error {
-			p.Bindings = removeRoleFromBindings(role, p.Bindings)
-			return nil
-		})
-
+		err = updateRoleMembers(ctx, config, updater, role, nil)
 		if err != nil {
 			if isStatusWithCode(err, codes.NotFound) {
 				log.Printf("[DEBUG]: Resource %s is missing or deleted, marking policy binding as deleted", updater.DescribeResource())
 				return nil
 			}
-			return err
+			return diag.FromErr(err)
 		}
 
-		return resourceAccessBindingRead(newUpdaterFunc, false)(d, meta)
+		return resourceAccessBindingRead(newUpdaterFunc, false)(ctx, d, meta)
+	}
+}
+
+// updateRoleMembers makes the bindings the only members of the role, other roles are preserved.
+func updateRoleMembers(ctx context.Context, config *Config, updater ResourceIamUpdater, role string, bindings []*access.AccessBinding) error {
+	p, err := updater.GetResourceIamPolicy(ctx)
+	if err != nil {
+		return err
 	}
+
+	wanted := rolesToMembersMap(bindings)[role]
+	current := rolesToMembersMap(p.Bindings)[role]
+
+	var deltas []*access.AccessBindingDelta
+	for member := range current {
+		if !wanted[member] {
+			deltas = append(deltas, &access.AccessBindingDelta{
+				Action:        access.AccessBindingAction_REMOVE,
+				AccessBinding: roleMemberToAccessBinding(role, member),
+			})
+		}
+	}
+	for member := range wanted {
+		if !current[member] {
+			deltas = append(deltas, &access.AccessBindingDelta{
+				Action:        access.AccessBindingAction_ADD,
+				AccessBinding: roleMemberToAccessBinding(role, member),
+			})
+		}
+	}
+
+	return config.accessBindingBatcher.Update(ctx, updater, deltas)
 }
 
-func iamBindingImport(resourceIDParser resourceIDParserFunc) schema.StateFunc {
-	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func iamBindingImport(resourceIDParser resourceIDParserFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 		if resourceIDParser == nil {
 			return nil, fmt.Errorf("Import not supported for this IAM resource")
 		}
@@ -201,7 +233,7 @@ func iamBindingImport(resourceIDParser resourceIDParserFunc) schema.StateFunc {
 		// Set the ID only to the first part so all IAM types can share the same resourceIDParserFunc.
 		d.SetId(id)
 		d.Set("role", roleID)
-		err := resourceIDParser(d, config)
+		err := resourceIDParser(ctx, d, config)
 		if err != nil {
 			return nil, err
 		}
@@ -33,26 +33,26 @@ func newCloudIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpda
 	}, nil
 }
 
-func cloudIDParseFunc(d *schema.ResourceData, _ *Config) error {
+func cloudIDParseFunc(_ context.Context, d *schema.ResourceData, _ *Config) error {
 	d.Set("cloud_id", d.Id())
 	return nil
 }
 
-func (u *CloudIamUpdater) GetResourceIamPolicy() (*Policy, error) {
-	bindings, err := getCloudAccessBindings(u.Config, u.GetResourceID())
+func (u *CloudIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getCloudAccessBindings(ctx, u.Config, u.GetResourceID())
 	if err != nil {
 		return nil, err
 	}
 	return &Policy{bindings}, nil
 }
 
-func (u *CloudIamUpdater) SetResourceIamPolicy(policy *Policy) error {
+func (u *CloudIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
 	req := &access.SetAccessBindingsRequest{
 		ResourceId:     u.cloudID,
 		AccessBindings: policy.Bindings,
 	}
 
-	ctx, cancel := context.WithTimeout(u.Config.Context(), yandexResourceManagerCloudDefaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexResourceManagerCloudDefaultTimeout)
 	defer cancel()
 
 	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.ResourceManager().Cloud().SetAccessBindings(ctx, req))
@@ -71,6 +71,31 @@ func (u *CloudIamUpdater) SetResourceIamPolicy(policy *Policy) error {
 	return nil
 }
 
+func (u *CloudIamUpdater) UpdateResourceIamPolicy(ctx context.Context, deltas []*access.AccessBindingDelta) error {
+	req := &access.UpdateAccessBindingsRequest{
+		ResourceId:          u.cloudID,
+		AccessBindingDeltas: deltas,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexResourceManagerCloudDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.ResourceManager().Cloud().UpdateAccessBindings(ctx, req))
+	if err != nil {
+		if reqID, ok := isRequestIDPresent(err); ok {
+			log.Printf("[DEBUG] request ID is %s\n", reqID)
+		}
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
 func (u *CloudIamUpdater) GetResourceID() string {
 	return u.cloudID
 }
@@ -83,10 +108,9 @@ func (u *CloudIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("cloud %q", u.cloudID)
 }
 
-func getCloudAccessBindings(config *Config, cloudID string) ([]*access.AccessBinding, error) {
+func getCloudAccessBindings(ctx context.Context, config *Config, cloudID string) ([]*access.AccessBinding, error) {
 	bindings := []*access.AccessBinding{}
 	pageToken := ""
-	ctx := config.Context()
 
 	for {
 		resp, err := config.sdk.ResourceManager().Cloud().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
@@ -96,7 +120,7 @@ func getCloudAccessBindings(config *Config, cloudID string) ([]*access.AccessBin
 		})
 
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving IAM access bindings for cloud %s: %s", cloudID, err)
+			return nil, fmt.Errorf("Error retrieving IAM access bindings for cloud %s: %w", cloudID, err)
 		}
 
 		bindings = append(bindings, resp.AccessBindings...)
@@ -32,26 +32,26 @@ func newContainerRegistryIamUpdater(d *schema.ResourceData, config *Config) (Res
 	}, nil
 }
 
-func containerRegistryIDParseFunc(d *schema.ResourceData, _ *Config) error {
+func containerRegistryIDParseFunc(_ context.Context, d *schema.ResourceData, _ *Config) error {
 	d.Set("registry_id", d.Id())
 	return nil
 }
 
-func (u *ContainerRegistryIamUpdater) GetResourceIamPolicy() (*Policy, error) {
-	bindings, err := getContainerRegistryAccessBindings(u.Config, u.GetResourceID())
+func (u *ContainerRegistryIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getContainerRegistryAccessBindings(ctx, u.Config, u.GetResourceID())
 	if err != nil {
 		return nil, err
 	}
 	return &Policy{bindings}, nil
 }
 
-func (u *ContainerRegistryIamUpdater) SetResourceIamPolicy(policy *Policy) error {
+func (u *ContainerRegistryIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
 	req := &access.SetAccessBindingsRequest{
 		ResourceId:     u.registryID,
 		AccessBindings: policy.Bindings,
 	}
 
-	ctx, cancel := context.WithTimeout(u.Config.Context(), yandexIAMContainerRegistryDefaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMContainerRegistryDefaultTimeout)
 	defer cancel()
 
 	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.ContainerRegistry().Registry().SetAccessBindings(ctx, req))
@@ -67,6 +67,28 @@ func (u *ContainerRegistryIamUpdater) SetResourceIamPolicy(policy *Policy) error
 	return nil
 }
 
+func (u *ContainerRegistryIamUpdater) UpdateResourceIamPolicy(ctx context.Context, deltas []*access.AccessBindingDelta) error {
+	req := &access.UpdateAccessBindingsRequest{
+		ResourceId:          u.registryID,
+		AccessBindingDeltas: deltas,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMContainerRegistryDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.ContainerRegistry().Registry().UpdateAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
 func (u *ContainerRegistryIamUpdater) GetResourceID() string {
 	return u.registryID
 }
@@ -79,10 +101,9 @@ func (u *ContainerRegistryIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("Container Registry '%s'", u.registryID)
 }
 
-func getContainerRegistryAccessBindings(config *Config, registryID string) ([]*access.AccessBinding, error) {
+func getContainerRegistryAccessBindings(ctx context.Context, config *Config, registryID string) ([]*access.AccessBinding, error) {
 	bindings := []*access.AccessBinding{}
 	pageToken := ""
-	ctx := config.Context()
 
 	for {
 		resp, err := config.sdk.ContainerRegistry().Registry().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
@@ -92,7 +113,7 @@ func getContainerRegistryAccessBindings(config *Config, registryID string) ([]*a
 		})
 
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving IAM access bindings for Container Registry %s: %s", registryID, err)
+			return nil, fmt.Errorf("Error retrieving IAM access bindings for Container Registry %s: %w", registryID, err)
 		}
 
 		bindings = append(bindings, resp.AccessBindings...)
@@ -32,26 +32,26 @@ func newContainerRepositoryIamUpdater(d *schema.ResourceData, config *Config) (R
 	}, nil
 }
 
-func containerRepositoryIDParseFunc(d *schema.ResourceData, _ *Config) error {
+func containerRepositoryIDParseFunc(_ context.Context, d *schema.ResourceData, _ *Config) error {
 	d.Set("repository_id", d.Id())
 	return nil
 }
 
-func (u *ContainerRepositoryIamUpdater) GetResourceIamPolicy() (*Policy, error) {
-	bindings, err := getContainerRepositoryAccessBindings(u.Config, u.GetResourceID())
+func (u *ContainerRepositoryIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getContainerRepositoryAccessBindings(ctx, u.Config, u.GetResourceID())
 	if err != nil {
 		return nil, err
 	}
 	return &Policy{bindings}, nil
 }
 
-func (u *ContainerRepositoryIamUpdater) SetResourceIamPolicy(policy *Policy) error {
+func (u *ContainerRepositoryIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
 	req := &access.SetAccessBindingsRequest{
 		ResourceId:     u.repositoryID,
 		AccessBindings: policy.Bindings,
 	}
 
-	ctx, cancel := context.WithTimeout(u.Config.Context(), yandexIAMContainerRepositoryDefaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMContainerRepositoryDefaultTimeout)
 	defer cancel()
 
 	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.ContainerRegistry().Repository().SetAccessBindings(ctx, req))
@@ -67,6 +67,28 @@ func (u *ContainerRepositoryIamUpdater) SetResourceIamPolicy(policy *Policy) err
 	return nil
 }
 
+func (u *ContainerRepositoryIamUpdater) UpdateResourceIamPolicy(ctx context.Context, deltas []*access.AccessBindingDelta) error {
+	req := &access.UpdateAccessBindingsRequest{
+		ResourceId:          u.repositoryID,
+		AccessBindingDeltas: deltas,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMContainerRepositoryDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.ContainerRegistry().Repository().UpdateAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
 func (u *ContainerRepositoryIamUpdater) GetResourceID() string {
 	return u.repositoryID
 }
@@ -79,10 +101,9 @@ func (u *ContainerRepositoryIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("Container Repository '%s'", u.repositoryID)
 }
 
-func getContainerRepositoryAccessBindings(config *Config, repositoryID string) ([]*access.AccessBinding, error) {
+func getContainerRepositoryAccessBindings(ctx context.Context, config *Config, repositoryID string) ([]*access.AccessBinding, error) {
 	bindings := []*access.AccessBinding{}
 	pageToken := ""
-	ctx := config.Context()
 
 	for {
 		resp, err := config.sdk.ContainerRegistry().Repository().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
@@ -92,7 +113,7 @@ func getContainerRepositoryAccessBindings(config *Config, repositoryID string) (
 		})
 
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving IAM access bindings for Container Repository %s: %s", repositoryID, err)
+			return nil, fmt.Errorf("Error retrieving IAM access bindings for Container Repository %s: %w", repositoryID, err)
 		}
 
 		bindings = append(bindings, resp.AccessBindings...)
@@ -33,26 +33,26 @@ func newFolderIamUpdaterFromFolderID(folderId string, config *Config) (ResourceI
 	}, nil
 }
 
-func folderIDParseFunc(d *schema.ResourceData, _ *Config) error {
+func folderIDParseFunc(_ context.Context, d *schema.ResourceData, _ *Config) error {
 	d.Set("folder_id", d.Id())
 	return nil
 }
 
-func (u *FolderIamUpdater) GetResourceIamPolicy() (*Policy, error) {
-	bindings, err := getFolderAccessBindings(u.Config, u.GetResourceID())
+func (u *FolderIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getFolderAccessBindings(ctx, u.Config, u.GetResourceID())
 	if err != nil {
 		return nil, err
 	}
 	return &Policy{bindings}, err
 }
 
-func (u *FolderIamUpdater) SetResourceIamPolicy(policy *Policy) error {
+func (u *FolderIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
 	req := &access.SetAccessBindingsRequest{
 		ResourceId:     u.folderID,
 		AccessBindings: policy.Bindings,
 	}
 
-	ctx, cancel := context.WithTimeout(u.Config.Context(), yandexResourceManagerFolderDefaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexResourceManagerFolderDefaultTimeout)
 	defer cancel()
 
 	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.ResourceManager().Folder().SetAccessBindings(ctx, req))
@@ -68,6 +68,28 @@ func (u *FolderIamUpdater) SetResourceIamPolicy(policy *Policy) error {
 	return nil
 }
 
+func (u *FolderIamUpdater) UpdateResourceIamPolicy(ctx context.Context, deltas []*access.AccessBindingDelta) error {
+	req := &access.UpdateAccessBindingsRequest{
+		ResourceId:          u.folderID,
+		AccessBindingDeltas: deltas,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexResourceManagerFolderDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.ResourceManager().Folder().UpdateAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
 func (u *FolderIamUpdater) GetResourceID() string {
 	return u.folderID
 }
@@ -80,10 +102,9 @@ func (u *FolderIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("folder %q", u.folderID)
 }
 
-func getFolderAccessBindings(config *Config, folderID string) ([]*access.AccessBinding, error) {
+func getFolderAccessBindings(ctx context.Context, config *Config, folderID string) ([]*access.AccessBinding, error) {
 	bindings := []*access.AccessBinding{}
 	pageToken := ""
-	ctx := config.Context()
 
 	for {
 		resp, err := config.sdk.ResourceManager().Folder().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
@@ -93,7 +114,7 @@ func getFolderAccessBindings(config *Config, folderID string) ([]*access.AccessB
 		})
 
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving IAM access bindings for folder %s: %s", folderID, err)
+			return nil, fmt.Errorf("Error retrieving IAM access bindings for folder %s: %w", folderID, err)
 		}
 
 		bindings = append(bindings, resp.AccessBindings...)
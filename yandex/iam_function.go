@@ -32,26 +32,26 @@ func newFunctionIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamU
 	}, nil
 }
 
-func functionIDParseFunc(d *schema.ResourceData, _ *Config) error {
+func functionIDParseFunc(_ context.Context, d *schema.ResourceData, _ *Config) error {
 	d.Set("function_id", d.Id())
 	return nil
 }
 
-func (u *FunctionIamUpdater) GetResourceIamPolicy() (*Policy, error) {
-	bindings, err := getFunctionAccessBindings(u.Config, u.GetResourceID())
+func (u *FunctionIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getFunctionAccessBindings(ctx, u.Config, u.GetResourceID())
 	if err != nil {
 		return nil, err
 	}
 	return &Policy{bindings}, nil
 }
 
-func (u *FunctionIamUpdater) SetResourceIamPolicy(policy *Policy) error {
+func (u *FunctionIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
 	req := &access.SetAccessBindingsRequest{
 		ResourceId:     u.functionID,
 		AccessBindings: policy.Bindings,
 	}
 
-	ctx, cancel := context.WithTimeout(u.Config.Context(), yandexIAMFunctionDefaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMFunctionDefaultTimeout)
 	defer cancel()
 
 	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Serverless().Functions().Function().SetAccessBindings(ctx, req))
@@ -67,6 +67,28 @@ func (u *FunctionIamUpdater) SetResourceIamPolicy(policy *Policy) error {
 	return nil
 }
 
+func (u *FunctionIamUpdater) UpdateResourceIamPolicy(ctx context.Context, deltas []*access.AccessBindingDelta) error {
+	req := &access.UpdateAccessBindingsRequest{
+		ResourceId:          u.functionID,
+		AccessBindingDeltas: deltas,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMFunctionDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Serverless().Functions().Function().UpdateAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
 func (u *FunctionIamUpdater) GetResourceID() string {
 	return u.functionID
 }
@@ -79,10 +101,9 @@ func (u *FunctionIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("function '%s'", u.functionID)
 }
 
-func getFunctionAccessBindings(config *Config, functionID string) ([]*access.AccessBinding, error) {
+func getFunctionAccessBindings(ctx context.Context, config *Config, functionID string) ([]*access.AccessBinding, error) {
 	bindings := []*access.AccessBinding{}
 	pageToken := ""
-	ctx := config.Context()
 
 	for {
 		resp, err := config.sdk.Serverless().Functions().Function().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
@@ -92,7 +113,7 @@ func getFunctionAccessBindings(config *Config, functionID string) ([]*access.Acc
 		})
 
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving IAM access bindings for function %s: %s", functionID, err)
+			return nil, fmt.Errorf("Error retrieving IAM access bindings for function %s: %w", functionID, err)
 		}
 
 		bindings = append(bindings, resp.AccessBindings...)
@@ -0,0 +1,194 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/grpc/codes"
+)
+
+// iamKeyRotation implements rotation of service account keys. On rotation a new key becomes the current one,
+// the current key becomes the previous one and is kept for an overlap window, so that consumers can switch
+// to the new key. The previous key is deleted by the first apply after the window.
+type iamKeyRotation struct {
+	// kind is the kind of the key used in messages.
+	kind string
+	// previous maps attributes of the current key to the attributes of the previous key,
+	// "id" is mapped to the ID of the previous key.
+	previous map[string]string
+	// deleteKey deletes the key with the ID.
+	deleteKey func(ctx context.Context, config *Config, id string) error
+}
+
+// addSchema adds arguments controlling the rotation and attributes of the previous key to the schema.
+func (r iamKeyRotation) addSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["rotation_period"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validateParsableValue(parseDuration),
+	}
+
+	s["overlap_period"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validateParsableValue(parseDuration),
+	}
+
+	s["keepers"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	for current, previous := range r.previous {
+		s[previous] = &schema.Schema{
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: current != "id" && s[current].Sensitive,
+		}
+	}
+
+	return s
+}
+
+// customizeDiff plans the rotation when the rotation period has passed since the current key was created
+// or keepers have changed, and plans removal of the previous key when the overlap window is over.
+// The key isn't rotated during the overlap window: a due rotation is postponed until the window ends,
+// and a change of keepers fails the plan.
+func (r iamKeyRotation) customizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	createdAt, err := time.Parse(defaultTimeFormat, d.Get("created_at").(string))
+	if err != nil {
+		return fmt.Errorf("failed to parse creation time of %s %q: %s", r.kind, d.Id(), err)
+	}
+	rotationPeriod, err := parseDuration(d.Get("rotation_period").(string))
+	if err != nil {
+		return err
+	}
+	overlapPeriod, err := parseDuration(d.Get("overlap_period").(string))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	previousID := d.Get(r.previous["id"]).(string)
+	overlapEnd := createdAt.Add(overlapPeriod.AsDuration())
+	if d.HasChange("keepers") || rotationPeriod.AsDuration() > 0 && !now.Before(createdAt.Add(rotationPeriod.AsDuration())) {
+		// Rotation would delete the previous key while consumers may still use it.
+		if previousID != "" && now.Before(overlapEnd) {
+			if d.HasChange("keepers") {
+				return fmt.Errorf("%s %q can't be rotated before the overlap window of the previous key %q ends at %s",
+					r.kind, d.Id(), previousID, overlapEnd.Format(defaultTimeFormat))
+			}
+			log.Printf("[DEBUG] Rotation of %s %q is postponed until the overlap window ends at %s", r.kind, d.Id(), overlapEnd.Format(defaultTimeFormat))
+			return nil
+		}
+
+		log.Printf("[DEBUG] Rotation of %s %q is planned", r.kind, d.Id())
+		// The new key is created on apply, while the ID of the resource can't be planned unknown for an update.
+		for current, previous := range r.previous {
+			if current != "id" {
+				if err := d.SetNewComputed(current); err != nil {
+					return err
+				}
+			}
+			if err := d.SetNewComputed(previous); err != nil {
+				return err
+			}
+		}
+		return d.SetNewComputed("created_at")
+	}
+
+	if previousID != "" && !now.Before(overlapEnd) {
+		log.Printf("[DEBUG] Deletion of %s %q is planned, overlap window is over", r.kind, previousID)
+		for _, previous := range r.previous {
+			if err := d.SetNew(previous, ""); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// rotating reports whether the rotation was planned for the update.
+func (r iamKeyRotation) rotating(d *schema.ResourceData) bool {
+	return d.HasChange("created_at")
+}
+
+// update deletes the previous key if it is no longer needed, and if the rotation was planned,
+// calls create, which must create the new key and set its attributes, and makes the current key the previous one.
+// If create fails, the current and the previous keys are kept in the state as they are.
+func (r iamKeyRotation) update(ctx context.Context, d *schema.ResourceData, config *Config, create func() error) error {
+	oldPreviousID, _ := d.GetChange(r.previous["id"])
+	if !r.rotating(d) {
+		if oldPreviousID.(string) != "" && d.Get(r.previous["id"]).(string) == "" {
+			if err := ignoreNotFound(r.deleteKey(ctx, config, oldPreviousID.(string))); err != nil {
+				return fmt.Errorf("error deleting previous %s %q: %s", r.kind, oldPreviousID, err)
+			}
+		}
+		return nil
+	}
+
+	current := make(map[string]string, len(r.previous)+1)
+	previous := make(map[string]string, len(r.previous))
+	for attr, previousAttr := range r.previous {
+		if attr != "id" {
+			old, _ := d.GetChange(attr)
+			current[attr] = old.(string)
+		}
+		old, _ := d.GetChange(previousAttr)
+		previous[previousAttr] = old.(string)
+	}
+	current["id"] = d.Id()
+	oldCreatedAt, _ := d.GetChange("created_at")
+	current["created_at"] = oldCreatedAt.(string)
+
+	if err := create(); err != nil {
+		for attr, value := range current {
+			if attr != "id" {
+				d.Set(attr, value)
+			}
+		}
+		for attr, value := range previous {
+			d.Set(attr, value)
+		}
+		return err
+	}
+
+	for attr, previousAttr := range r.previous {
+		d.Set(previousAttr, current[attr])
+	}
+
+	// The key which was the previous one is replaced only once the new key is created.
+	if oldPreviousID.(string) != "" {
+		if err := ignoreNotFound(r.deleteKey(ctx, config, oldPreviousID.(string))); err != nil {
+			return fmt.Errorf("error deleting previous %s %q: %s", r.kind, oldPreviousID, err)
+		}
+	}
+	return nil
+}
+
+// deletePrevious deletes the previous key, if there is one, along with the resource.
+func (r iamKeyRotation) deletePrevious(ctx context.Context, d *schema.ResourceData, config *Config) error {
+	if id := d.Get(r.previous["id"]).(string); id != "" {
+		if err := ignoreNotFound(r.deleteKey(ctx, config, id)); err != nil {
+			return fmt.Errorf("error deleting previous %s %q: %s", r.kind, id, err)
+		}
+	}
+	return nil
+}
+
+// ignoreNotFound is used for previous keys, which could be deleted outside of Terraform during the overlap window.
+func ignoreNotFound(err error) error {
+	if isStatusWithCode(err, codes.NotFound) {
+		return nil
+	}
+	return err
+}
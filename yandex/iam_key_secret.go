@@ -0,0 +1,158 @@
+package yandex
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/encryption"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+)
+
+// iamKeySecret describes how the secret part of a service account key, which is only available on create,
+// is kept: as is, encrypted with a PGP key, encrypted with a KMS key or written into a Lockbox secret.
+type iamKeySecret struct {
+	// description is the description of the secret used in PGP messages.
+	description string
+	// plaintext is the attribute with the secret as is.
+	plaintext string
+	// encrypted is the attribute with the secret encrypted with the PGP or KMS key.
+	encrypted string
+	// lockboxEntries are arguments of `output_to_lockbox` with names of the entries of the Lockbox secret,
+	// the first one is the entry for the secret itself.
+	lockboxEntries []string
+	// deleteKey deletes the key with the ID, it is used when the secret of a new key can't be stored.
+	deleteKey func(ctx context.Context, config *Config, id string) error
+}
+
+// addSchema adds `kms_key_id` and `output_to_lockbox` arguments to the schema, which already has `pgp_key`.
+func (k iamKeySecret) addSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["pgp_key"].ConflictsWith = []string{"kms_key_id", "output_to_lockbox"}
+
+	s["kms_key_id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"pgp_key", "output_to_lockbox"},
+	}
+
+	entries := map[string]*schema.Schema{
+		"secret_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+	}
+	for _, entry := range k.lockboxEntries {
+		entries[entry] = &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		}
+	}
+	s["output_to_lockbox"] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"pgp_key", "kms_key_id"},
+		Elem:          &schema.Resource{Schema: entries},
+	}
+
+	s["output_to_lockbox_version_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return s
+}
+
+// storeNew sets the ID of the key just created and stores its secret. If the secret can't be stored,
+// the key is deleted, since its secret can't be retrieved again, and the previous ID is restored.
+func (k iamKeySecret) storeNew(ctx context.Context, d *schema.ResourceData, config *Config, id string, values ...string) error {
+	previousID := d.Id()
+	d.SetId(id)
+
+	err := k.store(ctx, d, config, values...)
+	if err == nil {
+		return nil
+	}
+
+	d.SetId(previousID)
+	if deleteErr := k.deleteKey(ctx, config, id); deleteErr != nil {
+		return fmt.Errorf("%s, failed to delete the new key %q whose secret is lost: %s", err, id, deleteErr)
+	}
+	return err
+}
+
+// store keeps the secret according to the arguments, values are values of Lockbox entries
+// in the order of lockboxEntries, the first one is the secret.
+func (k iamKeySecret) store(ctx context.Context, d *schema.ResourceData, config *Config, values ...string) error {
+	secret := values[0]
+
+	if v, ok := d.GetOk("pgp_key"); ok {
+		encryptionKey, err := encryption.RetrieveGPGKey(v.(string))
+		if err != nil {
+			return err
+		}
+
+		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, secret, k.description)
+		if err != nil {
+			return err
+		}
+
+		d.Set("key_fingerprint", fingerprint)
+		d.Set(k.encrypted, encrypted)
+		return nil
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		resp, err := config.sdk.KMSCrypto().SymmetricCrypto().Encrypt(ctx, &kms.SymmetricEncryptRequest{
+			KeyId:     v.(string),
+			Plaintext: []byte(secret),
+		})
+		if err != nil {
+			return fmt.Errorf("error encrypting %s with KMS key %q: %s", k.description, v, err)
+		}
+
+		d.Set(k.encrypted, base64.StdEncoding.EncodeToString(resp.Ciphertext))
+		return nil
+	}
+
+	if _, ok := d.GetOk("output_to_lockbox"); ok {
+		secretID := d.Get("output_to_lockbox.0.secret_id").(string)
+		// BaseVersionId is not set, so the version is based on the current version of the secret, whichever
+		// it is: other entries of the secret are kept, including ones changed outside of Terraform.
+		req := &lockbox.AddVersionRequest{
+			SecretId:    secretID,
+			Description: fmt.Sprintf("%s %s", k.description, d.Id()),
+		}
+		for i, entry := range k.lockboxEntries {
+			req.PayloadEntries = append(req.PayloadEntries, &lockbox.PayloadEntryChange{
+				Key:   d.Get("output_to_lockbox.0." + entry).(string),
+				Value: &lockbox.PayloadEntryChange_TextValue{TextValue: values[i]},
+			})
+		}
+
+		op, err := config.sdk.WrapOperation(config.sdk.LockboxSecret().Secret().AddVersion(ctx, req))
+		if err != nil {
+			return fmt.Errorf("error writing %s into Lockbox secret %q: %s", k.description, secretID, err)
+		}
+		if err := op.Wait(ctx); err != nil {
+			return fmt.Errorf("error writing %s into Lockbox secret %q: %s", k.description, secretID, err)
+		}
+		md, err := op.Metadata()
+		if err != nil {
+			return fmt.Errorf("error while get Lockbox secret version metadata: %s", err)
+		}
+
+		d.Set("output_to_lockbox_version_id", md.(*lockbox.AddVersionMetadata).VersionId)
+		return nil
+	}
+
+	d.Set(k.plaintext, secret)
+	return nil
+}
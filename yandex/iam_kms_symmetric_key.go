@@ -32,26 +32,26 @@ func newKMSSymmetricKeyIamUpdater(d *schema.ResourceData, config *Config) (Resou
 	}, nil
 }
 
-func kmsSymmetricKeyIDParseFunc(d *schema.ResourceData, _ *Config) error {
+func kmsSymmetricKeyIDParseFunc(_ context.Context, d *schema.ResourceData, _ *Config) error {
 	d.Set("symmetric_key_id", d.Id())
 	return nil
 }
 
-func (u *KMSSymmetricKeyIamUpdater) GetResourceIamPolicy() (*Policy, error) {
-	bindings, err := getKMSSymmetricKeyAccessBindings(u.Config, u.GetResourceID())
+func (u *KMSSymmetricKeyIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getKMSSymmetricKeyAccessBindings(ctx, u.Config, u.GetResourceID())
 	if err != nil {
 		return nil, err
 	}
 	return &Policy{bindings}, nil
 }
 
-func (u *KMSSymmetricKeyIamUpdater) SetResourceIamPolicy(policy *Policy) error {
+func (u *KMSSymmetricKeyIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
 	req := &access.SetAccessBindingsRequest{
 		ResourceId:     u.symmetricKeyID,
 		AccessBindings: policy.Bindings,
 	}
 
-	ctx, cancel := context.WithTimeout(u.Config.Context(), yandexIAMKMSDefaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMKMSDefaultTimeout)
 	defer cancel()
 
 	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.KMS().SymmetricKey().SetAccessBindings(ctx, req))
@@ -67,6 +67,28 @@ func (u *KMSSymmetricKeyIamUpdater) SetResourceIamPolicy(policy *Policy) error {
 	return nil
 }
 
+func (u *KMSSymmetricKeyIamUpdater) UpdateResourceIamPolicy(ctx context.Context, deltas []*access.AccessBindingDelta) error {
+	req := &access.UpdateAccessBindingsRequest{
+		ResourceId:          u.symmetricKeyID,
+		AccessBindingDeltas: deltas,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMKMSDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.KMS().SymmetricKey().UpdateAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
 func (u *KMSSymmetricKeyIamUpdater) GetResourceID() string {
 	return u.symmetricKeyID
 }
@@ -79,10 +101,9 @@ func (u *KMSSymmetricKeyIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("KMS Symmetric Key '%s'", u.symmetricKeyID)
 }
 
-func getKMSSymmetricKeyAccessBindings(config *Config, symmetricKeyID string) ([]*access.AccessBinding, error) {
+func getKMSSymmetricKeyAccessBindings(ctx context.Context, config *Config, symmetricKeyID string) ([]*access.AccessBinding, error) {
 	bindings := []*access.AccessBinding{}
 	pageToken := ""
-	ctx := config.Context()
 
 	for {
 		resp, err := config.sdk.KMS().SymmetricKey().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
@@ -92,7 +113,7 @@ func getKMSSymmetricKeyAccessBindings(config *Config, symmetricKeyID string) ([]
 		})
 
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving IAM access bindings for KMS Symmetric Key %s: %s", symmetricKeyID, err)
+			return nil, fmt.Errorf("Error retrieving IAM access bindings for KMS Symmetric Key %s: %w", symmetricKeyID, err)
 		}
 
 		bindings = append(bindings, resp.AccessBindings...)
@@ -1,12 +1,14 @@
 package yandex
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/grpc/codes"
 
@@ -41,8 +43,8 @@ func validateIamMember(i interface{}, k string) (s []string, es []error) {
 	return
 }
 
-func iamMemberImport(resourceIDParser resourceIDParserFunc) schema.StateFunc {
-	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func iamMemberImport(resourceIDParser resourceIDParserFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 		if resourceIDParser == nil {
 			return nil, errors.New("Import not supported for this IAM resource")
 		}
@@ -66,7 +68,7 @@ func iamMemberImport(resourceIDParser resourceIDParserFunc) schema.StateFunc {
 		d.Set("role", role)
 		d.Set("member", member)
 
-		err := resourceIDParser(d, config)
+		err := resourceIDParser(ctx, d, config)
 		if err != nil {
 			return nil, err
 		}
@@ -80,18 +82,26 @@ func iamMemberImport(resourceIDParser resourceIDParserFunc) schema.StateFunc {
 
 func resourceIamMember(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc) *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIamMemberCreate(newUpdaterFunc),
-		Read:   resourceIamMemberRead(newUpdaterFunc),
-		Delete: resourceIamMemberDelete(newUpdaterFunc),
+		CreateContext: resourceIamMemberCreate(newUpdaterFunc),
+		ReadContext:   resourceIamMemberRead(newUpdaterFunc),
+		DeleteContext: resourceIamMemberDelete(newUpdaterFunc),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexIAMAccessBindingDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexIAMAccessBindingDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexIAMAccessBindingDefaultTimeout),
+		},
 
 		Schema: mergeSchemas(IamMemberBaseSchema, parentSpecificSchema),
+
+		CustomizeDiff: iamRoleExistsDiff,
 	}
 }
 
 func resourceIamMemberWithImport(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, resourceIDParser resourceIDParserFunc) *schema.Resource {
 	r := resourceIamMember(parentSpecificSchema, newUpdaterFunc)
 	r.Importer = &schema.ResourceImporter{
-		State: iamMemberImport(resourceIDParser),
+		StateContext: iamMemberImport(resourceIDParser),
 	}
 	return r
 }
@@ -103,22 +113,18 @@ func getResourceIamMember(d *schema.ResourceData) *access.AccessBinding {
 	return roleMemberToAccessBinding(role, member)
 }
 
-func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		p := getResourceIamMember(d)
-		err = iamPolicyReadModifyWrite(updater, func(ep *Policy) error {
-			// Merge the bindings together
-			ep.Bindings = mergeBindings(append(ep.Bindings, p))
-			return nil
-		})
+		err = config.accessBindingBatcher.Update(ctx, updater, accessBindingDeltas(access.AccessBindingAction_ADD, []*access.AccessBinding{p}))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(updater.GetResourceID() + "/" + p.RoleId + "/" + canonicalMember(p))
 
@@ -126,27 +132,27 @@ func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Cr
 			time.Sleep(time.Second * time.Duration(v.(int)))
 		}
 
-		return resourceIamMemberRead(newUpdaterFunc)(d, meta)
+		return resourceIamMemberRead(newUpdaterFunc)(ctx, d, meta)
 	}
 }
 
-func resourceIamMemberRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.ReadFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamMemberRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		eMember := getResourceIamMember(d)
-		p, err := updater.GetResourceIamPolicy()
+		p, err := updater.GetResourceIamPolicy(ctx)
 		if err != nil {
 			if isStatusWithCode(err, codes.NotFound) {
 				log.Printf("[DEBUG]: Binding of member %q with role %q does not exist for non-existent resource %s, removing from state.", canonicalMember(eMember), eMember.RoleId, updater.DescribeResource())
 				d.SetId("")
 				return nil
 			}
-			return err
+			return diag.FromErr(err)
 		}
 		log.Printf("[DEBUG]: Retrieved policy for %s: %+v\n", updater.DescribeResource(), p)
 		////
@@ -183,51 +189,25 @@ func resourceIamMemberRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 	}
 }
 
-func resourceIamMemberDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.DeleteFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamMemberDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		member := getResourceIamMember(d)
-		role := member.RoleId
-
-		err = iamPolicyReadModifyWrite(updater, func(p *Policy) error {
-			var toRemovePos []int
-			for pos, b := range p.Bindings {
-				if b.RoleId != role {
-					continue
-				}
-				if b.Subject.Type != member.Subject.Type || b.Subject.Id != member.Subject.Id {
-					continue
-				}
-				toRemovePos = append(toRemovePos, pos)
-				break
-			}
-
-			if len(toRemovePos) == 0 {
-				log.Printf("[DEBUG]: Binding for role %q does not exist in policy of project %q, so member %q can't be on it.", member.RoleId, updater.GetResourceID(), canonicalMember(member))
-				return nil
-			}
-
-			for _, pos := range toRemovePos {
-				p.Bindings = append(p.Bindings[:pos], p.Bindings[pos+1:]...)
-			}
-
-			return nil
-
-		})
+		err = config.accessBindingBatcher.Update(ctx, updater, accessBindingDeltas(access.AccessBindingAction_REMOVE, []*access.AccessBinding{member}))
 		if err != nil {
 			if isStatusWithCode(err, codes.NotFound) {
 				log.Printf("[DEBUG]: Member %q for binding for role %q does not exist for non-existent resource %q.", canonicalMember(member), member.RoleId, updater.GetResourceID())
 				return nil
 			}
-			return err
+			return diag.FromErr(err)
 		}
 
-		return resourceIamMemberRead(newUpdaterFunc)(d, meta)
+		return resourceIamMemberRead(newUpdaterFunc)(ctx, d, meta)
 	}
 }
 
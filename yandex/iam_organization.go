@@ -33,26 +33,26 @@ func newOrganizationIamUpdater(d *schema.ResourceData, config *Config) (Resource
 	}, nil
 }
 
-func organizationIDParseFunc(d *schema.ResourceData, _ *Config) error {
+func organizationIDParseFunc(_ context.Context, d *schema.ResourceData, _ *Config) error {
 	d.Set("organization_id", d.Id())
 	return nil
 }
 
-func (u *OrganizationIamUpdater) GetResourceIamPolicy() (*Policy, error) {
-	bindings, err := getOrganizationAccessBindings(u.Config, u.GetResourceID())
+func (u *OrganizationIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getOrganizationAccessBindings(ctx, u.Config, u.GetResourceID())
 	if err != nil {
 		return nil, err
 	}
 	return &Policy{bindings}, nil
 }
 
-func (u *OrganizationIamUpdater) SetResourceIamPolicy(policy *Policy) error {
+func (u *OrganizationIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
 	req := &access.SetAccessBindingsRequest{
 		ResourceId:     u.organizationID,
 		AccessBindings: policy.Bindings,
 	}
 
-	ctx, cancel := context.WithTimeout(u.Config.Context(), yandexOrganizationManagerOrganizationDefaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexOrganizationManagerOrganizationDefaultTimeout)
 	defer cancel()
 
 	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.OrganizationManager().Organization().SetAccessBindings(ctx, req))
@@ -71,6 +71,31 @@ func (u *OrganizationIamUpdater) SetResourceIamPolicy(policy *Policy) error {
 	return nil
 }
 
+func (u *OrganizationIamUpdater) UpdateResourceIamPolicy(ctx context.Context, deltas []*access.AccessBindingDelta) error {
+	req := &access.UpdateAccessBindingsRequest{
+		ResourceId:          u.organizationID,
+		AccessBindingDeltas: deltas,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexOrganizationManagerOrganizationDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.OrganizationManager().Organization().UpdateAccessBindings(ctx, req))
+	if err != nil {
+		if reqID, ok := isRequestIDPresent(err); ok {
+			log.Printf("[DEBUG] request ID is %s\n", reqID)
+		}
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
 func (u *OrganizationIamUpdater) GetResourceID() string {
 	return u.organizationID
 }
@@ -83,10 +108,9 @@ func (u *OrganizationIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("organization %q", u.organizationID)
 }
 
-func getOrganizationAccessBindings(config *Config, organizationID string) ([]*access.AccessBinding, error) {
+func getOrganizationAccessBindings(ctx context.Context, config *Config, organizationID string) ([]*access.AccessBinding, error) {
 	bindings := []*access.AccessBinding{}
 	pageToken := ""
-	ctx := config.Context()
 
 	for {
 		resp, err := config.sdk.OrganizationManager().Organization().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
@@ -96,7 +120,7 @@ func getOrganizationAccessBindings(config *Config, organizationID string) ([]*ac
 		})
 
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving IAM access bindings for organization %s: %s", organizationID, err)
+			return nil, fmt.Errorf("Error retrieving IAM access bindings for organization %s: %w", organizationID, err)
 		}
 
 		bindings = append(bindings, resp.AccessBindings...)
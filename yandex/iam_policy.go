@@ -1,12 +1,17 @@
 package yandex
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"google.golang.org/grpc/codes"
 )
 
@@ -17,15 +22,32 @@ var IamPolicyBaseSchema = map[string]*schema.Schema{
 		DiffSuppressFunc: shouldSuppressDiffForPolicies,
 		ValidateFunc:     validateIamPolicy,
 	},
+	"removed_bindings": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Bindings of the resource which are not in `policy_data`. The plan lists the bindings to remove, the state keeps the ones removed by the last apply.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"member": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
 }
 
-func iamPolicyImport(resourceIDParser resourceIDParserFunc) schema.StateFunc {
-	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func iamPolicyImport(resourceIDParser resourceIDParserFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 		if resourceIDParser == nil {
 			return nil, errors.New("Import not supported for this IAM resource")
 		}
 		config := m.(*Config)
-		err := resourceIDParser(d, config)
+		err := resourceIDParser(ctx, d, config)
 		if err != nil {
 			return nil, err
 		}
@@ -34,57 +56,69 @@ func iamPolicyImport(resourceIDParser resourceIDParserFunc) schema.StateFunc {
 }
 
 func resourceIamPolicy(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc) *schema.Resource {
-	return &schema.Resource{
-		Create: resourceIamPolicyCreate(newUpdaterFunc),
-		Read:   resourceIamPolicyRead(newUpdaterFunc),
-		Update: resourceIamPolicyUpdate(newUpdaterFunc),
-		Delete: resourceIamPolicyDelete(newUpdaterFunc),
+	r := &schema.Resource{
+		CreateContext: resourceIamPolicyCreate(newUpdaterFunc),
+		ReadContext:   resourceIamPolicyRead(newUpdaterFunc),
+		UpdateContext: resourceIamPolicyUpdate(newUpdaterFunc),
+		DeleteContext: resourceIamPolicyDelete(newUpdaterFunc),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexIAMAccessBindingDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexIAMAccessBindingDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexIAMAccessBindingDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexIAMAccessBindingDefaultTimeout),
+		},
 
 		Schema: mergeSchemas(IamPolicyBaseSchema, parentSpecificSchema),
 	}
+	r.CustomizeDiff = customdiff.All(
+		iamPolicyRolesExistDiff,
+		iamPolicyRemovedBindingsDiff(r, newUpdaterFunc, parentSpecificSchema),
+	)
+	return r
 }
 
 func resourceIamPolicyWithImport(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, resourceIDParser resourceIDParserFunc) *schema.Resource {
 	r := resourceIamPolicy(parentSpecificSchema, newUpdaterFunc)
 	r.Importer = &schema.ResourceImporter{
-		State: iamPolicyImport(resourceIDParser),
+		StateContext: iamPolicyImport(resourceIDParser),
 	}
 	return r
 }
 
-func resourceIamPolicyCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamPolicyCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		if err := setIamPolicyData(d, updater); err != nil {
-			return err
+		if err := setIamPolicyData(ctx, d, updater); err != nil {
+			return diag.FromErr(err)
 		}
 
 		d.SetId(updater.GetResourceID())
-		return resourceIamPolicyRead(newUpdaterFunc)(d, meta)
+		return resourceIamPolicyRead(newUpdaterFunc)(ctx, d, meta)
 	}
 }
 
-func resourceIamPolicyRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.ReadFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamPolicyRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		policy, err := updater.GetResourceIamPolicy()
+		policy, err := updater.GetResourceIamPolicy(ctx)
 
 		if err != nil {
 			if isStatusWithCode(err, codes.NotFound) {
 				log.Printf("[DEBUG]: Policy does not exist for non-existent resource %q", updater.GetResourceID())
 				return nil
 			}
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.Set("policy_data", marshalIamPolicy(policy))
@@ -93,46 +127,145 @@ func resourceIamPolicyRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 	}
 }
 
-func resourceIamPolicyUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.UpdateFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamPolicyUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if d.HasChange("policy_data") {
-			if err := setIamPolicyData(d, updater); err != nil {
-				return err
+			if err := setIamPolicyData(ctx, d, updater); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 
-		return resourceIamPolicyRead(newUpdaterFunc)(d, meta)
+		return resourceIamPolicyRead(newUpdaterFunc)(ctx, d, meta)
 	}
 }
 
-func resourceIamPolicyDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.DeleteFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamPolicyDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
+		mutexKV.Lock(updater.GetMutexKey())
+		defer mutexKV.Unlock(updater.GetMutexKey())
+
 		// Set an empty policy to delete the attached policy.
-		err = updater.SetResourceIamPolicy(&Policy{})
-		return err
+		err = updater.SetResourceIamPolicy(ctx, &Policy{})
+		return diag.FromErr(err)
 	}
 }
 
-func setIamPolicyData(d *schema.ResourceData, updater ResourceIamUpdater) error {
+func setIamPolicyData(ctx context.Context, d *schema.ResourceData, updater ResourceIamUpdater) error {
 	policy, err := unmarshalIamPolicy(d.Get("policy_data").(string))
 	if err != nil {
 		return fmt.Errorf("'policy_data' is not valid for %s: %s", updater.DescribeResource(), err)
 	}
 
-	err = updater.SetResourceIamPolicy(policy)
-	return err
+	// Exclude batched updates of access bindings of the resource.
+	mutexKV.Lock(updater.GetMutexKey())
+	defer mutexKV.Unlock(updater.GetMutexKey())
+
+	// The bindings removed by the policy are kept in the state, so the result of apply matches the plan.
+	current, err := updater.GetResourceIamPolicy(ctx)
+	if err != nil {
+		return fmt.Errorf("Error reading IAM policy of %s: %s", updater.DescribeResource(), err)
+	}
+	if err := updater.SetResourceIamPolicy(ctx, policy); err != nil {
+		return err
+	}
+	return d.Set("removed_bindings", flattenRemovedBindings(current, policy))
+}
+
+// iamPolicyRemovedBindingsDiff plans "removed_bindings" with the bindings the resource has now and
+// the new policy lacks, so the plan shows what is revoked rather than a diff of two JSON documents.
+func iamPolicyRemovedBindingsDiff(r *schema.Resource, newUpdaterFunc newResourceIamUpdaterFunc, parentSpecificSchema map[string]*schema.Schema) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() != "" && !d.HasChange("policy_data") {
+			return nil
+		}
+		if !d.NewValueKnown("policy_data") {
+			return d.SetNewComputed("removed_bindings")
+		}
+		policy, err := unmarshalIamPolicy(d.Get("policy_data").(string))
+		if err != nil {
+			return err
+		}
+
+		var current *Policy
+		if d.Id() == "" {
+			// The policy replaces bindings the resource already has, they aren't in state yet.
+			current, err = iamPolicyOfPlannedResource(ctx, d, meta, r, newUpdaterFunc, parentSpecificSchema)
+		} else {
+			old, _ := d.GetChange("policy_data")
+			current, err = unmarshalIamPolicy(old.(string))
+		}
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return d.SetNewComputed("removed_bindings")
+		}
+
+		return d.SetNew("removed_bindings", flattenRemovedBindings(current, policy))
+	}
+}
+
+// iamPolicyOfPlannedResource reads the policy of the resource the planned policy is attached to.
+// It returns nil if the resource ID is unknown, i.e. the resource is created in the same plan.
+func iamPolicyOfPlannedResource(ctx context.Context, d *schema.ResourceDiff, meta interface{}, r *schema.Resource,
+	newUpdaterFunc newResourceIamUpdaterFunc, parentSpecificSchema map[string]*schema.Schema) (*Policy, error) {
+	attributes := make(map[string]string, len(parentSpecificSchema))
+	for k := range parentSpecificSchema {
+		if !d.NewValueKnown(k) {
+			return nil, nil
+		}
+		attributes[k] = d.Get(k).(string)
+	}
+
+	updater, err := newUpdaterFunc(r.Data(&terraform.InstanceState{Attributes: attributes}), meta.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	policy, err := updater.GetResourceIamPolicy(ctx)
+	if err != nil {
+		if isStatusWithCode(err, codes.NotFound) {
+			return &Policy{}, nil
+		}
+		return nil, fmt.Errorf("Error reading IAM policy of %s: %s", updater.DescribeResource(), err)
+	}
+	return policy, nil
+}
+
+func flattenRemovedBindings(current, policy *Policy) []map[string]interface{} {
+	kept := rolesToMembersMap(policy.Bindings)
+	removed := []map[string]interface{}{}
+	for _, b := range current.Bindings {
+		role, member := b.RoleId, canonicalMember(b)
+		if kept[role][member] {
+			continue
+		}
+		if kept[role] == nil {
+			kept[role] = make(map[string]bool)
+		}
+		// Mark as seen, so duplicates of the current policy are reported once.
+		kept[role][member] = true
+		removed = append(removed, map[string]interface{}{"role": role, "member": member})
+	}
+
+	sort.Slice(removed, func(i, j int) bool {
+		if removed[i]["role"] != removed[j]["role"] {
+			return removed[i]["role"].(string) < removed[j]["role"].(string)
+		}
+		return removed[i]["member"].(string) < removed[j]["member"].(string)
+	})
+	return removed
 }
 
 func marshalIamPolicy(policy *Policy) string {
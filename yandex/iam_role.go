@@ -0,0 +1,184 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/pagination"
+)
+
+// iamRoleIDRegexp matches role IDs like `viewer`, `compute.admin` or `iam.serviceAccounts.user`.
+var iamRoleIDRegexp = regexp.MustCompile(`^[a-z][a-z0-9-]*(\.[a-zA-Z][a-zA-Z0-9-]*)*$`)
+
+// maxIamRoleSuggestions is the maximum number of similar roles suggested for a role which doesn't exist.
+const maxIamRoleSuggestions = 3
+
+func validateIamRole(i interface{}, k string) (s []string, es []error) {
+	if !iamRoleIDRegexp.MatchString(i.(string)) {
+		es = append(es, fmt.Errorf("expect '%s' value should be a role ID like 'viewer' or 'compute.admin', got '%v'", k, i.(string)))
+	}
+	return
+}
+
+// listIamRoles returns all predefined roles, the catalog is listed once per run.
+func listIamRoles(ctx context.Context, config *Config) ([]*iam.Role, error) {
+	items, err := config.cachedLookup("iam.roles", func() (interface{}, error) {
+		return pagination.List(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+			return config.sdk.IAM().Role().List(ctx, &iam.ListRolesRequest{
+				PageSize:  pagination.PageSize,
+				PageToken: pageToken,
+			})
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := items.([]proto.Message)
+	roles := make([]*iam.Role, len(messages))
+	for i, m := range messages {
+		roles[i] = m.(*iam.Role)
+	}
+	return roles, nil
+}
+
+// iamRoleExistsDiff fails the plan if the role of `_iam_binding` or `_iam_member` resource doesn't exist,
+// otherwise a typo in the role ID is reported by the API only at apply time.
+func iamRoleExistsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("role") {
+		return nil
+	}
+	if !d.NewValueKnown("role") {
+		return nil
+	}
+	return checkIamRolesExist(ctx, meta.(*Config), []string{d.Get("role").(string)})
+}
+
+// iamPolicyRolesExistDiff fails the plan if any of roles of the `_iam_policy` resource doesn't exist.
+func iamPolicyRolesExistDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("policy_data") {
+		return nil
+	}
+	if !d.NewValueKnown("policy_data") {
+		return nil
+	}
+	policy, err := unmarshalIamPolicy(d.Get("policy_data").(string))
+	if err != nil {
+		// The error is reported by iamPolicyRemovedBindingsDiff.
+		return nil
+	}
+
+	roles := make([]string, 0, len(policy.Bindings))
+	for _, b := range policy.Bindings {
+		roles = append(roles, b.RoleId)
+	}
+	return checkIamRolesExist(ctx, meta.(*Config), roles)
+}
+
+// checkIamRolesExist returns an error with suggestions of similar roles for the first role which doesn't exist.
+// Roles aren't checked if the catalog can't be listed, the API still rejects unknown roles on apply.
+func checkIamRolesExist(ctx context.Context, config *Config, roleIDs []string) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	roles, err := listIamRoles(ctx, config)
+	if err != nil {
+		log.Printf("[WARN] Skipping check that roles %v exist, failed to list roles: %s", roleIDs, err)
+		return nil
+	}
+
+	known := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		known[role.Id] = true
+	}
+	for _, roleID := range roleIDs {
+		if known[roleID] {
+			continue
+		}
+
+		suggestions := suggestIamRoles(roleID, roles)
+		switch len(suggestions) {
+		case 0:
+			return fmt.Errorf("role %q does not exist, use the yandex_iam_roles data source to list available roles", roleID)
+		case 1:
+			return fmt.Errorf("role %q does not exist, did you mean %q?", roleID, suggestions[0])
+		default:
+			quoted := make([]string, len(suggestions))
+			for i, suggestion := range suggestions {
+				quoted[i] = fmt.Sprintf("%q", suggestion)
+			}
+			return fmt.Errorf("role %q does not exist, did you mean one of %s?", roleID, strings.Join(quoted, ", "))
+		}
+	}
+	return nil
+}
+
+// suggestIamRoles returns IDs of roles which differ from the role ID by a few characters, most similar first.
+func suggestIamRoles(roleID string, roles []*iam.Role) []string {
+	maxDistance := len(roleID) / 4
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	type suggestion struct {
+		id       string
+		distance int
+	}
+	var suggestions []suggestion
+	for _, role := range roles {
+		distance := editDistance(strings.ToLower(roleID), strings.ToLower(role.Id))
+		if distance <= maxDistance {
+			suggestions = append(suggestions, suggestion{id: role.Id, distance: distance})
+		}
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].distance != suggestions[j].distance {
+			return suggestions[i].distance < suggestions[j].distance
+		}
+		return suggestions[i].id < suggestions[j].id
+	})
+
+	var result []string
+	for i := 0; i < len(suggestions) && i < maxIamRoleSuggestions; i++ {
+		result = append(result, suggestions[i].id)
+	}
+	return result
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	prev := make([]int, len(br)+1)
+	curr := make([]int, len(br)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		curr[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			curr[j] = prev[j-1] + cost
+			if prev[j]+1 < curr[j] {
+				curr[j] = prev[j] + 1
+			}
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(br)]
+}
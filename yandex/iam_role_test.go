@@ -0,0 +1,108 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+func TestValidateIamRole(t *testing.T) {
+	for _, role := range []string{"viewer", "compute.admin", "iam.serviceAccounts.user", "resource-manager.clouds.member", "k8s.cluster-api.cluster-admin"} {
+		_, errs := validateIamRole(role, "role")
+		assert.Empty(t, errs, role)
+	}
+	for _, role := range []string{"", "Viewer", "compute.", ".admin", "compute..admin", "role_editor", "compute admin"} {
+		_, errs := validateIamRole(role, "role")
+		assert.NotEmpty(t, errs, role)
+	}
+}
+
+func TestSuggestIamRoles(t *testing.T) {
+	roles := []*iam.Role{{Id: "viewer"}, {Id: "editor"}, {Id: "compute.admin"}, {Id: "compute.editor"}, {Id: "compute.viewer"}, {Id: "iam.serviceAccounts.user"}}
+
+	assert.Equal(t, []string{"compute.admin"}, suggestIamRoles("compute.admn", roles))
+	assert.Equal(t, []string{"iam.serviceAccounts.user"}, suggestIamRoles("iam.serviceaccounts.user", roles))
+	assert.Equal(t, []string{"compute.editor", "compute.viewer"}, suggestIamRoles("compute.ediwer", roles))
+	assert.Empty(t, suggestIamRoles("storage.uploader", roles))
+}
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("viewer", "viewer"))
+	assert.Equal(t, 1, editDistance("viewer", "viewr"))
+	assert.Equal(t, 3, editDistance("kitten", "sitting"))
+	assert.Equal(t, 6, editDistance("", "editor"))
+}
+
+func TestIamRoleExistsDiff_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t, fakecloud.WithMaxPageSize(4))
+
+	member := fakeCloudResource(t, "yandex_resourcemanager_folder_iam_member")
+	raw := map[string]interface{}{
+		"folder_id": fakecloud.FolderID,
+		"role":      "compute.admn",
+		"member":    "userAccount:alice",
+	}
+	_, err := member.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), config)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `role "compute.admn" does not exist, did you mean "compute.admin"?`)
+
+	raw["role"] = "compute.admin"
+	state := fakeCloudApply(t, config, member, nil, raw)
+	assert.Equal(t, "compute.admin", state.Attributes["role"])
+
+	binding := fakeCloudResource(t, "yandex_resourcemanager_folder_iam_binding")
+	_, err = binding.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"folder_id": fakecloud.FolderID,
+		"role":      "compute.ediwer",
+		"members":   []interface{}{"userAccount:alice"},
+	}), config)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `role "compute.ediwer" does not exist, did you mean one of "compute.editor", "compute.viewer"?`)
+
+	policy := fakeCloudResource(t, "yandex_resourcemanager_folder_iam_policy")
+	_, err = policy.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"folder_id": fakecloud.FolderID,
+		"policy_data": marshalIamPolicy(&Policy{Bindings: []*access.AccessBinding{
+			roleMemberToAccessBinding("viewer", "userAccount:alice"),
+			roleMemberToAccessBinding("storage.uploader", "userAccount:alice"),
+		}}),
+	}), config)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `role "storage.uploader" does not exist, use the yandex_iam_roles data source`)
+}
+
+func TestIamRoleExistsDiffSkipsUnknownRole_fakeCloud(t *testing.T) {
+	config, server := newFakeCloudConfig(t)
+
+	// The value which the plugin SDK decodes as unknown, e.g. a role taken from an attribute of a resource
+	// which is not created yet.
+	const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	member := fakeCloudResource(t, "yandex_resourcemanager_folder_iam_member")
+	diff, err := member.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"folder_id": fakecloud.FolderID,
+		"role":      unknownValue,
+		"member":    "userAccount:alice",
+	}), config)
+	require.NoError(t, err)
+	assert.True(t, diff.Attributes["role"].NewComputed)
+
+	binding := fakeCloudResource(t, "yandex_resourcemanager_folder_iam_binding")
+	diff, err = binding.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"folder_id": fakecloud.FolderID,
+		"role":      unknownValue,
+		"members":   []interface{}{"userAccount:alice"},
+	}), config)
+	require.NoError(t, err)
+	assert.True(t, diff.Attributes["role"].NewComputed)
+
+	assert.Zero(t, server.Calls("/yandex.cloud.iam.v1.RoleService/List"))
+}
@@ -32,26 +32,26 @@ func newServerlessContainerIamUpdater(d *schema.ResourceData, config *Config) (R
 	}, nil
 }
 
-func serverlessContainerIDParseFunc(d *schema.ResourceData, _ *Config) error {
+func serverlessContainerIDParseFunc(_ context.Context, d *schema.ResourceData, _ *Config) error {
 	d.Set("container_id", d.Id())
 	return nil
 }
 
-func (u *ServerlessContainerIamUpdater) GetResourceIamPolicy() (*Policy, error) {
-	bindings, err := getServerlessContainerAccessBindings(u.Config, u.GetResourceID())
+func (u *ServerlessContainerIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getServerlessContainerAccessBindings(ctx, u.Config, u.GetResourceID())
 	if err != nil {
 		return nil, err
 	}
 	return &Policy{bindings}, nil
 }
 
-func (u *ServerlessContainerIamUpdater) SetResourceIamPolicy(policy *Policy) error {
+func (u *ServerlessContainerIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
 	req := &access.SetAccessBindingsRequest{
 		ResourceId:     u.containerID,
 		AccessBindings: policy.Bindings,
 	}
 
-	ctx, cancel := context.WithTimeout(u.Config.Context(), yandexIAMServerlessContainerDefaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMServerlessContainerDefaultTimeout)
 	defer cancel()
 
 	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Serverless().Containers().Container().SetAccessBindings(ctx, req))
@@ -67,6 +67,28 @@ func (u *ServerlessContainerIamUpdater) SetResourceIamPolicy(policy *Policy) err
 	return nil
 }
 
+func (u *ServerlessContainerIamUpdater) UpdateResourceIamPolicy(ctx context.Context, deltas []*access.AccessBindingDelta) error {
+	req := &access.UpdateAccessBindingsRequest{
+		ResourceId:          u.containerID,
+		AccessBindingDeltas: deltas,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMServerlessContainerDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Serverless().Containers().Container().UpdateAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
 func (u *ServerlessContainerIamUpdater) GetResourceID() string {
 	return u.containerID
 }
@@ -79,10 +101,9 @@ func (u *ServerlessContainerIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("container '%s'", u.containerID)
 }
 
-func getServerlessContainerAccessBindings(config *Config, containerID string) ([]*access.AccessBinding, error) {
+func getServerlessContainerAccessBindings(ctx context.Context, config *Config, containerID string) ([]*access.AccessBinding, error) {
 	bindings := []*access.AccessBinding{}
 	pageToken := ""
-	ctx := config.Context()
 
 	for {
 		resp, err := config.sdk.Serverless().Containers().Container().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
@@ -29,26 +29,26 @@ func newServiceAccountIamUpdater(d *schema.ResourceData, config *Config) (Resour
 	}, nil
 }
 
-func serviceAccountIDParseFunc(d *schema.ResourceData, _ *Config) error {
+func serviceAccountIDParseFunc(_ context.Context, d *schema.ResourceData, _ *Config) error {
 	d.Set("service_account_id", d.Id())
 	return nil
 }
 
-func (u *ServiceAccountIamUpdater) GetResourceIamPolicy() (*Policy, error) {
-	bindings, err := getServiceAccountAccessBindings(u.Config, u.GetResourceID())
+func (u *ServiceAccountIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getServiceAccountAccessBindings(ctx, u.Config, u.GetResourceID())
 	if err != nil {
 		return nil, err
 	}
 	return &Policy{bindings}, nil
 }
 
-func (u *ServiceAccountIamUpdater) SetResourceIamPolicy(policy *Policy) error {
+func (u *ServiceAccountIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
 	req := &access.SetAccessBindingsRequest{
 		ResourceId:     u.serviceAccountID,
 		AccessBindings: policy.Bindings,
 	}
 
-	ctx, cancel := context.WithTimeout(u.Config.Context(), yandexIAMServiceAccountDefaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMServiceAccountDefaultTimeout)
 	defer cancel()
 
 	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.IAM().ServiceAccount().SetAccessBindings(ctx, req))
@@ -65,6 +65,29 @@ func (u *ServiceAccountIamUpdater) SetResourceIamPolicy(policy *Policy) error {
 
 }
 
+func (u *ServiceAccountIamUpdater) UpdateResourceIamPolicy(ctx context.Context, deltas []*access.AccessBindingDelta) error {
+	req := &access.UpdateAccessBindingsRequest{
+		ResourceId:          u.serviceAccountID,
+		AccessBindingDeltas: deltas,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMServiceAccountDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.IAM().ServiceAccount().UpdateAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	return nil
+
+}
+
 func (u *ServiceAccountIamUpdater) GetResourceID() string {
 	return u.serviceAccountID
 }
@@ -77,10 +100,9 @@ func (u *ServiceAccountIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("service account '%s'", u.serviceAccountID)
 }
 
-func getServiceAccountAccessBindings(config *Config, serviceAccountID string) ([]*access.AccessBinding, error) {
+func getServiceAccountAccessBindings(ctx context.Context, config *Config, serviceAccountID string) ([]*access.AccessBinding, error) {
 	bindings := []*access.AccessBinding{}
 	pageToken := ""
-	ctx := config.Context()
 
 	for {
 		resp, err := config.sdk.IAM().ServiceAccount().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
@@ -90,7 +112,7 @@ func getServiceAccountAccessBindings(config *Config, serviceAccountID string) ([
 		})
 
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving IAM access bindings for service account %s: %s", serviceAccountID, err)
+			return nil, fmt.Errorf("Error retrieving IAM access bindings for service account %s: %w", serviceAccountID, err)
 		}
 
 		bindings = append(bindings, resp.AccessBindings...)
@@ -32,26 +32,26 @@ func newYDBDatabaseIamUpdater(d *schema.ResourceData, config *Config) (ResourceI
 	}, nil
 }
 
-func ydbDatabaseIDParseFunc(d *schema.ResourceData, _ *Config) error {
+func ydbDatabaseIDParseFunc(_ context.Context, d *schema.ResourceData, _ *Config) error {
 	d.Set("database_id", d.Id())
 	return nil
 }
 
-func (u *YDBDatabaseIamUpdater) GetResourceIamPolicy() (*Policy, error) {
-	bindings, err := getYDBDatabaseAccessBindings(u.Config, u.GetResourceID())
+func (u *YDBDatabaseIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getYDBDatabaseAccessBindings(ctx, u.Config, u.GetResourceID())
 	if err != nil {
 		return nil, err
 	}
 	return &Policy{bindings}, nil
 }
 
-func (u *YDBDatabaseIamUpdater) SetResourceIamPolicy(policy *Policy) error {
+func (u *YDBDatabaseIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
 	req := &access.SetAccessBindingsRequest{
 		ResourceId:     u.databaseID,
 		AccessBindings: policy.Bindings,
 	}
 
-	ctx, cancel := context.WithTimeout(u.Config.Context(), yandexIAMYDBDefaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMYDBDefaultTimeout)
 	defer cancel()
 
 	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.YDB().Database().SetAccessBindings(ctx, req))
@@ -67,6 +67,28 @@ func (u *YDBDatabaseIamUpdater) SetResourceIamPolicy(policy *Policy) error {
 	return nil
 }
 
+func (u *YDBDatabaseIamUpdater) UpdateResourceIamPolicy(ctx context.Context, deltas []*access.AccessBindingDelta) error {
+	req := &access.UpdateAccessBindingsRequest{
+		ResourceId:          u.databaseID,
+		AccessBindingDeltas: deltas,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMYDBDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.YDB().Database().UpdateAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
 func (u *YDBDatabaseIamUpdater) GetResourceID() string {
 	return u.databaseID
 }
@@ -79,10 +101,9 @@ func (u *YDBDatabaseIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("YDB Database '%s'", u.databaseID)
 }
 
-func getYDBDatabaseAccessBindings(config *Config, databaseID string) ([]*access.AccessBinding, error) {
+func getYDBDatabaseAccessBindings(ctx context.Context, config *Config, databaseID string) ([]*access.AccessBinding, error) {
 	bindings := []*access.AccessBinding{}
 	pageToken := ""
-	ctx := config.Context()
 
 	for {
 		resp, err := config.sdk.YDB().Database().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
@@ -92,7 +113,7 @@ func getYDBDatabaseAccessBindings(config *Config, databaseID string) ([]*access.
 		})
 
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving IAM access bindings for YDB Database %s: %s", databaseID, err)
+			return nil, fmt.Errorf("Error retrieving IAM access bindings for YDB Database %s: %w", databaseID, err)
 		}
 
 		bindings = append(bindings, resp.AccessBindings...)
@@ -1,6 +1,7 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -423,7 +424,7 @@ func expandInstanceGroupResourcesSpec(d *schema.ResourceData, prefix string) (*i
 	return rs, nil
 }
 
-func expandInstanceGroupTemplateAttachedDiskSpec(d *schema.ResourceData, prefix string, config *Config) (*instancegroup.AttachedDiskSpec, error) {
+func expandInstanceGroupTemplateAttachedDiskSpec(ctx context.Context, d *schema.ResourceData, prefix string, config *Config) (*instancegroup.AttachedDiskSpec, error) {
 	ads := &instancegroup.AttachedDiskSpec{}
 
 	if v, ok := d.GetOk(prefix + ".device_name"); ok {
@@ -451,7 +452,7 @@ func expandInstanceGroupTemplateAttachedDiskSpec(d *schema.ResourceData, prefix
 
 	// create new one disk
 	if _, ok := d.GetOk(prefix + ".initialize_params"); ok {
-		bootDiskSpec, err := expandInstanceGroupAttachenDiskSpecSpec(d, prefix+".initialize_params.0", config)
+		bootDiskSpec, err := expandInstanceGroupAttachenDiskSpecSpec(ctx, d, prefix+".initialize_params.0", config)
 		if err != nil {
 			return nil, err
 		}
@@ -462,7 +463,7 @@ func expandInstanceGroupTemplateAttachedDiskSpec(d *schema.ResourceData, prefix
 	return ads, nil
 }
 
-func expandInstanceGroupAttachenDiskSpecSpec(d *schema.ResourceData, prefix string, config *Config) (*instancegroup.AttachedDiskSpec_DiskSpec, error) {
+func expandInstanceGroupAttachenDiskSpecSpec(ctx context.Context, d *schema.ResourceData, prefix string, config *Config) (*instancegroup.AttachedDiskSpec_DiskSpec, error) {
 	diskSpec := &instancegroup.AttachedDiskSpec_DiskSpec{}
 
 	if v, ok := d.GetOk(prefix + ".description"); ok {
@@ -486,7 +487,7 @@ func expandInstanceGroupAttachenDiskSpecSpec(d *schema.ResourceData, prefix stri
 			ImageId: imageID,
 		}
 
-		size, err := getImageMinStorageSize(imageID, config)
+		size, err := getImageMinStorageSize(ctx, imageID, config)
 		if err != nil {
 			return nil, err
 		}
@@ -499,7 +500,7 @@ func expandInstanceGroupAttachenDiskSpecSpec(d *schema.ResourceData, prefix stri
 			SnapshotId: snapshotID,
 		}
 
-		size, err := getSnapshotMinStorageSize(snapshotID, config)
+		size, err := getSnapshotMinStorageSize(ctx, snapshotID, config)
 		if err != nil {
 			return nil, err
 		}
@@ -517,12 +518,12 @@ func expandInstanceGroupAttachenDiskSpecSpec(d *schema.ResourceData, prefix stri
 	return diskSpec, nil
 }
 
-func expandInstanceGroupSecondaryDiskSpecs(d *schema.ResourceData, prefix string, config *Config) ([]*instancegroup.AttachedDiskSpec, error) {
+func expandInstanceGroupSecondaryDiskSpecs(ctx context.Context, d *schema.ResourceData, prefix string, config *Config) ([]*instancegroup.AttachedDiskSpec, error) {
 	secondaryDisksCount := d.Get(prefix + ".#").(int)
 	ads := make([]*instancegroup.AttachedDiskSpec, secondaryDisksCount)
 
 	for i := 0; i < secondaryDisksCount; i++ {
-		disk, err := expandInstanceGroupTemplateAttachedDiskSpec(d, fmt.Sprintf(prefix+".%d", i), config)
+		disk, err := expandInstanceGroupTemplateAttachedDiskSpec(ctx, d, fmt.Sprintf(prefix+".%d", i), config)
 		if err != nil {
 			return nil, err
 		}
@@ -644,7 +645,7 @@ func expandInstanceGroupDnsRecords(data []interface{}) []*instancegroup.DnsRecor
 }
 
 // revive:disable:var-naming
-func expandInstanceGroupInstanceTemplate(d *schema.ResourceData, prefix string, config *Config) (*instancegroup.InstanceTemplate, error) {
+func expandInstanceGroupInstanceTemplate(ctx context.Context, d *schema.ResourceData, prefix string, config *Config) (*instancegroup.InstanceTemplate, error) {
 	var platformId, description, serviceAccount, name, hostname string
 
 	if v, ok := d.GetOk(prefix + ".platform_id"); ok {
@@ -672,12 +673,12 @@ func expandInstanceGroupInstanceTemplate(d *schema.ResourceData, prefix string,
 		return nil, fmt.Errorf("Error create 'resources' object of api request: %s", err)
 	}
 
-	bootDiskSpec, err := expandInstanceGroupTemplateAttachedDiskSpec(d, prefix+".boot_disk.0", config)
+	bootDiskSpec, err := expandInstanceGroupTemplateAttachedDiskSpec(ctx, d, prefix+".boot_disk.0", config)
 	if err != nil {
 		return nil, fmt.Errorf("Error create 'boot_disk' object of api request: %s", err)
 	}
 
-	secondaryDiskSpecs, err := expandInstanceGroupSecondaryDiskSpecs(d, prefix+".secondary_disk", config)
+	secondaryDiskSpecs, err := expandInstanceGroupSecondaryDiskSpecs(ctx, d, prefix+".secondary_disk", config)
 	if err != nil {
 		return nil, fmt.Errorf("Error create 'secondary_disk' object of api request: %s", err)
 	}
@@ -197,36 +197,36 @@ func expandGreenplumAccess(d *schema.ResourceData) *greenplum.Access {
 	return out
 }
 
-func expandGreenplumUpdatePath(d *schema.ResourceData, settingNames []string) []string {
-	mdbGreenplumUpdateFieldsMap := map[string]string{
-		"name":                   "name",
-		"description":            "description",
-		"user_password":          "user_password",
-		"labels":                 "labels",
-		"access.0.data_lens":     "config.access.data_lens",
-		"access.0.web_sql":       "config.access.web_sql",
-		"access.0.data_transfer": "config.access.data_transfer",
-		"backup_window_start":    "config.backup_window_start",
-		"maintenance_window":     "maintenance_window",
-		"deletion_protection":    "deletion_protection",
-		"security_group_ids":     "security_group_ids",
-
-		"pooler_config.0.pooling_mode":             "config_spec.pool.mode",
-		"pooler_config.0.pool_size":                "config_spec.pool.size",
-		"pooler_config.0.pool_client_idle_timeout": "config_spec.pool.client_idle_timeout",
-
-		"master_subcluster.0.resources.0.resource_preset_id": "master_config.resources.resource_preset_id",
-		"master_subcluster.0.resources.0.disk_type_id":       "master_config.resources.disk_type_id",
-		"master_subcluster.0.resources.0.disk_size":          "master_config.resources.disk_size",
-
-		"segment_subcluster.0.resources.0.resource_preset_id": "segment_config.resources.resource_preset_id",
-		"segment_subcluster.0.resources.0.disk_type_id":       "segment_config.resources.disk_type_id",
-		"segment_subcluster.0.resources.0.disk_size":          "segment_config.resources.disk_size",
-	}
+var mdbGreenplumUpdateFieldsMap = map[string]string{
+	"name":                   "name",
+	"description":            "description",
+	"user_password":          "user_password",
+	"labels":                 "labels",
+	"access.0.data_lens":     "config.access.data_lens",
+	"access.0.web_sql":       "config.access.web_sql",
+	"access.0.data_transfer": "config.access.data_transfer",
+	"backup_window_start":    "config.backup_window_start",
+	"maintenance_window":     "maintenance_window",
+	"deletion_protection":    "deletion_protection",
+	"security_group_ids":     "security_group_ids",
+
+	"pooler_config.0.pooling_mode":             "config_spec.pool.mode",
+	"pooler_config.0.pool_size":                "config_spec.pool.size",
+	"pooler_config.0.pool_client_idle_timeout": "config_spec.pool.client_idle_timeout",
+
+	"master_subcluster.0.resources.0.resource_preset_id": "master_config.resources.resource_preset_id",
+	"master_subcluster.0.resources.0.disk_type_id":       "master_config.resources.disk_type_id",
+	"master_subcluster.0.resources.0.disk_size":          "master_config.resources.disk_size",
+
+	"segment_subcluster.0.resources.0.resource_preset_id": "segment_config.resources.resource_preset_id",
+	"segment_subcluster.0.resources.0.disk_type_id":       "segment_config.resources.disk_type_id",
+	"segment_subcluster.0.resources.0.disk_size":          "segment_config.resources.disk_size",
+}
 
+func expandGreenplumUpdatePath(d *schema.ResourceData, settingNames []string) []string {
 	updatePath := []string{}
 	for field, path := range mdbGreenplumUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasChangeWithDefaultLabels(d, field) {
 			updatePath = append(updatePath, path)
 		}
 	}
@@ -497,7 +497,7 @@ func addMySQLHost(ctx context.Context, config *Config, d *schema.ResourceData, h
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create host for MySQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create host for MySQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -520,7 +520,7 @@ func updateMySQLHost(ctx context.Context, config *Config, d *schema.ResourceData
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update host for MySQL Cluster %q - host %v: %s", d.Id(), host.HostName, err)
+		return apiError(fmt.Sprintf("error while requesting API to update host for MySQL Cluster %q - host %v", d.Id(), host.HostName), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -0,0 +1,99 @@
+package yandex
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/go-sdk/dial"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// organizationManagerGroups returns a client of the group service of Organization Manager, the SDK has no client for it.
+func (c *Config) organizationManagerGroups(ctx context.Context) (organizationmanager.GroupServiceClient, error) {
+	conn, err := c.organizationManagerConn.get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return organizationmanager.NewGroupServiceClient(conn), nil
+}
+
+// lazyEndpointConn is a connection to an API endpoint made on first use, for services which have no client in the SDK.
+// The connection is made the way the SDK makes its own ones: it is authenticated with the SDK credentials
+// and has the dial options of the provider.
+type lazyEndpointConn struct {
+	endpoint ycsdk.Endpoint
+
+	mu     sync.Mutex
+	conn   *grpc.ClientConn
+	closed bool
+}
+
+func newLazyEndpointConn(endpoint ycsdk.Endpoint) *lazyEndpointConn {
+	return &lazyEndpointConn{endpoint: endpoint}
+}
+
+func (l *lazyEndpointConn) get(ctx context.Context, config *Config) (*grpc.ClientConn, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		return l.conn, nil
+	}
+	if l.closed {
+		return nil, fmt.Errorf("connection to %q service is closed", l.endpoint)
+	}
+
+	// Addresses of endpoints are known to the SDK once it has connected to any of them.
+	if err := config.sdk.CheckEndpointConnection(ctx, l.endpoint); err != nil {
+		return nil, err
+	}
+	endpoint, ok := config.sdk.Endpoint(l.endpoint)
+	if !ok {
+		return nil, fmt.Errorf("service %q is not available at Cloud API endpoint %q", l.endpoint, config.Endpoint)
+	}
+
+	transportCredentials := insecure.NewCredentials()
+	if !config.Plaintext {
+		tlsConfig := config.tlsConfig
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+		transportCredentials = credentials.NewTLS(tlsConfig)
+	}
+
+	tokenMiddleware := ycsdk.NewIAMTokenMiddleware(config.sdk, time.Now)
+	dialOptions := []grpc.DialOption{
+		grpc.WithContextDialer(dial.NewProxyDialer(dial.NewDialer())),
+		grpc.WithChainUnaryInterceptor(tokenMiddleware.InterceptUnary),
+		grpc.WithTransportCredentials(transportCredentials),
+	}
+	dialOptions = append(dialOptions, config.dialOptions...)
+
+	conn, err := grpc.DialContext(ctx, endpoint.Address, dialOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %q service: %s", l.endpoint, err)
+	}
+	l.conn = conn
+	return conn, nil
+}
+
+// close closes the connection if it was made, no connection is made after that.
+func (l *lazyEndpointConn) close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.closed = true
+	if l.conn == nil {
+		return nil
+	}
+	err := l.conn.Close()
+	l.conn = nil
+	return err
+}
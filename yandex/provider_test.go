@@ -17,6 +17,7 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
 	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc"
 )
 
 const providerDefaultValueInsecure = false
@@ -70,9 +71,15 @@ func init() {
 	}
 
 	if os.Getenv("TF_ACC") != "" {
+		if err := testAccStartSuiteCassette(); err != nil {
+			panic(err)
+		}
 		if err := setTestIDs(); err != nil {
 			panic(err)
 		}
+		if err := testAccStopSuiteCassette(); err != nil {
+			panic(err)
+		}
 	}
 }
 
@@ -82,6 +89,33 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderContextAwareResources(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		assert.Nil(t, r.Create, "%s uses Create instead of CreateContext", name)
+		assert.Nil(t, r.Read, "%s uses Read instead of ReadContext", name)
+		assert.Nil(t, r.Update, "%s uses Update instead of UpdateContext", name)
+		assert.Nil(t, r.Delete, "%s uses Delete instead of DeleteContext", name)
+		assert.NotNil(t, r.Timeouts, "%s has no timeouts", name)
+	}
+	for name, r := range Provider().DataSourcesMap {
+		assert.Nil(t, r.Read, "%s uses Read instead of ReadContext", name)
+	}
+}
+
+// resourcesWithoutImport can't be imported since their state can't be read back from API.
+var resourcesWithoutImport = map[string]bool{
+	// Plaintext is never returned by API.
+	"yandex_kms_secret_ciphertext": true,
+}
+
+func TestProviderResourcesSupportImport(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		if !resourcesWithoutImport[name] {
+			assert.NotNil(t, r.Importer, "%s has no importer", name)
+		}
+	}
+}
+
 func TestProviderWithRawConfig(t *testing.T) {
 	testProvider := Provider()
 
@@ -186,7 +220,48 @@ func TestProviderOrganizationId(t *testing.T) {
 	assert.Equal(t, org, conf.OrganizationID)
 }
 
+func TestProviderRateLimit(t *testing.T) {
+	testProvider := Provider()
+
+	raw := map[string]interface{}{
+		"token": "any_string_like_a_oauth",
+		"rate_limit": []interface{}{
+			map[string]interface{}{
+				"requests_per_second":          10,
+				"burst":                        20,
+				"mutating_requests_per_second": 2,
+				"max_concurrent_requests":      8,
+				"service": []interface{}{
+					map[string]interface{}{
+						"name":                    "mdb.postgresql",
+						"max_concurrent_requests": 2,
+					},
+				},
+			},
+		},
+	}
+
+	diags := testProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags != nil && diags.HasError() {
+		for _, d := range diags {
+			if d.Severity == diag.Error {
+				t.Fatalf("error configuring provider: %s", d.Summary)
+			}
+		}
+	}
+
+	conf := testProvider.Meta().(*Config)
+	assert.Equal(t, 10.0, conf.RateLimit.RequestsPerSecond)
+	assert.Equal(t, 20, conf.RateLimit.Burst)
+	assert.Equal(t, 2.0, conf.RateLimit.MutatingRequestsPerSecond)
+	assert.Equal(t, 0, conf.RateLimit.MutatingBurst)
+	assert.Equal(t, 8, conf.RateLimit.MaxConcurrentRequests)
+	assert.Equal(t, 2, conf.RateLimit.Services["mdb.postgresql"].MaxConcurrentRequests)
+}
+
 func testAccPreCheck(t *testing.T) {
+	testAccStartCassette(t)
+
 	for _, varName := range testAccEnvVars {
 		if val := os.Getenv(varName); val == "" {
 			t.Fatalf("%s must be set for acceptance tests", varName)
@@ -260,7 +335,12 @@ func setTestIDs() error {
 
 	ctx := context.Background()
 
-	sdk, err := ycsdk.Build(ctx, *config)
+	var dialOptions []grpc.DialOption
+	if apiCassette != nil {
+		dialOptions = apiCassette.DialOptions()
+	}
+
+	sdk, err := ycsdk.Build(ctx, *config, dialOptions...)
 	if err != nil {
 		return err
 	}
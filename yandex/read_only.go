@@ -0,0 +1,51 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// addReadOnlyGuard makes Create, Update and Delete of every resource fail in read-only mode
+// before any API call is made, so the error names the resource. API calls made elsewhere
+// are rejected by the read-only interceptor installed in Config.
+func addReadOnlyGuard(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		withReadOnlyGuard(name, r)
+	}
+}
+
+func withReadOnlyGuard(name string, r *schema.Resource) *schema.Resource {
+	if r.CreateContext != nil {
+		r.CreateContext = wrapReadOnlyGuardContext(name, "created", r.CreateContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrapReadOnlyGuardContext(name, "updated", r.UpdateContext)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = wrapReadOnlyGuardContext(name, "deleted", r.DeleteContext)
+	}
+	return r
+}
+
+func wrapReadOnlyGuardContext(name, action string, f crudContextFunc) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := checkReadOnly(name, action, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		return f(ctx, d, meta)
+	}
+}
+
+func checkReadOnly(name, action string, d *schema.ResourceData, meta interface{}) error {
+	config, ok := meta.(*Config)
+	if !ok || !config.ReadOnly {
+		return nil
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("provider is in read-only mode, %s can't be %s", name, action)
+	}
+	return fmt.Errorf("provider is in read-only mode, %s %q can't be %s", name, d.Id(), action)
+}
@@ -0,0 +1,40 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithReadOnlyGuard(t *testing.T) {
+	called := false
+	crud := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		called = true
+		return nil
+	}
+	r := withReadOnlyGuard("yandex_vpc_network", &schema.Resource{
+		Schema:        map[string]*schema.Schema{},
+		CreateContext: crud,
+		ReadContext:   crud,
+		DeleteContext: crud,
+	})
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	d.SetId("enp1234")
+
+	diags := r.DeleteContext(context.Background(), d, &Config{ReadOnly: true})
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, `yandex_vpc_network "enp1234" can't be deleted`)
+	assert.False(t, called)
+
+	require.Empty(t, r.ReadContext(context.Background(), d, &Config{ReadOnly: true}))
+	assert.True(t, called)
+
+	called = false
+	require.Empty(t, r.CreateContext(context.Background(), d, &Config{}))
+	assert.True(t, called)
+}
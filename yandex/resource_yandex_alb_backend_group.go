@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
@@ -15,12 +16,12 @@ const yandexALBBackendGroupDefaultTimeout = 5 * time.Minute
 
 func resourceYandexALBBackendGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexALBBackendGroupCreate,
-		Read:   resourceYandexALBBackendGroupRead,
-		Update: resourceYandexALBBackendGroupUpdate,
-		Delete: resourceYandexALBBackendGroupDelete,
+		CreateContext: resourceYandexALBBackendGroupCreate,
+		ReadContext:   resourceYandexALBBackendGroupRead,
+		UpdateContext: resourceYandexALBBackendGroupUpdate,
+		DeleteContext: resourceYandexALBBackendGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -446,67 +447,61 @@ func buildALBBackendGroupCreateRequest(d *schema.ResourceData, folderID string)
 	return req, nil
 }
 
-func resourceYandexALBBackendGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBBackendGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Creating Application Backend Group %q", d.Id())
 
 	config := meta.(*Config)
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating Application Backend Group: %w", err)
+		return diag.Errorf("Error getting folder ID while creating Application Backend Group: %s", err)
 	}
 
 	req, err := buildALBBackendGroupCreateRequest(d, folderID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().BackendGroup().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Application Backend Group: %w", err)
+		return errorDiagnostics("Error while requesting API to create Application Backend Group", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get Application Backend Group create operation metadata: %w", err)
+		return diag.Errorf("Error while get Application Backend Group create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*apploadbalancer.CreateBackendGroupMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Application Backend Group ID from create operation metadata")
+		return diag.Errorf("could not get Application Backend Group ID from create operation metadata")
 	}
 
 	d.SetId(md.BackendGroupId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create Application Backend Group: %w", err)
+		return errorDiagnostics("Error while waiting operation to create Application Backend Group", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Application Backend Group creation failed: %w", err)
+		return errorDiagnostics("Application Backend Group creation failed", err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished creating Application Backend Group %q", d.Id())
-	return resourceYandexALBBackendGroupRead(d, meta)
+	return resourceYandexALBBackendGroupRead(ctx, d, meta)
 }
 
-func resourceYandexALBBackendGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBBackendGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Reading Application Backend Group %q", d.Id())
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	bg, err := config.sdk.ApplicationLoadBalancer().BackendGroup().Get(ctx, &apploadbalancer.GetBackendGroupRequest{
 		BackendGroupId: d.Id(),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Application Backend Group %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Application Backend Group %q", d.Get("name").(string))))
 	}
 
 	_ = d.Set("created_at", getTimestamp(bg.CreatedAt))
@@ -518,52 +513,52 @@ func resourceYandexALBBackendGroupRead(d *schema.ResourceData, meta interface{})
 	case *apploadbalancer.BackendGroup_Http:
 		backends, err := flattenALBHTTPBackends(bg)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("http_backend", backends); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		affinity, err := flattenALBHTTPSessionAffinity(bg.GetHttp())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("session_affinity", affinity); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	case *apploadbalancer.BackendGroup_Grpc:
 		backends, err := flattenALBGRPCBackends(bg)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("grpc_backend", backends); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		affinity, err := flattenALBGRPCSessionAffinity(bg.GetGrpc())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("session_affinity", affinity); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	case *apploadbalancer.BackendGroup_Stream:
 		backends, err := flattenALBStreamBackends(bg)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("stream_backend", backends); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		affinity, err := flattenALBStreamSessionAffinity(bg.GetStream())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("session_affinity", affinity); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	log.Printf("[DEBUG] Finished reading Application Backend Group %q", d.Id())
-	return d.Set("labels", bg.Labels)
+	return diag.FromErr(d.Set("labels", bg.Labels))
 }
 
 func buildALBBackendGroupUpdateRequest(d *schema.ResourceData) (*apploadbalancer.UpdateBackendGroupRequest, error) {
@@ -608,33 +603,30 @@ func buildALBBackendGroupUpdateRequest(d *schema.ResourceData) (*apploadbalancer
 	return req, nil
 }
 
-func resourceYandexALBBackendGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBBackendGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Updating Application Backend Group %q", d.Id())
 	config := meta.(*Config)
 
 	req, err := buildALBBackendGroupUpdateRequest(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().BackendGroup().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Application Backend Group %q: %w", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to update Application Backend Group %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error updating Application Backend Group %q: %w", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error updating Application Backend Group %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished updating Application Backend Group %q", d.Id())
-	return resourceYandexALBBackendGroupRead(d, meta)
+	return resourceYandexALBBackendGroupRead(ctx, d, meta)
 }
 
-func resourceYandexALBBackendGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBBackendGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Deleting Application Backend Group %q", d.Id())
 	config := meta.(*Config)
 
@@ -642,22 +634,19 @@ func resourceYandexALBBackendGroupDelete(d *schema.ResourceData, meta interface{
 		BackendGroupId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().BackendGroup().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Application Backend Group %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Application Backend Group %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Application Backend Group %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Application Backend Group %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Application Backend Group %q", d.Id())
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -15,12 +16,12 @@ const yandexALBHTTPRouterDefaultTimeout = 5 * time.Minute
 
 func resourceYandexALBHTTPRouter() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexALBHTTPRouterCreate,
-		Read:   resourceYandexALBHTTPRouterRead,
-		Update: resourceYandexALBHTTPRouterUpdate,
-		Delete: resourceYandexALBHTTPRouterDelete,
+		CreateContext: resourceYandexALBHTTPRouterCreate,
+		ReadContext:   resourceYandexALBHTTPRouterRead,
+		UpdateContext: resourceYandexALBHTTPRouterUpdate,
+		DeleteContext: resourceYandexALBHTTPRouterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -65,7 +66,7 @@ func resourceYandexALBHTTPRouter() *schema.Resource {
 
 }
 
-func resourceYandexALBHTTPRouterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBHTTPRouterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Creating Application Http Router %q", d.Id())
 
 	config := meta.(*Config)
@@ -73,12 +74,12 @@ func resourceYandexALBHTTPRouterCreate(d *schema.ResourceData, meta interface{})
 	labels, err := expandLabels(d.Get("labels"))
 
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating Application : %w", err)
+		return diag.Errorf("Error expanding labels while creating Application : %s", err)
 	}
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating Application Http Router: %w", err)
+		return diag.Errorf("Error getting folder ID while creating Application Http Router: %s", err)
 	}
 
 	req := apploadbalancer.CreateHttpRouterRequest{
@@ -88,53 +89,47 @@ func resourceYandexALBHTTPRouterCreate(d *schema.ResourceData, meta interface{})
 		Labels:      labels,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().HttpRouter().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Application Http Router: %w", err)
+		return errorDiagnostics("Error while requesting API to create Application Http Router", err, resourceALBHTTPRouterUpdateFieldsMap)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get Application Http Router create operation metadata: %w", err)
+		return diag.Errorf("Error while get Application Http Router create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*apploadbalancer.CreateHttpRouterMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Application Http Router ID from create operation metadata")
+		return diag.Errorf("could not get Application Http Router ID from create operation metadata")
 	}
 
 	d.SetId(md.HttpRouterId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create Application Http Router: %w", err)
+		return errorDiagnostics("Error while waiting operation to create Application Http Router", err, resourceALBHTTPRouterUpdateFieldsMap)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Application Http Router creation failed: %w", err)
+		return errorDiagnostics("Application Http Router creation failed", err, resourceALBHTTPRouterUpdateFieldsMap)
 	}
 
 	log.Printf("[DEBUG] Finished creating Application Http Router %q", d.Id())
-	return resourceYandexALBHTTPRouterRead(d, meta)
+	return resourceYandexALBHTTPRouterRead(ctx, d, meta)
 
 }
 
-func resourceYandexALBHTTPRouterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBHTTPRouterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Reading Application Http Router %q", d.Id())
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	bg, err := config.sdk.ApplicationLoadBalancer().HttpRouter().Get(ctx, &apploadbalancer.GetHttpRouterRequest{
 		HttpRouterId: d.Id(),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Application Http Router %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Application Http Router %q", d.Get("name").(string))))
 	}
 
 	d.Set("created_at", getTimestamp(bg.CreatedAt))
@@ -143,16 +138,16 @@ func resourceYandexALBHTTPRouterRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("description", bg.Description)
 
 	log.Printf("[DEBUG] Finished reading Application Http Router %q", d.Id())
-	return d.Set("labels", bg.Labels)
+	return diag.FromErr(d.Set("labels", bg.Labels))
 }
 
-func resourceYandexALBHTTPRouterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBHTTPRouterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Updating Application Http Router %q", d.Id())
 	config := meta.(*Config)
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	req := &apploadbalancer.UpdateHttpRouterRequest{
@@ -164,32 +159,29 @@ func resourceYandexALBHTTPRouterUpdate(d *schema.ResourceData, meta interface{})
 
 	var updatePath []string
 	for field, path := range resourceALBHTTPRouterUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasChangeWithDefaultLabels(d, field) {
 			updatePath = append(updatePath, path)
 		}
 	}
 
 	req.UpdateMask = &field_mask.FieldMask{Paths: updatePath}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().HttpRouter().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Application Http Router %q: %w", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to update Application Http Router %q", d.Id()), err, resourceALBHTTPRouterUpdateFieldsMap)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error updating Application Http Router %q: %w", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error updating Application Http Router %q", d.Id()), err, resourceALBHTTPRouterUpdateFieldsMap)
 	}
 
 	log.Printf("[DEBUG] Finished updating Application Http Router %q", d.Id())
 
-	return resourceYandexALBHTTPRouterRead(d, meta)
+	return resourceYandexALBHTTPRouterRead(ctx, d, meta)
 }
 
-func resourceYandexALBHTTPRouterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBHTTPRouterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Deleting Application Http Router %q", d.Id())
 	config := meta.(*Config)
 
@@ -197,22 +189,19 @@ func resourceYandexALBHTTPRouterDelete(d *schema.ResourceData, meta interface{})
 		HttpRouterId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().HttpRouter().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Application Http Router %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Application Http Router %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("Error deleting Application Http Router %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("Error deleting Application Http Router %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Application Http Router %q", d.Id())
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
 )
@@ -15,12 +16,12 @@ const yandexALBLoadBalancerDefaultTimeout = 10 * time.Minute
 
 func resourceYandexALBLoadBalancer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexALBLoadBalancerCreate,
-		Read:   resourceYandexALBLoadBalancerRead,
-		Update: resourceYandexALBLoadBalancerUpdate,
-		Delete: resourceYandexALBLoadBalancerDelete,
+		CreateContext: resourceYandexALBLoadBalancerCreate,
+		ReadContext:   resourceYandexALBLoadBalancerRead,
+		UpdateContext: resourceYandexALBLoadBalancerUpdate,
+		DeleteContext: resourceYandexALBLoadBalancerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -340,7 +341,7 @@ func streamHandler() *schema.Schema {
 	}
 }
 
-func resourceYandexALBLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Creating ALB Load Balancer %q", d.Id())
 
 	config := meta.(*Config)
@@ -348,12 +349,12 @@ func resourceYandexALBLoadBalancerCreate(d *schema.ResourceData, meta interface{
 	labels, err := expandLabels(d.Get("labels"))
 
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating ALB Load Balancer: %w", err)
+		return diag.Errorf("Error expanding labels while creating ALB Load Balancer: %s", err)
 	}
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating ALB Load Balancer: %w", err)
+		return diag.Errorf("Error getting folder ID while creating ALB Load Balancer: %s", err)
 	}
 
 	req := apploadbalancer.CreateLoadBalancerRequest{
@@ -368,63 +369,57 @@ func resourceYandexALBLoadBalancerCreate(d *schema.ResourceData, meta interface{
 
 	allocationPolicy, err := expandALBAllocationPolicy(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding allocation policy while creating ALB Load Balancer: %w", err)
+		return diag.Errorf("Error expanding allocation policy while creating ALB Load Balancer: %s", err)
 	}
 	req.SetAllocationPolicy(allocationPolicy)
 
 	listeners, err := expandALBListeners(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding listeners while creating ALB Load Balancer: %w", err)
+		return diag.Errorf("Error expanding listeners while creating ALB Load Balancer: %s", err)
 	}
 	req.SetListenerSpecs(listeners)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().LoadBalancer().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create ALB Load Balancer: %w", err)
+		return errorDiagnostics("Error while requesting API to create ALB Load Balancer", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get ALB Load Balancer create operation metadata: %w", err)
+		return diag.Errorf("Error while get ALB Load Balancer create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*apploadbalancer.CreateLoadBalancerMetadata)
 	if !ok {
-		return fmt.Errorf("could not get ALB Load Balancer ID from create operation metadata")
+		return diag.Errorf("could not get ALB Load Balancer ID from create operation metadata")
 	}
 
 	d.SetId(md.LoadBalancerId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create ALB Load Balancer: %w", err)
+		return errorDiagnostics("Error while waiting operation to create ALB Load Balancer", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("ALB Load Balancer creation failed: %w", err)
+		return errorDiagnostics("ALB Load Balancer creation failed", err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished creating ALB Load Balancer %q", d.Id())
-	return resourceYandexALBLoadBalancerRead(d, meta)
+	return resourceYandexALBLoadBalancerRead(ctx, d, meta)
 
 }
 
-func resourceYandexALBLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Reading ALB Load Balancer %q", d.Id())
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	alb, err := config.sdk.ApplicationLoadBalancer().LoadBalancer().Get(ctx, &apploadbalancer.GetLoadBalancerRequest{
 		LoadBalancerId: d.Id(),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ALB Load Balancer %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("ALB Load Balancer %q", d.Get("name").(string))))
 	}
 
 	d.Set("created_at", getTimestamp(alb.CreatedAt))
@@ -439,31 +434,31 @@ func resourceYandexALBLoadBalancerRead(d *schema.ResourceData, meta interface{})
 
 	allocationPolicy, err := flattenALBAllocationPolicy(alb)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("allocation_policy", allocationPolicy); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	listeners, err := flattenALBListeners(alb)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("listener", listeners); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Finished reading ALB Load Balancer %q", d.Id())
-	return d.Set("labels", alb.Labels)
+	return diag.FromErr(d.Set("labels", alb.Labels))
 }
 
-func resourceYandexALBLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Updating ALB Load Balancer %q", d.Id())
 	config := meta.(*Config)
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	req := &apploadbalancer.UpdateLoadBalancerRequest{
@@ -476,34 +471,31 @@ func resourceYandexALBLoadBalancerUpdate(d *schema.ResourceData, meta interface{
 
 	allocationPolicy, err := expandALBAllocationPolicy(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding allocation policy while creating ALB Load Balancer: %w", err)
+		return diag.Errorf("Error expanding allocation policy while creating ALB Load Balancer: %s", err)
 	}
 	req.SetAllocationPolicy(allocationPolicy)
 
 	listeners, err := expandALBListeners(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding listeners while creating ALB Load Balancer: %w", err)
+		return diag.Errorf("Error expanding listeners while creating ALB Load Balancer: %s", err)
 	}
 	req.SetListenerSpecs(listeners)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().LoadBalancer().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update ALB Load Balancer %q: %w", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to update ALB Load Balancer %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error updating ALB Load Balancer %q: %w", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error updating ALB Load Balancer %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished updating ALB Load Balancer %q", d.Id())
-	return resourceYandexALBLoadBalancerRead(d, meta)
+	return resourceYandexALBLoadBalancerRead(ctx, d, meta)
 }
 
-func resourceYandexALBLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Deleting ALB Load Balancer %q", d.Id())
 	config := meta.(*Config)
 
@@ -511,22 +503,19 @@ func resourceYandexALBLoadBalancerDelete(d *schema.ResourceData, meta interface{
 		LoadBalancerId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().LoadBalancer().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ALB Load Balancer %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("ALB Load Balancer %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting ALB Load Balancer %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting ALB Load Balancer %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting ALB Load Balancer %q", d.Id())
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
@@ -15,12 +16,12 @@ const yandexALBTargetGroupDefaultTimeout = 5 * time.Minute
 
 func resourceYandexALBTargetGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexALBTargetGroupCreate,
-		Read:   resourceYandexALBTargetGroupRead,
-		Update: resourceYandexALBTargetGroupUpdate,
-		Delete: resourceYandexALBTargetGroupDelete,
+		CreateContext: resourceYandexALBTargetGroupCreate,
+		ReadContext:   resourceYandexALBTargetGroupRead,
+		UpdateContext: resourceYandexALBTargetGroupUpdate,
+		DeleteContext: resourceYandexALBTargetGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -86,24 +87,24 @@ func resourceYandexALBTargetGroup() *schema.Resource {
 
 }
 
-func resourceYandexALBTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBTargetGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Creating Application Target Group %q", d.Id())
 	labels, err := expandLabels(d.Get("labels"))
 
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating Application Target Group: %w", err)
+		return diag.Errorf("Error expanding labels while creating Application Target Group: %s", err)
 	}
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating Application Target Group: %w", err)
+		return diag.Errorf("Error getting folder ID while creating Application Target Group: %s", err)
 	}
 
 	targets, err := expandALBTargets(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding targets while creating Application Target Group: %w", err)
+		return diag.Errorf("Error expanding targets while creating Application Target Group: %s", err)
 	}
 
 	req := apploadbalancer.CreateTargetGroupRequest{
@@ -114,53 +115,47 @@ func resourceYandexALBTargetGroupCreate(d *schema.ResourceData, meta interface{}
 		Targets:     targets,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().TargetGroup().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Application Target Group: %w", err)
+		return errorDiagnostics("Error while requesting API to create Application Target Group", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get Application Target Group create operation metadata: %w", err)
+		return diag.Errorf("Error while get Application Target Group create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*apploadbalancer.CreateTargetGroupMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Application Target Group ID from create operation metadata")
+		return diag.Errorf("could not get Application Target Group ID from create operation metadata")
 	}
 
 	d.SetId(md.TargetGroupId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create Application Target Group: %w", err)
+		return errorDiagnostics("Error while waiting operation to create Application Target Group", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Application Target Group creation failed: %w", err)
+		return errorDiagnostics("Application Target Group creation failed", err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished creating Application Target Group %q", d.Id())
-	return resourceYandexALBTargetGroupRead(d, meta)
+	return resourceYandexALBTargetGroupRead(ctx, d, meta)
 }
 
-func resourceYandexALBTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Reading Application Target Group %q", d.Id())
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	tg, err := config.sdk.ApplicationLoadBalancer().TargetGroup().Get(ctx, &apploadbalancer.GetTargetGroupRequest{
 		TargetGroupId: d.Id(),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Application Target Group %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Application Target Group %q", d.Get("name").(string))))
 	}
 
 	targets := flattenALBTargets(tg)
@@ -171,26 +166,26 @@ func resourceYandexALBTargetGroupRead(d *schema.ResourceData, meta interface{})
 	_ = d.Set("description", tg.Description)
 
 	if err := d.Set("target", targets); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Finished reading Application Target Group %q", d.Id())
-	return d.Set("labels", tg.Labels)
+	return diag.FromErr(d.Set("labels", tg.Labels))
 }
 
-func resourceYandexALBTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBTargetGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Updating Application Target Group %q", d.Id())
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targets, err := expandALBTargets(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding targets while updating Application Target Group: %w", err)
+		return diag.Errorf("Error expanding targets while updating Application Target Group: %s", err)
 	}
 
 	req := &apploadbalancer.UpdateTargetGroupRequest{
@@ -201,24 +196,21 @@ func resourceYandexALBTargetGroupUpdate(d *schema.ResourceData, meta interface{}
 		Targets:       targets,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().TargetGroup().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Application Target Group %q: %w", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to update Application Target Group %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error updating Application Target Group %q: %w", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error updating Application Target Group %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished updating Application Target Group %q", d.Id())
-	return resourceYandexALBTargetGroupRead(d, meta)
+	return resourceYandexALBTargetGroupRead(ctx, d, meta)
 }
 
-func resourceYandexALBTargetGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBTargetGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Application Target Group %q", d.Id())
@@ -227,22 +219,19 @@ func resourceYandexALBTargetGroupDelete(d *schema.ResourceData, meta interface{}
 		TargetGroupId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().TargetGroup().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Application Target Group %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Application Target Group %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Application Target Group %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Application Target Group %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Application Target Group %q", d.Id())
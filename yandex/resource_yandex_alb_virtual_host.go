@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
@@ -15,12 +16,12 @@ const yandexALBVirtualHostDefaultTimeout = 5 * time.Minute
 
 func resourceYandexALBVirtualHost() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexALBVirtualHostCreate,
-		Read:   resourceYandexALBVirtualHostRead,
-		Update: resourceYandexALBVirtualHostUpdate,
-		Delete: resourceYandexALBVirtualHostDelete,
+		CreateContext: resourceYandexALBVirtualHostCreate,
+		ReadContext:   resourceYandexALBVirtualHostRead,
+		UpdateContext: resourceYandexALBVirtualHostUpdate,
+		DeleteContext: resourceYandexALBVirtualHostDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -298,28 +299,28 @@ func headerModification(path string) *schema.Schema {
 	}
 }
 
-func resourceYandexALBVirtualHostCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBVirtualHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Creating Application Virtual Host %q", d.Get("name"))
 	authority, err := expandALBStringListFromSchemaSet(d.Get("authority"))
 	if err != nil {
-		return fmt.Errorf("Error expanding authority while updating Application Virtual Host: %w", err)
+		return diag.Errorf("Error expanding authority while updating Application Virtual Host: %s", err)
 	}
 
 	routes, err := expandALBRoutes(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding routes while updating Application Virtual Host: %w", err)
+		return diag.Errorf("Error expanding routes while updating Application Virtual Host: %s", err)
 	}
 
 	requestHeaders, err := expandALBHeaderModification(d, "modify_request_headers")
 	if err != nil {
-		return fmt.Errorf("Error expanding modify request headers while updating Application Virtual Host: %w", err)
+		return diag.Errorf("Error expanding modify request headers while updating Application Virtual Host: %s", err)
 	}
 
 	responseHeaders, err := expandALBHeaderModification(d, "modify_response_headers")
 	if err != nil {
-		return fmt.Errorf("Error expanding modify response headers while updating Application Virtual Host: %w", err)
+		return diag.Errorf("Error expanding modify response headers while updating Application Virtual Host: %s", err)
 	}
 	req := apploadbalancer.CreateVirtualHostRequest{
 		HttpRouterId:          d.Get("http_router_id").(string),
@@ -330,112 +331,106 @@ func resourceYandexALBVirtualHostCreate(d *schema.ResourceData, meta interface{}
 		ModifyRequestHeaders:  requestHeaders,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().VirtualHost().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Application Virtual Host: %w", err)
+		return errorDiagnostics("Error while requesting API to create Application Virtual Host", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get Application Virtual Host create operation metadata: %w", err)
+		return diag.Errorf("Error while get Application Virtual Host create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*apploadbalancer.CreateVirtualHostMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Application Virtual Host ID from create operation metadata")
+		return diag.Errorf("could not get Application Virtual Host ID from create operation metadata")
 	}
 
 	d.SetId(md.HttpRouterId + "/" + md.VirtualHostName)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create Application Virtual Host: %w", err)
+		return errorDiagnostics("Error while waiting operation to create Application Virtual Host", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Application Virtual Host creation failed: %w", err)
+		return errorDiagnostics("Application Virtual Host creation failed", err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished creating Application Virtual Host %q", d.Id())
-	return resourceYandexALBVirtualHostRead(d, meta)
+	return resourceYandexALBVirtualHostRead(ctx, d, meta)
 }
 
-func resourceYandexALBVirtualHostRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBVirtualHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Reading Application Virtual Host %q", d.Id())
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	virtualHost, err := config.sdk.ApplicationLoadBalancer().VirtualHost().Get(ctx, &apploadbalancer.GetVirtualHostRequest{
 		HttpRouterId:    d.Get("http_router_id").(string),
 		VirtualHostName: d.Get("name").(string),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Application Virtual Host %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Application Virtual Host %q", d.Get("name").(string))))
 	}
 
 	requestHeaderModification, err := flattenALBHeaderModification(virtualHost.ModifyRequestHeaders)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	responseHeaderModification, err := flattenALBHeaderModification(virtualHost.ModifyResponseHeaders)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routes, err := flattenALBRoutes(virtualHost.Routes)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("name", virtualHost.Name)
 	d.Set("authority", virtualHost.Authority)
 
 	if err := d.Set("modify_request_headers", requestHeaderModification); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("modify_response_headers", responseHeaderModification); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("route", routes); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Finished reading Application Virtual Host %q", d.Id())
 	return nil
 }
 
-func resourceYandexALBVirtualHostUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBVirtualHostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Updating Application Virtual Host %q", d.Id())
 
 	authority, err := expandALBStringListFromSchemaSet(d.Get("authority"))
 	if err != nil {
-		return fmt.Errorf("Error expanding authority while updating Application Virtual Host: %w", err)
+		return diag.Errorf("Error expanding authority while updating Application Virtual Host: %s", err)
 	}
 
 	routes, err := expandALBRoutes(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding routes while updating Application Virtual Host: %w", err)
+		return diag.Errorf("Error expanding routes while updating Application Virtual Host: %s", err)
 	}
 
 	requestHeaders, err := expandALBHeaderModification(d, "modify_request_headers")
 	if err != nil {
-		return fmt.Errorf("Error expanding modify request headers while updating Application Virtual Host: %w", err)
+		return diag.Errorf("Error expanding modify request headers while updating Application Virtual Host: %s", err)
 	}
 
 	responseHeaders, err := expandALBHeaderModification(d, "modify_response_headers")
 	if err != nil {
-		return fmt.Errorf("Error expanding modify response headers while updating Application Virtual Host: %w", err)
+		return diag.Errorf("Error expanding modify response headers while updating Application Virtual Host: %s", err)
 	}
 
 	req := &apploadbalancer.UpdateVirtualHostRequest{
@@ -447,24 +442,21 @@ func resourceYandexALBVirtualHostUpdate(d *schema.ResourceData, meta interface{}
 		ModifyRequestHeaders:  requestHeaders,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().VirtualHost().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Application Virtual Host %q: %w", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to update Application Virtual Host %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error updating Application Virtual Host %q: %w", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error updating Application Virtual Host %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished updating Application Virtual Host %q", d.Id())
-	return resourceYandexALBVirtualHostRead(d, meta)
+	return resourceYandexALBVirtualHostRead(ctx, d, meta)
 }
 
-func resourceYandexALBVirtualHostDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexALBVirtualHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Application Virtual Host %q", d.Id())
@@ -474,22 +466,19 @@ func resourceYandexALBVirtualHostDelete(d *schema.ResourceData, meta interface{}
 		HttpRouterId:    d.Get("http_router_id").(string),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().VirtualHost().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Application Virtual Host %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Application Virtual Host %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Application Virtual Host %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Application Virtual Host %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Application Virtual Host %q", d.Id())
@@ -1,10 +1,12 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/apigateway/v1"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -14,10 +16,13 @@ const yandexApiGatewayDefaultTimeout = 5 * time.Minute
 
 func resourceYandexApiGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexApiGatewayCreate,
-		Read:   resourceYandexApiGatewayRead,
-		Update: resourceYandexApiGatewayUpdate,
-		Delete: resourceYandexApiGatewayDelete,
+		CreateContext: resourceYandexApiGatewayCreate,
+		ReadContext:   resourceYandexApiGatewayRead,
+		UpdateContext: resourceYandexApiGatewayUpdate,
+		DeleteContext: resourceYandexApiGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexApiGatewayImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexApiGatewayDefaultTimeout),
@@ -87,41 +92,38 @@ func resourceYandexApiGateway() *schema.Resource {
 	}
 }
 
-func resourceYandexApiGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexApiGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	req, err := getCreateApiGatewayRequest(d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	op, err := config.sdk.WrapOperation(config.sdk.Serverless().APIGateway().ApiGateway().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Yandex Cloud API Gateway: %s", err)
+		return errorDiagnostics("Error while requesting API to create Yandex Cloud API Gateway", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Yandex Cloud API Gateway: %s", err)
+		return errorDiagnostics("Error while requesting API to create Yandex Cloud API Gateway", err, nil)
 	}
 
 	md, ok := protoMetadata.(*apigateway.CreateApiGatewayMetadata)
 	if !ok {
-		return fmt.Errorf("Could not get Yandex Cloud API Gateway ID from create operation metadata")
+		return diag.Errorf("Could not get Yandex Cloud API Gateway ID from create operation metadata")
 	}
 
 	d.SetId(md.ApiGatewayId)
 	d.Set("spec", d.Get("spec").(string))
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Yandex Cloud API Gateway: %s", err)
+		return errorDiagnostics("Error while requesting API to create Yandex Cloud API Gateway", err, nil)
 	}
 
-	return resourceYandexApiGatewayRead(d, meta)
+	return resourceYandexApiGatewayRead(ctx, d, meta)
 }
 
 func getCreateApiGatewayRequest(d *schema.ResourceData, config *Config) (*apigateway.CreateApiGatewayRequest, error) {
@@ -148,15 +150,12 @@ func getCreateApiGatewayRequest(d *schema.ResourceData, config *Config) (*apigat
 	return req, err
 }
 
-func resourceYandexApiGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexApiGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while updating Yandex Cloud API Gateway: %s", err)
+		return diag.Errorf("Error expanding labels while updating Yandex Cloud API Gateway: %s", err)
 	}
 
 	d.Partial(true)
@@ -175,7 +174,7 @@ func resourceYandexApiGatewayUpdate(d *schema.ResourceData, meta interface{}) er
 		updatePaths = append(updatePaths, "description")
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		updatePaths = append(updatePaths, "labels")
 	}
 
@@ -194,39 +193,48 @@ func resourceYandexApiGatewayUpdate(d *schema.ResourceData, meta interface{}) er
 		op, err := config.sdk.Serverless().APIGateway().ApiGateway().Update(ctx, &req)
 		err = waitOperation(ctx, config, op, err)
 		if err != nil {
-			return fmt.Errorf("Error while requesting API to update Yandex Cloud API Gateway: %s", err)
+			return errorDiagnostics("Error while requesting API to update Yandex Cloud API Gateway", err, nil)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceYandexApiGatewayRead(d, meta)
+	return resourceYandexApiGatewayRead(ctx, d, meta)
 }
 
-func resourceYandexApiGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexApiGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	req := apigateway.GetApiGatewayRequest{
 		ApiGatewayId: d.Id(),
 	}
 
 	apiGateway, err := config.sdk.Serverless().APIGateway().ApiGateway().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud API Gateway %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud API Gateway %q", d.Id())))
 	}
 
-	return flattenYandexApiGateway(d, apiGateway)
+	return diag.FromErr(flattenYandexApiGateway(d, apiGateway))
 }
 
-func resourceYandexApiGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+// resourceYandexApiGatewayImport reads OpenAPI specification, which is not returned along with the gateway.
+func resourceYandexApiGatewayImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
+	resp, err := config.sdk.Serverless().APIGateway().ApiGateway().GetOpenapiSpec(ctx, &apigateway.GetOpenapiSpecRequest{
+		ApiGatewayId: d.Id(),
+	})
+	if err != nil {
+		return nil, apiError(fmt.Sprintf("Error while requesting API to get specification of Yandex Cloud API Gateway %q", d.Id()), err, nil)
+	}
+	d.Set("spec", resp.OpenapiSpec)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceYandexApiGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
 
 	req := apigateway.DeleteApiGatewayRequest{
 		ApiGatewayId: d.Id(),
@@ -235,7 +243,7 @@ func resourceYandexApiGatewayDelete(d *schema.ResourceData, meta interface{}) er
 	op, err := config.sdk.Serverless().APIGateway().ApiGateway().Delete(ctx, &req)
 	err = waitOperation(ctx, config, op, err)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud API Gateway %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud API Gateway %q", d.Id())))
 	}
 
 	return nil
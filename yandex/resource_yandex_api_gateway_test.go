@@ -81,6 +81,7 @@ func TestAccYandexAPIGateway_basic(t *testing.T) {
 		CheckDestroy: testYandexAPIGatewayDestroy,
 		Steps: []resource.TestStep{
 			basicYandexAPIGatewayTestStep(apiGatewayName, apiGatewayDesc, labelKey, labelValue, spec, &apiGateway),
+			apiGatewayImportTestStep(),
 		},
 	})
 }
@@ -302,3 +303,11 @@ resource "yandex_api_gateway" "test-api-gateway" {
 		params.labelValue,
 		spec)
 }
+
+func apiGatewayImportTestStep() resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      apiGatewayResource,
+		ImportState:       true,
+		ImportStateVerify: true,
+	}
+}
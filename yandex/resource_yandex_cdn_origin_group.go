@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/cdn/v1"
@@ -19,10 +20,10 @@ const (
 
 func resourceYandexCDNOriginGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexCDNOriginGroupCreate,
-		Read:   resourceYandexCDNOriginGroupRead,
-		Update: resourceYandexCDNOriginGroupUpdate,
-		Delete: resourceYandexCDNOriginGroupDelete,
+		CreateContext: resourceYandexCDNOriginGroupCreate,
+		ReadContext:   resourceYandexCDNOriginGroupRead,
+		UpdateContext: resourceYandexCDNOriginGroupUpdate,
+		DeleteContext: resourceYandexCDNOriginGroupDelete,
 
 		SchemaVersion: 0,
 
@@ -138,42 +139,39 @@ func prepareCDNCreateOriginGroupRequest(d *schema.ResourceData, meta *Config) (*
 	return result, nil
 }
 
-func resourceYandexCDNOriginGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexCDNOriginGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Crating CDN Origin Group %q", d.Get("name").(string))
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	request, err := prepareCDNCreateOriginGroupRequest(d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	operation, err := config.sdk.WrapOperation(config.sdk.CDN().OriginGroup().Create(ctx, request))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create CDN Origin Group: %s", err)
+		return errorDiagnostics("error while requesting API to create CDN Origin Group", err, nil)
 	}
 
 	protoMetadata, err := operation.Metadata()
 	if err != nil {
-		return fmt.Errorf("error while obtaining response metadata for CDN Origin Group: %s", err)
+		return diag.Errorf("error while obtaining response metadata for CDN Origin Group: %s", err)
 	}
 
 	pm, ok := protoMetadata.(*cdn.CreateOriginGroupMetadata)
 	if !ok {
-		return fmt.Errorf("origin group metadata type mismatch on create")
+		return diag.Errorf("origin group metadata type mismatch on create")
 	}
 
 	d.SetId(strconv.FormatInt(pm.OriginGroupId, 10))
 
 	err = operation.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create origin group: %s", err)
+		return errorDiagnostics("error while requesting API to create origin group", err, nil)
 	}
 
-	return resourceYandexCDNOriginGroupRead(d, meta)
+	return resourceYandexCDNOriginGroupRead(ctx, d, meta)
 }
 
 func flattenYandexCDNOrigins(origins []*cdn.Origin) []map[string]interface{} {
@@ -207,14 +205,11 @@ func flattenYandexCDNOriginGroup(d *schema.ResourceData, origin *cdn.OriginGroup
 	return nil
 }
 
-func resourceYandexCDNOriginGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexCDNOriginGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Reading CDN Origin Group %q", d.Id())
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	request, err := func() (*cdn.GetOriginGroupRequest, error) {
 		folderID, err := getFolderID(d, config)
 		if err != nil {
@@ -233,17 +228,17 @@ func resourceYandexCDNOriginGroupRead(d *schema.ResourceData, meta interface{})
 	}()
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	originGroup, err := config.sdk.CDN().OriginGroup().Get(ctx, request)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("origin group %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("origin group %q", d.Id())))
 	}
 
 	log.Printf("[DEBUG] Completed Reading CDN Origin Group %q", d.Id())
 
-	return flattenYandexCDNOriginGroup(d, originGroup)
+	return diag.FromErr(flattenYandexCDNOriginGroup(d, originGroup))
 }
 
 func prepareCDNUpdateOriginGroupRequest(d *schema.ResourceData, config *Config) (*cdn.UpdateOriginGroupRequest, error) {
@@ -283,46 +278,43 @@ func prepareCDNUpdateOriginGroupRequest(d *schema.ResourceData, config *Config)
 	return result, nil
 }
 
-func resourceYandexCDNOriginGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexCDNOriginGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Updating CDN Origin Group %q", d.Id())
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	// NOTE: Update has PUT semantics
 
 	request, err := prepareCDNUpdateOriginGroupRequest(d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	operation, err := config.sdk.WrapOperation(config.sdk.CDN().OriginGroup().Update(ctx, request))
 	if err != nil {
-		return err
+		return errorDiagnostics("error while requesting API to update CDN Origin Group", err, nil)
 	}
 
 	protoMetadata, err := operation.Metadata()
 	if err != nil {
-		return fmt.Errorf("error while obtaining response metadate for CDN Origin Group update: %s", err)
+		return diag.Errorf("error while obtaining response metadate for CDN Origin Group update: %s", err)
 	}
 
 	pm, ok := protoMetadata.(*cdn.UpdateOriginGroupMetadata)
 	if !ok {
-		return fmt.Errorf("origin group metadata type mismatch on update")
+		return diag.Errorf("origin group metadata type mismatch on update")
 	}
 
 	d.SetId(strconv.FormatInt(pm.OriginGroupId, 10))
 
 	err = operation.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update CDN Origin Group: %s", err)
+		return errorDiagnostics("error while requesting API to update CDN Origin Group", err, nil)
 	}
 
 	log.Printf("[DEBUG] Completed updating CDN Origin Group %q", d.Id())
 
-	return resourceYandexCDNOriginGroupRead(d, meta)
+	return resourceYandexCDNOriginGroupRead(ctx, d, meta)
 }
 
 func prepareCDNDeleteOriginGroupRequest(d *schema.ResourceData, config *Config) (*cdn.DeleteOriginGroupRequest, error) {
@@ -344,38 +336,35 @@ func prepareCDNDeleteOriginGroupRequest(d *schema.ResourceData, config *Config)
 	return result, nil
 }
 
-func resourceYandexCDNOriginGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexCDNOriginGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting CDN Origin Group %q", d.Id())
 
 	request, err := prepareCDNDeleteOriginGroupRequest(d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	operation, err := config.sdk.WrapOperation(config.sdk.CDN().OriginGroup().Delete(ctx, request))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Origin Group ID: %d", request.OriginGroupId))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Origin Group ID: %d", request.OriginGroupId)))
 	}
 
 	protoMetadata, err := operation.Metadata()
 	if err != nil {
-		return fmt.Errorf("error while obtaining response metadata for CDN Origin Group: %s", err)
+		return diag.Errorf("error while obtaining response metadata for CDN Origin Group: %s", err)
 	}
 
 	pm, ok := protoMetadata.(*cdn.DeleteOriginGroupMetadata)
 	if !ok {
-		return fmt.Errorf("origin group metadata type mismatch on delete")
+		return diag.Errorf("origin group metadata type mismatch on delete")
 	}
 
 	log.Printf("[DEBUG] Waiting Deleting CDN Origin Group operation completion %q", d.Id())
 
 	if err = operation.Wait(ctx); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Origin Group %q: %#v", d.Id(), pm.OriginGroupId)
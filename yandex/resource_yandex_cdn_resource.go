@@ -8,6 +8,7 @@ import (
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/cdn/v1"
@@ -297,10 +298,10 @@ func validateResourceSSLCertTypeFunc() schema.SchemaValidateFunc {
 func resourceYandexCDNResource() *schema.Resource {
 	resourceSchema := defineYandexCDNResourceBaseSchema()
 
-	resourceSchema.Create = resourceYandexCDNResourceCreate
-	resourceSchema.Read = resourceYandexCDNResourceRead
-	resourceSchema.Update = resourceYandexCDNResourceUpdate
-	resourceSchema.Delete = resourceYandexCDNResourceDelete
+	resourceSchema.CreateContext = resourceYandexCDNResourceCreate
+	resourceSchema.ReadContext = resourceYandexCDNResourceRead
+	resourceSchema.UpdateContext = resourceYandexCDNResourceUpdate
+	resourceSchema.DeleteContext = resourceYandexCDNResourceDelete
 
 	resourceSchema.Importer = &schema.ResourceImporter{
 		StateContext: schema.ImportStatePassthroughContext,
@@ -698,17 +699,14 @@ func prepareCDNCreateResourceRequest(ctx context.Context, d *schema.ResourceData
 	return result, nil
 }
 
-func resourceYandexCDNResourceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexCDNResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Crating CDN Resource %q", d.Get("cname").(string))
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	request, err := prepareCDNCreateResourceRequest(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	operation, err := config.sdk.WrapOperation(
@@ -716,31 +714,31 @@ func resourceYandexCDNResourceCreate(d *schema.ResourceData, meta interface{}) e
 	)
 
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create CDN Resource: %s", err)
+		return errorDiagnostics("error while requesting API to create CDN Resource", err, nil)
 	}
 
 	protoMetadata, err := operation.Metadata()
 	if err != nil {
-		return fmt.Errorf("error while obtaining response metadata for create CDN Resource operation: %s", err)
+		return diag.Errorf("error while obtaining response metadata for create CDN Resource operation: %s", err)
 	}
 
 	pm, ok := protoMetadata.(*cdn.CreateResourceMetadata)
 	if !ok {
-		return fmt.Errorf("resource metadata type mismatch")
+		return diag.Errorf("resource metadata type mismatch")
 	}
 
 	d.SetId(pm.ResourceId)
 
 	err = operation.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create CDN Resource: %s", err)
+		return errorDiagnostics("error while requesting API to create CDN Resource", err, nil)
 	}
 
 	if _, err = operation.Response(); err != nil {
-		return err
+		return errorDiagnostics("error while requesting API to create CDN Resource", err, nil)
 	}
 
-	return resourceYandexCDNResourceRead(d, meta)
+	return resourceYandexCDNResourceRead(ctx, d, meta)
 }
 
 func flattenYandexCDNResourceOptions(options *cdn.ResourceOptions) []map[string]interface{} {
@@ -959,29 +957,26 @@ func flattenYandexCDNResourceSSLCertificate(d *schema.ResourceData, cert *cdn.SS
 	return d.Set("ssl_certificate", []interface{}{result})
 }
 
-func resourceYandexCDNResourceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexCDNResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Reading CDN Resource: %q", d.Id())
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	resource, err := config.sdk.CDN().Resource().Get(ctx, &cdn.GetResourceRequest{
 		ResourceId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("cdn resource %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("cdn resource %q", d.Id())))
 	}
 
 	log.Printf("[DEBUG] Completed Reading CDN Resource %q", d.Id())
 
 	if err = flattenYandexCDNResource(d, resource); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("options", flattenYandexCDNResourceOptions(resource.Options)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -1048,58 +1043,52 @@ func prepareCDNUpdateResourceRequest(ctx context.Context, d *schema.ResourceData
 	return request, nil
 }
 
-func resourceYandexCDNResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexCDNResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Updating CDN Resource %q", d.Id())
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	request, err := prepareCDNUpdateResourceRequest(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	operation, err := config.sdk.WrapOperation(config.sdk.CDN().Resource().Update(ctx, request))
 	if err != nil {
-		return err
+		return errorDiagnostics("error while requesting API to update CDN Resource", err, nil)
 	}
 
 	protoMetadata, err := operation.Metadata()
 	if err != nil {
-		return fmt.Errorf("error while obtaining response metadate for CDN Resource update: %s", err)
+		return diag.Errorf("error while obtaining response metadate for CDN Resource update: %s", err)
 	}
 
 	pm, ok := protoMetadata.(*cdn.UpdateResourceMetadata)
 	if !ok {
-		return fmt.Errorf("cdn resource metadata type mismatch")
+		return diag.Errorf("cdn resource metadata type mismatch")
 	}
 
 	d.SetId(pm.ResourceId)
 
 	err = operation.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update CDN Resource: %s", err)
+		return errorDiagnostics("error while requesting API to update CDN Resource", err, nil)
 	}
 
 	if _, err := operation.Response(); err != nil {
-		return err
+		return errorDiagnostics("error while requesting API to update CDN Resource", err, nil)
 	}
 
 	log.Printf("[DEBUG] Completed updating CDN Resource %q", d.Id())
 
-	return resourceYandexCDNResourceRead(d, meta)
+	return resourceYandexCDNResourceRead(ctx, d, meta)
 }
 
-func resourceYandexCDNResourceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexCDNResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting CDN Resource %q", d.Id())
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	operation, err := config.sdk.WrapOperation(
 		config.sdk.CDN().Resource().Delete(ctx, &cdn.DeleteResourceRequest{
 			ResourceId: d.Id(),
@@ -1107,27 +1096,27 @@ func resourceYandexCDNResourceDelete(d *schema.ResourceData, meta interface{}) e
 	)
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("CDN Resource ID: %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("CDN Resource ID: %q", d.Id())))
 	}
 
 	protoMetadata, err := operation.Metadata()
 	if err != nil {
-		return fmt.Errorf("error while obtaining response metadata for CDN Resource: %s", err)
+		return diag.Errorf("error while obtaining response metadata for CDN Resource: %s", err)
 	}
 
 	pm, ok := protoMetadata.(*cdn.DeleteResourceMetadata)
 	if !ok {
-		return fmt.Errorf("resource metadata type mismatch")
+		return diag.Errorf("resource metadata type mismatch")
 	}
 
 	log.Printf("[DEBUG] Waiting Deleting of CDN Resource operation completion %q", d.Id())
 
 	if err = operation.Wait(ctx); err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting CDN Resource %q", d.Id()), err, nil)
 	}
 
 	if _, err := operation.Response(); err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting CDN Resource %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting of CDN Resource %q: %#v", d.Id(), pm.ResourceId)
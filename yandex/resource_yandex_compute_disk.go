@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -21,12 +22,12 @@ const (
 
 func resourceYandexComputeDisk() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexComputeDiskCreate,
-		Read:   resourceYandexComputeDiskRead,
-		Update: resourceYandexComputeDiskUpdate,
-		Delete: resourceYandexComputeDiskDelete,
+		CreateContext: resourceYandexComputeDiskCreate,
+		ReadContext:   resourceYandexComputeDiskRead,
+		UpdateContext: resourceYandexComputeDiskUpdate,
+		DeleteContext: resourceYandexComputeDiskDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		CustomizeDiff: customdiff.ForceNewIfChange("size", isDiskSizeDecreased),
@@ -166,27 +167,27 @@ func flattenDiskPlacementPolicy(disk *compute.Disk) ([]map[string]interface{}, e
 	return diskPlacementPolicy, nil
 }
 
-func resourceYandexComputeDiskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeDiskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	zone, err := getZone(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting zone while creating disk: %s", err)
+		return errorDiagnostics("Error getting zone while creating disk", err, nil)
 	}
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating disk: %s", err)
+		return diag.Errorf("Error getting folder ID while creating disk: %s", err)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating disk: %s", err)
+		return diag.Errorf("Error expanding labels while creating disk: %s", err)
 	}
 
 	diskPlacementPolicy, err := expandDiskPlacementPolicy(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding disk placement policy while creating disk: %s", err)
+		return diag.Errorf("Error expanding disk placement policy while creating disk: %s", err)
 	}
 
 	req := compute.CreateDiskRequest{
@@ -211,52 +212,49 @@ func resourceYandexComputeDiskCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Disk().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create disk: %s", err)
+		return errorDiagnostics("Error while requesting API to create disk", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get disk create operation metadata: %s", err)
+		return diag.Errorf("Error while get disk create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*compute.CreateDiskMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Disk ID from create operation metadata")
+		return diag.Errorf("could not get Disk ID from create operation metadata")
 	}
 
 	d.SetId(md.DiskId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create disk: %s", err)
+		return errorDiagnostics("Error while waiting operation to create disk", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Disk creation failed: %s", err)
+		return errorDiagnostics("Disk creation failed", err, nil)
 	}
 
-	return resourceYandexComputeDiskRead(d, meta)
+	return resourceYandexComputeDiskRead(ctx, d, meta)
 }
 
-func resourceYandexComputeDiskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeDiskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	disk, err := config.sdk.Compute().Disk().Get(config.Context(), &compute.GetDiskRequest{
+	disk, err := config.sdk.Compute().Disk().Get(ctx, &compute.GetDiskRequest{
 		DiskId: d.Id(),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Disk %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Disk %q", d.Get("name").(string))))
 	}
 
 	diskPlacementPolicy, err := flattenDiskPlacementPolicy(disk)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("created_at", getTimestamp(disk.CreatedAt))
@@ -273,13 +271,13 @@ func resourceYandexComputeDiskRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("disk_placement_policy", diskPlacementPolicy)
 
 	if err := d.Set("product_ids", disk.ProductIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("labels", disk.Labels)
+	return diag.FromErr(d.Set("labels", disk.Labels))
 }
 
-func resourceYandexComputeDiskUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeDiskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.Partial(true)
 
 	folderPropName := "folder_id"
@@ -290,24 +288,24 @@ func resourceYandexComputeDiskUpdate(d *schema.ResourceData, meta interface{}) e
 				DestinationFolderId: d.Get(folderPropName).(string),
 			}
 
-			if err := makeDiskMoveRequest(req, d, meta); err != nil {
-				return err
+			if err := makeDiskMoveRequest(ctx, req, d, meta); err != nil {
+				return diagnosticsFromError(err)
 			}
 		} else {
-			if err := resourceYandexComputeDiskDelete(d, meta); err != nil {
-				return err
+			if diags := resourceYandexComputeDiskDelete(ctx, d, meta); diags.HasError() {
+				return diags
 			}
-			if err := resourceYandexComputeDiskCreate(d, meta); err != nil {
-				return err
+			if diags := resourceYandexComputeDiskCreate(ctx, d, meta); diags.HasError() {
+				return diags
 			}
 		}
 	}
 
 	labelPropName := "labels"
-	if d.HasChange(labelPropName) {
+	if hasChangeWithDefaultLabels(d, labelPropName) {
 		labelsProp, err := expandLabels(d.Get(labelPropName))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req := &compute.UpdateDiskRequest{
@@ -318,9 +316,9 @@ func resourceYandexComputeDiskUpdate(d *schema.ResourceData, meta interface{}) e
 			},
 		}
 
-		err = makeDiskUpdateRequest(req, d, meta)
+		err = makeDiskUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
@@ -335,9 +333,9 @@ func resourceYandexComputeDiskUpdate(d *schema.ResourceData, meta interface{}) e
 			},
 		}
 
-		err := makeDiskUpdateRequest(req, d, meta)
+		err := makeDiskUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
@@ -352,9 +350,9 @@ func resourceYandexComputeDiskUpdate(d *schema.ResourceData, meta interface{}) e
 			},
 		}
 
-		err := makeDiskUpdateRequest(req, d, meta)
+		err := makeDiskUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
@@ -371,9 +369,9 @@ func resourceYandexComputeDiskUpdate(d *schema.ResourceData, meta interface{}) e
 			},
 		}
 
-		err := makeDiskUpdateRequest(req, d, meta)
+		err := makeDiskUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
@@ -388,26 +386,26 @@ func resourceYandexComputeDiskUpdate(d *schema.ResourceData, meta interface{}) e
 			},
 		}
 
-		err := makeDiskUpdateRequest(req, d, meta)
+		err := makeDiskUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceYandexComputeDiskRead(d, meta)
+	return resourceYandexComputeDiskRead(ctx, d, meta)
 }
 
-func resourceYandexComputeDiskDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeDiskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	disk, err := config.sdk.Compute().Disk().Get(config.Context(), &compute.GetDiskRequest{
+	disk, err := config.sdk.Compute().Disk().Get(ctx, &compute.GetDiskRequest{
 		DiskId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Disk %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Disk %q", d.Get("name").(string))))
 	}
 
 	for _, instanceID := range disk.GetInstanceIds() {
@@ -417,8 +415,8 @@ func resourceYandexComputeDiskDelete(d *schema.ResourceData, meta interface{}) e
 				DiskId: disk.Id,
 			},
 		}
-		if err := makeDetachDiskRequest(req, meta); err != nil {
-			return err
+		if err := makeDetachDiskRequest(ctx, req, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 		log.Printf("[DEBUG] Successfully detached disk %s from instance %s", disk.Id, instanceID)
 	}
@@ -429,37 +427,31 @@ func resourceYandexComputeDiskDelete(d *schema.ResourceData, meta interface{}) e
 		DiskId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Disk().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Disk %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Disk %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Disk %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Disk %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Disk %q", d.Id())
 	return nil
 }
 
-func makeDiskUpdateRequest(req *compute.UpdateDiskRequest, d *schema.ResourceData, meta interface{}) error {
+func makeDiskUpdateRequest(ctx context.Context, req *compute.UpdateDiskRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Disk().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Disk %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update Disk %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -470,15 +462,15 @@ func makeDiskUpdateRequest(req *compute.UpdateDiskRequest, d *schema.ResourceDat
 	return nil
 }
 
-func makeDiskMoveRequest(req *compute.MoveDiskRequest, d *schema.ResourceData, meta interface{}) error {
+func makeDiskMoveRequest(ctx context.Context, req *compute.MoveDiskRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), yandexComputeDiskMoveTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexComputeDiskMoveTimeout)
 	defer cancel()
 
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Disk().Move(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to move Disk %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to move Disk %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -15,12 +16,12 @@ const yandexComputeDiskPlacementGroupDefaultTimeout = 1 * time.Minute
 
 func resourceYandexComputeDiskPlacementGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexComputeDiskPlacementGroupCreate,
-		Read:   resourceYandexComputeDiskPlacementGroupRead,
-		Update: resourceYandexComputeDiskPlacementGroupUpdate,
-		Delete: resourceYandexComputeDiskPlacementGroupDelete,
+		CreateContext: resourceYandexComputeDiskPlacementGroupCreate,
+		ReadContext:   resourceYandexComputeDiskPlacementGroupRead,
+		UpdateContext: resourceYandexComputeDiskPlacementGroupUpdate,
+		DeleteContext: resourceYandexComputeDiskPlacementGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -76,17 +77,17 @@ func resourceYandexComputeDiskPlacementGroup() *schema.Resource {
 	}
 }
 
-func resourceYandexComputeDiskPlacementGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeDiskPlacementGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating Disk Placement Group: %s", err)
+		return diag.Errorf("Error getting folder ID while creating Disk Placement Group: %s", err)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating Disk Placement Group: %s", err)
+		return diag.Errorf("Error expanding labels while creating Disk Placement Group: %s", err)
 	}
 
 	req := compute.CreateDiskPlacementGroupRequest{
@@ -100,48 +101,45 @@ func resourceYandexComputeDiskPlacementGroupCreate(d *schema.ResourceData, meta
 		ZoneId: d.Get("zone").(string),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().DiskPlacementGroup().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Disk Placement Group: %s", err)
+		return errorDiagnostics("Error while requesting API to create Disk Placement Group", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get Disk Placement Group create operation metadata: %s", err)
+		return diag.Errorf("Error while get Disk Placement Group create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*compute.CreateDiskPlacementGroupMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Disk Placement Group ID from create operation metadata")
+		return diag.Errorf("could not get Disk Placement Group ID from create operation metadata")
 	}
 
 	d.SetId(md.GetDiskPlacementGroupId())
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create Disk Placement Group: %s", err)
+		return errorDiagnostics("Error while waiting operation to create Disk Placement Group", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Disk Placement Group creation failed: %s", err)
+		return errorDiagnostics("Disk Placement Group creation failed", err, nil)
 	}
 
-	return resourceYandexComputeDiskPlacementGroupRead(d, meta)
+	return resourceYandexComputeDiskPlacementGroupRead(ctx, d, meta)
 }
 
-func resourceYandexComputeDiskPlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeDiskPlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	placementGroup, err := config.sdk.Compute().DiskPlacementGroup().Get(context.Background(),
+	placementGroup, err := config.sdk.Compute().DiskPlacementGroup().Get(ctx,
 		&compute.GetDiskPlacementGroupRequest{
 			DiskPlacementGroupId: d.Id(),
 		})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Disk Placement Group %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Disk Placement Group %q", d.Id())))
 	}
 
 	d.Set("created_at", getTimestamp(placementGroup.CreatedAt))
@@ -151,19 +149,19 @@ func resourceYandexComputeDiskPlacementGroupRead(d *schema.ResourceData, meta in
 	d.Set("zone", placementGroup.ZoneId)
 	d.Set("status", placementGroup.Status.String())
 
-	return d.Set("labels", placementGroup.Labels)
+	return diag.FromErr(d.Set("labels", placementGroup.Labels))
 }
 
-func resourceYandexComputeDiskPlacementGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeDiskPlacementGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	req := &compute.UpdateDiskPlacementGroupRequest{
 		DiskPlacementGroupId: d.Id(),
 		UpdateMask:           &field_mask.FieldMask{},
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req.Labels = labelsProp
@@ -181,18 +179,18 @@ func resourceYandexComputeDiskPlacementGroupUpdate(d *schema.ResourceData, meta
 	}
 
 	if len(req.UpdateMask.Paths) == 0 {
-		return fmt.Errorf("No fields were updated for Disk Placement Group %s", d.Id())
+		return diag.Errorf("No fields were updated for Disk Placement Group %s", d.Id())
 	}
 
-	err := makeDiskPlacementGroupUpdateRequest(req, d, meta)
+	err := makeDiskPlacementGroupUpdateRequest(ctx, req, d, meta)
 	if err != nil {
-		return err
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexComputeDiskPlacementGroupRead(d, meta)
+	return resourceYandexComputeDiskPlacementGroupRead(ctx, d, meta)
 }
 
-func resourceYandexComputeDiskPlacementGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeDiskPlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Disk Placement Group %q", d.Id())
@@ -201,37 +199,31 @@ func resourceYandexComputeDiskPlacementGroupDelete(d *schema.ResourceData, meta
 		DiskPlacementGroupId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().DiskPlacementGroup().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Disk Placement Group %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Disk Placement Group %q", d.Id())))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Disk Placement Group %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Disk Placement Group %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Disk Placement Group %q", d.Id())
 	return nil
 }
 
-func makeDiskPlacementGroupUpdateRequest(req *compute.UpdateDiskPlacementGroupRequest, d *schema.ResourceData, meta interface{}) error {
+func makeDiskPlacementGroupUpdateRequest(ctx context.Context, req *compute.UpdateDiskPlacementGroupRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().DiskPlacementGroup().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Disk Placement Group %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update Disk Placement Group %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
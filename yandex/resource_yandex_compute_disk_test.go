@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
 )
@@ -473,3 +474,36 @@ resource "yandex_vpc_subnet" "bar-subnet" {
 }
 `, diskName, instanceName)
 }
+
+func TestComputeDisk_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	r := fakeCloudResource(t, "yandex_compute_disk")
+	raw := map[string]interface{}{
+		"name": "disk",
+		"size": 10,
+	}
+
+	state := fakeCloudApply(t, config, r, nil, raw)
+	assert.Equal(t, "10", state.Attributes["size"])
+	assert.Equal(t, testConfigZone, state.Attributes["zone"])
+	assert.Equal(t, "network-hdd", state.Attributes["type"])
+	assert.Equal(t, "ready", state.Attributes["status"])
+
+	imported := fakeCloudImport(t, config, r, state.ID)
+	assert.Equal(t, state.Attributes["size"], imported.Attributes["size"])
+	assert.Equal(t, state.Attributes["name"], imported.Attributes["name"])
+
+	// Disk grows in place, but must be recreated to shrink.
+	raw["size"] = 20
+	grown := fakeCloudApply(t, config, r, state, raw)
+	assert.Equal(t, state.ID, grown.ID)
+	assert.Equal(t, "20", grown.Attributes["size"])
+
+	raw["size"] = 15
+	shrunk := fakeCloudApply(t, config, r, grown, raw)
+	assert.NotEqual(t, grown.ID, shrunk.ID)
+	assert.Equal(t, "15", shrunk.Attributes["size"])
+
+	fakeCloudDestroy(t, config, r, shrunk)
+	assert.Nil(t, fakeCloudRefresh(t, config, r, shrunk))
+}
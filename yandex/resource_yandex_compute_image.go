@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -18,12 +19,12 @@ const StandardImagesFolderID = "standard-images"
 
 func resourceYandexComputeImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexComputeImageCreate,
-		Read:   resourceYandexComputeImageRead,
-		Update: resourceYandexComputeImageUpdate,
-		Delete: resourceYandexComputeImageDelete,
+		CreateContext: resourceYandexComputeImageCreate,
+		ReadContext:   resourceYandexComputeImageRead,
+		UpdateContext: resourceYandexComputeImageUpdate,
+		DeleteContext: resourceYandexComputeImageDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -153,22 +154,22 @@ func resourceYandexComputeImage() *schema.Resource {
 
 }
 
-func resourceYandexComputeImageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating image: %s", err)
+		return diag.Errorf("Error getting folder ID while creating image: %s", err)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating image: %s", err)
+		return diag.Errorf("Error expanding labels while creating image: %s", err)
 	}
 
 	productIds, err := expandProductIds(d.Get("product_ids"))
 	if err != nil {
-		return fmt.Errorf("Error expanding product IDs while creating image: %s", err)
+		return diag.Errorf("Error expanding product IDs while creating image: %s", err)
 	}
 
 	osTypeName := strings.ToUpper(d.Get("os_type").(string))
@@ -188,52 +189,49 @@ func resourceYandexComputeImageCreate(d *schema.ResourceData, meta interface{})
 		},
 	}
 
-	err = prepareSourceForImage(&req, d, meta)
+	err = prepareSourceForImage(ctx, &req, d, meta)
 	if err != nil {
-		return fmt.Errorf("Error while prepare request to create image: %s", err)
+		return diag.Errorf("Error while prepare request to create image: %s", err)
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Image().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create image: %s", err)
+		return errorDiagnostics("Error while requesting API to create image", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get image create operation metadata: %s", err)
+		return diag.Errorf("Error while get image create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*compute.CreateImageMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Image ID from create operation metadata")
+		return diag.Errorf("could not get Image ID from create operation metadata")
 	}
 
 	d.SetId(md.ImageId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create image: %s", err)
+		return errorDiagnostics("Error while waiting operation to create image", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Image creation failed: %s", err)
+		return errorDiagnostics("Image creation failed", err, nil)
 	}
 
-	return resourceYandexComputeImageRead(d, meta)
+	return resourceYandexComputeImageRead(ctx, d, meta)
 }
 
-func resourceYandexComputeImageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	image, err := config.sdk.Compute().Image().Get(config.Context(), &compute.GetImageRequest{
+	image, err := config.sdk.Compute().Image().Get(ctx, &compute.GetImageRequest{
 		ImageId: d.Id(),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Image %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Image %q", d.Get("name").(string))))
 	}
 
 	d.Set("created_at", getTimestamp(image.CreatedAt))
@@ -247,20 +245,20 @@ func resourceYandexComputeImageRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("pooled", image.Pooled)
 
 	if err := d.Set("labels", image.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("product_ids", image.ProductIds)
+	return diag.FromErr(d.Set("product_ids", image.ProductIds))
 }
 
-func resourceYandexComputeImageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.Partial(true)
 
 	labelPropName := "labels"
-	if d.HasChange(labelPropName) {
+	if hasChangeWithDefaultLabels(d, labelPropName) {
 		labelsProp, err := expandLabels(d.Get(labelPropName))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req := &compute.UpdateImageRequest{
@@ -271,9 +269,9 @@ func resourceYandexComputeImageUpdate(d *schema.ResourceData, meta interface{})
 			},
 		}
 
-		err = makeImageUpdateRequest(req, d, meta)
+		err = makeImageUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
@@ -288,9 +286,9 @@ func resourceYandexComputeImageUpdate(d *schema.ResourceData, meta interface{})
 			},
 		}
 
-		err := makeImageUpdateRequest(req, d, meta)
+		err := makeImageUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
@@ -305,9 +303,9 @@ func resourceYandexComputeImageUpdate(d *schema.ResourceData, meta interface{})
 			},
 		}
 
-		err := makeImageUpdateRequest(req, d, meta)
+		err := makeImageUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
@@ -322,19 +320,19 @@ func resourceYandexComputeImageUpdate(d *schema.ResourceData, meta interface{})
 			},
 		}
 
-		err := makeImageUpdateRequest(req, d, meta)
+		err := makeImageUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceYandexComputeImageRead(d, meta)
+	return resourceYandexComputeImageRead(ctx, d, meta)
 }
 
-func resourceYandexComputeImageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Image %q", d.Id())
@@ -343,29 +341,26 @@ func resourceYandexComputeImageDelete(d *schema.ResourceData, meta interface{})
 		ImageId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Image().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Image %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Image %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Image %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Image %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Image %q", d.Id())
 	return nil
 }
 
-func prepareSourceForImage(req *compute.CreateImageRequest, d *schema.ResourceData, meta interface{}) error {
+func prepareSourceForImage(ctx context.Context, req *compute.CreateImageRequest, d *schema.ResourceData, meta interface{}) error {
 	sourceAttrs := []string{"source_family", "source_disk", "source_image", "source_snapshot", "source_url"}
 	var selectedSourceAttr string
 	var selectedSourceValue string
@@ -385,12 +380,8 @@ func prepareSourceForImage(req *compute.CreateImageRequest, d *schema.ResourceDa
 	switch selectedSourceAttr {
 	case "source_family":
 		config := meta.(*Config)
-		ctx := config.Context()
 		familyName := d.Get("source_family").(string)
-		img, err := config.sdk.Compute().Image().GetLatestByFamily(ctx, &compute.GetImageLatestByFamilyRequest{
-			FolderId: StandardImagesFolderID,
-			Family:   familyName,
-		})
+		img, err := getLatestImageByFamily(ctx, config, StandardImagesFolderID, familyName)
 		if err != nil {
 			return fmt.Errorf("failed to find image with family \"%s\": %s", familyName, err)
 		}
@@ -421,15 +412,12 @@ func prepareSourceForImage(req *compute.CreateImageRequest, d *schema.ResourceDa
 	return nil
 }
 
-func makeImageUpdateRequest(req *compute.UpdateImageRequest, d *schema.ResourceData, meta interface{}) error {
+func makeImageUpdateRequest(ctx context.Context, req *compute.UpdateImageRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Image().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Image %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update Image %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/hashstructure"
@@ -26,12 +27,12 @@ const (
 
 func resourceYandexComputeInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexComputeInstanceCreate,
-		Read:   resourceYandexComputeInstanceRead,
-		Update: resourceYandexComputeInstanceUpdate,
-		Delete: resourceYandexComputeInstanceDelete,
+		CreateContext: resourceYandexComputeInstanceCreate,
+		ReadContext:   resourceYandexComputeInstanceRead,
+		UpdateContext: resourceYandexComputeInstanceUpdate,
+		DeleteContext: resourceYandexComputeInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -533,89 +534,83 @@ func resourceYandexComputeInstance() *schema.Resource {
 	}
 }
 
-func resourceYandexComputeInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	req, err := prepareCreateInstanceRequest(d, config)
+	req, err := prepareCreateInstanceRequest(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Instance().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create instance: %s", err)
+		return errorDiagnostics("Error while requesting API to create instance", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get instance create operation metadata: %s", err)
+		return diag.Errorf("Error while get instance create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*compute.CreateInstanceMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Instance ID from create operation metadata")
+		return diag.Errorf("could not get Instance ID from create operation metadata")
 	}
 
 	d.SetId(md.InstanceId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create instance: %s", err)
+		return errorDiagnostics("Error while waiting operation to create instance", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Instance creation failed: %s", err)
+		return errorDiagnostics("Instance creation failed", err, nil)
 	}
 
-	return resourceYandexComputeInstanceRead(d, meta)
+	return resourceYandexComputeInstanceRead(ctx, d, meta)
 }
 
-func resourceYandexComputeInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	instance, err := config.sdk.Compute().Instance().Get(ctx, &compute.GetInstanceRequest{
 		InstanceId: d.Id(),
 		View:       compute.InstanceView_FULL,
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Instance %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Instance %q", d.Get("name").(string))))
 	}
 
 	resources, err := flattenInstanceResources(instance)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bootDisk, err := flattenInstanceBootDisk(ctx, instance, config.sdk.Compute().Disk())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	secondaryDisks, err := flattenInstanceSecondaryDisks(instance)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	schedulingPolicy, err := flattenInstanceSchedulingPolicy(instance)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	placementPolicy, err := flattenInstancePlacementPolicy(instance)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	networkInterfaces, externalIP, internalIP, err := flattenInstanceNetworkInterfaces(instance)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	localDisks := flattenLocalDisks(instance)
@@ -632,40 +627,40 @@ func resourceYandexComputeInstanceRead(d *schema.ResourceData, meta interface{})
 
 	hostname, err := parseHostnameFromFQDN(instance.Fqdn)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("hostname", hostname)
 
 	if err := d.Set("metadata", instance.Metadata); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("labels", instance.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("resources", resources); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("boot_disk", bootDisk); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("secondary_disk", secondaryDisks); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("scheduling_policy", schedulingPolicy); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("placement_policy", placementPolicy); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("local_disk", localDisks); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance.NetworkSettings != nil {
@@ -673,7 +668,7 @@ func resourceYandexComputeInstanceRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if err := d.Set("network_interface", networkInterfaces); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	connIP := externalIP
@@ -691,17 +686,15 @@ func resourceYandexComputeInstanceRead(d *schema.ResourceData, meta interface{})
 
 // revive:enable:var-naming
 
-func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx := config.Context()
-
 	instance, err := config.sdk.Compute().Instance().Get(ctx, &compute.GetInstanceRequest{
 		InstanceId: d.Id(),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Instance %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Instance %q", d.Get("name").(string))))
 	}
 
 	d.Partial(true)
@@ -710,12 +703,12 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange(folderPropName) {
 		if !d.Get("allow_recreate").(bool) {
 			if err := ensureAllowStoppingForUpdate(d, folderPropName); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			if instance.Status != compute.Instance_STOPPED {
-				if err := makeInstanceActionRequest(instanceActionStop, d, meta); err != nil {
-					return err
+				if err := makeInstanceActionRequest(ctx, instanceActionStop, d, meta); err != nil {
+					return diag.FromErr(err)
 				}
 			}
 
@@ -724,29 +717,29 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 				DestinationFolderId: d.Get(folderPropName).(string),
 			}
 
-			if err := makeInstanceMoveRequest(req, d, meta); err != nil {
-				return err
+			if err := makeInstanceMoveRequest(ctx, req, d, meta); err != nil {
+				return diagnosticsFromError(err)
 			}
 
-			if err := makeInstanceActionRequest(instanceActionStart, d, meta); err != nil {
-				return err
+			if err := makeInstanceActionRequest(ctx, instanceActionStart, d, meta); err != nil {
+				return diag.FromErr(err)
 			}
 
 		} else {
-			if err := resourceYandexComputeInstanceDelete(d, meta); err != nil {
-				return err
+			if diags := resourceYandexComputeInstanceDelete(ctx, d, meta); diags.HasError() {
+				return diags
 			}
-			if err := resourceYandexComputeInstanceCreate(d, meta); err != nil {
-				return err
+			if diags := resourceYandexComputeInstanceCreate(ctx, d, meta); diags.HasError() {
+				return diags
 			}
 		}
 	}
 
 	labelPropName := "labels"
-	if d.HasChange(labelPropName) {
+	if hasChangeWithDefaultLabels(d, labelPropName) {
 		labelsProp, err := expandLabels(d.Get(labelPropName))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req := &compute.UpdateInstanceRequest{
@@ -757,9 +750,9 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 			},
 		}
 
-		err = makeInstanceUpdateRequest(req, d, meta)
+		err = makeInstanceUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
@@ -768,7 +761,7 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange(metadataPropName) {
 		metadataProp, err := expandLabels(d.Get(metadataPropName))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req := &compute.UpdateInstanceRequest{
@@ -779,9 +772,9 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 			},
 		}
 
-		err = makeInstanceUpdateRequest(req, d, meta)
+		err = makeInstanceUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
@@ -796,9 +789,9 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 			},
 		}
 
-		err := makeInstanceUpdateRequest(req, d, meta)
+		err := makeInstanceUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
@@ -813,9 +806,9 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 			},
 		}
 
-		err := makeInstanceUpdateRequest(req, d, meta)
+		err := makeInstanceUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
@@ -830,9 +823,9 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 			},
 		}
 
-		err := makeInstanceUpdateRequest(req, d, meta)
+		err := makeInstanceUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
@@ -848,7 +841,7 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 		newList := n.([]interface{})
 
 		if len(oldList) != len(newList) {
-			return fmt.Errorf("Changing count of network interfaces is't supported yet")
+			return diag.Errorf("Changing count of network interfaces is't supported yet")
 		}
 
 		for ifaceIndex := 0; ifaceIndex < len(oldList); ifaceIndex++ {
@@ -865,19 +858,19 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 
 			oldV4Spec, err := expandPrimaryV4AddressSpec(oldIface)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			oldV6Spec, err := expandPrimaryV6AddressSpec(oldIface)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			newV4Spec, err := expandPrimaryV4AddressSpec(newIface)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			newV6Spec, err := expandPrimaryV6AddressSpec(newIface)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			if oldIface["subnet_id"].(string) != newIface["subnet_id"].(string) {
@@ -943,21 +936,21 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 
 		if !needUpdateInterfacesOnStoppedInstance && (len(removeNatRequests) > 0 || len(addNatRequests) > 0 || len(updateInterfaceRequests) > 0) {
 			for _, req := range removeNatRequests {
-				err := makeInstanceRemoveOneToOneNatRequest(req, d, meta)
+				err := makeInstanceRemoveOneToOneNatRequest(ctx, req, d, meta)
 				if err != nil {
-					return err
+					return diagnosticsFromError(err)
 				}
 			}
 			for _, req := range addNatRequests {
-				err := makeInstanceAddOneToOneNatRequest(req, d, meta)
+				err := makeInstanceAddOneToOneNatRequest(ctx, req, d, meta)
 				if err != nil {
-					return err
+					return diagnosticsFromError(err)
 				}
 			}
 			for _, req := range updateInterfaceRequests {
-				err := makeInstanceUpdateNetworkInterfaceRequest(req, d, meta)
+				err := makeInstanceUpdateNetworkInterfaceRequest(ctx, req, d, meta)
 				if err != nil {
-					return err
+					return diagnosticsFromError(err)
 				}
 			}
 
@@ -984,11 +977,11 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 			diskConfig := disk.(map[string]interface{})
 			diskSpec, err := expandSecondaryDiskSpec(diskConfig)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			hash, err := hashstructure.Hash(diskSpec, nil)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			if _, ok := currDisks[diskSpec.GetDiskId()]; ok {
 				oDisks[hash] = diskSpec.GetDiskId()
@@ -1005,11 +998,11 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 			diskConfig := disk.(map[string]interface{})
 			diskSpec, err := expandSecondaryDiskSpec(diskConfig)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			hash, err := hashstructure.Hash(diskSpec, nil)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			nDisks[hash] = struct{}{}
 
@@ -1029,9 +1022,9 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 					},
 				}
 
-				err = makeDetachDiskRequest(req, meta)
+				err = makeDetachDiskRequest(ctx, req, meta)
 				if err != nil {
-					return err
+					return diagnosticsFromError(err)
 				}
 				log.Printf("[DEBUG] Successfully detached disk %s", deviceID)
 			}
@@ -1044,9 +1037,9 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 				AttachedDiskSpec: diskSpec,
 			}
 
-			err := makeAttachDiskRequest(req, meta)
+			err := makeAttachDiskRequest(ctx, req, meta)
 			if err != nil {
-				return err
+				return diagnosticsFromError(err)
 			}
 			log.Printf("[DEBUG] Successfully attached disk %s", diskSpec.GetDiskId())
 		}
@@ -1067,10 +1060,10 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange(resourcesPropName) || d.HasChange(platformIDPropName) || d.HasChange(networkAccelerationTypePropName) ||
 		needUpdateInterfacesOnStoppedInstance || d.HasChange(schedulingPolicyName) || d.HasChange(placementPolicyPropName) {
 		if err := ensureAllowStoppingForUpdate(d, properties...); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		if err := makeInstanceActionRequest(instanceActionStop, d, meta); err != nil {
-			return err
+		if err := makeInstanceActionRequest(ctx, instanceActionStop, d, meta); err != nil {
+			return diag.FromErr(err)
 		}
 
 		instanceStoppedAt := time.Now()
@@ -1088,7 +1081,7 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 			if d.HasChange(resourcesPropName) {
 				spec, err := expandInstanceResourcesSpec(d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 
 				req.ResourcesSpec = spec
@@ -1103,7 +1096,7 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 			if d.HasChange(networkAccelerationTypePropName) {
 				networkSettings, err := expandInstanceNetworkSettingsSpecs(d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 
 				req.NetworkSettings = networkSettings
@@ -1113,7 +1106,7 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 			if d.HasChange(schedulingPolicyName) {
 				schedulingPolicy, err := expandInstanceSchedulingPolicy(d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 
 				req.SchedulingPolicy = schedulingPolicy
@@ -1126,9 +1119,9 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 				req.UpdateMask.Paths = append(req.UpdateMask.Paths, paths...)
 			}
 
-			err = makeInstanceUpdateRequest(req, d, meta)
+			err = makeInstanceUpdateRequest(ctx, req, d, meta)
 			if err != nil {
-				return err
+				return diagnosticsFromError(err)
 			}
 		}
 
@@ -1142,37 +1135,37 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 				time.Sleep(sleepTime)
 			}
 			for _, req := range removeNatRequests {
-				err := makeInstanceRemoveOneToOneNatRequest(req, d, meta)
+				err := makeInstanceRemoveOneToOneNatRequest(ctx, req, d, meta)
 				if err != nil {
-					return err
+					return diagnosticsFromError(err)
 				}
 			}
 			for _, req := range addNatRequests {
-				err := makeInstanceAddOneToOneNatRequest(req, d, meta)
+				err := makeInstanceAddOneToOneNatRequest(ctx, req, d, meta)
 				if err != nil {
-					return err
+					return diagnosticsFromError(err)
 				}
 			}
 			for _, req := range updateInterfaceRequests {
-				err := makeInstanceUpdateNetworkInterfaceRequest(req, d, meta)
+				err := makeInstanceUpdateNetworkInterfaceRequest(ctx, req, d, meta)
 				if err != nil {
-					return err
+					return diagnosticsFromError(err)
 				}
 			}
 
 		}
 
-		if err := makeInstanceActionRequest(instanceActionStart, d, meta); err != nil {
-			return err
+		if err := makeInstanceActionRequest(ctx, instanceActionStart, d, meta); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceYandexComputeInstanceRead(d, meta)
+	return resourceYandexComputeInstanceRead(ctx, d, meta)
 }
 
-func resourceYandexComputeInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Instance %q", d.Id())
@@ -1181,29 +1174,26 @@ func resourceYandexComputeInstanceDelete(d *schema.ResourceData, meta interface{
 		InstanceId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Instance().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Instance %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Instance %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Instance %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Instance %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Instance %q", d.Id())
 	return nil
 }
 
-func prepareCreateInstanceRequest(d *schema.ResourceData, meta *Config) (*compute.CreateInstanceRequest, error) {
+func prepareCreateInstanceRequest(ctx context.Context, d *schema.ResourceData, meta *Config) (*compute.CreateInstanceRequest, error) {
 	zone, err := getZone(d, meta)
 	if err != nil {
 		return nil, fmt.Errorf("Error getting zone while creating instance: %s", err)
@@ -1229,7 +1219,7 @@ func prepareCreateInstanceRequest(d *schema.ResourceData, meta *Config) (*comput
 		return nil, fmt.Errorf("Error create 'resources_spec' object of api request: %s", err)
 	}
 
-	bootDiskSpec, err := expandInstanceBootDiskSpec(d, meta)
+	bootDiskSpec, err := expandInstanceBootDiskSpec(ctx, d, meta)
 	if err != nil {
 		return nil, fmt.Errorf("Error create 'boot_disk' object of api request: %s", err)
 	}
@@ -1351,15 +1341,12 @@ func wantChangeNatSpec(old *compute.OneToOneNatSpec, new *compute.OneToOneNatSpe
 	return false
 }
 
-func makeInstanceUpdateRequest(req *compute.UpdateInstanceRequest, d *schema.ResourceData, meta interface{}) error {
+func makeInstanceUpdateRequest(ctx context.Context, req *compute.UpdateInstanceRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Instance().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Instance %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update Instance %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1370,15 +1357,12 @@ func makeInstanceUpdateRequest(req *compute.UpdateInstanceRequest, d *schema.Res
 	return nil
 }
 
-func makeInstanceUpdateNetworkInterfaceRequest(req *compute.UpdateInstanceNetworkInterfaceRequest, d *schema.ResourceData, meta interface{}) error {
+func makeInstanceUpdateNetworkInterfaceRequest(ctx context.Context, req *compute.UpdateInstanceNetworkInterfaceRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Instance().UpdateNetworkInterface(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update network interface for Instance %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update network interface for Instance %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1389,15 +1373,12 @@ func makeInstanceUpdateNetworkInterfaceRequest(req *compute.UpdateInstanceNetwor
 	return nil
 }
 
-func makeInstanceAddOneToOneNatRequest(req *compute.AddInstanceOneToOneNatRequest, d *schema.ResourceData, meta interface{}) error {
+func makeInstanceAddOneToOneNatRequest(ctx context.Context, req *compute.AddInstanceOneToOneNatRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Instance().AddOneToOneNat(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to add one-to-one nat for Instance %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to add one-to-one nat for Instance %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1408,15 +1389,12 @@ func makeInstanceAddOneToOneNatRequest(req *compute.AddInstanceOneToOneNatReques
 	return nil
 }
 
-func makeInstanceRemoveOneToOneNatRequest(req *compute.RemoveInstanceOneToOneNatRequest, d *schema.ResourceData, meta interface{}) error {
+func makeInstanceRemoveOneToOneNatRequest(ctx context.Context, req *compute.RemoveInstanceOneToOneNatRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Instance().RemoveOneToOneNat(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to remove one-to-one nat for Instance %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to remove one-to-one nat for Instance %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1427,12 +1405,9 @@ func makeInstanceRemoveOneToOneNatRequest(req *compute.RemoveInstanceOneToOneNat
 	return nil
 }
 
-func makeInstanceActionRequest(action instanceAction, d *schema.ResourceData, meta interface{}) error {
+func makeInstanceActionRequest(ctx context.Context, action instanceAction, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	instanceID := d.Id()
 	var err error
 	var op *operation.Operation
@@ -1472,15 +1447,15 @@ func makeInstanceActionRequest(action instanceAction, d *schema.ResourceData, me
 	return nil
 }
 
-func makeDetachDiskRequest(req *compute.DetachInstanceDiskRequest, meta interface{}) error {
+func makeDetachDiskRequest(ctx context.Context, req *compute.DetachInstanceDiskRequest, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), yandexComputeInstanceDiskOperationTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexComputeInstanceDiskOperationTimeout)
 	defer cancel()
 
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Instance().DetachDisk(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to detach Disk %s from Instance %q: %s", req.GetDiskId(), req.GetInstanceId(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to detach Disk %s from Instance %q", req.GetDiskId(), req.GetInstanceId()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1491,15 +1466,15 @@ func makeDetachDiskRequest(req *compute.DetachInstanceDiskRequest, meta interfac
 	return nil
 }
 
-func makeAttachDiskRequest(req *compute.AttachInstanceDiskRequest, meta interface{}) error {
+func makeAttachDiskRequest(ctx context.Context, req *compute.AttachInstanceDiskRequest, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), yandexComputeInstanceDiskOperationTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexComputeInstanceDiskOperationTimeout)
 	defer cancel()
 
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Instance().AttachDisk(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to attach Disk %s to Instance %q: %s", req.AttachedDiskSpec.GetDiskId(), req.GetInstanceId(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to attach Disk %s to Instance %q", req.AttachedDiskSpec.GetDiskId(), req.GetInstanceId()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1510,15 +1485,15 @@ func makeAttachDiskRequest(req *compute.AttachInstanceDiskRequest, meta interfac
 	return nil
 }
 
-func makeInstanceMoveRequest(req *compute.MoveInstanceRequest, d *schema.ResourceData, meta interface{}) error {
+func makeInstanceMoveRequest(ctx context.Context, req *compute.MoveInstanceRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), yandexComputeInstanceMoveTimeout)
+	ctx, cancel := context.WithTimeout(ctx, yandexComputeInstanceMoveTimeout)
 	defer cancel()
 
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Instance().Move(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to move Instance %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to move Instance %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
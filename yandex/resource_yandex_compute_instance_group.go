@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -19,12 +20,12 @@ const (
 
 func resourceYandexComputeInstanceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexComputeInstanceGroupCreate,
-		Read:   resourceYandexComputeInstanceGroupRead,
-		Update: resourceYandexComputeInstanceGroupUpdate,
-		Delete: resourceYandexComputeInstanceGroupDelete,
+		CreateContext: resourceYandexComputeInstanceGroupCreate,
+		ReadContext:   resourceYandexComputeInstanceGroupRead,
+		UpdateContext: resourceYandexComputeInstanceGroupUpdate,
+		DeleteContext: resourceYandexComputeInstanceGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -966,55 +967,49 @@ func resourceYandexComputeInstanceGroup() *schema.Resource {
 	}
 }
 
-func resourceYandexComputeInstanceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeInstanceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	req, err := prepareCreateInstanceGroupRequest(d, config)
+	req, err := prepareCreateInstanceGroupRequest(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.InstanceGroup().InstanceGroup().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create instance group: %s", err)
+		return errorDiagnostics("Error while requesting API to create instance group", err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create instance group: %s", err)
+		return errorDiagnostics("Error while waiting operation to create instance group", err, nil)
 	}
 
 	resp, err := op.Response()
 	if err != nil {
-		return fmt.Errorf("Instance group creation failed: %s", err)
+		return errorDiagnostics("Instance group creation failed", err, nil)
 	}
 
 	instanceGroup, ok := resp.(*instancegroup.InstanceGroup)
 	if !ok {
-		return fmt.Errorf("Create response doesn't contain Instance group")
+		return diag.Errorf("Create response doesn't contain Instance group")
 	}
 
 	d.SetId(instanceGroup.Id)
 
-	return resourceYandexComputeInstanceGroupRead(d, meta)
+	return resourceYandexComputeInstanceGroupRead(ctx, d, meta)
 }
 
-func resourceYandexComputeInstanceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeInstanceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	instanceGroup, err := config.sdk.InstanceGroup().InstanceGroup().Get(ctx, &instancegroup.GetInstanceGroupRequest{
 		InstanceGroupId: d.Id(),
 		View:            instancegroup.InstanceGroupView_FULL,
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Instance group %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Instance group %q", d.Id())))
 	}
 
 	instances, err := config.sdk.InstanceGroup().InstanceGroup().ListInstances(ctx, &instancegroup.ListInstanceGroupInstancesRequest{
@@ -1022,10 +1017,10 @@ func resourceYandexComputeInstanceGroupRead(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Can't read instances for instance group with ID %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Can't read instances for instance group with ID %q", d.Id())))
 	}
 
-	return flattenInstanceGroup(d, instanceGroup, instances.GetInstances())
+	return diag.FromErr(flattenInstanceGroup(d, instanceGroup, instances.GetInstances()))
 }
 
 func flattenInstanceGroup(d *schema.ResourceData, instanceGroup *instancegroup.InstanceGroup, instances []*instancegroup.ManagedInstance) error {
@@ -1119,23 +1114,23 @@ func flattenInstanceGroup(d *schema.ResourceData, instanceGroup *instancegroup.I
 	return d.Set("health_check", healthChecks)
 }
 
-func resourceYandexComputeInstanceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeInstanceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	req, err := prepareUpdateInstanceGroupRequest(d, config)
+	req, err := prepareUpdateInstanceGroupRequest(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = makeInstanceGroupUpdateRequest(req, d, meta)
+	err = makeInstanceGroupUpdateRequest(ctx, req, d, meta)
 	if err != nil {
-		return err
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexComputeInstanceGroupRead(d, meta)
+	return resourceYandexComputeInstanceGroupRead(ctx, d, meta)
 }
 
-func resourceYandexComputeInstanceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeInstanceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Instance group %q", d.Id())
@@ -1144,29 +1139,26 @@ func resourceYandexComputeInstanceGroupDelete(d *schema.ResourceData, meta inter
 		InstanceGroupId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.InstanceGroup().InstanceGroup().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Instance group %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Instance group %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Instance group %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Instance group %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Instance group %q", d.Id())
 	return nil
 }
 
-func prepareCreateInstanceGroupRequest(d *schema.ResourceData, meta *Config) (*instancegroup.CreateInstanceGroupRequest, error) {
+func prepareCreateInstanceGroupRequest(ctx context.Context, d *schema.ResourceData, meta *Config) (*instancegroup.CreateInstanceGroupRequest, error) {
 	folderID, err := getFolderID(d, meta)
 	if err != nil {
 		return nil, fmt.Errorf("Error getting folder ID while creating instance group: %s", err)
@@ -1177,7 +1169,7 @@ func prepareCreateInstanceGroupRequest(d *schema.ResourceData, meta *Config) (*i
 		return nil, fmt.Errorf("Error expanding labels while creating instance group: %s", err)
 	}
 
-	instanceTemplate, err := expandInstanceGroupInstanceTemplate(d, "instance_template.0", meta)
+	instanceTemplate, err := expandInstanceGroupInstanceTemplate(ctx, d, "instance_template.0", meta)
 	if err != nil {
 		return nil, fmt.Errorf("Error creating 'instance_template' object of api request: %s", err)
 	}
@@ -1239,13 +1231,13 @@ func prepareCreateInstanceGroupRequest(d *schema.ResourceData, meta *Config) (*i
 	return req, nil
 }
 
-func prepareUpdateInstanceGroupRequest(d *schema.ResourceData, meta *Config) (*instancegroup.UpdateInstanceGroupRequest, error) {
+func prepareUpdateInstanceGroupRequest(ctx context.Context, d *schema.ResourceData, meta *Config) (*instancegroup.UpdateInstanceGroupRequest, error) {
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
 		return nil, fmt.Errorf("Error expanding labels while creating instance: %s", err)
 	}
 
-	instanceTemplate, err := expandInstanceGroupInstanceTemplate(d, "instance_template.0", meta)
+	instanceTemplate, err := expandInstanceGroupInstanceTemplate(ctx, d, "instance_template.0", meta)
 	if err != nil {
 		return nil, fmt.Errorf("Error creating 'instance_template' object of api request: %s", err)
 	}
@@ -1343,15 +1335,12 @@ func getStaticUpdatePath() []string {
 	}
 }
 
-func makeInstanceGroupUpdateRequest(req *instancegroup.UpdateInstanceGroupRequest, d *schema.ResourceData, meta interface{}) error {
+func makeInstanceGroupUpdateRequest(ctx context.Context, req *instancegroup.UpdateInstanceGroupRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.InstanceGroup().InstanceGroup().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Instance group %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update Instance group %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1311,7 +1311,7 @@ func TestComputeInstanceLocalDisksRequest(t *testing.T) {
 			resourceData.SetId(rawInstanceID)
 
 			config := Config{FolderID: "folder-id"}
-			req, err := prepareCreateInstanceRequest(resourceData, &config)
+			req, err := prepareCreateInstanceRequest(context.Background(), resourceData, &config)
 			assert.NoError(t, err)
 			assert.Equal(t, c.expected, req.LocalDiskSpecs)
 		})
@@ -3978,3 +3978,55 @@ resource "yandex_vpc_subnet" "inst-test-subnet" {
 }
 `, instance, diskSize, hostGroupID)
 }
+
+func TestComputeInstance_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	network := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_vpc_network"), nil, map[string]interface{}{"name": "network"})
+	subnet := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_vpc_subnet"), nil, map[string]interface{}{
+		"network_id":     network.ID,
+		"v4_cidr_blocks": []interface{}{"192.168.0.0/24"},
+	})
+	image := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_compute_image"), nil, map[string]interface{}{
+		"source_url": "https://storage.example.com/ubuntu.qcow2",
+	})
+
+	r := fakeCloudResource(t, "yandex_compute_instance")
+	raw := map[string]interface{}{
+		"name":     "instance",
+		"hostname": "instance",
+		"resources": []interface{}{map[string]interface{}{
+			"cores":  2,
+			"memory": 2,
+		}},
+		"boot_disk": []interface{}{map[string]interface{}{
+			"initialize_params": []interface{}{map[string]interface{}{
+				"image_id": image.ID,
+				"size":     10,
+			}},
+		}},
+		"network_interface": []interface{}{map[string]interface{}{
+			"subnet_id": subnet.ID,
+			"nat":       true,
+		}},
+		"metadata": map[string]interface{}{"user-data": "#cloud-config"},
+	}
+
+	state := fakeCloudApply(t, config, r, nil, raw)
+	assert.Equal(t, "running", state.Attributes["status"])
+	assert.Equal(t, "instance.ru-central1.internal", state.Attributes["fqdn"])
+	assert.Equal(t, "192.168.0.3", state.Attributes["network_interface.0.ip_address"])
+	assert.NotEmpty(t, state.Attributes["network_interface.0.nat_ip_address"])
+	assert.NotEmpty(t, state.Attributes["boot_disk.0.disk_id"])
+	assert.Empty(t, fakeCloudPlan(t, config, r, state, raw).Attributes)
+
+	imported := fakeCloudImport(t, config, r, state.ID)
+	assert.Equal(t, state.Attributes["boot_disk.0.disk_id"], imported.Attributes["boot_disk.0.disk_id"])
+	assert.Equal(t, state.Attributes["network_interface.0.ip_address"], imported.Attributes["network_interface.0.ip_address"])
+
+	raw["labels"] = map[string]interface{}{"role": "web"}
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.Equal(t, "web", state.Attributes["labels.role"])
+
+	fakeCloudDestroy(t, config, r, state)
+	assert.Nil(t, fakeCloudRefresh(t, config, r, state))
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -15,12 +16,12 @@ const yandexComputePlacementGroupDefaultTimeout = 1 * time.Minute
 
 func resourceYandexComputePlacementGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexComputePlacementGroupCreate,
-		Read:   resourceYandexComputePlacementGroupRead,
-		Update: resourceYandexComputePlacementGroupUpdate,
-		Delete: resourceYandexComputePlacementGroupDelete,
+		CreateContext: resourceYandexComputePlacementGroupCreate,
+		ReadContext:   resourceYandexComputePlacementGroupRead,
+		UpdateContext: resourceYandexComputePlacementGroupUpdate,
+		DeleteContext: resourceYandexComputePlacementGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -64,17 +65,17 @@ func resourceYandexComputePlacementGroup() *schema.Resource {
 	}
 }
 
-func resourceYandexComputePlacementGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputePlacementGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating Placement Group: %s", err)
+		return diag.Errorf("Error getting folder ID while creating Placement Group: %s", err)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating Placement Group: %s", err)
+		return diag.Errorf("Error expanding labels while creating Placement Group: %s", err)
 	}
 
 	req := compute.CreatePlacementGroupRequest{
@@ -87,48 +88,45 @@ func resourceYandexComputePlacementGroupCreate(d *schema.ResourceData, meta inte
 		},
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().PlacementGroup().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Placement Group: %s", err)
+		return errorDiagnostics("Error while requesting API to create Placement Group", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get Placement Group create operation metadata: %s", err)
+		return diag.Errorf("Error while get Placement Group create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*compute.CreatePlacementGroupMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Placement Group ID from create operation metadata")
+		return diag.Errorf("could not get Placement Group ID from create operation metadata")
 	}
 
 	d.SetId(md.GetPlacementGroupId())
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create Placement Group: %s", err)
+		return errorDiagnostics("Error while waiting operation to create Placement Group", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Placement Group creation failed: %s", err)
+		return errorDiagnostics("Placement Group creation failed", err, nil)
 	}
 
-	return resourceYandexComputePlacementGroupRead(d, meta)
+	return resourceYandexComputePlacementGroupRead(ctx, d, meta)
 }
 
-func resourceYandexComputePlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputePlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	placementGroup, err := config.sdk.Compute().PlacementGroup().Get(context.Background(),
+	placementGroup, err := config.sdk.Compute().PlacementGroup().Get(ctx,
 		&compute.GetPlacementGroupRequest{
 			PlacementGroupId: d.Id(),
 		})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Placement Group %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Placement Group %q", d.Id())))
 	}
 
 	d.Set("created_at", getTimestamp(placementGroup.CreatedAt))
@@ -136,19 +134,19 @@ func resourceYandexComputePlacementGroupRead(d *schema.ResourceData, meta interf
 	d.Set("folder_id", placementGroup.FolderId)
 	d.Set("description", placementGroup.Description)
 
-	return d.Set("labels", placementGroup.Labels)
+	return diag.FromErr(d.Set("labels", placementGroup.Labels))
 }
 
-func resourceYandexComputePlacementGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputePlacementGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	req := &compute.UpdatePlacementGroupRequest{
 		PlacementGroupId: d.Id(),
 		UpdateMask:       &field_mask.FieldMask{},
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req.Labels = labelsProp
@@ -166,18 +164,18 @@ func resourceYandexComputePlacementGroupUpdate(d *schema.ResourceData, meta inte
 	}
 
 	if len(req.UpdateMask.Paths) == 0 {
-		return fmt.Errorf("No fields were updated for Placement Group %s", d.Id())
+		return diag.Errorf("No fields were updated for Placement Group %s", d.Id())
 	}
 
-	err := makePlacementGroupUpdateRequest(req, d, meta)
+	err := makePlacementGroupUpdateRequest(ctx, req, d, meta)
 	if err != nil {
-		return err
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexComputePlacementGroupRead(d, meta)
+	return resourceYandexComputePlacementGroupRead(ctx, d, meta)
 }
 
-func resourceYandexComputePlacementGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputePlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Placement Group %q", d.Id())
@@ -186,37 +184,31 @@ func resourceYandexComputePlacementGroupDelete(d *schema.ResourceData, meta inte
 		PlacementGroupId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().PlacementGroup().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Placement Group %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Placement Group %q", d.Id())))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Placement Group %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Placement Group %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Placement Group %q", d.Id())
 	return nil
 }
 
-func makePlacementGroupUpdateRequest(req *compute.UpdatePlacementGroupRequest, d *schema.ResourceData, meta interface{}) error {
+func makePlacementGroupUpdateRequest(ctx context.Context, req *compute.UpdatePlacementGroupRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().PlacementGroup().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Placement Group %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update Placement Group %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -16,12 +17,12 @@ const yandexComputeSnapshotDefaultTimeout = 20 * time.Minute
 
 func resourceYandexComputeSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexComputeSnapshotCreate,
-		Read:   resourceYandexComputeSnapshotRead,
-		Update: resourceYandexComputeSnapshotUpdate,
-		Delete: resourceYandexComputeSnapshotDelete,
+		CreateContext: resourceYandexComputeSnapshotCreate,
+		ReadContext:   resourceYandexComputeSnapshotRead,
+		UpdateContext: resourceYandexComputeSnapshotUpdate,
+		DeleteContext: resourceYandexComputeSnapshotDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -83,17 +84,17 @@ func resourceYandexComputeSnapshot() *schema.Resource {
 
 }
 
-func resourceYandexComputeSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating snapshot: %s", err)
+		return diag.Errorf("Error getting folder ID while creating snapshot: %s", err)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating snapshot: %s", err)
+		return diag.Errorf("Error expanding labels while creating snapshot: %s", err)
 	}
 
 	req := compute.CreateSnapshotRequest{
@@ -104,47 +105,44 @@ func resourceYandexComputeSnapshotCreate(d *schema.ResourceData, meta interface{
 		Labels:      labels,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Snapshot().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create snapshot: %s", err)
+		return errorDiagnostics("Error while requesting API to create snapshot", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get snapshot create operation metadata: %s", err)
+		return diag.Errorf("Error while get snapshot create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*compute.CreateSnapshotMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Snapshot ID from create operation metadata")
+		return diag.Errorf("could not get Snapshot ID from create operation metadata")
 	}
 
 	d.SetId(md.SnapshotId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create snapshot: %s", err)
+		return errorDiagnostics("Error while waiting operation to create snapshot", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Snapshot creation failed: %s", err)
+		return errorDiagnostics("Snapshot creation failed", err, nil)
 	}
 
-	return resourceYandexComputeSnapshotRead(d, meta)
+	return resourceYandexComputeSnapshotRead(ctx, d, meta)
 }
 
-func resourceYandexComputeSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	snapshot, err := config.sdk.Compute().Snapshot().Get(config.Context(), &compute.GetSnapshotRequest{
+	snapshot, err := config.sdk.Compute().Snapshot().Get(ctx, &compute.GetSnapshotRequest{
 		SnapshotId: d.Id(),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Snapshot %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Snapshot %q", d.Get("name").(string))))
 	}
 
 	d.Set("created_at", getTimestamp(snapshot.CreatedAt))
@@ -155,17 +153,17 @@ func resourceYandexComputeSnapshotRead(d *schema.ResourceData, meta interface{})
 	d.Set("storage_size", toGigabytes(snapshot.StorageSize))
 	d.Set("source_disk_id", snapshot.SourceDiskId)
 
-	return d.Set("labels", snapshot.Labels)
+	return diag.FromErr(d.Set("labels", snapshot.Labels))
 }
 
-func resourceYandexComputeSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.Partial(true)
 
 	labelPropName := "labels"
-	if d.HasChange(labelPropName) {
+	if hasChangeWithDefaultLabels(d, labelPropName) {
 		labelsProp, err := expandLabels(d.Get(labelPropName))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req := &compute.UpdateSnapshotRequest{
@@ -176,9 +174,9 @@ func resourceYandexComputeSnapshotUpdate(d *schema.ResourceData, meta interface{
 			},
 		}
 
-		err = makeSnapshotUpdateRequest(req, d, meta)
+		err = makeSnapshotUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
@@ -193,9 +191,9 @@ func resourceYandexComputeSnapshotUpdate(d *schema.ResourceData, meta interface{
 			},
 		}
 
-		err := makeSnapshotUpdateRequest(req, d, meta)
+		err := makeSnapshotUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
@@ -210,19 +208,19 @@ func resourceYandexComputeSnapshotUpdate(d *schema.ResourceData, meta interface{
 			},
 		}
 
-		err := makeSnapshotUpdateRequest(req, d, meta)
+		err := makeSnapshotUpdateRequest(ctx, req, d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceYandexComputeSnapshotRead(d, meta)
+	return resourceYandexComputeSnapshotRead(ctx, d, meta)
 }
 
-func resourceYandexComputeSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexComputeSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Snapshot %q", d.Id())
@@ -231,37 +229,31 @@ func resourceYandexComputeSnapshotDelete(d *schema.ResourceData, meta interface{
 		SnapshotId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Snapshot().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Snapshot %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Snapshot %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Snapshot %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Snapshot %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Snapshot %q", d.Id())
 	return nil
 }
 
-func makeSnapshotUpdateRequest(req *compute.UpdateSnapshotRequest, d *schema.ResourceData, meta interface{}) error {
+func makeSnapshotUpdateRequest(ctx context.Context, req *compute.UpdateSnapshotRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Snapshot().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Snapshot %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update Snapshot %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
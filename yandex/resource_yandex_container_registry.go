@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -17,12 +18,12 @@ const yandexContainerRegistryDefaultTimeout = 15 * time.Minute
 
 func resourceYandexContainerRegistry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexContainerRegistryCreate,
-		Read:   resourceYandexContainerRegistryRead,
-		Update: resourceYandexContainerRegistryUpdate,
-		Delete: resourceYandexContainerRegistryDelete,
+		CreateContext: resourceYandexContainerRegistryCreate,
+		ReadContext:   resourceYandexContainerRegistryRead,
+		UpdateContext: resourceYandexContainerRegistryUpdate,
+		DeleteContext: resourceYandexContainerRegistryDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -67,17 +68,17 @@ func resourceYandexContainerRegistry() *schema.Resource {
 	}
 }
 
-func resourceYandexContainerRegistryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexContainerRegistryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating Container Registry: %s", err)
+		return diag.Errorf("Error getting folder ID while creating Container Registry: %s", err)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating Container Registry: %s", err)
+		return diag.Errorf("Error expanding labels while creating Container Registry: %s", err)
 	}
 
 	req := containerregistry.CreateRegistryRequest{
@@ -86,48 +87,45 @@ func resourceYandexContainerRegistryCreate(d *schema.ResourceData, meta interfac
 		Labels:   labels,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ContainerRegistry().Registry().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Container Registry: %s", err)
+		return errorDiagnostics("Error while requesting API to create Container Registry", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get Container Registry create operation metadata: %s", err)
+		return diag.Errorf("Error while get Container Registry create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*containerregistry.CreateRegistryMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Container Registry ID from create operation metadata")
+		return diag.Errorf("could not get Container Registry ID from create operation metadata")
 	}
 
 	d.SetId(md.RegistryId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create Container Registry: %s", err)
+		return errorDiagnostics("Error while waiting operation to create Container Registry", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Container Registry creation failed: %s", err)
+		return errorDiagnostics("Container Registry creation failed", err, nil)
 	}
 
-	return resourceYandexContainerRegistryRead(d, meta)
+	return resourceYandexContainerRegistryRead(ctx, d, meta)
 }
 
-func resourceYandexContainerRegistryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexContainerRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	registry, err := config.sdk.ContainerRegistry().Registry().Get(context.Background(),
+	registry, err := config.sdk.ContainerRegistry().Registry().Get(ctx,
 		&containerregistry.GetRegistryRequest{
 			RegistryId: d.Id(),
 		})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Container Registry %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Container Registry %q", d.Id())))
 	}
 
 	d.Set("created_at", getTimestamp(registry.CreatedAt))
@@ -135,20 +133,20 @@ func resourceYandexContainerRegistryRead(d *schema.ResourceData, meta interface{
 	d.Set("folder_id", registry.FolderId)
 	d.Set("status", strings.ToLower(registry.Status.String()))
 
-	return d.Set("labels", registry.Labels)
+	return diag.FromErr(d.Set("labels", registry.Labels))
 }
 
-func resourceYandexContainerRegistryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexContainerRegistryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	req := &containerregistry.UpdateRegistryRequest{
 		RegistryId: d.Id(),
 		UpdateMask: &field_mask.FieldMask{},
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req.Labels = labelsProp
@@ -161,18 +159,18 @@ func resourceYandexContainerRegistryUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	if len(req.UpdateMask.Paths) == 0 {
-		return fmt.Errorf("No fields were updated for Container Registry %s", d.Id())
+		return diag.Errorf("No fields were updated for Container Registry %s", d.Id())
 	}
 
-	err := makeRegistryUpdateRequest(req, d, meta)
+	err := makeRegistryUpdateRequest(ctx, req, d, meta)
 	if err != nil {
-		return err
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexContainerRegistryRead(d, meta)
+	return resourceYandexContainerRegistryRead(ctx, d, meta)
 }
 
-func resourceYandexContainerRegistryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexContainerRegistryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Container Registry %q", d.Id())
@@ -181,37 +179,31 @@ func resourceYandexContainerRegistryDelete(d *schema.ResourceData, meta interfac
 		RegistryId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ContainerRegistry().Registry().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Container Registry %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Container Registry %q", d.Id())))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Container Registry %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Container Registry %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Container Registry %q", d.Id())
 	return nil
 }
 
-func makeRegistryUpdateRequest(req *containerregistry.UpdateRegistryRequest, d *schema.ResourceData, meta interface{}) error {
+func makeRegistryUpdateRequest(ctx context.Context, req *containerregistry.UpdateRegistryRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ContainerRegistry().Registry().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Container Registry %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update Container Registry %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
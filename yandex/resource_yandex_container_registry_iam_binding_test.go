@@ -1,6 +1,7 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sort"
@@ -158,5 +159,5 @@ func getContainerRegistryResourceAccessBindings(s *terraform.State, resourceName
 		return nil, fmt.Errorf("can't find %s in state", resourceName)
 	}
 
-	return getContainerRegistryAccessBindings(config, rs.Primary.ID)
+	return getContainerRegistryAccessBindings(context.Background(), config, rs.Primary.ID)
 }
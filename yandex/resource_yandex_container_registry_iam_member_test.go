@@ -0,0 +1,52 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/containerregistry/v1"
+)
+
+func TestAccContainerRegistryIamMember(t *testing.T) {
+	var registry containerregistry.Registry
+	registryName := acctest.RandomWithPrefix("tf-container-registry")
+
+	role := "container-registry.images.puller"
+	member := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerRegistryIamMemberBasic(registryName, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerRegistryExists(containerRegistryResource, &registry),
+					testAccCheckContainerRegistryIam(containerRegistryResource, role, []string{member}),
+				),
+			},
+			{
+				ResourceName: "yandex_container_registry_iam_member.puller",
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return fmt.Sprintf("%s %s %s", registry.Id, role, member), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccContainerRegistryIamMemberBasic(registryName, role, member string) string {
+	return testAccContainerRegistry(registryName) + fmt.Sprintf(`
+resource "yandex_container_registry_iam_member" "puller" {
+  registry_id = yandex_container_registry.test-registry.id
+  role        = "%s"
+  member      = "%s"
+}
+`, role, member)
+}
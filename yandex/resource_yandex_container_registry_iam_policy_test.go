@@ -0,0 +1,58 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/containerregistry/v1"
+)
+
+func TestAccContainerRegistryIamPolicy(t *testing.T) {
+	var registry containerregistry.Registry
+	registryName := acctest.RandomWithPrefix("tf-container-registry")
+
+	role := "container-registry.images.puller"
+	member := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerRegistryIamPolicyBasic(registryName, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerRegistryExists(containerRegistryResource, &registry),
+					testAccCheckContainerRegistryIam(containerRegistryResource, role, []string{member}),
+				),
+			},
+			{
+				ResourceName: "yandex_container_registry_iam_policy.puller",
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return registry.Id, nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccContainerRegistryIamPolicyBasic(registryName, role, member string) string {
+	return testAccContainerRegistry(registryName) + fmt.Sprintf(`
+data "yandex_iam_policy" "puller" {
+  binding {
+    role    = "%s"
+    members = ["%s"]
+  }
+}
+
+resource "yandex_container_registry_iam_policy" "puller" {
+  registry_id = yandex_container_registry.test-registry.id
+  policy_data = data.yandex_iam_policy.puller.policy_data
+}
+`, role, member)
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/containerregistry/v1"
 )
@@ -14,11 +15,11 @@ const yandexContainerRepositoryDefaultTimeout = 5 * time.Minute
 
 func resourceYandexContainerRepository() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexContainerRepositoryCreate,
-		Read:   resourceYandexContainerRepositoryRead,
-		Delete: resourceYandexContainerRepositoryDelete,
+		CreateContext: resourceYandexContainerRepositoryCreate,
+		ReadContext:   resourceYandexContainerRepositoryRead,
+		DeleteContext: resourceYandexContainerRepositoryDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -38,61 +39,58 @@ func resourceYandexContainerRepository() *schema.Resource {
 	}
 }
 
-func resourceYandexContainerRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexContainerRepositoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	req := containerregistry.UpsertRepositoryRequest{
 		Name: d.Get("name").(string),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ContainerRegistry().Repository().Upsert(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Container Repository: %s", err)
+		return errorDiagnostics("Error while requesting API to create Container Repository", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get Container Repository create operation metadata: %s", err)
+		return diag.Errorf("Error while get Container Repository create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*containerregistry.UpsertRepositoryMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Container Repository ID from create operation metadata")
+		return diag.Errorf("could not get Container Repository ID from create operation metadata")
 	}
 
 	d.SetId(md.RepositoryId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create Container Repository: %s", err)
+		return errorDiagnostics("Error while waiting operation to create Container Repository", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Container Repository creation failed: %s", err)
+		return errorDiagnostics("Container Repository creation failed", err, nil)
 	}
 
-	return resourceYandexContainerRepositoryRead(d, meta)
+	return resourceYandexContainerRepositoryRead(ctx, d, meta)
 }
 
-func resourceYandexContainerRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexContainerRepositoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	repository, err := config.sdk.ContainerRegistry().Repository().Get(context.Background(),
+	repository, err := config.sdk.ContainerRegistry().Repository().Get(ctx,
 		&containerregistry.GetRepositoryRequest{
 			RepositoryId: d.Id(),
 		})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Container Repository %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Container Repository %q", d.Id())))
 	}
 
-	return d.Set("name", repository.Name)
+	return diag.FromErr(d.Set("name", repository.Name))
 }
 
-func resourceYandexContainerRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexContainerRepositoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Container Repository %q", d.Id())
@@ -101,22 +99,19 @@ func resourceYandexContainerRepositoryDelete(d *schema.ResourceData, meta interf
 		RepositoryId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ContainerRegistry().Repository().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Container Repository %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Container Repository %q", d.Id())))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Container Repository %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Container Repository %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Container Repository %q", d.Id())
@@ -1,6 +1,7 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sort"
@@ -168,5 +169,5 @@ func getContainerRepositoryResourceAccessBindings(s *terraform.State, resourceNa
 		return nil, fmt.Errorf("can't find %s in state", resourceName)
 	}
 
-	return getContainerRepositoryAccessBindings(config, rs.Primary.ID)
+	return getContainerRepositoryAccessBindings(context.Background(), config, rs.Primary.ID)
 }
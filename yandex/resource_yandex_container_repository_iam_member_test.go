@@ -0,0 +1,53 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/containerregistry/v1"
+)
+
+func TestAccContainerRepositoryIamMember(t *testing.T) {
+	var repository containerregistry.Repository
+	registryName := acctest.RandomWithPrefix("tf-container-registry")
+	repositoryNameSuffix := acctest.RandomWithPrefix("tf-container-repository")
+
+	role := "container-registry.images.puller"
+	member := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerRepositoryIamMemberBasic(registryName, repositoryNameSuffix, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerRepositoryExists(containerRepositoryResource, &repository),
+					testAccCheckContainerRepositoryIam(containerRepositoryResource, role, []string{member}),
+				),
+			},
+			{
+				ResourceName: "yandex_container_repository_iam_member.puller",
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return fmt.Sprintf("%s %s %s", repository.Id, role, member), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccContainerRepositoryIamMemberBasic(registryName, repositoryNameSuffix, role, member string) string {
+	return testAccContainerRepository(registryName, repositoryNameSuffix) + fmt.Sprintf(`
+resource "yandex_container_repository_iam_member" "puller" {
+  repository_id = yandex_container_repository.test-repository.id
+  role          = "%s"
+  member        = "%s"
+}
+`, role, member)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -36,12 +37,12 @@ func isVersionPrefix(prefix string, version string) bool {
 
 func resourceYandexDataprocCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexDataprocClusterCreate,
-		Read:   resourceYandexDataprocClusterRead,
-		Update: resourceYandexDataprocClusterUpdate,
-		Delete: resourceYandexDataprocClusterDelete,
+		CreateContext: resourceYandexDataprocClusterCreate,
+		ReadContext:   resourceYandexDataprocClusterRead,
+		UpdateContext: resourceYandexDataprocClusterUpdate,
+		DeleteContext: resourceYandexDataprocClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -290,66 +291,57 @@ func resourceYandexDataprocCluster() *schema.Resource {
 	}
 }
 
-func resourceYandexDataprocClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDataprocClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	req, err := prepareDataprocCreateClusterRequest(d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Dataproc().Cluster().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create Data Proc Cluster: %s", err)
+		return errorDiagnostics("error while requesting API to create Data Proc Cluster", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("error while getting Data Proc Cluster create operation metadata: %s", err)
+		return diag.Errorf("error while getting Data Proc Cluster create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*dataproc.CreateClusterMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Data Proc Cluster ID from create operation metadata")
+		return diag.Errorf("could not get Data Proc Cluster ID from create operation metadata")
 	}
 
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("error while waiting for operation to create Data Proc Cluster: %s", err)
+		return errorDiagnostics("error while waiting for operation to create Data Proc Cluster", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("failed to create Data Proc Cluster: %s", err)
+		return errorDiagnostics("failed to create Data Proc Cluster", err, nil)
 	}
 
-	return resourceYandexDataprocClusterRead(d, meta)
+	return resourceYandexDataprocClusterRead(ctx, d, meta)
 }
 
-func resourceYandexDataprocClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDataprocClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	cluster, err := config.sdk.Dataproc().Cluster().Get(ctx, &dataproc.GetClusterRequest{
 		ClusterId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("cluster %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("cluster %q", d.Id())))
 	}
 
-	return populateDataprocClusterResourceData(d, config, cluster)
+	return diag.FromErr(populateDataprocClusterResourceData(ctx, d, config, cluster))
 }
 
-func populateDataprocClusterResourceData(d *schema.ResourceData, config *Config, cluster *dataproc.Cluster) error {
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
+func populateDataprocClusterResourceData(ctx context.Context, d *schema.ResourceData, config *Config, cluster *dataproc.Cluster) error {
 	subclusters, err := listDataprocSubclusters(ctx, config, cluster.Id)
 	if err != nil {
 		return err
@@ -511,7 +503,7 @@ func listDataprocSubclusters(ctx context.Context, config *Config, id string) ([]
 	return subclusters, nil
 }
 
-func resourceYandexDataprocClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDataprocClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Data Proc Cluster %q", d.Id())
@@ -520,50 +512,47 @@ func resourceYandexDataprocClusterDelete(d *schema.ResourceData, meta interface{
 		ClusterId: d.Id(),
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Dataproc().Cluster().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Data Proc Cluster %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Data Proc Cluster %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Data Proc Cluster %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Data Proc Cluster %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Data Proc Cluster %q", d.Id())
 	return nil
 }
 
-func resourceYandexDataprocClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDataprocClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Updating Data Proc Cluster %q", d.Id())
 
 	d.Partial(true)
 
-	if err := updateDataprocClusterParams(d, meta); err != nil {
-		return err
+	if err := updateDataprocClusterParams(ctx, d, meta); err != nil {
+		return diagnosticsFromError(err)
 	}
 
 	if d.HasChange("cluster_config.0.subcluster_spec") {
-		if err := updateDataprocSubclusters(d, meta); err != nil {
-			return err
+		if err := updateDataprocSubclusters(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	d.Partial(false)
 
 	log.Printf("[DEBUG] Finished updating Data Proc Cluster %q", d.Id())
-	return resourceYandexDataprocClusterRead(d, meta)
+	return resourceYandexDataprocClusterRead(ctx, d, meta)
 }
 
-func updateDataprocClusterParams(d *schema.ResourceData, meta interface{}) error {
+func updateDataprocClusterParams(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	req, err := getDataprocClusterUpdateRequest(d)
 	if err != nil {
@@ -573,12 +562,9 @@ func updateDataprocClusterParams(d *schema.ResourceData, meta interface{}) error
 		return nil
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Dataproc().Cluster().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update Data Proc Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update Data Proc Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -610,7 +596,7 @@ func getDataprocClusterUpdateRequest(d *schema.ResourceData) (*dataproc.UpdateCl
 	var updatePaths []string
 	fieldNames := []string{"description", "labels", "name", "service_account_id", "bucket", "ui_proxy", "security_group_ids", "deletion_protection"}
 	for _, fieldName := range fieldNames {
-		if d.HasChange(fieldName) {
+		if hasChangeWithDefaultLabels(d, fieldName) {
 			updatePaths = append(updatePaths, fieldName)
 		}
 	}
@@ -632,10 +618,8 @@ func getDataprocClusterUpdateRequest(d *schema.ResourceData) (*dataproc.UpdateCl
 	return req, nil
 }
 
-func updateDataprocSubclusters(d *schema.ResourceData, meta interface{}) error {
+func updateDataprocSubclusters(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
 
 	subclusters, err := listDataprocSubclusters(ctx, config, d.Id())
 	if err != nil {
@@ -648,21 +632,21 @@ func updateDataprocSubclusters(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	for _, deleteReq := range deleteReqs {
-		err := deleteDataprocSubcluster(deleteReq, config, d.Timeout(schema.TimeoutDelete))
+		err := deleteDataprocSubcluster(ctx, deleteReq, config, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
 	}
 
 	for _, createReq := range createReqs {
-		err := createDataprocSubcluster(createReq, config, d.Timeout(schema.TimeoutCreate))
+		err := createDataprocSubcluster(ctx, createReq, config, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
 	}
 
 	for _, updateReq := range updateReqs {
-		err := updateDataprocSubcluster(updateReq, config, d.Timeout(schema.TimeoutUpdate))
+		err := updateDataprocSubcluster(ctx, updateReq, config, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
@@ -796,15 +780,15 @@ func getDataprocSubclusterUpdateRequest(d *schema.ResourceData, path string) (*d
 	return req, nil
 }
 
-func deleteDataprocSubcluster(deleteReq *dataproc.DeleteSubclusterRequest, config *Config, timeout time.Duration) error {
+func deleteDataprocSubcluster(ctx context.Context, deleteReq *dataproc.DeleteSubclusterRequest, config *Config, timeout time.Duration) error {
 	log.Printf("[DEBUG] Deleting Data Proc Subcluster %q", deleteReq.SubclusterId)
 
-	ctx, cancel := config.ContextWithTimeout(timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	op, err := config.sdk.WrapOperation(config.sdk.Dataproc().Subcluster().Delete(ctx, deleteReq))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete Data Proc Subcluster %q: %s", deleteReq.SubclusterId, err)
+		return apiError(fmt.Sprintf("error while requesting API to delete Data Proc Subcluster %q", deleteReq.SubclusterId), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -816,15 +800,15 @@ func deleteDataprocSubcluster(deleteReq *dataproc.DeleteSubclusterRequest, confi
 	return nil
 }
 
-func createDataprocSubcluster(createReq *dataproc.CreateSubclusterRequest, config *Config, timeout time.Duration) error {
+func createDataprocSubcluster(ctx context.Context, createReq *dataproc.CreateSubclusterRequest, config *Config, timeout time.Duration) error {
 	log.Printf("[DEBUG] Creating Data Proc Subcluster %q", createReq.Name)
 
-	ctx, cancel := config.ContextWithTimeout(timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	op, err := config.sdk.WrapOperation(config.sdk.Dataproc().Subcluster().Create(ctx, createReq))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create Data Proc Subcluster %q: %s", createReq.Name, err)
+		return apiError(fmt.Sprintf("error while requesting API to create Data Proc Subcluster %q", createReq.Name), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -836,15 +820,15 @@ func createDataprocSubcluster(createReq *dataproc.CreateSubclusterRequest, confi
 	return nil
 }
 
-func updateDataprocSubcluster(updateReq *dataproc.UpdateSubclusterRequest, config *Config, timeout time.Duration) error {
+func updateDataprocSubcluster(ctx context.Context, updateReq *dataproc.UpdateSubclusterRequest, config *Config, timeout time.Duration) error {
 	log.Printf("[DEBUG] Updating Data Proc Subcluster %q", updateReq.SubclusterId)
 
-	ctx, cancel := config.ContextWithTimeout(timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	op, err := config.sdk.WrapOperation(config.sdk.Dataproc().Subcluster().Update(ctx, updateReq))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update Data Proc Subcluster %q: %s", updateReq.SubclusterId, err)
+		return apiError(fmt.Sprintf("error while requesting API to update Data Proc Subcluster %q", updateReq.SubclusterId), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -2,9 +2,12 @@
 package yandex
 
 import (
+	"context"
 	fmt "fmt"
 	log "log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	datatransfer "github.com/yandex-cloud/go-genproto/yandex/cloud/datatransfer/v1"
 	grpc "google.golang.org/grpc"
@@ -12,15 +15,23 @@ import (
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
+const yandexDatatransferEndpointDefaultTimeout = 10 * time.Minute
+
 func resourceYandexDatatransferEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexDatatransferEndpointCreate,
-		Read:   resourceYandexDatatransferEndpointRead,
-		Update: resourceYandexDatatransferEndpointUpdate,
-		Delete: resourceYandexDatatransferEndpointDelete,
+		CreateContext: resourceYandexDatatransferEndpointCreate,
+		ReadContext:   resourceYandexDatatransferEndpointRead,
+		UpdateContext: resourceYandexDatatransferEndpointUpdate,
+		DeleteContext: resourceYandexDatatransferEndpointDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexDatatransferEndpointDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexDatatransferEndpointDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexDatatransferEndpointDefaultTimeout),
 		},
 
 		SchemaVersion: 1,
@@ -1488,13 +1499,12 @@ func prepareCreateDatatransferEndpointRequest(d *schema.ResourceData, config *Co
 	}, nil
 }
 
-func resourceYandexDatatransferEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDatatransferEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	req, err := prepareCreateDatatransferEndpointRequest(d, config)
 	if err != nil {
-		return fmt.Errorf("could not prepare request: %s", err)
+		return diag.Errorf("could not prepare request: %s", err)
 	}
 
 	md := new(metadata.MD)
@@ -1506,33 +1516,31 @@ func resourceYandexDatatransferEndpointCreate(d *schema.ResourceData, meta inter
 		log.Printf("[DEBUG] Create Endpoint x-server-request-id: %s", traceHeader[0])
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("error while getting EndpointService.Create operation metadata: %s", err)
+		return diag.Errorf("error while getting EndpointService.Create operation metadata: %s", err)
 	}
 	createEndpointMetadata, ok := protoMetadata.(*datatransfer.CreateEndpointMetadata)
 	if !ok {
-		return fmt.Errorf("expected EndpointService.Create response metadata to have type CreateEndpointMetadata but got %T", protoMetadata)
+		return diag.Errorf("expected EndpointService.Create response metadata to have type CreateEndpointMetadata but got %T", protoMetadata)
 	}
 
 	d.SetId(createEndpointMetadata.EndpointId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceYandexDatatransferEndpointRead(d, meta)
+	return resourceYandexDatatransferEndpointRead(ctx, d, meta)
 }
 
-func resourceYandexDatatransferEndpointRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDatatransferEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx := config.Context()
-
 	req := &datatransfer.GetEndpointRequest{
 		EndpointId: d.Id(),
 	}
@@ -1546,51 +1554,49 @@ func resourceYandexDatatransferEndpointRead(d *schema.ResourceData, meta interfa
 		log.Printf("[DEBUG] Read Endpoint x-server-request-id: %s", traceHeader[0])
 	}
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("endpoint %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("endpoint %q", d.Id())))
 	}
 
 	settings, err := flattenDatatransferEndpointSettings(d, resp.GetSettings())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("description", resp.GetDescription()); err != nil {
 		log.Printf("[ERROR] failed set field description: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("folder_id", resp.GetFolderId()); err != nil {
 		log.Printf("[ERROR] failed set field folder_id: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("labels", resp.GetLabels()); err != nil {
 		log.Printf("[ERROR] failed set field labels: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("name", resp.GetName()); err != nil {
 		log.Printf("[ERROR] failed set field name: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("settings", settings); err != nil {
 		log.Printf("[ERROR] failed set field settings: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceYandexDatatransferEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDatatransferEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx := config.Context()
-
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	settings, err := expandDatatransferEndpointSettings(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	req := &datatransfer.UpdateEndpointRequest{
@@ -1613,21 +1619,19 @@ func resourceYandexDatatransferEndpointUpdate(d *schema.ResourceData, meta inter
 		log.Printf("[DEBUG] Update Endpoint x-server-request-id: %s", traceHeader[0])
 	}
 	if err != nil {
-		return err
+		return errorDiagnostics("error while requesting API to update endpoint", err, nil)
 	}
 
 	if err := op.Wait(ctx); err != nil {
-		return err
+		return errorDiagnostics("error while requesting API to update endpoint", err, nil)
 	}
 
-	return resourceYandexDatatransferEndpointRead(d, meta)
+	return resourceYandexDatatransferEndpointRead(ctx, d, meta)
 }
 
-func resourceYandexDatatransferEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDatatransferEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx := config.Context()
-
 	req := &datatransfer.DeleteEndpointRequest{
 		EndpointId: d.Id(),
 	}
@@ -1641,11 +1645,11 @@ func resourceYandexDatatransferEndpointDelete(d *schema.ResourceData, meta inter
 		log.Printf("[DEBUG] Delete Endpoint x-server-request-id: %s", traceHeader[0])
 	}
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("endpoint %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("endpoint %q", d.Id())))
 	}
 
 	if err := op.Wait(ctx); err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting endpoint %q", d.Id()), err, nil)
 	}
 
 	return nil
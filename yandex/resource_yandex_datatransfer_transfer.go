@@ -1,9 +1,12 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/datatransfer/v1"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -13,6 +16,8 @@ import (
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
+const yandexDatatransferTransferDefaultTimeout = 15 * time.Minute
+
 const (
 	traceIDMetadataKey   = "x-server-trace-id"
 	requestIDMetadataKey = "x-server-request-id"
@@ -20,13 +25,19 @@ const (
 
 func resourceYandexDatatransferTransfer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexDatatransferTransferCreateAndActivate,
-		Read:   resourceYandexDatatransferTransferRead,
-		Update: resourceYandexDatatransferTransferUpdate,
-		Delete: resourceYandexDatatransferTransferDeactivateAndDelete,
+		CreateContext: resourceYandexDatatransferTransferCreateAndActivate,
+		ReadContext:   resourceYandexDatatransferTransferRead,
+		UpdateContext: resourceYandexDatatransferTransferUpdate,
+		DeleteContext: resourceYandexDatatransferTransferDeactivateAndDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexDatatransferTransferDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexDatatransferTransferDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexDatatransferTransferDefaultTimeout),
 		},
 
 		SchemaVersion: 1,
@@ -85,8 +96,7 @@ func resourceYandexDatatransferTransfer() *schema.Resource {
 	}
 }
 
-func createTransfer(config *Config, d *schema.ResourceData) (*datatransfer.Transfer, error) {
-	ctx := config.Context()
+func createTransfer(ctx context.Context, config *Config, d *schema.ResourceData) (*datatransfer.Transfer, error) {
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
@@ -136,7 +146,7 @@ func createTransfer(config *Config, d *schema.ResourceData) (*datatransfer.Trans
 	d.SetId(createOpMetadata.TransferId)
 
 	if err := createOp.Wait(ctx); err != nil {
-		return nil, fmt.Errorf("error while waiting operation to complete: %s", err)
+		return nil, apiError("error while waiting operation to complete", err, nil)
 	}
 
 	response, err := createOp.Response()
@@ -150,8 +160,7 @@ func createTransfer(config *Config, d *schema.ResourceData) (*datatransfer.Trans
 	return transfer, nil
 }
 
-func activateTransfer(config *Config, transferID string) error {
-	ctx := config.Context()
+func activateTransfer(ctx context.Context, config *Config, transferID string) error {
 
 	req := &datatransfer.ActivateTransferRequest{TransferId: transferID}
 
@@ -168,13 +177,12 @@ func activateTransfer(config *Config, transferID string) error {
 	}
 
 	if err := activateOp.Wait(ctx); err != nil {
-		return fmt.Errorf("error while waiting operation to complete: %s", err)
+		return apiError("error while waiting operation to complete", err, nil)
 	}
 	return nil
 }
 
-func deactivateTransfer(config *Config, transferID string) error {
-	ctx := config.Context()
+func deactivateTransfer(ctx context.Context, config *Config, transferID string) error {
 
 	req := &datatransfer.DeactivateTransferRequest{TransferId: transferID}
 
@@ -205,14 +213,13 @@ func deactivateTransfer(config *Config, transferID string) error {
 		return err
 	}
 	if err := deactivateOp.Wait(ctx); err != nil {
-		return fmt.Errorf("error while waiting operation to complete: %s", err)
+		return apiError("error while waiting operation to complete", err, nil)
 	}
 
 	return nil
 }
 
-func deleteTransfer(config *Config, transferID string) error {
-	ctx := config.Context()
+func deleteTransfer(ctx context.Context, config *Config, transferID string) error {
 
 	req := &datatransfer.DeleteTransferRequest{TransferId: transferID}
 
@@ -229,56 +236,54 @@ func deleteTransfer(config *Config, transferID string) error {
 	}
 
 	if err := op.Wait(ctx); err != nil {
-		return fmt.Errorf("error while waiting operation to complete: %s", err)
+		return apiError("error while waiting operation to complete", err, nil)
 	}
 
 	return nil
 }
 
-func resourceYandexDatatransferTransferCreateAndActivate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDatatransferTransferCreateAndActivate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	transfer, err := createTransfer(config, d)
+	transfer, err := createTransfer(ctx, config, d)
 	if err != nil {
-		return fmt.Errorf("cannot create transfer: %w", err)
+		return diag.Errorf("cannot create transfer: %s", err)
 	}
 
 	if transfer.Type != datatransfer.TransferType_SNAPSHOT_ONLY {
-		err := activateTransfer(config, transfer.Id)
+		err := activateTransfer(ctx, config, transfer.Id)
 		if err != nil {
-			return fmt.Errorf("cannot activate transfer %q: %w", transfer.Id, err)
+			return diag.Errorf("cannot activate transfer %q: %s", transfer.Id, err)
 		}
 	}
 
-	return resourceYandexDatatransferTransferRead(d, meta)
+	return resourceYandexDatatransferTransferRead(ctx, d, meta)
 }
 
-func resourceYandexDatatransferTransferDeactivateAndDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDatatransferTransferDeactivateAndDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	transferType, err := parseDatatransferTransferType(d.Get("type").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if transferType != datatransfer.TransferType_SNAPSHOT_ONLY {
-		if err := deactivateTransfer(config, d.Id()); err != nil {
-			return handleNotFoundError(err, d, fmt.Sprintf("transfer %q", d.Id()))
+		if err := deactivateTransfer(ctx, config, d.Id()); err != nil {
+			return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("transfer %q", d.Id())))
 		}
 	}
 
-	if err := deleteTransfer(config, d.Id()); err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("transfer %q", d.Id()))
+	if err := deleteTransfer(ctx, config, d.Id()); err != nil {
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("transfer %q", d.Id())))
 	}
 
 	return nil
 }
 
-func resourceYandexDatatransferTransferRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDatatransferTransferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx := config.Context()
-
 	req := &datatransfer.GetTransferRequest{
 		TransferId: d.Id(),
 	}
@@ -292,45 +297,43 @@ func resourceYandexDatatransferTransferRead(d *schema.ResourceData, meta interfa
 		log.Printf("[DEBUG] Read Transfer x-server-request-id: %s", traceHeader[0])
 	}
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("transfer %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("transfer %q", d.Id())))
 	}
 
 	if err := d.Set("description", resp.GetDescription()); err != nil {
 		log.Printf("[ERROR] failed set field description: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("folder_id", resp.GetFolderId()); err != nil {
 		log.Printf("[ERROR] failed set field folder_id: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("labels", resp.GetLabels()); err != nil {
 		log.Printf("[ERROR] failed set field labels: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("name", resp.GetName()); err != nil {
 		log.Printf("[ERROR] failed set field name: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("type", resp.GetType().String()); err != nil {
 		log.Printf("[ERROR] failed set field type: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("warning", resp.GetWarning()); err != nil {
 		log.Printf("[ERROR] failed set field warning: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceYandexDatatransferTransferUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDatatransferTransferUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx := config.Context()
-
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	req := &datatransfer.UpdateTransferRequest{
@@ -352,14 +355,14 @@ func resourceYandexDatatransferTransferUpdate(d *schema.ResourceData, meta inter
 		log.Printf("[DEBUG] Update Transfer x-server-request-id: %s", traceHeader[0])
 	}
 	if err != nil {
-		return err
+		return errorDiagnostics("error while requesting API to update transfer", err, resourceYandexDatatransferTransferUpdateFieldsMap)
 	}
 
 	if err := op.Wait(ctx); err != nil {
-		return fmt.Errorf("error while waiting operation to complete: %s", err)
+		return errorDiagnostics("error while waiting operation to complete", err, resourceYandexDatatransferTransferUpdateFieldsMap)
 	}
 
-	return resourceYandexDatatransferTransferRead(d, meta)
+	return resourceYandexDatatransferTransferRead(ctx, d, meta)
 }
 
 var resourceYandexDatatransferTransferUpdateFieldsMap = map[string]string{
@@ -7,6 +7,7 @@ import (
 	"net"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
@@ -14,12 +15,12 @@ import (
 
 func resourceYandexDnsRecordSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexDnsRecordSetCreate,
-		Read:   resourceYandexDnsRecordSetRead,
-		Update: resourceYandexDnsRecordSetUpdate,
-		Delete: resourceYandexDnsRecordSetDelete,
+		CreateContext: resourceYandexDnsRecordSetCreate,
+		ReadContext:   resourceYandexDnsRecordSetRead,
+		UpdateContext: resourceYandexDnsRecordSetUpdate,
+		DeleteContext: resourceYandexDnsRecordSetDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceDnsRecordSetImportState,
+			StateContext: resourceDnsRecordSetImportState,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -73,7 +74,7 @@ func resourceYandexDnsRecordSet() *schema.Resource {
 	}
 }
 
-func resourceYandexDnsRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDnsRecordSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	sdk := getSDK(config)
 
@@ -89,29 +90,29 @@ func resourceYandexDnsRecordSetCreate(d *schema.ResourceData, meta interface{})
 		Additions: []*dns.RecordSet{rs},
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
 	op, err := sdk.WrapOperation(sdk.DNS().DnsZone().UpdateRecordSets(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create DnsRecordSet: %s", err)
+		return errorDiagnostics("Error while requesting API to create DnsRecordSet", err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create DnsRecordSet: %s", err)
+		return errorDiagnostics("Error while waiting operation to create DnsRecordSet", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("DnsRecordSet creation failed: %s", err)
+		return errorDiagnostics("DnsRecordSet creation failed", err, nil)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", d.Get("zone_id"), d.Get("name"), d.Get("type")))
 
-	return resourceYandexDnsRecordSetRead(d, meta)
+	return resourceYandexDnsRecordSetRead(ctx, d, meta)
 }
 
-func resourceYandexDnsRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDnsRecordSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	sdk := getSDK(config)
 
@@ -121,10 +122,10 @@ func resourceYandexDnsRecordSetRead(d *schema.ResourceData, meta interface{}) er
 		Name:      d.Get("name").(string),
 	}
 
-	rs, err := sdk.DNS().DnsZone().GetRecordSet(config.Context(), req)
+	rs, err := sdk.DNS().DnsZone().GetRecordSet(ctx, req)
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("DnsRecordSet %s", rsId(d)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("DnsRecordSet %s", rsId(d))))
 	}
 
 	d.Set("ttl", int(rs.Ttl))
@@ -133,21 +134,21 @@ func resourceYandexDnsRecordSetRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceYandexDnsRecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDnsRecordSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	req, err := prepareDnsRecordSetUpdateRequest(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = makeDnsRecordSetUpdateRequest(req, d, meta)
+	err = makeDnsRecordSetUpdateRequest(ctx, req, d, meta)
 	if err != nil {
-		return err
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexDnsRecordSetRead(d, meta)
+	return resourceYandexDnsRecordSetRead(ctx, d, meta)
 }
 
-func resourceYandexDnsRecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDnsRecordSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	sdk := getSDK(config)
 
@@ -163,21 +164,21 @@ func resourceYandexDnsRecordSetDelete(d *schema.ResourceData, meta interface{})
 		Deletions: []*dns.RecordSet{rs},
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
 	op, err := sdk.WrapOperation(sdk.DNS().DnsZone().UpdateRecordSets(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create DnsRecordSet: %s", err)
+		return errorDiagnostics("Error while requesting API to create DnsRecordSet", err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create DnsRecordSet: %s", err)
+		return errorDiagnostics("Error while waiting operation to create DnsRecordSet", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("DnsRecordSet creation failed: %s", err)
+		return errorDiagnostics("DnsRecordSet creation failed", err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting DnsRecordSet %s", rsId(d))
@@ -215,16 +216,13 @@ func prepareDnsRecordSetUpdateRequest(d *schema.ResourceData) (*dns.UpdateRecord
 	return req, nil
 }
 
-func makeDnsRecordSetUpdateRequest(req *dns.UpdateRecordSetsRequest, d *schema.ResourceData, meta interface{}) error {
+func makeDnsRecordSetUpdateRequest(ctx context.Context, req *dns.UpdateRecordSetsRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	sdk := getSDK(config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := sdk.WrapOperation(sdk.DNS().DnsZone().UpdateRecordSets(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update DnsRecordSet %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update DnsRecordSet %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -235,7 +233,7 @@ func makeDnsRecordSetUpdateRequest(req *dns.UpdateRecordSetsRequest, d *schema.R
 	return nil
 }
 
-func resourceDnsRecordSetImportState(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceDnsRecordSetImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) == 3 {
 		if err := d.Set("zone_id", parts[0]); err != nil {
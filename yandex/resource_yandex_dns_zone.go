@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
 	"github.com/yandex-cloud/go-sdk/operation"
@@ -17,12 +18,12 @@ const yandexDnsDefaultTimeout = 5 * time.Minute
 
 func resourceYandexDnsZone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexDnsZoneCreate,
-		Read:   resourceYandexDnsZoneRead,
-		Update: resourceYandexDnsZoneUpdate,
-		Delete: resourceYandexDnsZoneDelete,
+		CreateContext: resourceYandexDnsZoneCreate,
+		ReadContext:   resourceYandexDnsZoneRead,
+		UpdateContext: resourceYandexDnsZoneUpdate,
+		DeleteContext: resourceYandexDnsZoneDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -91,17 +92,17 @@ func resourceYandexDnsZone() *schema.Resource {
 	}
 }
 
-func resourceYandexDnsZoneCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDnsZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating DnsZone: %s", err)
+		return diag.Errorf("Error getting folder ID while creating DnsZone: %s", err)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating DnsZone: %s", err)
+		return diag.Errorf("Error expanding labels while creating DnsZone: %s", err)
 	}
 
 	req := &dns.CreateDnsZoneRequest{
@@ -121,23 +122,23 @@ func resourceYandexDnsZoneCreate(d *schema.ResourceData, meta interface{}) error
 		req.PrivateVisibility.NetworkIds = convertStringSet(n.(*schema.Set))
 	}
 
-	if err := makeDnsZoneCreateRequest(req, d, meta); err != nil {
-		return fmt.Errorf("DnsZone creation failed: %s", err)
+	if err := makeDnsZoneCreateRequest(ctx, req, d, meta); err != nil {
+		return errorDiagnostics("DnsZone creation failed", err, nil)
 	}
 
-	return resourceYandexDnsZoneRead(d, meta)
+	return resourceYandexDnsZoneRead(ctx, d, meta)
 }
 
-func resourceYandexDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDnsZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	sdk := getSDK(config)
 
-	dnsZone, err := sdk.DNS().DnsZone().Get(config.Context(), &dns.GetDnsZoneRequest{
+	dnsZone, err := sdk.DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
 		DnsZoneId: d.Id(),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", d.Get("name").(string))))
 	}
 
 	d.Set("created_at", getTimestamp(dnsZone.CreatedAt))
@@ -150,27 +151,27 @@ func resourceYandexDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 
 	if dnsZone.PrivateVisibility != nil {
 		if err := d.Set("private_networks", convertStringArrToInterface(dnsZone.PrivateVisibility.GetNetworkIds())); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return d.Set("labels", dnsZone.Labels)
+	return diag.FromErr(d.Set("labels", dnsZone.Labels))
 }
 
-func resourceYandexDnsZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDnsZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	req, err := prepareDnsZoneUpdateRequest(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = makeDnsZoneUpdateRequest(req, d, meta)
+	err = makeDnsZoneUpdateRequest(ctx, req, d, meta)
 	if err != nil {
-		return err
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexDnsZoneRead(d, meta)
+	return resourceYandexDnsZoneRead(ctx, d, meta)
 }
 
-func resourceYandexDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexDnsZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	sdk := getSDK(config)
 
@@ -180,22 +181,19 @@ func resourceYandexDnsZoneDelete(d *schema.ResourceData, meta interface{}) error
 		DnsZoneId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := sdk.WrapOperation(sdk.DNS().DnsZone().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting DnsZone %q", d.Id()), err, nil)
 	}
 
 	resp, err := op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting DnsZone %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting DnsZone %q: %#v", d.Id(), resp)
@@ -227,13 +225,10 @@ func prepareDnsZoneUpdateRequest(d *schema.ResourceData) (*dns.UpdateDnsZoneRequ
 	return req, nil
 }
 
-func makeDnsZoneCreateRequest(req *dns.CreateDnsZoneRequest, d *schema.ResourceData, meta interface{}) error {
+func makeDnsZoneCreateRequest(ctx context.Context, req *dns.CreateDnsZoneRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	sdk := getSDK(config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	timeouts := []time.Duration{time.Millisecond * 500, time.Second * 2, time.Second * 10}
 
 	op, err := retrySpecificError(timeouts, func() (*operation.Operation, error) {
@@ -241,7 +236,7 @@ func makeDnsZoneCreateRequest(req *dns.CreateDnsZoneRequest, d *schema.ResourceD
 	}, isErrNetworkNotFound)
 
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create DnsZone: %s", err)
+		return apiError("Error while requesting API to create DnsZone", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
@@ -258,7 +253,7 @@ func makeDnsZoneCreateRequest(req *dns.CreateDnsZoneRequest, d *schema.ResourceD
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create DnsZone: %s", err)
+		return apiError("Error while waiting operation to create DnsZone", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
@@ -268,16 +263,13 @@ func makeDnsZoneCreateRequest(req *dns.CreateDnsZoneRequest, d *schema.ResourceD
 	return nil
 }
 
-func makeDnsZoneUpdateRequest(req *dns.UpdateDnsZoneRequest, d *schema.ResourceData, meta interface{}) error {
+func makeDnsZoneUpdateRequest(ctx context.Context, req *dns.UpdateDnsZoneRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	sdk := getSDK(config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := sdk.WrapOperation(sdk.DNS().DnsZone().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update DnsZone %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update DnsZone %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -3,6 +3,7 @@ package yandex
 import (
 	"archive/zip"
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -14,8 +15,10 @@ import (
 
 	"github.com/c2h5oh/datasize"
 	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/functions/v1"
 )
@@ -25,12 +28,12 @@ const versionCreateSourceContentMaxBytes = 3670016
 
 func resourceYandexFunction() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexFunctionCreate,
-		Read:   resourceYandexFunctionRead,
-		Update: resourceYandexFunctionUpdate,
-		Delete: resourceYandexFunctionDelete,
+		CreateContext: resourceYandexFunctionCreate,
+		ReadContext:   resourceYandexFunctionRead,
+		UpdateContext: resourceYandexFunctionUpdate,
+		DeleteContext: resourceYandexFunctionDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -172,25 +175,21 @@ func resourceYandexFunction() *schema.Resource {
 	}
 }
 
-func resourceYandexFunctionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexFunctionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating Yandex Cloud Function: %s", err)
+		return diag.Errorf("Error expanding labels while creating Yandex Cloud Function: %s", err)
 	}
 
-	versionReq, err := expandLastVersion(d)
-	if err != nil {
-		return err
+	if _, err := expandLastVersion(d); err != nil {
+		return diag.FromErr(err)
 	}
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating Yandex Cloud Function: %s", err)
+		return diag.Errorf("Error getting folder ID while creating Yandex Cloud Function: %s", err)
 	}
 
 	req := functions.CreateFunctionRequest{
@@ -202,51 +201,77 @@ func resourceYandexFunctionCreate(d *schema.ResourceData, meta interface{}) erro
 
 	op, err := config.sdk.WrapOperation(config.sdk.Serverless().Functions().Function().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Yandex Cloud Function: %s", err)
+		return errorDiagnostics("Error while requesting API to create Yandex Cloud Function", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Yandex Cloud Function: %s", err)
+		return errorDiagnostics("Error while requesting API to create Yandex Cloud Function", err, nil)
 	}
 
 	md, ok := protoMetadata.(*functions.CreateFunctionMetadata)
 	if !ok {
-		return fmt.Errorf("Could not get Yandex Cloud Function ID from create operation metadata")
+		return diag.Errorf("Could not get Yandex Cloud Function ID from create operation metadata")
 	}
 
 	d.SetId(md.FunctionId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Yandex Cloud Function: %s", err)
+		return errorDiagnostics("Error while requesting API to create Yandex Cloud Function", err, nil)
 	}
 
-	if versionReq != nil {
-		versionReq.FunctionId = md.FunctionId
-		op, err = config.sdk.WrapOperation(config.sdk.Serverless().Functions().Function().CreateVersion(ctx, versionReq))
-		if err != nil {
-			return fmt.Errorf("Error while requesting API to create version for Yandex Cloud Function: %s", err)
-		}
+	if err := finishResumableCreate(ctx, d, meta, op.Id(), finishFunctionCreate); err != nil {
+		return diag.FromErr(err)
+	}
 
-		err = op.Wait(ctx)
-		if err != nil {
-			return fmt.Errorf("Error while requesting API to create version for Yandex Cloud Function: %s", err)
-		}
+	return resourceYandexFunctionRead(ctx, d, meta)
+}
+
+// finishFunctionCreate creates the first version unless the function already has one.
+func finishFunctionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	versionReq, err := expandLastVersion(d)
+	if err != nil || versionReq == nil {
+		return err
 	}
 
-	return resourceYandexFunctionRead(d, meta)
+	_, err = config.sdk.Serverless().Functions().Function().GetVersionByTag(ctx, &functions.GetFunctionVersionByTagRequest{
+		FunctionId: d.Id(),
+		Tag:        "$latest",
+	})
+	if err == nil {
+		return nil
+	}
+	if !isStatusWithCode(err, codes.NotFound) {
+		return fmt.Errorf("Failed to get latest version of Yandex Cloud Function: %s", err)
+	}
+
+	versionReq.FunctionId = d.Id()
+	op, err := config.sdk.WrapOperation(config.sdk.Serverless().Functions().Function().CreateVersion(ctx, versionReq))
+	if err != nil {
+		return apiError("Error while requesting API to create version for Yandex Cloud Function", err, nil)
+	}
+	// The version is awaited on the next run instead of creating another one.
+	if err := d.Set(pendingOperationIDKey, op.Id()); err != nil {
+		return err
+	}
+	if err := op.Wait(ctx); err != nil {
+		return apiError("Error while requesting API to create version for Yandex Cloud Function", err, nil)
+	}
+	return nil
 }
 
-func resourceYandexFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while updating Yandex Cloud Function: %s", err)
+		return diag.Errorf("Error expanding labels while updating Yandex Cloud Function: %s", err)
 	}
 
 	d.Partial(true)
@@ -260,7 +285,7 @@ func resourceYandexFunctionUpdate(d *schema.ResourceData, meta interface{}) erro
 		updatePaths = append(updatePaths, "description")
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		updatePaths = append(updatePaths, "labels")
 	}
 
@@ -276,7 +301,7 @@ func resourceYandexFunctionUpdate(d *schema.ResourceData, meta interface{}) erro
 	if len(versionPartialPaths) != 0 {
 		versionReq, err = expandLastVersion(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -292,7 +317,7 @@ func resourceYandexFunctionUpdate(d *schema.ResourceData, meta interface{}) erro
 		op, err := config.sdk.Serverless().Functions().Function().Update(ctx, &req)
 		err = waitOperation(ctx, config, op, err)
 		if err != nil {
-			return fmt.Errorf("Error while requesting API to update Yandex Cloud Function: %s", err)
+			return errorDiagnostics("Error while requesting API to update Yandex Cloud Function", err, nil)
 		}
 
 	}
@@ -301,33 +326,30 @@ func resourceYandexFunctionUpdate(d *schema.ResourceData, meta interface{}) erro
 		versionReq.FunctionId = d.Id()
 		op, err := config.sdk.WrapOperation(config.sdk.Serverless().Functions().Function().CreateVersion(ctx, versionReq))
 		if err != nil {
-			return fmt.Errorf("Error while requesting API to create version for Yandex Cloud Function: %s", err)
+			return errorDiagnostics("Error while requesting API to create version for Yandex Cloud Function", err, nil)
 		}
 
 		err = op.Wait(ctx)
 		if err != nil {
-			return fmt.Errorf("Error while requesting API to create version for Yandex Cloud Function: %s", err)
+			return errorDiagnostics("Error while requesting API to create version for Yandex Cloud Function", err, nil)
 		}
 
 	}
 	d.Partial(false)
 
-	return resourceYandexFunctionRead(d, meta)
+	return resourceYandexFunctionRead(ctx, d, meta)
 }
 
-func resourceYandexFunctionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexFunctionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	req := functions.GetFunctionRequest{
 		FunctionId: d.Id(),
 	}
 
 	function, err := config.sdk.Serverless().Functions().Function().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Function %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Function %q", d.Id())))
 	}
 
 	versionReq := functions.GetFunctionVersionByTagRequest{
@@ -337,18 +359,15 @@ func resourceYandexFunctionRead(d *schema.ResourceData, meta interface{}) error
 
 	version, err := config.sdk.Serverless().Functions().Function().GetVersionByTag(ctx, &versionReq)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return flattenYandexFunction(d, function, version)
+	return diag.FromErr(flattenYandexFunction(d, function, version))
 }
 
-func resourceYandexFunctionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexFunctionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	req := functions.DeleteFunctionRequest{
 		FunctionId: d.Id(),
 	}
@@ -356,7 +375,7 @@ func resourceYandexFunctionDelete(d *schema.ResourceData, meta interface{}) erro
 	op, err := config.sdk.Serverless().Functions().Function().Delete(ctx, &req)
 	err = waitOperation(ctx, config, op, err)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Function %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Function %q", d.Id())))
 	}
 
 	return nil
@@ -0,0 +1,64 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/functions/v1"
+)
+
+func TestAccFunctionIamMember(t *testing.T) {
+	var function functions.Function
+	functionName := acctest.RandomWithPrefix("tf-function")
+	zipFilename := "test-fixtures/serverless/main.zip"
+
+	role := "serverless.functions.invoker"
+	member := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionIamMemberBasic(functionName, zipFilename, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testYandexFunctionExists(functionResource, &function),
+					testAccCheckFunctionIam(functionResource, role, []string{member}),
+				),
+			},
+			{
+				ResourceName: "yandex_function_iam_member.invoker",
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return fmt.Sprintf("%s %s %s", function.Id, role, member), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFunctionIamMemberBasic(functionName, zipFilename, role, member string) string {
+	return fmt.Sprintf(`
+resource "yandex_function" "test-function" {
+  name       = "%s"
+  user_hash  = "user_hash"
+  runtime    = "python37"
+  entrypoint = "main"
+  memory     = "128"
+  content {
+    zip_filename = "%s"
+  }
+}
+
+resource "yandex_function_iam_member" "invoker" {
+  function_id = yandex_function.test-function.id
+  role        = "%s"
+  member      = "%s"
+}
+`, functionName, zipFilename, role, member)
+}
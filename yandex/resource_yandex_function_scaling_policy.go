@@ -3,19 +3,29 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/functions/v1"
 )
 
+const yandexFunctionScalingPolicyDefaultTimeout = 5 * time.Minute
+
 func resourceYandexFunctionScalingPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexFunctionScalingPolicyCreate,
-		Read:   resourceYandexFunctionScalingPolicyRead,
-		Update: resourceYandexFunctionScalingPolicyUpdate,
-		Delete: resourceYandexFunctionScalingPolicyDelete,
+		CreateContext: resourceYandexFunctionScalingPolicyCreate,
+		ReadContext:   resourceYandexFunctionScalingPolicyRead,
+		UpdateContext: resourceYandexFunctionScalingPolicyUpdate,
+		DeleteContext: resourceYandexFunctionScalingPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexFunctionScalingPolicyDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexFunctionScalingPolicyDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexFunctionScalingPolicyDefaultTimeout),
 		},
 
 		SchemaVersion: 0,
@@ -53,45 +63,44 @@ func resourceYandexFunctionScalingPolicy() *schema.Resource {
 	}
 }
 
-func resourceYandexFunctionScalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	err := compareAndUpdateFunctionScalingPolicies(nil, d.Get("policy").(*schema.Set), d, meta)
+func resourceYandexFunctionScalingPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := compareAndUpdateFunctionScalingPolicies(ctx, nil, d.Get("policy").(*schema.Set), d, meta)
 	if err != nil {
-		return err
+		return diagnosticsFromError(err)
 	}
 
 	functionID := d.Get("function_id").(string)
 	d.SetId(functionID)
 
-	return resourceYandexFunctionScalingPolicyRead(d, meta)
+	return resourceYandexFunctionScalingPolicyRead(ctx, d, meta)
 }
 
-func resourceYandexFunctionScalingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexFunctionScalingPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx := config.Context()
 	functionID := d.Get("function_id").(string)
 
 	policies, err := fetchFunctionScalingPolicies(ctx, config, functionID)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Function %s Scaling Policy", functionID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Function %s Scaling Policy", functionID)))
 	}
 
-	return flattenYandexFunctionScalingPolicy(d, policies)
+	return diag.FromErr(flattenYandexFunctionScalingPolicy(d, policies))
 }
 
-func resourceYandexFunctionScalingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexFunctionScalingPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange("policy") {
 		o, n := d.GetChange("policy")
-		err := compareAndUpdateFunctionScalingPolicies(o.(*schema.Set), n.(*schema.Set), d, meta)
+		err := compareAndUpdateFunctionScalingPolicies(ctx, o.(*schema.Set), n.(*schema.Set), d, meta)
 		if err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 	}
 
-	return resourceYandexFunctionScalingPolicyRead(d, meta)
+	return resourceYandexFunctionScalingPolicyRead(ctx, d, meta)
 }
 
-func resourceYandexFunctionScalingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	return compareAndUpdateFunctionScalingPolicies(d.Get("policy").(*schema.Set), nil, d, meta)
+func resourceYandexFunctionScalingPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.FromErr(compareAndUpdateFunctionScalingPolicies(ctx, d.Get("policy").(*schema.Set), nil, d, meta))
 }
 
 func fetchFunctionScalingPolicies(ctx context.Context, config *Config, functionID string) ([]*functions.ScalingPolicy, error) {
@@ -153,7 +162,7 @@ func flattenYandexFunctionScalingPolicy(d *schema.ResourceData, scalingPolicies
 	return d.Set("policy", policies)
 }
 
-func compareAndUpdateFunctionScalingPolicies(oldPoliciesSet *schema.Set, newPoliciesSet *schema.Set, d *schema.ResourceData, meta interface{}) error {
+func compareAndUpdateFunctionScalingPolicies(ctx context.Context, oldPoliciesSet *schema.Set, newPoliciesSet *schema.Set, d *schema.ResourceData, meta interface{}) error {
 	newPolicies, err := expandFunctionScalingPolicies(newPoliciesSet)
 	if err != nil {
 		return err
@@ -164,8 +173,6 @@ func compareAndUpdateFunctionScalingPolicies(oldPoliciesSet *schema.Set, newPoli
 	}
 
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
 
 	functionID := d.Get("function_id").(string)
 
@@ -182,7 +189,7 @@ func compareAndUpdateFunctionScalingPolicies(oldPoliciesSet *schema.Set, newPoli
 			op, err := config.sdk.Serverless().Functions().Function().SetScalingPolicy(ctx, req)
 			err = waitOperation(ctx, config, op, err)
 			if err != nil {
-				return fmt.Errorf("Error while requesting API to set Yandex Cloud Function Scaling Policy: %s", err)
+				return apiError("Error while requesting API to set Yandex Cloud Function Scaling Policy", err, nil)
 			}
 		}
 	}
@@ -198,7 +205,7 @@ func compareAndUpdateFunctionScalingPolicies(oldPoliciesSet *schema.Set, newPoli
 			op, err := config.sdk.Serverless().Functions().Function().RemoveScalingPolicy(ctx, req)
 			err = waitOperation(ctx, config, op, err)
 			if err != nil {
-				return fmt.Errorf("Error while requesting API to remove Yandex Cloud Function Scaling Policy: %s", err)
+				return apiError("Error while requesting API to remove Yandex Cloud Function Scaling Policy", err, nil)
 			}
 		}
 	}
@@ -1,12 +1,14 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -54,12 +56,12 @@ var levelEnumToName = map[logging.LogLevel_Level]string{
 
 func resourceYandexFunctionTrigger() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexFunctionTriggerCreate,
-		Read:   resourceYandexFunctionTriggerRead,
-		Update: resourceYandexFunctionTriggerUpdate,
-		Delete: resourceYandexFunctionTriggerDelete,
+		CreateContext: resourceYandexFunctionTriggerCreate,
+		ReadContext:   resourceYandexFunctionTriggerRead,
+		UpdateContext: resourceYandexFunctionTriggerUpdate,
+		DeleteContext: resourceYandexFunctionTriggerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -387,20 +389,17 @@ func resourceYandexFunctionTrigger() *schema.Resource {
 	}
 }
 
-func resourceYandexFunctionTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexFunctionTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating Yandex Cloud Functions Trigger: %s", err)
+		return diag.Errorf("Error expanding labels while creating Yandex Cloud Functions Trigger: %s", err)
 	}
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating Yandex Cloud Functions Trigger: %s", err)
+		return diag.Errorf("Error getting folder ID while creating Yandex Cloud Functions Trigger: %s", err)
 	}
 
 	req := triggers.CreateTriggerRequest{
@@ -412,12 +411,12 @@ func resourceYandexFunctionTriggerCreate(d *schema.ResourceData, meta interface{
 
 	retrySettings, err := expandRetrySettings(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dlqSettings, err := expandDLQSettings(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var getInvokeFunctionWithRetry = func() *triggers.InvokeFunctionWithRetry {
@@ -459,7 +458,7 @@ func resourceYandexFunctionTriggerCreate(d *schema.ResourceData, meta interface{
 		triggerCnt++
 		batch, err := expandBatchSettings(d, "message_queue.0")
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		messageQueue := &triggers.Trigger_MessageQueue{
@@ -474,7 +473,7 @@ func resourceYandexFunctionTriggerCreate(d *schema.ResourceData, meta interface{
 		if _, ok := d.GetOk("message_queue.0.visibility_timeout"); ok {
 			timeout, err := strconv.ParseInt(d.Get("message_queue.0.visibility_timeout").(string), 10, 64)
 			if err != nil {
-				return fmt.Errorf("Cannot define message_queue.visibility_timeout for Yandex Cloud Functions Trigger: %s", err)
+				return diag.Errorf("Cannot define message_queue.visibility_timeout for Yandex Cloud Functions Trigger: %s", err)
 			}
 			messageQueue.VisibilityTimeout = &duration.Duration{Seconds: timeout}
 		}
@@ -544,7 +543,7 @@ func resourceYandexFunctionTriggerCreate(d *schema.ResourceData, meta interface{
 		}
 		batch, err := expandBatchSettings(d, "log_group.0")
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if batch != nil {
 			cloudLogs.BatchSettings = &triggers.CloudLogsBatchSettings{
@@ -580,7 +579,7 @@ func resourceYandexFunctionTriggerCreate(d *schema.ResourceData, meta interface{
 		}
 		batch, err := expandBatchSettings(d, "logging.0")
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if batch != nil {
 			logging.BatchSettings = &triggers.LoggingBatchSettings{
@@ -594,43 +593,43 @@ func resourceYandexFunctionTriggerCreate(d *schema.ResourceData, meta interface{
 	}
 
 	if triggerCnt != 1 {
-		return fmt.Errorf("Yandex Cloud Functions Trigger must have only one any iot, message_queue, object_storage, timer, logging section")
+		return diag.Errorf("Yandex Cloud Functions Trigger must have only one any iot, message_queue, object_storage, timer, logging section")
 	}
 
 	op, err := config.sdk.WrapOperation(config.sdk.Serverless().Triggers().Trigger().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Yandex Cloud Functions Trigger: %s", err)
+		return errorDiagnostics("Error while requesting API to create Yandex Cloud Functions Trigger", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Yandex Cloud Function Trigger: %s", err)
+		return errorDiagnostics("Error while requesting API to create Yandex Cloud Function Trigger", err, nil)
 	}
 
 	md, ok := protoMetadata.(*triggers.CreateTriggerMetadata)
 	if !ok {
-		return fmt.Errorf("Could not get Yandex Cloud Functions Trigger ID from create operation metadata")
+		return diag.Errorf("Could not get Yandex Cloud Functions Trigger ID from create operation metadata")
 	}
 
 	d.SetId(md.TriggerId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Yandex Cloud Functions Trigger: %s", err)
+		return errorDiagnostics("Error while requesting API to create Yandex Cloud Functions Trigger", err, nil)
 	}
 
-	return resourceYandexFunctionTriggerRead(d, meta)
+	return resourceYandexFunctionTriggerRead(ctx, d, meta)
 }
 
-func resourceYandexFunctionTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexFunctionTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating Yandex Cloud Functions Trigger: %s", err)
+		return diag.Errorf("Error expanding labels while creating Yandex Cloud Functions Trigger: %s", err)
 	}
 
 	d.Partial(true)
@@ -644,7 +643,7 @@ func resourceYandexFunctionTriggerUpdate(d *schema.ResourceData, meta interface{
 		updatePaths = append(updatePaths, "description")
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		updatePaths = append(updatePaths, "labels")
 	}
 
@@ -660,14 +659,14 @@ func resourceYandexFunctionTriggerUpdate(d *schema.ResourceData, meta interface{
 		op, err := config.sdk.Serverless().Triggers().Trigger().Update(ctx, &req)
 		err = waitOperation(ctx, config, op, err)
 		if err != nil {
-			return fmt.Errorf("Error while requesting API to update Yandex Cloud Functions Trigger: %s", err)
+			return errorDiagnostics("Error while requesting API to update Yandex Cloud Functions Trigger", err, nil)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceYandexFunctionTriggerRead(d, meta)
+	return resourceYandexFunctionTriggerRead(ctx, d, meta)
 }
 
 func flattenYandexFunctionTriggerInvokeOnce(d *schema.ResourceData, function *triggers.InvokeFunctionOnce) error {
@@ -881,30 +880,24 @@ func flattenYandexFunctionTrigger(d *schema.ResourceData, trig *triggers.Trigger
 	return nil
 }
 
-func resourceYandexFunctionTriggerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexFunctionTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	req := triggers.GetTriggerRequest{
 		TriggerId: d.Id(),
 	}
 
 	trig, err := config.sdk.Serverless().Triggers().Trigger().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Functions Trigger %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Functions Trigger %q", d.Id())))
 	}
 
-	return flattenYandexFunctionTrigger(d, trig)
+	return diag.FromErr(flattenYandexFunctionTrigger(d, trig))
 }
 
-func resourceYandexFunctionTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexFunctionTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	req := triggers.DeleteTriggerRequest{
 		TriggerId: d.Id(),
 	}
@@ -912,7 +905,7 @@ func resourceYandexFunctionTriggerDelete(d *schema.ResourceData, meta interface{
 	op, err := config.sdk.Serverless().Triggers().Trigger().Delete(ctx, &req)
 	err = waitOperation(ctx, config, op, err)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Functions Trigger %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Functions Trigger %q", d.Id())))
 	}
 
 	return nil
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -16,12 +17,12 @@ const yandexIAMServiceAccountDefaultTimeout = 1 * time.Minute
 
 func resourceYandexIAMServiceAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexIAMServiceAccountCreate,
-		Read:   resourceYandexIAMServiceAccountRead,
-		Update: resourceYandexIAMServiceAccountUpdate,
-		Delete: resourceYandexIAMServiceAccountDelete,
+		CreateContext: resourceYandexIAMServiceAccountCreate,
+		ReadContext:   resourceYandexIAMServiceAccountRead,
+		UpdateContext: resourceYandexIAMServiceAccountUpdate,
+		DeleteContext: resourceYandexIAMServiceAccountDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -58,19 +59,19 @@ func resourceYandexIAMServiceAccount() *schema.Resource {
 	}
 }
 
-func resourceYandexIAMServiceAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIAMServiceAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating service account: %s", err)
+		return diag.Errorf("Error getting folder ID while creating service account: %s", err)
 	}
 
 	// Lock cloud to prevent out IAM changes:
 	// SA create operation adds 'resource-manager.clouds.member' role
-	unlock, err := lockCloudByFolderID(config, folderID)
+	unlock, err := lockCloudByFolderID(ctx, config, folderID)
 	if err != nil {
-		return fmt.Errorf("could not lock cloud to prevent IAM changes: %s", err)
+		return diag.Errorf("could not lock cloud to prevent IAM changes: %s", err)
 	}
 	defer unlock()
 
@@ -80,47 +81,44 @@ func resourceYandexIAMServiceAccountCreate(d *schema.ResourceData, meta interfac
 		Description: d.Get("description").(string),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.IAM().ServiceAccount().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create service account: %s", err)
+		return errorDiagnostics("Error while requesting API to create service account", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get service account create operation metadata: %s", err)
+		return diag.Errorf("Error while get service account create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*iam.CreateServiceAccountMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Service Account ID from create operation metadata")
+		return diag.Errorf("could not get Service Account ID from create operation metadata")
 	}
 
 	d.SetId(md.ServiceAccountId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create service account: %s", err)
+		return errorDiagnostics("Error while waiting operation to create service account", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Service account creation failed: %s", err)
+		return errorDiagnostics("Service account creation failed", err, nil)
 	}
 
-	return resourceYandexIAMServiceAccountRead(d, meta)
+	return resourceYandexIAMServiceAccountRead(ctx, d, meta)
 }
 
-func resourceYandexIAMServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIAMServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	sa, err := config.sdk.IAM().ServiceAccount().Get(config.Context(), &iam.GetServiceAccountRequest{
+	sa, err := config.sdk.IAM().ServiceAccount().Get(ctx, &iam.GetServiceAccountRequest{
 		ServiceAccountId: d.Id(),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Service Account %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Service Account %q", d.Get("name").(string))))
 	}
 
 	d.Set("created_at", getTimestamp(sa.CreatedAt))
@@ -131,7 +129,7 @@ func resourceYandexIAMServiceAccountRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceYandexIAMServiceAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIAMServiceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	d.Partial(true)
@@ -151,37 +149,34 @@ func resourceYandexIAMServiceAccountUpdate(d *schema.ResourceData, meta interfac
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.IAM().ServiceAccount().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Service Account %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to update Service Account %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error updating Service Account %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error updating Service Account %q", d.Id()), err, nil)
 	}
 
 	d.Partial(false)
 
-	return resourceYandexIAMServiceAccountRead(d, meta)
+	return resourceYandexIAMServiceAccountRead(ctx, d, meta)
 }
 
-func resourceYandexIAMServiceAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIAMServiceAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while deleting service account: %s", err)
+		return diag.Errorf("Error getting folder ID while deleting service account: %s", err)
 	}
 
 	// Lock cloud to prevent out IAM changes:
 	// SA delete operation removes 'resource-manager.clouds.member' role
-	unlock, err := lockCloudByFolderID(config, folderID)
+	unlock, err := lockCloudByFolderID(ctx, config, folderID)
 	if err != nil {
-		return fmt.Errorf("could not lock cloud to prevent IAM changes: %s", err)
+		return diag.Errorf("could not lock cloud to prevent IAM changes: %s", err)
 	}
 	defer unlock()
 
@@ -191,22 +186,19 @@ func resourceYandexIAMServiceAccountDelete(d *schema.ResourceData, meta interfac
 		ServiceAccountId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.IAM().ServiceAccount().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Service Account %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Service Account %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Service Account %q", d.Id()), err, nil)
 	}
 
 	resp, err := op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Service Account %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Service Account %q: %#v", d.Id(), resp)
@@ -4,19 +4,39 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/encryption"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
 )
 
+var iamServiceAccountAPIKeySecret = iamKeySecret{
+	description:    "Yandex Service Account API Key",
+	plaintext:      "secret_key",
+	encrypted:      "encrypted_secret_key",
+	lockboxEntries: []string{"entry_for_secret_key"},
+	deleteKey: func(ctx context.Context, config *Config, id string) error {
+		_, err := config.sdk.IAM().ApiKey().Delete(ctx, &iam.DeleteApiKeyRequest{ApiKeyId: id})
+		return err
+	},
+}
+
 func resourceYandexIAMServiceAccountAPIKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexIAMServiceAccountAPIKeyCreate,
-		Read:   resourceYandexIAMServiceAccountAPIKeyRead,
-		Delete: resourceYandexIAMServiceAccountAPIKeyDelete,
+		CreateContext: resourceYandexIAMServiceAccountAPIKeyCreate,
+		ReadContext:   resourceYandexIAMServiceAccountAPIKeyRead,
+		DeleteContext: resourceYandexIAMServiceAccountAPIKeyDelete,
+		// Only metadata is imported, secret part of the key is never returned by API.
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexIAMServiceAccountDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexIAMServiceAccountDefaultTimeout),
+		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: iamServiceAccountAPIKeySecret.addSchema(map[string]*schema.Schema{
 			"service_account_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -57,57 +77,37 @@ func resourceYandexIAMServiceAccountAPIKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-		},
+		}),
 	}
 }
 
-func resourceYandexIAMServiceAccountAPIKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIAMServiceAccountAPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	resp, err := config.sdk.IAM().ApiKey().Create(ctx, &iam.CreateApiKeyRequest{
 		ServiceAccountId: d.Get("service_account_id").(string),
 		Description:      d.Get("description").(string),
 	})
 	if err != nil {
-		return fmt.Errorf("error creating api key: %s", err)
+		return errorDiagnostics("error creating api key", err, nil)
 	}
 
-	d.SetId(resp.ApiKey.Id)
 	// Data only available on create.
-	if v, ok := d.GetOk("pgp_key"); ok {
-		encryptionKey, err := encryption.RetrieveGPGKey(v.(string))
-		if err != nil {
-			return err
-		}
-
-		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, resp.Secret, "Yandex Service Account API Key")
-		if err != nil {
-			return err
-		}
-
-		d.Set("key_fingerprint", fingerprint)
-		d.Set("encrypted_secret_key", encrypted)
-	} else {
-		d.Set("secret_key", resp.Secret)
+	if err := iamServiceAccountAPIKeySecret.storeNew(ctx, d, config, resp.ApiKey.Id, resp.Secret); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceYandexIAMServiceAccountAPIKeyRead(d, meta)
+	return resourceYandexIAMServiceAccountAPIKeyRead(ctx, d, meta)
 }
 
-func resourceYandexIAMServiceAccountAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIAMServiceAccountAPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	ak, err := config.sdk.IAM().ApiKey().Get(ctx, &iam.GetApiKeyRequest{
 		ApiKeyId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Api Key %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Api Key %q", d.Id())))
 	}
 
 	d.Set("service_account_id", ak.ServiceAccountId)
@@ -117,17 +117,14 @@ func resourceYandexIAMServiceAccountAPIKeyRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceYandexIAMServiceAccountAPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIAMServiceAccountAPIKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	_, err := config.sdk.IAM().ApiKey().Delete(ctx, &iam.DeleteApiKeyRequest{
 		ApiKeyId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Api Key %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Api Key %q", d.Id())))
 	}
 
 	d.SetId("")
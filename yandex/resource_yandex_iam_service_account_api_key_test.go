@@ -34,6 +34,13 @@ func TestAccServiceAccountAPIKey_basic(t *testing.T) {
 					testAccCheckCreatedAtAttr(resourceName),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Secret part of the key is never returned by API.
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
 		},
 	})
 }
@@ -66,6 +73,30 @@ func TestAccServiceAccountAPIKey_encrypted(t *testing.T) {
 	})
 }
 
+func TestAccServiceAccountAPIKey_kms(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "yandex_iam_service_account_api_key.acceptance"
+	accountName := "sa" + acctest.RandString(10)
+	keyName := "key" + acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceAccountAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountAPIKeyConfigKMS(accountName, keyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAccountAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "encrypted_secret_key"),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_key"),
+					resource.TestCheckNoResourceAttr(resourceName, "key_fingerprint"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckServiceAccountAPIKeyDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -136,3 +167,20 @@ EOF
 }
 `, name, desc, key)
 }
+
+func testAccServiceAccountAPIKeyConfigKMS(name, keyName string) string {
+	return fmt.Sprintf(`
+resource "yandex_iam_service_account" "acceptance" {
+  name = "%s"
+}
+
+resource "yandex_kms_symmetric_key" "acceptance" {
+  name = "%s"
+}
+
+resource "yandex_iam_service_account_api_key" "acceptance" {
+  service_account_id = "${yandex_iam_service_account.acceptance.id}"
+  kms_key_id         = "${yandex_kms_symmetric_key.acceptance.id}"
+}
+`, name, keyName)
+}
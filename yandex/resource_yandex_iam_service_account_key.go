@@ -4,20 +4,57 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
-	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/encryption"
 	"google.golang.org/genproto/protobuf/field_mask"
 )
 
+var iamServiceAccountKeyRotation = iamKeyRotation{
+	kind: "service account key",
+	previous: map[string]string{
+		"id":                    "previous_key_id",
+		"public_key":            "previous_public_key",
+		"private_key":           "previous_private_key",
+		"encrypted_private_key": "previous_encrypted_private_key",
+		// Lockbox secret keeps the previous key in the previous version.
+		"output_to_lockbox_version_id": "previous_output_to_lockbox_version_id",
+	},
+	deleteKey: deleteIAMServiceAccountKey,
+}
+
+var iamServiceAccountKeySecret = iamKeySecret{
+	description:    "Yandex Service Account Key",
+	plaintext:      "private_key",
+	encrypted:      "encrypted_private_key",
+	lockboxEntries: []string{"entry_for_private_key"},
+	deleteKey:      deleteIAMServiceAccountKey,
+}
+
+func deleteIAMServiceAccountKey(ctx context.Context, config *Config, id string) error {
+	_, err := config.sdk.IAM().Key().Delete(ctx, &iam.DeleteKeyRequest{KeyId: id})
+	return err
+}
+
 func resourceYandexIAMServiceAccountKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexIAMServiceAccountKeyCreate,
-		Read:   resourceYandexIAMServiceAccountKeyRead,
-		Update: resourceYandexIAMServiceAccountKeyUpdate,
-		Delete: resourceYandexIAMServiceAccountKeyDelete,
+		CreateContext: resourceYandexIAMServiceAccountKeyCreate,
+		ReadContext:   resourceYandexIAMServiceAccountKeyRead,
+		UpdateContext: resourceYandexIAMServiceAccountKeyUpdate,
+		DeleteContext: resourceYandexIAMServiceAccountKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexIAMServiceAccountKeyImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexIAMServiceAccountDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexIAMServiceAccountDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexIAMServiceAccountDefaultTimeout),
+		},
+
+		CustomizeDiff: iamServiceAccountKeyRotation.customizeDiff,
 
-		Schema: map[string]*schema.Schema{
+		Schema: iamServiceAccountKeyRotation.addSchema(iamServiceAccountKeySecret.addSchema(map[string]*schema.Schema{
 			"service_account_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -76,16 +113,20 @@ func resourceYandexIAMServiceAccountKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-		},
+		})),
 	}
 }
 
-func resourceYandexIAMServiceAccountKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
+func resourceYandexIAMServiceAccountKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := createIAMServiceAccountKey(ctx, d, meta.(*Config)); err != nil {
+		return diag.FromErr(err)
+	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
+	return resourceYandexIAMServiceAccountKeyRead(ctx, d, meta)
+}
 
+// createIAMServiceAccountKey creates a new key and sets its ID and private key, which is only available on create.
+func createIAMServiceAccountKey(ctx context.Context, d *schema.ResourceData, config *Config) error {
 	format, err := parseIamKeyFormat(d.Get("format").(string))
 	if err != nil {
 		return err
@@ -106,37 +147,16 @@ func resourceYandexIAMServiceAccountKeyCreate(d *schema.ResourceData, meta inter
 		return fmt.Errorf("error creating service account key: %s", err)
 	}
 
-	d.SetId(resp.Key.Id)
 	// Data only available on create.
-	if v, ok := d.GetOk("pgp_key"); ok {
-		encryptionKey, err := encryption.RetrieveGPGKey(v.(string))
-		if err != nil {
-			return err
-		}
-
-		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, resp.PrivateKey, "Yandex Service Account Key")
-		if err != nil {
-			return err
-		}
-
-		d.Set("key_fingerprint", fingerprint)
-		d.Set("encrypted_private_key", encrypted)
-	} else {
-		d.Set("private_key", resp.PrivateKey)
-	}
-
-	return resourceYandexIAMServiceAccountKeyRead(d, meta)
+	return iamServiceAccountKeySecret.storeNew(ctx, d, config, resp.Key.Id, resp.PrivateKey)
 }
 
-func resourceYandexIAMServiceAccountKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIAMServiceAccountKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	format, err := parseIamKeyFormat(d.Get("format").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	key, err := config.sdk.IAM().Key().Get(ctx, &iam.GetKeyRequest{
@@ -144,7 +164,7 @@ func resourceYandexIAMServiceAccountKeyRead(d *schema.ResourceData, meta interfa
 		Format: format,
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Service Account Key %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Service Account Key %q", d.Id())))
 	}
 
 	d.Set("service_account_id", key.GetServiceAccountId())
@@ -156,11 +176,26 @@ func resourceYandexIAMServiceAccountKeyRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceYandexIAMServiceAccountKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+// resourceYandexIAMServiceAccountKeyImport imports key metadata and public key, private key is never returned by API.
+func resourceYandexIAMServiceAccountKeyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Read requests public key in the format from state, which is empty on import.
+	d.Set("format", iam.KeyFormat_PEM_FILE.String())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceYandexIAMServiceAccountKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
+	err := iamServiceAccountKeyRotation.update(ctx, d, config, func() error {
+		return createIAMServiceAccountKey(ctx, d, config)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// The new key is created with the new description.
+	if iamServiceAccountKeyRotation.rotating(d) {
+		return resourceYandexIAMServiceAccountKeyRead(ctx, d, meta)
+	}
 
 	req := &iam.UpdateKeyRequest{
 		KeyId:       d.Id(),
@@ -179,24 +214,25 @@ func resourceYandexIAMServiceAccountKeyUpdate(d *schema.ResourceData, meta inter
 		req.UpdateMask = &field_mask.FieldMask{Paths: updatedFields}
 		_, err := config.sdk.IAM().Key().Update(ctx, req)
 		if err != nil {
-			return handleNotFoundError(err, d, fmt.Sprintf("Service Account Key %q", d.Id()))
+			return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Service Account Key %q", d.Id())))
 		}
 	}
 
-	return resourceYandexIAMServiceAccountKeyRead(d, meta)
+	return resourceYandexIAMServiceAccountKeyRead(ctx, d, meta)
 }
 
-func resourceYandexIAMServiceAccountKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIAMServiceAccountKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
+	if err := iamServiceAccountKeyRotation.deletePrevious(ctx, d, config); err != nil {
+		return diag.FromErr(err)
+	}
 
 	_, err := config.sdk.IAM().Key().Delete(ctx, &iam.DeleteKeyRequest{
 		KeyId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Service Account Key %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Service Account Key %q", d.Id())))
 	}
 
 	d.SetId("")
@@ -2,15 +2,22 @@ package yandex
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/vault/helper/pgpkeys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
 )
 
 // Test that a service account key can be created and destroyed
@@ -42,6 +49,13 @@ func TestAccServiceAccountKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Secret part of the key is never returned by API.
+				ImportStateVerifyIgnore: []string{"private_key"},
+			},
 		},
 	})
 }
@@ -144,3 +158,157 @@ EOF
 }
 `, name, desc, key)
 }
+
+// ageKeyState makes the key in the state look created the duration ago.
+func ageKeyState(state *terraform.InstanceState, age time.Duration) {
+	state.Attributes["created_at"] = time.Now().Add(-age).Format(defaultTimeFormat)
+}
+
+func TestServiceAccountKeyRotation_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	sa := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_iam_service_account"), nil, map[string]interface{}{"name": "robot"})
+
+	r := fakeCloudResource(t, "yandex_iam_service_account_key")
+	raw := map[string]interface{}{
+		"service_account_id": sa.ID,
+		"rotation_period":    "720h",
+		"overlap_period":     "24h",
+	}
+	keyExists := func(id string) bool {
+		_, err := config.sdk.IAM().Key().Get(context.Background(), &iam.GetKeyRequest{KeyId: id})
+		if status.Code(err) == codes.NotFound {
+			return false
+		}
+		require.NoError(t, err)
+		return true
+	}
+
+	state := fakeCloudApply(t, config, r, nil, raw)
+	first := state.ID
+	firstPrivateKey := state.Attributes["private_key"]
+	require.NotEmpty(t, firstPrivateKey)
+	assert.True(t, fakeCloudPlan(t, config, r, state, raw).Empty())
+
+	// Rotation creates a new key and keeps the current one as the previous key.
+	ageKeyState(state, 721*time.Hour)
+	state = fakeCloudApply(t, config, r, state, raw)
+	second := state.ID
+	assert.NotEqual(t, first, second)
+	assert.NotEqual(t, firstPrivateKey, state.Attributes["private_key"])
+	assert.Equal(t, first, state.Attributes["previous_key_id"])
+	assert.Equal(t, firstPrivateKey, state.Attributes["previous_private_key"])
+	assert.True(t, keyExists(first))
+	assert.True(t, fakeCloudPlan(t, config, r, state, raw).Empty())
+
+	// The previous key is deleted by the first apply after the overlap window.
+	ageKeyState(state, 25*time.Hour)
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.Equal(t, second, state.ID)
+	assert.Empty(t, state.Attributes["previous_key_id"])
+	assert.Empty(t, state.Attributes["previous_private_key"])
+	assert.False(t, keyExists(first))
+
+	// Change of keepers rotates the key regardless of the rotation period.
+	raw["keepers"] = map[string]interface{}{"deployment": "2"}
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.NotEqual(t, second, state.ID)
+	assert.Equal(t, second, state.Attributes["previous_key_id"])
+	third := state.ID
+
+	// The key isn't rotated again while the previous key is in the overlap window.
+	raw["keepers"] = map[string]interface{}{"deployment": "3"}
+	_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), config)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("can't be rotated before the overlap window of the previous key %q ends", second))
+
+	raw["keepers"] = map[string]interface{}{"deployment": "2"}
+	raw["overlap_period"] = "800h"
+	ageKeyState(state, 721*time.Hour)
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.Equal(t, third, state.ID)
+	assert.Equal(t, second, state.Attributes["previous_key_id"])
+	assert.True(t, keyExists(second))
+	assert.True(t, fakeCloudPlan(t, config, r, state, raw).Empty())
+
+	// The due rotation happens after the window, replacing the previous key.
+	ageKeyState(state, 801*time.Hour)
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.NotEqual(t, third, state.ID)
+	assert.Equal(t, third, state.Attributes["previous_key_id"])
+	assert.False(t, keyExists(second))
+
+	fakeCloudDestroy(t, config, r, state)
+	assert.False(t, keyExists(state.ID))
+	assert.False(t, keyExists(third))
+}
+
+func TestServiceAccountKeyKMS_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	sa := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_iam_service_account"), nil, map[string]interface{}{"name": "robot"})
+
+	r := fakeCloudResource(t, "yandex_iam_service_account_key")
+	state := fakeCloudApply(t, config, r, nil, map[string]interface{}{
+		"service_account_id": sa.ID,
+		"kms_key_id":         "abjfakekmskey0000000",
+	})
+	assert.Empty(t, state.Attributes["private_key"])
+	assert.Empty(t, state.Attributes["key_fingerprint"])
+
+	ciphertext, err := base64.StdEncoding.DecodeString(state.Attributes["encrypted_private_key"])
+	require.NoError(t, err)
+	resp, err := config.sdk.KMSCrypto().SymmetricCrypto().Decrypt(context.Background(), &kms.SymmetricDecryptRequest{
+		KeyId:      "abjfakekmskey0000000",
+		Ciphertext: ciphertext,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "fake private key "+state.ID, string(resp.Plaintext))
+}
+
+func TestServiceAccountKeyStoreFailure_fakeCloud(t *testing.T) {
+	config, server := newFakeCloudConfig(t)
+	sa := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_iam_service_account"), nil, map[string]interface{}{"name": "robot"})
+	keyIDs := func() []string {
+		resp, err := config.sdk.IAM().Key().List(context.Background(), &iam.ListKeysRequest{ServiceAccountId: sa.ID})
+		require.NoError(t, err)
+		var ids []string
+		for _, k := range resp.Keys {
+			ids = append(ids, k.Id)
+		}
+		return ids
+	}
+	const encryptMethod = "/yandex.cloud.kms.v1.SymmetricCryptoService/Encrypt"
+
+	r := fakeCloudResource(t, "yandex_iam_service_account_key")
+	raw := map[string]interface{}{
+		"service_account_id": sa.ID,
+		"kms_key_id":         "abjfakekmskey0000000",
+		"rotation_period":    "720h",
+		"overlap_period":     "24h",
+	}
+
+	// The key whose private part can't be stored is deleted, nothing is saved in the state.
+	server.FailNext(encryptMethod, status.Error(codes.Unavailable, "try again later"))
+	state, diags := r.Apply(context.Background(), nil, fakeCloudPlan(t, config, r, nil, raw), config)
+	require.True(t, diags.HasError())
+	assert.True(t, state == nil || state.ID == "")
+	assert.Empty(t, keyIDs())
+
+	state = fakeCloudApply(t, config, r, nil, raw)
+	first := state.ID
+	ageKeyState(state, 721*time.Hour)
+	state = fakeCloudApply(t, config, r, state, raw)
+	second := state.ID
+	require.Equal(t, first, state.Attributes["previous_key_id"])
+
+	// Failed rotation keeps both keys in the state and deletes the new one.
+	ageKeyState(state, 721*time.Hour)
+	encrypted := state.Attributes["encrypted_private_key"]
+	server.FailNext(encryptMethod, status.Error(codes.Unavailable, "try again later"))
+	failed, diags := r.Apply(context.Background(), state, fakeCloudPlan(t, config, r, state, raw), config)
+	require.True(t, diags.HasError())
+	require.NotNil(t, failed)
+	assert.Equal(t, second, failed.ID)
+	assert.Equal(t, encrypted, failed.Attributes["encrypted_private_key"])
+	assert.Equal(t, first, failed.Attributes["previous_key_id"])
+	assert.ElementsMatch(t, []string{first, second}, keyIDs())
+}
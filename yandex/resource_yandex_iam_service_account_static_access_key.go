@@ -4,31 +4,68 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/encryption"
+	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1/awscompatibility"
 )
 
+var iamServiceAccountStaticAccessKeyRotation = iamKeyRotation{
+	kind: "service account static access key",
+	previous: map[string]string{
+		"id":                   "previous_key_id",
+		"access_key":           "previous_access_key",
+		"secret_key":           "previous_secret_key",
+		"encrypted_secret_key": "previous_encrypted_secret_key",
+		// Lockbox secret keeps the previous key in the previous version.
+		"output_to_lockbox_version_id": "previous_output_to_lockbox_version_id",
+	},
+	deleteKey: deleteIAMServiceAccountStaticAccessKey,
+}
+
+var iamServiceAccountStaticAccessKeySecret = iamKeySecret{
+	description:    "Yandex Service Account Static Access Key",
+	plaintext:      "secret_key",
+	encrypted:      "encrypted_secret_key",
+	lockboxEntries: []string{"entry_for_secret_key", "entry_for_access_key"},
+	deleteKey:      deleteIAMServiceAccountStaticAccessKey,
+}
+
+func deleteIAMServiceAccountStaticAccessKey(ctx context.Context, config *Config, id string) error {
+	_, err := config.sdk.IAM().AWSCompatibility().AccessKey().Delete(ctx, &awscompatibility.DeleteAccessKeyRequest{AccessKeyId: id})
+	return err
+}
+
 func resourceYandexIAMServiceAccountStaticAccessKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexIAMServiceAccountStaticAccessKeyCreate,
-		Read:   resourceYandexIAMServiceAccountStaticAccessKeyRead,
-		Delete: resourceYandexIAMServiceAccountStaticAccessKeyDelete,
+		CreateContext: resourceYandexIAMServiceAccountStaticAccessKeyCreate,
+		ReadContext:   resourceYandexIAMServiceAccountStaticAccessKeyRead,
+		UpdateContext: resourceYandexIAMServiceAccountStaticAccessKeyUpdate,
+		DeleteContext: resourceYandexIAMServiceAccountStaticAccessKeyDelete,
+		// Only metadata is imported, secret part of the key is never returned by API.
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexIAMServiceAccountDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexIAMServiceAccountDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexIAMServiceAccountDefaultTimeout),
+		},
+
+		CustomizeDiff: iamServiceAccountStaticAccessKeyRotation.customizeDiff,
 
-		Schema: map[string]*schema.Schema{
+		Schema: iamServiceAccountStaticAccessKeyRotation.addSchema(iamServiceAccountStaticAccessKeySecret.addSchema(map[string]*schema.Schema{
 			"service_account_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
 
-			// There is no Update method for IAM SA Key resource,
-			// so "description" attr set as 'ForceNew:true'
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 
 			"pgp_key": {
@@ -62,16 +99,20 @@ func resourceYandexIAMServiceAccountStaticAccessKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-		},
+		})),
 	}
 }
 
-func resourceYandexIAMServiceAccountStaticAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
+func resourceYandexIAMServiceAccountStaticAccessKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := createIAMServiceAccountStaticAccessKey(ctx, d, meta.(*Config)); err != nil {
+		return diag.FromErr(err)
+	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
+	return resourceYandexIAMServiceAccountStaticAccessKeyRead(ctx, d, meta)
+}
 
+// createIAMServiceAccountStaticAccessKey creates a new key and sets its ID and secret, which is only available on create.
+func createIAMServiceAccountStaticAccessKey(ctx context.Context, d *schema.ResourceData, config *Config) error {
 	resp, err := config.sdk.IAM().AWSCompatibility().AccessKey().Create(ctx, &awscompatibility.CreateAccessKeyRequest{
 		ServiceAccountId: d.Get("service_account_id").(string),
 		Description:      d.Get("description").(string),
@@ -80,39 +121,18 @@ func resourceYandexIAMServiceAccountStaticAccessKeyCreate(d *schema.ResourceData
 		return fmt.Errorf("error creating service account key: %s", err)
 	}
 
-	d.SetId(resp.AccessKey.Id)
 	// Data only available on create.
-	if v, ok := d.GetOk("pgp_key"); ok {
-		encryptionKey, err := encryption.RetrieveGPGKey(v.(string))
-		if err != nil {
-			return err
-		}
-
-		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, resp.Secret, "Yandex Service Account Static Access Key")
-		if err != nil {
-			return err
-		}
-
-		d.Set("key_fingerprint", fingerprint)
-		d.Set("encrypted_secret_key", encrypted)
-	} else {
-		d.Set("secret_key", resp.Secret)
-	}
-
-	return resourceYandexIAMServiceAccountStaticAccessKeyRead(d, meta)
+	return iamServiceAccountStaticAccessKeySecret.storeNew(ctx, d, config, resp.AccessKey.Id, resp.Secret, resp.AccessKey.KeyId)
 }
 
-func resourceYandexIAMServiceAccountStaticAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIAMServiceAccountStaticAccessKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	sak, err := config.sdk.IAM().AWSCompatibility().AccessKey().Get(ctx, &awscompatibility.GetAccessKeyRequest{
 		AccessKeyId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Service Account Static Access Key %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Service Account Static Access Key %q", d.Id())))
 	}
 
 	d.Set("service_account_id", sak.ServiceAccountId)
@@ -123,17 +143,46 @@ func resourceYandexIAMServiceAccountStaticAccessKeyRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceYandexIAMServiceAccountStaticAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIAMServiceAccountStaticAccessKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
+	err := iamServiceAccountStaticAccessKeyRotation.update(ctx, d, config, func() error {
+		return createIAMServiceAccountStaticAccessKey(ctx, d, config)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// The new key is created with the new description.
+	if iamServiceAccountStaticAccessKeyRotation.rotating(d) {
+		return resourceYandexIAMServiceAccountStaticAccessKeyRead(ctx, d, meta)
+	}
+
+	if d.HasChange("description") {
+		_, err := config.sdk.IAM().AWSCompatibility().AccessKey().Update(ctx, &awscompatibility.UpdateAccessKeyRequest{
+			AccessKeyId: d.Id(),
+			Description: d.Get("description").(string),
+			UpdateMask:  &field_mask.FieldMask{Paths: []string{"description"}},
+		})
+		if err != nil {
+			return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Service Account Static Access Key %q", d.Id())))
+		}
+	}
+
+	return resourceYandexIAMServiceAccountStaticAccessKeyRead(ctx, d, meta)
+}
+
+func resourceYandexIAMServiceAccountStaticAccessKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	if err := iamServiceAccountStaticAccessKeyRotation.deletePrevious(ctx, d, config); err != nil {
+		return diag.FromErr(err)
+	}
 
 	_, err := config.sdk.IAM().AWSCompatibility().AccessKey().Delete(ctx, &awscompatibility.DeleteAccessKeyRequest{
 		AccessKeyId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Service Account Static Access Key %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Service Account Static Access Key %q", d.Id())))
 	}
 
 	d.SetId("")
@@ -4,13 +4,21 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/vault/helper/pgpkeys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1/awscompatibility"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
 )
 
 // Test that a service account key can be created and destroyed
@@ -35,6 +43,13 @@ func TestAccServiceAccountStaticAccessKey_basic(t *testing.T) {
 					testAccCheckCreatedAtAttr(resourceName),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Secret part of the key is never returned by API.
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
 		},
 	})
 }
@@ -137,3 +152,107 @@ EOF
 }
 `, name, desc, key)
 }
+
+func TestServiceAccountStaticAccessKeyRotation_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	sa := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_iam_service_account"), nil, map[string]interface{}{"name": "robot"})
+
+	r := fakeCloudResource(t, "yandex_iam_service_account_static_access_key")
+	raw := map[string]interface{}{
+		"service_account_id": sa.ID,
+		"description":        "for storage",
+		"rotation_period":    "720h",
+	}
+	keyExists := func(id string) bool {
+		_, err := config.sdk.IAM().AWSCompatibility().AccessKey().Get(context.Background(), &awscompatibility.GetAccessKeyRequest{AccessKeyId: id})
+		if status.Code(err) == codes.NotFound {
+			return false
+		}
+		require.NoError(t, err)
+		return true
+	}
+
+	state := fakeCloudApply(t, config, r, nil, raw)
+	first := state.ID
+	firstAccessKey := state.Attributes["access_key"]
+
+	// Description is updated in place.
+	raw["description"] = "for backups"
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.Equal(t, first, state.ID)
+	assert.Equal(t, "for backups", state.Attributes["description"])
+
+	ageKeyState(state, 721*time.Hour)
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.NotEqual(t, first, state.ID)
+	assert.NotEqual(t, firstAccessKey, state.Attributes["access_key"])
+	assert.Equal(t, first, state.Attributes["previous_key_id"])
+	assert.Equal(t, firstAccessKey, state.Attributes["previous_access_key"])
+	assert.NotEmpty(t, state.Attributes["previous_secret_key"])
+
+	// Without overlap period the previous key is deleted by the next apply.
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.Empty(t, state.Attributes["previous_key_id"])
+	assert.False(t, keyExists(first))
+
+	fakeCloudDestroy(t, config, r, state)
+	assert.False(t, keyExists(state.ID))
+}
+
+func TestServiceAccountStaticAccessKeyLockbox_fakeCloud(t *testing.T) {
+	ctx := context.Background()
+	config, _ := newFakeCloudConfig(t)
+	sa := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_iam_service_account"), nil, map[string]interface{}{"name": "robot"})
+
+	op, err := config.sdk.WrapOperation(config.sdk.LockboxSecret().Secret().Create(ctx, &lockbox.CreateSecretRequest{
+		FolderId: fakecloud.FolderID,
+		Name:     "storage",
+		VersionPayloadEntries: []*lockbox.PayloadEntryChange{
+			{Key: "bucket", Value: &lockbox.PayloadEntryChange_TextValue{TextValue: "backups"}},
+		},
+	}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	md, err := op.Metadata()
+	require.NoError(t, err)
+	secretID := md.(*lockbox.CreateSecretMetadata).SecretId
+
+	payload := func(versionID string) map[string]string {
+		resp, err := config.sdk.LockboxPayload().Payload().Get(ctx, &lockbox.GetPayloadRequest{SecretId: secretID, VersionId: versionID})
+		require.NoError(t, err)
+		entries := make(map[string]string)
+		for _, e := range resp.Entries {
+			entries[e.Key] = e.GetTextValue()
+		}
+		return entries
+	}
+
+	r := fakeCloudResource(t, "yandex_iam_service_account_static_access_key")
+	raw := map[string]interface{}{
+		"service_account_id": sa.ID,
+		"output_to_lockbox": []interface{}{map[string]interface{}{
+			"secret_id":            secretID,
+			"entry_for_access_key": "access_key",
+			"entry_for_secret_key": "secret_key",
+		}},
+		"keepers": map[string]interface{}{"version": "1"},
+	}
+	state := fakeCloudApply(t, config, r, nil, raw)
+	assert.Empty(t, state.Attributes["secret_key"])
+	assert.Empty(t, state.Attributes["encrypted_secret_key"])
+
+	first := state.Attributes["output_to_lockbox_version_id"]
+	assert.Equal(t, map[string]string{
+		"bucket":     "backups",
+		"access_key": state.Attributes["access_key"],
+		"secret_key": "fake secret " + state.ID,
+	}, payload(first))
+
+	// Rotated key is written into a new version of the secret.
+	raw["keepers"] = map[string]interface{}{"version": "2"}
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.Equal(t, first, state.Attributes["previous_output_to_lockbox_version_id"])
+	assert.NotEqual(t, first, state.Attributes["output_to_lockbox_version_id"])
+	assert.Equal(t, "fake secret "+state.ID, payload("")["secret_key"])
+	assert.Empty(t, state.Attributes["previous_secret_key"])
+}
@@ -9,9 +9,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
 )
 
 func init() {
@@ -300,3 +304,31 @@ resource "yandex_iam_service_account" "acceptance" {
 }
 `, folderID, name, desc)
 }
+
+func TestServiceAccount_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	r := fakeCloudResource(t, "yandex_iam_service_account")
+	raw := map[string]interface{}{
+		"name":        "robot",
+		"description": "created offline",
+	}
+
+	state := fakeCloudApply(t, config, r, nil, raw)
+	assert.Equal(t, "robot", state.Attributes["name"])
+	assert.Equal(t, fakecloud.FolderID, state.Attributes["folder_id"])
+
+	imported := fakeCloudImport(t, config, r, state.ID)
+	assert.Equal(t, state.Attributes, imported.Attributes)
+
+	raw["description"] = "updated offline"
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.Equal(t, "updated offline", state.Attributes["description"])
+
+	// The service account deleted outside of Terraform is removed from the state on refresh.
+	op, err := config.sdk.WrapOperation(config.sdk.IAM().ServiceAccount().Delete(context.Background(), &iam.DeleteServiceAccountRequest{
+		ServiceAccountId: state.ID,
+	}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(context.Background()))
+	assert.Nil(t, fakeCloudRefresh(t, config, r, state))
+}
@@ -1,8 +1,10 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -11,10 +13,13 @@ import (
 
 func resourceYandexIoTCoreBroker() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexIoTCoreBrokerCreate,
-		Read:   resourceYandexIoTCoreBrokerRead,
-		Update: resourceYandexIoTCoreBrokerUpdate,
-		Delete: resourceYandexIoTCoreBrokerDelete,
+		CreateContext: resourceYandexIoTCoreBrokerCreate,
+		ReadContext:   resourceYandexIoTCoreBrokerRead,
+		UpdateContext: resourceYandexIoTCoreBrokerUpdate,
+		DeleteContext: resourceYandexIoTCoreBrokerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexIoTCoreBrokerImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexIoTDefaultTimeout),
@@ -65,15 +70,12 @@ func resourceYandexIoTCoreBroker() *schema.Resource {
 	}
 }
 
-func resourceYandexIoTCoreBrokerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIoTCoreBrokerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating IoT Broker: %s", err)
+		return diag.Errorf("Error expanding labels while creating IoT Broker: %s", err)
 	}
 
 	certsSet := expandIoTCerts(d)
@@ -84,7 +86,7 @@ func resourceYandexIoTCoreBrokerCreate(d *schema.ResourceData, meta interface{})
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating IoT Broker: %s", err)
+		return diag.Errorf("Error getting folder ID while creating IoT Broker: %s", err)
 	}
 
 	req := iot.CreateBrokerRequest{
@@ -97,27 +99,27 @@ func resourceYandexIoTCoreBrokerCreate(d *schema.ResourceData, meta interface{})
 
 	op, err := config.sdk.WrapOperation(config.sdk.IoT().Broker().Broker().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create IoT Broker: %s", err)
+		return errorDiagnostics("Error while requesting API to create IoT Broker", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create IoT Broker: %s", err)
+		return errorDiagnostics("Error while requesting API to create IoT Broker", err, nil)
 	}
 
 	md, ok := protoMetadata.(*iot.CreateBrokerMetadata)
 	if !ok {
-		return fmt.Errorf("Could not get IoT Broker ID from create operation metadata")
+		return diag.Errorf("Could not get IoT Broker ID from create operation metadata")
 	}
 
 	d.SetId(md.BrokerId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create IoT Broker: %s", err)
+		return errorDiagnostics("Error while requesting API to create IoT Broker", err, nil)
 	}
 
-	return resourceYandexIoTCoreBrokerRead(d, meta)
+	return resourceYandexIoTCoreBrokerRead(ctx, d, meta)
 }
 
 func flattenYandexIoTCoreBroker(d *schema.ResourceData, broker *iot.Broker) error {
@@ -131,29 +133,43 @@ func flattenYandexIoTCoreBroker(d *schema.ResourceData, broker *iot.Broker) erro
 	return nil
 }
 
-func resourceYandexIoTCoreBrokerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIoTCoreBrokerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	req := iot.GetBrokerRequest{
 		BrokerId: d.Id(),
 	}
 
 	broker, err := config.sdk.IoT().Broker().Broker().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("IoT Broker %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("IoT Broker %q", d.Id())))
 	}
 
-	return flattenYandexIoTCoreBroker(d, broker)
+	return diag.FromErr(flattenYandexIoTCoreBroker(d, broker))
 }
 
-func resourceYandexIoTCoreBrokerDelete(d *schema.ResourceData, meta interface{}) error {
+// resourceYandexIoTCoreBrokerImport reads certificates, which are managed by separate calls.
+func resourceYandexIoTCoreBrokerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
+	resp, err := config.sdk.IoT().Broker().Broker().ListCertificates(ctx, &iot.ListBrokerCertificatesRequest{BrokerId: d.Id()})
+	if err != nil {
+		return nil, apiError(fmt.Sprintf("Error while requesting API to list certificates of IoT Broker %q", d.Id()), err, nil)
+	}
+
+	certificates := make([]interface{}, len(resp.Certificates))
+	for i, cert := range resp.Certificates {
+		certificates[i] = cert.CertificateData
+	}
+	if err := d.Set("certificates", certificates); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceYandexIoTCoreBrokerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
 
 	req := iot.DeleteBrokerRequest{
 		BrokerId: d.Id(),
@@ -162,21 +178,18 @@ func resourceYandexIoTCoreBrokerDelete(d *schema.ResourceData, meta interface{})
 	op, err := config.sdk.IoT().Broker().Broker().Delete(ctx, &req)
 	err = waitOperation(ctx, config, op, err)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("IoT Broker %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("IoT Broker %q", d.Id())))
 	}
 
 	return nil
 }
 
-func resourceYandexIoTCoreBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIoTCoreBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while updating IoT Broker: %s", err)
+		return diag.Errorf("Error expanding labels while updating IoT Broker: %s", err)
 	}
 
 	d.Partial(true)
@@ -190,7 +203,7 @@ func resourceYandexIoTCoreBrokerUpdate(d *schema.ResourceData, meta interface{})
 		updatePaths = append(updatePaths, "description")
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		updatePaths = append(updatePaths, "labels")
 	}
 
@@ -206,7 +219,7 @@ func resourceYandexIoTCoreBrokerUpdate(d *schema.ResourceData, meta interface{})
 		op, err := config.sdk.IoT().Broker().Broker().Update(ctx, &req)
 		err = waitOperation(ctx, config, op, err)
 		if err != nil {
-			return fmt.Errorf("Error while requesting API to update IoT Broker: %s", err)
+			return errorDiagnostics("Error while requesting API to update IoT Broker", err, nil)
 		}
 
 	}
@@ -216,7 +229,7 @@ func resourceYandexIoTCoreBrokerUpdate(d *schema.ResourceData, meta interface{})
 
 		certsResp, err := config.sdk.IoT().Broker().Broker().ListCertificates(ctx, &iot.ListBrokerCertificatesRequest{BrokerId: d.Id()})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		for _, cert := range certsResp.Certificates {
@@ -225,7 +238,7 @@ func resourceYandexIoTCoreBrokerUpdate(d *schema.ResourceData, meta interface{})
 				op, err := config.sdk.IoT().Broker().Broker().DeleteCertificate(ctx, &iot.DeleteBrokerCertificateRequest{BrokerId: d.Id(), Fingerprint: cert.Fingerprint})
 				err = waitOperation(ctx, config, op, err)
 				if err != nil {
-					return fmt.Errorf("Failed to delete certificate: %s, fingerpring: %s", err, cert.Fingerprint)
+					return diag.Errorf("Failed to delete certificate: %s, fingerpring: %s", err, cert.Fingerprint)
 				}
 			} else {
 				delete(certsSetInner, cert.CertificateData)
@@ -236,7 +249,7 @@ func resourceYandexIoTCoreBrokerUpdate(d *schema.ResourceData, meta interface{})
 			op, err := config.sdk.IoT().Broker().Broker().AddCertificate(ctx, &iot.AddBrokerCertificateRequest{BrokerId: d.Id(), CertificateData: cert})
 			err = waitOperation(ctx, config, op, err)
 			if err != nil {
-				return fmt.Errorf("Failed to add certificate: %s", err)
+				return errorDiagnostics("Failed to add certificate", err, nil)
 			}
 		}
 
@@ -244,5 +257,5 @@ func resourceYandexIoTCoreBrokerUpdate(d *schema.ResourceData, meta interface{})
 
 	d.Partial(false)
 
-	return resourceYandexIoTCoreBrokerRead(d, meta)
+	return resourceYandexIoTCoreBrokerRead(ctx, d, meta)
 }
@@ -147,6 +147,7 @@ func TestAccYandexIoTCoreBroker_update(t *testing.T) {
 					testYandexIoTCoreStoreBrokerCertificates(authInfo, &broker),
 				),
 			},
+			iotCoreImportTestStep(iotBrokerResource),
 		},
 	})
 }
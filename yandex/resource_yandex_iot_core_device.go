@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -12,10 +13,13 @@ import (
 
 func resourceYandexIoTCoreDevice() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexIoTCoreDeviceCreate,
-		Read:   resourceYandexIoTCoreDeviceRead,
-		Update: resourceYandexIoTCoreDeviceUpdate,
-		Delete: resourceYandexIoTCoreDeviceDelete,
+		CreateContext: resourceYandexIoTCoreDeviceCreate,
+		ReadContext:   resourceYandexIoTCoreDeviceRead,
+		UpdateContext: resourceYandexIoTCoreDeviceUpdate,
+		DeleteContext: resourceYandexIoTCoreDeviceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexIoTCoreDeviceImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexIoTDefaultTimeout),
@@ -74,15 +78,12 @@ func resourceYandexIoTCoreDevice() *schema.Resource {
 	}
 }
 
-func resourceYandexIoTCoreDeviceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIoTCoreDeviceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	aliases, err := expandLabels(d.Get("aliases"))
 	if err != nil {
-		return fmt.Errorf("Error expanding aliases while creating IoT Device: %s", err)
+		return diag.Errorf("Error expanding aliases while creating IoT Device: %s", err)
 	}
 
 	certsSet := expandIoTCerts(d)
@@ -101,32 +102,55 @@ func resourceYandexIoTCoreDeviceCreate(d *schema.ResourceData, meta interface{})
 
 	op, err := config.sdk.WrapOperation(config.sdk.IoT().Devices().Device().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create IoT Device: %s", err)
+		return errorDiagnostics("Error while requesting API to create IoT Device", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create IoT Device: %s", err)
+		return errorDiagnostics("Error while requesting API to create IoT Device", err, nil)
 	}
 
 	md, ok := protoMetadata.(*iot.CreateDeviceMetadata)
 	if !ok {
-		return fmt.Errorf("Could not get IoT Device ID from create operation metadata")
+		return diag.Errorf("Could not get IoT Device ID from create operation metadata")
 	}
 
 	d.SetId(md.DeviceId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create IoT Device: %s", err)
+		return errorDiagnostics("Error while requesting API to create IoT Device", err, nil)
+	}
+
+	if err := finishResumableCreate(ctx, d, meta, op.Id(), finishIoTCoreDeviceCreate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceYandexIoTCoreDeviceRead(ctx, d, meta)
+}
+
+// finishIoTCoreDeviceCreate sets the passwords. Passwords can't be compared with the configured ones,
+// so the ones added by an interrupted run are replaced like Update does.
+func finishIoTCoreDeviceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	passResp, err := config.sdk.IoT().Devices().Device().ListPasswords(ctx, &iot.ListDevicePasswordsRequest{DeviceId: d.Id()})
+	if err != nil {
+		return err
+	}
+	for _, pass := range passResp.Passwords {
+		op, err := config.sdk.IoT().Devices().Device().DeletePassword(ctx, &iot.DeleteDevicePasswordRequest{DeviceId: d.Id(), PasswordId: pass.Id})
+		err = waitOperation(ctx, config, op, err)
+		if err != nil {
+			return fmt.Errorf("Failed to delete password: %s", err)
+		}
 	}
 
 	err = addDevicePasswords(ctx, config, d)
 	if err != nil {
 		return fmt.Errorf("Failed to set IoT Device password(s): %s", err)
 	}
-
-	return resourceYandexIoTCoreDeviceRead(d, meta)
+	return nil
 }
 
 func flattenYandexIoTCoreDevice(d *schema.ResourceData, device *iot.Device) error {
@@ -138,29 +162,52 @@ func flattenYandexIoTCoreDevice(d *schema.ResourceData, device *iot.Device) erro
 	return nil
 }
 
-func resourceYandexIoTCoreDeviceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIoTCoreDeviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	req := iot.GetDeviceRequest{
 		DeviceId: d.Id(),
 	}
 
 	device, err := config.sdk.IoT().Devices().Device().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("IoT Device %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("IoT Device %q", d.Id())))
 	}
 
-	return flattenYandexIoTCoreDevice(d, device)
+	return diag.FromErr(flattenYandexIoTCoreDevice(d, device))
 }
 
-func resourceYandexIoTCoreDeviceDelete(d *schema.ResourceData, meta interface{}) error {
+// resourceYandexIoTCoreDeviceImport reads certificates and topic aliases, which are not refreshed by Read.
+// Passwords can't be read back and are left empty.
+func resourceYandexIoTCoreDeviceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
+	device, err := config.sdk.IoT().Devices().Device().Get(ctx, &iot.GetDeviceRequest{DeviceId: d.Id()})
+	if err != nil {
+		return nil, apiError(fmt.Sprintf("Error while requesting API to get IoT Device %q", d.Id()), err, nil)
+	}
+	if err := d.Set("aliases", device.TopicAliases); err != nil {
+		return nil, err
+	}
+
+	resp, err := config.sdk.IoT().Devices().Device().ListCertificates(ctx, &iot.ListDeviceCertificatesRequest{DeviceId: d.Id()})
+	if err != nil {
+		return nil, apiError(fmt.Sprintf("Error while requesting API to list certificates of IoT Device %q", d.Id()), err, nil)
+	}
+
+	certificates := make([]interface{}, len(resp.Certificates))
+	for i, cert := range resp.Certificates {
+		certificates[i] = cert.CertificateData
+	}
+	if err := d.Set("certificates", certificates); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceYandexIoTCoreDeviceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
 
 	req := iot.DeleteDeviceRequest{
 		DeviceId: d.Id(),
@@ -169,21 +216,18 @@ func resourceYandexIoTCoreDeviceDelete(d *schema.ResourceData, meta interface{})
 	op, err := config.sdk.IoT().Devices().Device().Delete(ctx, &req)
 	err = waitOperation(ctx, config, op, err)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("IoT Device %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("IoT Device %q", d.Id())))
 	}
 
 	return nil
 }
 
-func resourceYandexIoTCoreDeviceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIoTCoreDeviceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	aliases, err := expandLabels(d.Get("aliases"))
 	if err != nil {
-		return fmt.Errorf("Error expanding aliases while updating IoT Device: %s", err)
+		return diag.Errorf("Error expanding aliases while updating IoT Device: %s", err)
 	}
 
 	d.Partial(true)
@@ -213,7 +257,7 @@ func resourceYandexIoTCoreDeviceUpdate(d *schema.ResourceData, meta interface{})
 		op, err := config.sdk.IoT().Devices().Device().Update(ctx, &req)
 		err = waitOperation(ctx, config, op, err)
 		if err != nil {
-			return fmt.Errorf("Error while requesting API to update IoT Device: %s", err)
+			return errorDiagnostics("Error while requesting API to update IoT Device", err, nil)
 		}
 	}
 
@@ -222,7 +266,7 @@ func resourceYandexIoTCoreDeviceUpdate(d *schema.ResourceData, meta interface{})
 
 		certsResp, err := config.sdk.IoT().Devices().Device().ListCertificates(ctx, &iot.ListDeviceCertificatesRequest{DeviceId: d.Id()})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		for _, cert := range certsResp.Certificates {
@@ -231,7 +275,7 @@ func resourceYandexIoTCoreDeviceUpdate(d *schema.ResourceData, meta interface{})
 				op, err := config.sdk.IoT().Devices().Device().DeleteCertificate(ctx, &iot.DeleteDeviceCertificateRequest{DeviceId: d.Id(), Fingerprint: cert.Fingerprint})
 				err = waitOperation(ctx, config, op, err)
 				if err != nil {
-					return fmt.Errorf("Failed to remove certificate: %s, fingerpring: %s", err, cert.Fingerprint)
+					return diag.Errorf("Failed to remove certificate: %s, fingerpring: %s", err, cert.Fingerprint)
 				}
 			} else {
 				delete(certsSetInner, cert.CertificateData)
@@ -242,7 +286,7 @@ func resourceYandexIoTCoreDeviceUpdate(d *schema.ResourceData, meta interface{})
 			op, err := config.sdk.IoT().Devices().Device().AddCertificate(ctx, &iot.AddDeviceCertificateRequest{DeviceId: d.Id(), CertificateData: cert})
 			err = waitOperation(ctx, config, op, err)
 			if err != nil {
-				return fmt.Errorf("Failed to add certificate: %s", err)
+				return errorDiagnostics("Failed to add certificate", err, nil)
 			}
 		}
 
@@ -251,27 +295,27 @@ func resourceYandexIoTCoreDeviceUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange("passwords") {
 		passResp, err := config.sdk.IoT().Devices().Device().ListPasswords(ctx, &iot.ListDevicePasswordsRequest{DeviceId: d.Id()})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		for _, pass := range passResp.Passwords {
 			op, err := config.sdk.IoT().Devices().Device().DeletePassword(ctx, &iot.DeleteDevicePasswordRequest{DeviceId: d.Id(), PasswordId: pass.Id})
 			err = waitOperation(ctx, config, op, err)
 			if err != nil {
-				return fmt.Errorf("Failed to delete password: %s", err)
+				return errorDiagnostics("Failed to delete password", err, nil)
 			}
 		}
 
 		err = addDevicePasswords(ctx, config, d)
 		if err != nil {
-			return fmt.Errorf("Failed to add password: %s", err)
+			return errorDiagnostics("Failed to add password", err, nil)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceYandexIoTCoreDeviceRead(d, meta)
+	return resourceYandexIoTCoreDeviceRead(ctx, d, meta)
 }
 
 func addDevicePasswords(ctx context.Context, config *Config, d *schema.ResourceData) error {
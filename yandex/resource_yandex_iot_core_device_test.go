@@ -186,6 +186,7 @@ func TestAccYandexIoTCoreDevice_update(t *testing.T) {
 					testYandexIoTCoreDeviceContainsAlias(&device, "$devices/{id}/events/updated", "aaa/bbb_updated"),
 				),
 			},
+			iotCoreImportTestStep(iotDeviceResource),
 		},
 	})
 }
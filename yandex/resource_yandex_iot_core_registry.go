@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -16,10 +17,13 @@ const yandexIoTDefaultTimeout = 5 * time.Minute
 
 func resourceYandexIoTCoreRegistry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexIoTCoreRegistryCreate,
-		Read:   resourceYandexIoTCoreRegistryRead,
-		Update: resourceYandexIoTCoreRegistryUpdate,
-		Delete: resourceYandexIoTCoreRegistryDelete,
+		CreateContext: resourceYandexIoTCoreRegistryCreate,
+		ReadContext:   resourceYandexIoTCoreRegistryRead,
+		UpdateContext: resourceYandexIoTCoreRegistryUpdate,
+		DeleteContext: resourceYandexIoTCoreRegistryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexIoTCoreRegistryImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexIoTDefaultTimeout),
@@ -79,15 +83,12 @@ func resourceYandexIoTCoreRegistry() *schema.Resource {
 	}
 }
 
-func resourceYandexIoTCoreRegistryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIoTCoreRegistryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating IoT Registry: %s", err)
+		return diag.Errorf("Error expanding labels while creating IoT Registry: %s", err)
 	}
 
 	certsSet := expandIoTCerts(d)
@@ -98,7 +99,7 @@ func resourceYandexIoTCoreRegistryCreate(d *schema.ResourceData, meta interface{
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating IoT Registry: %s", err)
+		return diag.Errorf("Error getting folder ID while creating IoT Registry: %s", err)
 	}
 
 	req := iot.CreateRegistryRequest{
@@ -111,32 +112,55 @@ func resourceYandexIoTCoreRegistryCreate(d *schema.ResourceData, meta interface{
 
 	op, err := config.sdk.WrapOperation(config.sdk.IoT().Devices().Registry().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create IoT Registry: %s", err)
+		return errorDiagnostics("Error while requesting API to create IoT Registry", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create IoT Registry: %s", err)
+		return errorDiagnostics("Error while requesting API to create IoT Registry", err, nil)
 	}
 
 	md, ok := protoMetadata.(*iot.CreateRegistryMetadata)
 	if !ok {
-		return fmt.Errorf("Could not get IoT Registry ID from create operation metadata")
+		return diag.Errorf("Could not get IoT Registry ID from create operation metadata")
 	}
 
 	d.SetId(md.RegistryId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
+	if err != nil {
+		return errorDiagnostics("Error while requesting API to create IoT Registry", err, nil)
+	}
+
+	if err := finishResumableCreate(ctx, d, meta, op.Id(), finishIoTCoreRegistryCreate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceYandexIoTCoreRegistryRead(ctx, d, meta)
+}
+
+// finishIoTCoreRegistryCreate sets the passwords. Passwords can't be compared with the configured ones,
+// so the ones added by an interrupted run are replaced like Update does.
+func finishIoTCoreRegistryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	passResp, err := config.sdk.IoT().Devices().Registry().ListPasswords(ctx, &iot.ListRegistryPasswordsRequest{RegistryId: d.Id()})
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create IoT Registry: %s", err)
+		return err
+	}
+	for _, pass := range passResp.Passwords {
+		op, err := config.sdk.IoT().Devices().Registry().DeletePassword(ctx, &iot.DeleteRegistryPasswordRequest{RegistryId: d.Id(), PasswordId: pass.Id})
+		err = waitOperation(ctx, config, op, err)
+		if err != nil {
+			return fmt.Errorf("Failed to delete password: %s", err)
+		}
 	}
 
 	err = addRegistryPasswords(ctx, config, d)
 	if err != nil {
 		return fmt.Errorf("Failed to set IoT Registry password(s): %s", err)
 	}
-
-	return resourceYandexIoTCoreRegistryRead(d, meta)
+	return nil
 }
 
 func flattenYandexIoTCoreRegistry(d *schema.ResourceData, registry *iot.Registry) error {
@@ -150,29 +174,44 @@ func flattenYandexIoTCoreRegistry(d *schema.ResourceData, registry *iot.Registry
 	return nil
 }
 
-func resourceYandexIoTCoreRegistryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIoTCoreRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	req := iot.GetRegistryRequest{
 		RegistryId: d.Id(),
 	}
 
 	registry, err := config.sdk.IoT().Devices().Registry().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("IoT Registry %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("IoT Registry %q", d.Id())))
 	}
 
-	return flattenYandexIoTCoreRegistry(d, registry)
+	return diag.FromErr(flattenYandexIoTCoreRegistry(d, registry))
 }
 
-func resourceYandexIoTCoreRegistryDelete(d *schema.ResourceData, meta interface{}) error {
+// resourceYandexIoTCoreRegistryImport reads certificates, which are managed by separate calls.
+// Passwords can't be read back and are left empty.
+func resourceYandexIoTCoreRegistryImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
+	resp, err := config.sdk.IoT().Devices().Registry().ListCertificates(ctx, &iot.ListRegistryCertificatesRequest{RegistryId: d.Id()})
+	if err != nil {
+		return nil, apiError(fmt.Sprintf("Error while requesting API to list certificates of IoT Registry %q", d.Id()), err, nil)
+	}
+
+	certificates := make([]interface{}, len(resp.Certificates))
+	for i, cert := range resp.Certificates {
+		certificates[i] = cert.CertificateData
+	}
+	if err := d.Set("certificates", certificates); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceYandexIoTCoreRegistryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
 
 	req := iot.DeleteRegistryRequest{
 		RegistryId: d.Id(),
@@ -181,21 +220,18 @@ func resourceYandexIoTCoreRegistryDelete(d *schema.ResourceData, meta interface{
 	op, err := config.sdk.IoT().Devices().Registry().Delete(ctx, &req)
 	err = waitOperation(ctx, config, op, err)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("IoT Registry %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("IoT Registry %q", d.Id())))
 	}
 
 	return nil
 }
 
-func resourceYandexIoTCoreRegistryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIoTCoreRegistryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while updating IoT Registry: %s", err)
+		return diag.Errorf("Error expanding labels while updating IoT Registry: %s", err)
 	}
 
 	d.Partial(true)
@@ -209,7 +245,7 @@ func resourceYandexIoTCoreRegistryUpdate(d *schema.ResourceData, meta interface{
 		updatePaths = append(updatePaths, "description")
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		updatePaths = append(updatePaths, "labels")
 	}
 
@@ -225,7 +261,7 @@ func resourceYandexIoTCoreRegistryUpdate(d *schema.ResourceData, meta interface{
 		op, err := config.sdk.IoT().Devices().Registry().Update(ctx, &req)
 		err = waitOperation(ctx, config, op, err)
 		if err != nil {
-			return fmt.Errorf("Error while requesting API to update IoT Registry: %s", err)
+			return errorDiagnostics("Error while requesting API to update IoT Registry", err, nil)
 		}
 
 	}
@@ -235,7 +271,7 @@ func resourceYandexIoTCoreRegistryUpdate(d *schema.ResourceData, meta interface{
 
 		certsResp, err := config.sdk.IoT().Devices().Registry().ListCertificates(ctx, &iot.ListRegistryCertificatesRequest{RegistryId: d.Id()})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		for _, cert := range certsResp.Certificates {
@@ -244,7 +280,7 @@ func resourceYandexIoTCoreRegistryUpdate(d *schema.ResourceData, meta interface{
 				op, err := config.sdk.IoT().Devices().Registry().DeleteCertificate(ctx, &iot.DeleteRegistryCertificateRequest{RegistryId: d.Id(), Fingerprint: cert.Fingerprint})
 				err = waitOperation(ctx, config, op, err)
 				if err != nil {
-					return fmt.Errorf("Failed to delete certificate: %s, fingerpring: %s", err, cert.Fingerprint)
+					return diag.Errorf("Failed to delete certificate: %s, fingerpring: %s", err, cert.Fingerprint)
 				}
 			} else {
 				delete(certsSetInner, cert.CertificateData)
@@ -255,7 +291,7 @@ func resourceYandexIoTCoreRegistryUpdate(d *schema.ResourceData, meta interface{
 			op, err := config.sdk.IoT().Devices().Registry().AddCertificate(ctx, &iot.AddRegistryCertificateRequest{RegistryId: d.Id(), CertificateData: cert})
 			err = waitOperation(ctx, config, op, err)
 			if err != nil {
-				return fmt.Errorf("Failed to add certificate: %s", err)
+				return errorDiagnostics("Failed to add certificate", err, nil)
 			}
 		}
 
@@ -264,27 +300,27 @@ func resourceYandexIoTCoreRegistryUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("passwords") {
 		passResp, err := config.sdk.IoT().Devices().Registry().ListPasswords(ctx, &iot.ListRegistryPasswordsRequest{RegistryId: d.Id()})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		passwordsSet := expandIoTPasswords(d)
 
 		if len(passResp.Passwords) == len(passwordsSet) {
 			err = addRegistryPasswords(ctx, config, d)
 			if err != nil {
-				return fmt.Errorf("Failed to add password: %s", err)
+				return errorDiagnostics("Failed to add password", err, nil)
 			}
 		} else {
 			for _, pass := range passResp.Passwords {
 				op, err := config.sdk.IoT().Devices().Registry().DeletePassword(ctx, &iot.DeleteRegistryPasswordRequest{RegistryId: d.Id(), PasswordId: pass.Id})
 				err = waitOperation(ctx, config, op, err)
 				if err != nil {
-					return fmt.Errorf("Failed to delete password: %s", err)
+					return errorDiagnostics("Failed to delete password", err, nil)
 				}
 			}
 
 			err = addRegistryPasswords(ctx, config, d)
 			if err != nil {
-				return fmt.Errorf("Failed to add password: %s", err)
+				return errorDiagnostics("Failed to add password", err, nil)
 			}
 		}
 
@@ -292,7 +328,7 @@ func resourceYandexIoTCoreRegistryUpdate(d *schema.ResourceData, meta interface{
 
 	d.Partial(false)
 
-	return resourceYandexIoTCoreRegistryRead(d, meta)
+	return resourceYandexIoTCoreRegistryRead(ctx, d, meta)
 }
 
 func addRegistryPasswords(ctx context.Context, config *Config, d *schema.ResourceData) error {
@@ -161,6 +161,7 @@ func TestAccYandexIoTCoreRegistry_update(t *testing.T) {
 					testYandexIoTCoreNoChangePasswords(authInfo, &registry),
 				),
 			},
+			iotCoreImportTestStep(iotRegistryResource),
 		},
 	})
 }
@@ -376,3 +377,13 @@ func testIsEqualSets(s1 map[string]interface{}, s2 map[string]interface{}) bool
 	}
 	return true
 }
+
+// iotCoreImportTestStep checks import of IoT resource, passwords can't be read back.
+func iotCoreImportTestStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:            name,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{"passwords"},
+	}
+}
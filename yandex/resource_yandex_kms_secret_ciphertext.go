@@ -1,11 +1,13 @@
 package yandex
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -18,9 +20,9 @@ const (
 
 func resourceYandexKMSSecretCiphertext() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexKMSSecretCiphertextCreate,
-		Read:   resourceYandexKMSSecretCiphertextRead,
-		Delete: resourceYandexKMSSecretCiphertextDelete,
+		CreateContext: resourceYandexKMSSecretCiphertextCreate,
+		ReadContext:   resourceYandexKMSSecretCiphertextRead,
+		DeleteContext: resourceYandexKMSSecretCiphertextDelete,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexKMSSecretCiphertextDefaultTimeout),
@@ -58,12 +60,9 @@ func resourceYandexKMSSecretCiphertext() *schema.Resource {
 	}
 }
 
-func resourceYandexKMSSecretCiphertextCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKMSSecretCiphertextCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	req := &kms.SymmetricEncryptRequest{
 		KeyId:      d.Get("key_id").(string),
 		Plaintext:  []byte(d.Get("plaintext").(string)),
@@ -72,7 +71,7 @@ func resourceYandexKMSSecretCiphertextCreate(d *schema.ResourceData, meta interf
 
 	resp, err := config.sdk.KMSCrypto().SymmetricCrypto().Encrypt(ctx, req)
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to encrypt data with KMS symmetric key: %s", err)
+		return errorDiagnostics("Error while requesting API to encrypt data with KMS symmetric key", err, nil)
 	}
 
 	ciphertext := base64.StdEncoding.EncodeToString(resp.Ciphertext)
@@ -81,7 +80,7 @@ func resourceYandexKMSSecretCiphertextCreate(d *schema.ResourceData, meta interf
 	h := sha256.New()
 	_, err = h.Write(resp.Ciphertext)
 	if err != nil {
-		return fmt.Errorf("Error while hashing ciphertext with sha256: %s", err)
+		return diag.Errorf("Error while hashing ciphertext with sha256: %s", err)
 	}
 	hashedCiphertext := h.Sum(nil)
 
@@ -89,24 +88,21 @@ func resourceYandexKMSSecretCiphertextCreate(d *schema.ResourceData, meta interf
 
 	d.SetId(id)
 
-	return resourceYandexKMSSecretCiphertextRead(d, meta)
+	return resourceYandexKMSSecretCiphertextRead(ctx, d, meta)
 }
 
-func resourceYandexKMSSecretCiphertextRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKMSSecretCiphertextRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	resp, err := config.sdk.KMS().SymmetricKey().Get(ctx, &kms.GetSymmetricKeyRequest{
 		KeyId: d.Get("key_id").(string),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("KMS Symmetric Key %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("KMS Symmetric Key %q", d.Id())))
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to get KMS symmetric key: %s", err)
+		return errorDiagnostics("Error while requesting API to get KMS symmetric key", err, nil)
 	}
 
 	if resp == nil {
@@ -118,7 +114,7 @@ func resourceYandexKMSSecretCiphertextRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceYandexKMSSecretCiphertextDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKMSSecretCiphertextDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 
 	return nil
@@ -1,11 +1,13 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -18,12 +20,12 @@ const (
 
 func resourceYandexKMSSymmetricKeyKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexKMSSymmetricKeyCreate,
-		Read:   resourceYandexKMSSymmetricKeyRead,
-		Update: resourceYandexKMSSymmetricKeyUpdate,
-		Delete: resourceYandexKMSSymmetricKeyDelete,
+		CreateContext: resourceYandexKMSSymmetricKeyCreate,
+		ReadContext:   resourceYandexKMSSymmetricKeyRead,
+		UpdateContext: resourceYandexKMSSymmetricKeyUpdate,
+		DeleteContext: resourceYandexKMSSymmetricKeyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -72,6 +74,11 @@ func resourceYandexKMSSymmetricKeyKey() *schema.Resource {
 				DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -90,81 +97,76 @@ func resourceYandexKMSSymmetricKeyKey() *schema.Resource {
 	}
 }
 
-func resourceYandexKMSSymmetricKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKMSSymmetricKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating KMS symmetric key: %s", err)
+		return diag.Errorf("Error getting folder ID while creating KMS symmetric key: %s", err)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating KMS symmetric key: %s", err)
+		return diag.Errorf("Error expanding labels while creating KMS symmetric key: %s", err)
 	}
 
 	defaultAlgorithm, err := parseKmsDefaultAlgorithm(d.Get("default_algorithm").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rotationPeriod, err := parseDuration(d.Get("rotation_period").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	req := &kms.CreateSymmetricKeyRequest{
-		FolderId:         folderID,
-		Name:             d.Get("name").(string),
-		Description:      d.Get("description").(string),
-		Labels:           labels,
-		DefaultAlgorithm: defaultAlgorithm,
-		RotationPeriod:   rotationPeriod,
+		FolderId:           folderID,
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		Labels:             labels,
+		DefaultAlgorithm:   defaultAlgorithm,
+		RotationPeriod:     rotationPeriod,
+		DeletionProtection: d.Get("deletion_protection").(bool),
 	}
 
 	op, err := config.sdk.WrapOperation(config.sdk.KMS().SymmetricKey().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create KMS symmetric key: %s", err)
+		return errorDiagnostics("Error while requesting API to create KMS symmetric key", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get KMS symmetric key create operation metadata: %s", err)
+		return diag.Errorf("Error while get KMS symmetric key create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*kms.CreateSymmetricKeyMetadata)
 	if !ok {
-		return fmt.Errorf("could not get KMS symmetric key ID from create operation metadata")
+		return diag.Errorf("could not get KMS symmetric key ID from create operation metadata")
 	}
 
 	d.SetId(md.KeyId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create KMS symmetric key: %s", err)
+		return errorDiagnostics("Error while waiting operation to create KMS symmetric key", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("KMS symmetric key creation failed: %s", err)
+		return errorDiagnostics("KMS symmetric key creation failed", err, nil)
 	}
 
-	return resourceYandexKMSSymmetricKeyRead(d, meta)
+	return resourceYandexKMSSymmetricKeyRead(ctx, d, meta)
 }
 
-func resourceYandexKMSSymmetricKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKMSSymmetricKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	key, err := config.sdk.KMS().SymmetricKey().Get(ctx, &kms.GetSymmetricKeyRequest{
 		KeyId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("KMS Symmetric Key %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("KMS Symmetric Key %q", d.Id())))
 	}
 
 	d.Set("created_at", getTimestamp(key.CreatedAt))
@@ -175,16 +177,17 @@ func resourceYandexKMSSymmetricKeyRead(d *schema.ResourceData, meta interface{})
 	d.Set("default_algorithm", kms.SymmetricAlgorithm_name[int32(key.DefaultAlgorithm)])
 	d.Set("rotation_period", formatDuration(key.GetRotationPeriod()))
 	d.Set("status", strings.ToLower(key.Status.String()))
+	d.Set("deletion_protection", key.DeletionProtection)
 
 	if err := d.Set("labels", key.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//TODO support key.PrimaryVersion
 	return nil
 }
 
-func resourceYandexKMSSymmetricKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKMSSymmetricKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	var err error
@@ -196,10 +199,10 @@ func resourceYandexKMSSymmetricKeyUpdate(d *schema.ResourceData, meta interface{
 	d.Partial(true)
 
 	labelPropName := "labels"
-	if d.HasChange(labelPropName) {
+	if hasChangeWithDefaultLabels(d, labelPropName) {
 		labelsProp, err := expandLabels(d.Get(labelPropName))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req.Labels = labelsProp
@@ -222,7 +225,7 @@ func resourceYandexKMSSymmetricKeyUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange(defAlgoName) {
 		defaultAlgorithm, err := parseKmsDefaultAlgorithm(d.Get(defAlgoName).(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		req.DefaultAlgorithm = defaultAlgorithm
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, defAlgoName)
@@ -233,40 +236,41 @@ func resourceYandexKMSSymmetricKeyUpdate(d *schema.ResourceData, meta interface{
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, rotationPeriodName)
 		req.RotationPeriod, err = parseDuration(d.Get("rotation_period").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
+	deletionProtectionPropName := "deletion_protection"
+	if d.HasChange(deletionProtectionPropName) {
+		req.DeletionProtection = d.Get(deletionProtectionPropName).(bool)
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, deletionProtectionPropName)
+	}
+
 	//TODO support update Status
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	op, err := config.sdk.WrapOperation(config.sdk.KMS().SymmetricKey().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update KMS Symmetric Key %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to update KMS Symmetric Key %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error updating KMS Symmetric Key %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error updating KMS Symmetric Key %q", d.Id()), err, nil)
 	}
 
 	d.Partial(false)
 
-	return resourceYandexKMSSymmetricKeyRead(d, meta)
+	return resourceYandexKMSSymmetricKeyRead(ctx, d, meta)
 }
 
-func resourceYandexKMSSymmetricKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKMSSymmetricKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	_, err := config.sdk.KMS().SymmetricKey().Delete(ctx, &kms.DeleteSymmetricKeyRequest{
 		KeyId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("KMS Symmetric Key %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("KMS Symmetric Key %q", d.Id())))
 	}
 
 	d.SetId("")
@@ -1,6 +1,7 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sort"
@@ -157,5 +158,5 @@ func getKMSSymmetricKeyResourceAccessBindings(s *terraform.State, resourceName s
 		return nil, fmt.Errorf("can't find %s in state", resourceName)
 	}
 
-	return getKMSSymmetricKeyAccessBindings(config, rs.Primary.ID)
+	return getKMSSymmetricKeyAccessBindings(context.Background(), config, rs.Primary.ID)
 }
@@ -0,0 +1,52 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+)
+
+func TestAccKMSSymmetricKeyIamMember(t *testing.T) {
+	var symmetricKey kms.SymmetricKey
+	symmetricKeyName := acctest.RandomWithPrefix("tf-kms-symmetric-key")
+
+	role := "viewer"
+	member := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMSSymmetricKeyIamMemberBasic(symmetricKeyName, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKMSSymmetricKeyExists(kmsSymmetricKeyResource, &symmetricKey),
+					testAccCheckKMSSymmetricKeyIam(kmsSymmetricKeyResource, role, []string{member}),
+				),
+			},
+			{
+				ResourceName: "yandex_kms_symmetric_key_iam_member.viewer",
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return fmt.Sprintf("%s %s %s", symmetricKey.Id, role, member), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccKMSSymmetricKeyIamMemberBasic(symmetricKeyName, role, member string) string {
+	return testAccKMSSymmetricKey(symmetricKeyName) + fmt.Sprintf(`
+resource "yandex_kms_symmetric_key_iam_member" "viewer" {
+  symmetric_key_id = yandex_kms_symmetric_key.test-key.id
+  role             = "%s"
+  member           = "%s"
+}
+`, role, member)
+}
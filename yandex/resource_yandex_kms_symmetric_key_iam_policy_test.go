@@ -0,0 +1,58 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+)
+
+func TestAccKMSSymmetricKeyIamPolicy(t *testing.T) {
+	var symmetricKey kms.SymmetricKey
+	symmetricKeyName := acctest.RandomWithPrefix("tf-kms-symmetric-key")
+
+	role := "viewer"
+	member := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMSSymmetricKeyIamPolicyBasic(symmetricKeyName, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKMSSymmetricKeyExists(kmsSymmetricKeyResource, &symmetricKey),
+					testAccCheckKMSSymmetricKeyIam(kmsSymmetricKeyResource, role, []string{member}),
+				),
+			},
+			{
+				ResourceName: "yandex_kms_symmetric_key_iam_policy.viewer",
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return symmetricKey.Id, nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccKMSSymmetricKeyIamPolicyBasic(symmetricKeyName, role, member string) string {
+	return testAccKMSSymmetricKey(symmetricKeyName) + fmt.Sprintf(`
+data "yandex_iam_policy" "viewer" {
+  binding {
+    role    = "%s"
+    members = ["%s"]
+  }
+}
+
+resource "yandex_kms_symmetric_key_iam_policy" "viewer" {
+  symmetric_key_id = yandex_kms_symmetric_key.test-key.id
+  policy_data      = data.yandex_iam_policy.viewer.policy_data
+}
+`, role, member)
+}
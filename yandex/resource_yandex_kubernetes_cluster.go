@@ -1,6 +1,7 @@
 package yandex
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/hashcode"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/googleapis/type/dayofweek"
 	"google.golang.org/genproto/googleapis/type/timeofday"
@@ -27,12 +29,12 @@ const (
 
 func resourceYandexKubernetesCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexKubernetesClusterCreate,
-		Read:   resourceYandexKubernetesClusterRead,
-		Update: resourceYandexKubernetesClusterUpdate,
-		Delete: resourceYandexKubernetesClusterDelete,
+		CreateContext: resourceYandexKubernetesClusterCreate,
+		ReadContext:   resourceYandexKubernetesClusterRead,
+		UpdateContext: resourceYandexKubernetesClusterUpdate,
+		DeleteContext: resourceYandexKubernetesClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -351,62 +353,56 @@ func resourceYandexKubernetesCluster() *schema.Resource {
 	}
 }
 
-func resourceYandexKubernetesClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	req, err := prepareCreateKubernetesClusterRequest(d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Kubernetes().Cluster().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create Kubernetes cluster: %s", err)
+		return errorDiagnostics("error while requesting API to create Kubernetes cluster", err, updateKubernetesClusterFieldsMap)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("error while get Kubernetes cluster create operation metadata: %s", err)
+		return diag.Errorf("error while get Kubernetes cluster create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*k8s.CreateClusterMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Kubernetes cluster ID from create operation metadata")
+		return diag.Errorf("could not get Kubernetes cluster ID from create operation metadata")
 	}
 
 	d.SetId(md.GetClusterId())
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("error while waiting operation to create Kubernetes cluster: %s", err)
+		return errorDiagnostics("error while waiting operation to create Kubernetes cluster", err, updateKubernetesClusterFieldsMap)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Kubernetes cluster creation failed: %s", err)
+		return errorDiagnostics("Kubernetes cluster creation failed", err, updateKubernetesClusterFieldsMap)
 	}
 
-	return resourceYandexKubernetesClusterRead(d, meta)
+	return resourceYandexKubernetesClusterRead(ctx, d, meta)
 }
 
-func resourceYandexKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKubernetesClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	clusterID := d.Id()
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	cluster, err := config.sdk.Kubernetes().Cluster().Get(ctx, &k8s.GetClusterRequest{
 		ClusterId: clusterID,
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Kubernetes cluster with ID %q", clusterID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Kubernetes cluster with ID %q", clusterID)))
 	}
 
-	return flattenKubernetesClusterAttributes(cluster, d, true)
+	return diag.FromErr(flattenKubernetesClusterAttributes(cluster, d, true))
 }
 
 var updateKubernetesClusterFieldsMap = map[string]string{
@@ -420,42 +416,40 @@ var updateKubernetesClusterFieldsMap = map[string]string{
 	"master.0.security_group_ids": "master_spec.security_group_ids",
 }
 
-func resourceYandexKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKubernetesClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	clusterID := d.Id()
 	log.Printf("[DEBUG] updating Kubernetes cluster %q", clusterID)
 
 	req, err := getKubernetesClusterUpdateRequest(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var updatePath []string
 	for field, path := range updateKubernetesClusterFieldsMap {
-		if d.HasChange(field) {
+		if hasChangeWithDefaultLabels(d, field) {
 			updatePath = append(updatePath, path)
 		}
 	}
 
 	if len(updatePath) == 0 {
-		return fmt.Errorf("error while updating Kubernetes cluster, didn't detect any changes")
+		return diag.Errorf("error while updating Kubernetes cluster, didn't detect any changes")
 	}
 
 	req.UpdateMask = &field_mask.FieldMask{Paths: updatePath}
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	op, err := config.sdk.WrapOperation(config.sdk.Kubernetes().Cluster().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update Kubernetes cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to update Kubernetes cluster %q", clusterID), err, updateKubernetesClusterFieldsMap)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error updating Kubernetes cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error updating Kubernetes cluster %q", clusterID), err, updateKubernetesClusterFieldsMap)
 	}
 
-	return resourceYandexKubernetesClusterRead(d, meta)
+	return resourceYandexKubernetesClusterRead(ctx, d, meta)
 }
 
 func getKubernetesClusterUpdateRequest(d *schema.ResourceData) (*k8s.UpdateClusterRequest, error) {
@@ -495,7 +489,7 @@ func getKubernetesClusterUpdateRequest(d *schema.ResourceData) (*k8s.UpdateClust
 
 }
 
-func resourceYandexKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKubernetesClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	clusterID := d.Id()
 
@@ -505,22 +499,19 @@ func resourceYandexKubernetesClusterDelete(d *schema.ResourceData, meta interfac
 		ClusterId: clusterID,
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Kubernetes().Cluster().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Kubernetes cluster %q", clusterID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Kubernetes cluster %q", clusterID)))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Kubernetes cluster %q", clusterID), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Kubernetes cluster %q", clusterID), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Kubernetes cluster %q", d.Id())
@@ -1,11 +1,13 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -22,12 +24,12 @@ const (
 
 func resourceYandexKubernetesNodeGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexKubernetesNodeGroupCreate,
-		Read:   resourceYandexKubernetesNodeGroupRead,
-		Update: resourceYandexKubernetesNodeGroupUpdate,
-		Delete: resourceYandexKubernetesNodeGroupDelete,
+		CreateContext: resourceYandexKubernetesNodeGroupCreate,
+		ReadContext:   resourceYandexKubernetesNodeGroupRead,
+		UpdateContext: resourceYandexKubernetesNodeGroupUpdate,
+		DeleteContext: resourceYandexKubernetesNodeGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -495,65 +497,59 @@ func resourceYandexKubernetesNodeGroup() *schema.Resource {
 	}
 }
 
-func resourceYandexKubernetesNodeGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKubernetesNodeGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	req, err := prepareCreateNodeGroupRequest(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Kubernetes().NodeGroup().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create Kubernetes node group: %s", err)
+		return errorDiagnostics("error while requesting API to create Kubernetes node group", err, nodeGroupUpdateFieldsMap)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("error while get Kubernetes node group create operation metadata: %s", err)
+		return diag.Errorf("error while get Kubernetes node group create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*k8s.CreateNodeGroupMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Instance ID from create operation metadata")
+		return diag.Errorf("could not get Instance ID from create operation metadata")
 	}
 
 	d.SetId(md.GetNodeGroupId())
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("error while waiting operation to create Kubernetes node group: %s", err)
+		return errorDiagnostics("error while waiting operation to create Kubernetes node group", err, nodeGroupUpdateFieldsMap)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Kubernetes node group creation failed: %s", err)
+		return errorDiagnostics("Kubernetes node group creation failed", err, nodeGroupUpdateFieldsMap)
 	}
 
-	return resourceYandexKubernetesNodeGroupRead(d, meta)
+	return resourceYandexKubernetesNodeGroupRead(ctx, d, meta)
 }
 
-func resourceYandexKubernetesNodeGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKubernetesNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	ngID := d.Id()
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	ng, err := config.sdk.Kubernetes().NodeGroup().Get(ctx, &k8s.GetNodeGroupRequest{
 		NodeGroupId: ngID,
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Kubernetes node group with ID %q", ngID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Kubernetes node group with ID %q", ngID)))
 	}
 
 	// resource only parameter
 	d.Set("version", ng.GetVersionInfo().GetCurrentVersion())
 
-	return flattenNodeGroupSchemaData(ng, d)
+	return diag.FromErr(flattenNodeGroupSchemaData(ng, d))
 }
 
 func prepareCreateNodeGroupRequest(d *schema.ResourceData) (*k8s.CreateNodeGroupRequest, error) {
@@ -1063,42 +1059,40 @@ var nodeGroupUpdateFieldsMap = map[string]string{
 	"deploy_policy.0.max_unavailable":                           "deploy_policy.max_unavailable",
 }
 
-func resourceYandexKubernetesNodeGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKubernetesNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	ngID := d.Id()
 	log.Printf("[DEBUG] updating Kubernetes node group %q", ngID)
 
 	req, err := getKubernetesNodeGroupUpdateRequest(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var updatePath []string
 	for field, path := range nodeGroupUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasChangeWithDefaultLabels(d, field) {
 			updatePath = append(updatePath, path)
 		}
 	}
 
 	if len(updatePath) == 0 {
-		return fmt.Errorf("error while updating Kubernetes node group, didn't detect any changes")
+		return diag.Errorf("error while updating Kubernetes node group, didn't detect any changes")
 	}
 
 	req.UpdateMask = &field_mask.FieldMask{Paths: updatePath}
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	op, err := config.sdk.WrapOperation(config.sdk.Kubernetes().NodeGroup().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update Kubernetes node group %q: %s", ngID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to update Kubernetes node group %q", ngID), err, nodeGroupUpdateFieldsMap)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error updating Kubernetes node group %q: %s", ngID, err)
+		return errorDiagnostics(fmt.Sprintf("error updating Kubernetes node group %q", ngID), err, nodeGroupUpdateFieldsMap)
 	}
 
-	return resourceYandexKubernetesNodeGroupRead(d, meta)
+	return resourceYandexKubernetesNodeGroupRead(ctx, d, meta)
 }
 
 func getKubernetesNodeGroupUpdateRequest(d *schema.ResourceData) (*k8s.UpdateNodeGroupRequest, error) {
@@ -1148,7 +1142,7 @@ func getKubernetesNodeGroupUpdateRequest(d *schema.ResourceData) (*k8s.UpdateNod
 
 }
 
-func resourceYandexKubernetesNodeGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexKubernetesNodeGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	ngID := d.Id()
 
@@ -1158,22 +1152,19 @@ func resourceYandexKubernetesNodeGroupDelete(d *schema.ResourceData, meta interf
 		NodeGroupId: ngID,
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Kubernetes().NodeGroup().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Kubernetes node group %q", ngID))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Kubernetes node group %q", ngID)))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Kubernetes node group %q", ngID), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Kubernetes node group %q", ngID), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Kubernetes node group %q", ngID)
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -17,12 +18,12 @@ const yandexLBNetworkLoadBalancerDefaultTimeout = 5 * time.Minute
 
 func resourceYandexLBNetworkLoadBalancer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexLBNetworkLoadBalancerCreate,
-		Read:   resourceYandexLBNetworkLoadBalancerRead,
-		Update: resourceYandexLBNetworkLoadBalancerUpdate,
-		Delete: resourceYandexLBNetworkLoadBalancerDelete,
+		CreateContext: resourceYandexLBNetworkLoadBalancerCreate,
+		ReadContext:   resourceYandexLBNetworkLoadBalancerRead,
+		UpdateContext: resourceYandexLBNetworkLoadBalancerUpdate,
+		DeleteContext: resourceYandexLBNetworkLoadBalancerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -232,32 +233,32 @@ func resourceYandexLBNetworkLoadBalancer() *schema.Resource {
 
 }
 
-func resourceYandexLBNetworkLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexLBNetworkLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating network load balancer: %s", err)
+		return diag.Errorf("Error expanding labels while creating network load balancer: %s", err)
 	}
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating network load balancer: %s", err)
+		return diag.Errorf("Error getting folder ID while creating network load balancer: %s", err)
 	}
 
 	ls, err := expandLBListenerSpecs(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding listeners while creating network load balancer: %s", err)
+		return diag.Errorf("Error expanding listeners while creating network load balancer: %s", err)
 	}
 
 	atgs, err := expandLBAttachedTargetGroups(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding attached target groups while creating network load balancer: %s", err)
+		return diag.Errorf("Error expanding attached target groups while creating network load balancer: %s", err)
 	}
 
 	nlbType, err := parseNetworkLoadBalancerType(d.Get("type").(string))
 	if err != nil {
-		return fmt.Errorf("Error expanding balancer type while creating network load balancer: %s", err)
+		return diag.Errorf("Error expanding balancer type while creating network load balancer: %s", err)
 	}
 
 	req := loadbalancer.CreateNetworkLoadBalancerRequest{
@@ -271,60 +272,54 @@ func resourceYandexLBNetworkLoadBalancerCreate(d *schema.ResourceData, meta inte
 		AttachedTargetGroups: atgs,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.LoadBalancer().NetworkLoadBalancer().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create network load balancer: %s", err)
+		return errorDiagnostics("Error while requesting API to create network load balancer", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get network load balancer create operation metadata: %s", err)
+		return diag.Errorf("Error while get network load balancer create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*loadbalancer.CreateNetworkLoadBalancerMetadata)
 	if !ok {
-		return fmt.Errorf("could not get NetworkLoadBalancer ID from create operation metadata")
+		return diag.Errorf("could not get NetworkLoadBalancer ID from create operation metadata")
 	}
 
 	d.SetId(md.NetworkLoadBalancerId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create network load balancer: %s", err)
+		return errorDiagnostics("Error while waiting operation to create network load balancer", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Network creation failed: %s", err)
+		return errorDiagnostics("Network creation failed", err, nil)
 	}
 
-	return resourceYandexLBNetworkLoadBalancerRead(d, meta)
+	return resourceYandexLBNetworkLoadBalancerRead(ctx, d, meta)
 }
 
-func resourceYandexLBNetworkLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexLBNetworkLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	nlb, err := config.sdk.LoadBalancer().NetworkLoadBalancer().Get(ctx, &loadbalancer.GetNetworkLoadBalancerRequest{
 		NetworkLoadBalancerId: d.Id(),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("NetworkLoadBalancer %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("NetworkLoadBalancer %q", d.Get("name").(string))))
 	}
 
 	ls, err := flattenLBListenerSpecs(nlb)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	atgs, err := flattenLBAttachedTargetGroups(nlb)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("created_at", getTimestamp(nlb.CreatedAt))
@@ -335,32 +330,32 @@ func resourceYandexLBNetworkLoadBalancerRead(d *schema.ResourceData, meta interf
 	d.Set("type", strings.ToLower(nlb.Type.String()))
 
 	if err := d.Set("listener", ls); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("attached_target_group", atgs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("labels", nlb.Labels)
+	return diag.FromErr(d.Set("labels", nlb.Labels))
 }
 
-func resourceYandexLBNetworkLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexLBNetworkLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ls, err := expandLBListenerSpecs(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding listeners while creating network load balancer: %s", err)
+		return diag.Errorf("Error expanding listeners while creating network load balancer: %s", err)
 	}
 
 	atgs, err := expandLBAttachedTargetGroups(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding attached target groups while creating network load balancer: %s", err)
+		return diag.Errorf("Error expanding attached target groups while creating network load balancer: %s", err)
 	}
 
 	req := &loadbalancer.UpdateNetworkLoadBalancerRequest{
@@ -372,23 +367,20 @@ func resourceYandexLBNetworkLoadBalancerUpdate(d *schema.ResourceData, meta inte
 		AttachedTargetGroups:  atgs,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.LoadBalancer().NetworkLoadBalancer().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update NetworkLoadBalancer %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to update NetworkLoadBalancer %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error updating NetworkLoadBalancer %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error updating NetworkLoadBalancer %q", d.Id()), err, nil)
 	}
 
-	return resourceYandexLBNetworkLoadBalancerRead(d, meta)
+	return resourceYandexLBNetworkLoadBalancerRead(ctx, d, meta)
 }
 
-func resourceYandexLBNetworkLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexLBNetworkLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting NetworkLoadBalancer %q", d.Id())
@@ -397,22 +389,19 @@ func resourceYandexLBNetworkLoadBalancerDelete(d *schema.ResourceData, meta inte
 		NetworkLoadBalancerId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.LoadBalancer().NetworkLoadBalancer().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("NetworkLoadBalancer %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("NetworkLoadBalancer %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting NetworkLoadBalancer %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting NetworkLoadBalancer %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting NetworkLoadBalancer %q", d.Id())
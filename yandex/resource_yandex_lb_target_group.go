@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,12 +17,12 @@ const yandexLBTargetGroupDefaultTimeout = 5 * time.Minute
 
 func resourceYandexLBTargetGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexLBTargetGroupCreate,
-		Read:   resourceYandexLBTargetGroupRead,
-		Update: resourceYandexLBTargetGroupUpdate,
-		Delete: resourceYandexLBTargetGroupDelete,
+		CreateContext: resourceYandexLBTargetGroupCreate,
+		ReadContext:   resourceYandexLBTargetGroupRead,
+		UpdateContext: resourceYandexLBTargetGroupUpdate,
+		DeleteContext: resourceYandexLBTargetGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -91,22 +92,22 @@ func resourceYandexLBTargetGroup() *schema.Resource {
 
 }
 
-func resourceYandexLBTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexLBTargetGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating target group: %s", err)
+		return diag.Errorf("Error expanding labels while creating target group: %s", err)
 	}
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating target group: %s", err)
+		return diag.Errorf("Error getting folder ID while creating target group: %s", err)
 	}
 
 	targets, err := expandLBTargets(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding targets while creating target group: %s", err)
+		return diag.Errorf("Error expanding targets while creating target group: %s", err)
 	}
 
 	req := loadbalancer.CreateTargetGroupRequest{
@@ -118,55 +119,49 @@ func resourceYandexLBTargetGroupCreate(d *schema.ResourceData, meta interface{})
 		Targets:     targets,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.LoadBalancer().TargetGroup().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create target group: %s", err)
+		return errorDiagnostics("Error while requesting API to create target group", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get target group create operation metadata: %s", err)
+		return diag.Errorf("Error while get target group create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*loadbalancer.CreateTargetGroupMetadata)
 	if !ok {
-		return fmt.Errorf("could not get TargetGroup ID from create operation metadata")
+		return diag.Errorf("could not get TargetGroup ID from create operation metadata")
 	}
 
 	d.SetId(md.TargetGroupId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create target group: %s", err)
+		return errorDiagnostics("Error while waiting operation to create target group", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("TargetGroup creation failed: %s", err)
+		return errorDiagnostics("TargetGroup creation failed", err, nil)
 	}
 
-	return resourceYandexLBTargetGroupRead(d, meta)
+	return resourceYandexLBTargetGroupRead(ctx, d, meta)
 }
 
-func resourceYandexLBTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexLBTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	tg, err := config.sdk.LoadBalancer().TargetGroup().Get(ctx, &loadbalancer.GetTargetGroupRequest{
 		TargetGroupId: d.Id(),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("TargetGroup %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("TargetGroup %q", d.Get("name").(string))))
 	}
 
 	targets, err := flattenLBTargets(tg)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("created_at", getTimestamp(tg.CreatedAt))
@@ -176,23 +171,23 @@ func resourceYandexLBTargetGroupRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("description", tg.Description)
 
 	if err := d.Set("target", targets); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("labels", tg.Labels)
+	return diag.FromErr(d.Set("labels", tg.Labels))
 }
 
-func resourceYandexLBTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexLBTargetGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targets, err := expandLBTargets(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding targets while creating target group: %s", err)
+		return diag.Errorf("Error expanding targets while creating target group: %s", err)
 	}
 
 	req := &loadbalancer.UpdateTargetGroupRequest{
@@ -203,23 +198,20 @@ func resourceYandexLBTargetGroupUpdate(d *schema.ResourceData, meta interface{})
 		Targets:       targets,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.LoadBalancer().TargetGroup().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update TargetGroup %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to update TargetGroup %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error updating TargetGroup %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error updating TargetGroup %q", d.Id()), err, nil)
 	}
 
-	return resourceYandexLBTargetGroupRead(d, meta)
+	return resourceYandexLBTargetGroupRead(ctx, d, meta)
 }
 
-func resourceYandexLBTargetGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexLBTargetGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting TargetGroup %q", d.Id())
@@ -228,22 +220,19 @@ func resourceYandexLBTargetGroupDelete(d *schema.ResourceData, meta interface{})
 		TargetGroupId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.LoadBalancer().TargetGroup().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("TargetGroup %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("TargetGroup %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting TargetGroup %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting TargetGroup %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting TargetGroup %q", d.Id())
@@ -7,6 +7,7 @@ import (
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/logging/v1"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -16,12 +17,12 @@ const yandexLoggingGroupDefaultTimeout = 30 * time.Second
 
 func resourceYandexLoggingGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexLoggingGroupCreate,
-		Read:   resourceYandexLoggingGroupRead,
-		Update: resourceYandexLoggingGroupUpdate,
-		Delete: performYandexLoggingGroupDelete,
+		CreateContext: resourceYandexLoggingGroupCreate,
+		ReadContext:   resourceYandexLoggingGroupRead,
+		UpdateContext: resourceYandexLoggingGroupUpdate,
+		DeleteContext: performYandexLoggingGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -83,22 +84,22 @@ func resourceYandexLoggingGroup() *schema.Resource {
 	}
 }
 
-func resourceYandexLoggingGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexLoggingGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("error getting folder ID while creating log group: %s", err)
+		return diag.Errorf("error getting folder ID while creating log group: %s", err)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("error expanding labels while creating log group: %s", err)
+		return diag.Errorf("error expanding labels while creating log group: %s", err)
 	}
 
 	retentionPeriod, err := parseDuration(d.Get("retention_period").(string))
 	if err != nil {
-		return fmt.Errorf("error parsing retention_period while creating log group: %s", err)
+		return errorDiagnostics("error parsing retention_period while creating log group", err, nil)
 	}
 
 	req := logging.CreateLogGroupRequest{
@@ -109,20 +110,17 @@ func resourceYandexLoggingGroupCreate(d *schema.ResourceData, meta interface{})
 		RetentionPeriod: retentionPeriod,
 	}
 
-	if err := performYandexLoggingGroupCreate(d, config, &req); err != nil {
-		return err
+	if err := performYandexLoggingGroupCreate(ctx, d, config, &req); err != nil {
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexLoggingGroupRead(d, meta)
+	return resourceYandexLoggingGroupRead(ctx, d, meta)
 }
 
-func performYandexLoggingGroupCreate(d *schema.ResourceData, config *Config, req *logging.CreateLogGroupRequest) error {
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
+func performYandexLoggingGroupCreate(ctx context.Context, d *schema.ResourceData, config *Config, req *logging.CreateLogGroupRequest) error {
 	op, err := config.sdk.WrapOperation(config.sdk.Logging().LogGroup().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create log group: %s", err)
+		return apiError("error while requesting API to create log group", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
@@ -139,7 +137,7 @@ func performYandexLoggingGroupCreate(d *schema.ResourceData, config *Config, req
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while waiting operation to create log group: %s", err)
+		return apiError("error while waiting operation to create log group", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
@@ -148,7 +146,7 @@ func performYandexLoggingGroupCreate(d *schema.ResourceData, config *Config, req
 	return nil
 }
 
-func resourceYandexLoggingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexLoggingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	req := logging.UpdateLogGroupRequest{
@@ -156,14 +154,14 @@ func resourceYandexLoggingGroupUpdate(d *schema.ResourceData, meta interface{})
 		UpdateMask: &field_mask.FieldMask{},
 	}
 
-	if err := performYandexLoggingGroupUpdate(d, config, &req); err != nil {
-		return err
+	if err := performYandexLoggingGroupUpdate(ctx, d, config, &req); err != nil {
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexLoggingGroupRead(d, meta)
+	return resourceYandexLoggingGroupRead(ctx, d, meta)
 }
 
-func performYandexLoggingGroupUpdate(d *schema.ResourceData, config *Config, req *logging.UpdateLogGroupRequest) error {
+func performYandexLoggingGroupUpdate(ctx context.Context, d *schema.ResourceData, config *Config, req *logging.UpdateLogGroupRequest) error {
 	d.Partial(true)
 
 	if d.HasChange("name") {
@@ -185,7 +183,7 @@ func performYandexLoggingGroupUpdate(d *schema.ResourceData, config *Config, req
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "retention_period")
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
 			return err
@@ -195,12 +193,9 @@ func performYandexLoggingGroupUpdate(d *schema.ResourceData, config *Config, req
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "labels")
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.Logging().LogGroup().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update log group: %s", err)
+		return apiError("error while requesting API to update log group", err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -213,36 +208,30 @@ func performYandexLoggingGroupUpdate(d *schema.ResourceData, config *Config, req
 	return nil
 }
 
-func performYandexLoggingGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func performYandexLoggingGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.Logging().LogGroup().Delete(ctx, &logging.DeleteLogGroupRequest{LogGroupId: d.Id()})
 	err = waitOperation(ctx, config, op, err)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Logging group %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Logging group %q", d.Id())))
 	}
 
 	return nil
 }
 
-func resourceYandexLoggingGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexLoggingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	group, err := performYandexLoggingGroupRead(d, config)
+	group, err := performYandexLoggingGroupRead(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return flattenYandexLoggingGroup(d, group)
+	return diag.FromErr(flattenYandexLoggingGroup(d, group))
 }
 
-func performYandexLoggingGroupRead(d *schema.ResourceData, config *Config) (*logging.LogGroup, error) {
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
+func performYandexLoggingGroupRead(ctx context.Context, d *schema.ResourceData, config *Config) (*logging.LogGroup, error) {
 	group, err := config.sdk.Logging().LogGroup().Get(ctx, &logging.GetLogGroupRequest{LogGroupId: d.Id()})
 	if err != nil {
 		return nil, handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Logging group %q", d.Get("name").(string)))
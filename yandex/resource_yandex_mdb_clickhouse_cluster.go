@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -22,12 +23,12 @@ const (
 
 func resourceYandexMDBClickHouseCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBClickHouseClusterCreate,
-		Read:   resourceYandexMDBClickHouseClusterRead,
-		Update: resourceYandexMDBClickHouseClusterUpdate,
-		Delete: resourceYandexMDBClickHouseClusterDelete,
+		CreateContext: resourceYandexMDBClickHouseClusterCreate,
+		ReadContext:   resourceYandexMDBClickHouseClusterRead,
+		UpdateContext: resourceYandexMDBClickHouseClusterUpdate,
+		DeleteContext: resourceYandexMDBClickHouseClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -721,44 +722,65 @@ func resourceYandexMDBClickHouseCluster() *schema.Resource {
 	}
 }
 
-func resourceYandexMDBClickHouseClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBClickHouseClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	req, shards, err := prepareCreateClickHouseCreateRequest(d, config)
+	req, _, err := prepareCreateClickHouseCreateRequest(d, config)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create ClickHouse Cluster: %s", err)
+		return errorDiagnostics("error while requesting API to create ClickHouse Cluster", err, mdbClickHouseUpdateFieldsMap)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("error while getting ClickHouse create operation metadata: %s", err)
+		return diag.Errorf("error while getting ClickHouse create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*clickhouse.CreateClusterMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Cluster ID from create operation metadata")
+		return diag.Errorf("could not get Cluster ID from create operation metadata")
 	}
 
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("error while waiting for operation to create ClickHouse Cluster: %s", err)
+		return errorDiagnostics("error while waiting for operation to create ClickHouse Cluster", err, mdbClickHouseUpdateFieldsMap)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("ClickHouse Cluster creation failed: %s", err)
+		return errorDiagnostics("ClickHouse Cluster creation failed", err, mdbClickHouseUpdateFieldsMap)
 	}
 
+	if err := finishResumableCreate(ctx, d, meta, op.Id(), finishClickHouseClusterCreate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceYandexMDBClickHouseClusterRead(ctx, d, meta)
+}
+
+// finishClickHouseClusterCreate adds shards, shard groups, format schemas and ML models, which can't be created
+// along with the cluster, and sets the maintenance window. Items which already exist are skipped, so it can be repeated.
+func finishClickHouseClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	_, shards, err := prepareCreateClickHouseCreateRequest(d, config)
+	if err != nil {
+		return err
+	}
+
+	existingShards, err := listClickHouseShards(ctx, config, d.Id())
+	if err != nil {
+		return err
+	}
+	for _, shard := range existingShards {
+		delete(shards, shard.Name)
+	}
 	for shardName, shardHosts := range shards {
 		err = createClickHouseShard(ctx, config, d, shardName, shardHosts)
 		if err != nil {
@@ -770,8 +792,18 @@ func resourceYandexMDBClickHouseClusterCreate(d *schema.ResourceData, meta inter
 	if err != nil {
 		return err
 	}
-
+	existingShardGroups := make(map[string]bool)
+	groups, err := listClickHouseShardGroups(ctx, config, d.Id())
+	if err != nil {
+		return err
+	}
+	for _, item := range groups {
+		existingShardGroups[item.Name] = true
+	}
 	for _, group := range shardGroups {
+		if existingShardGroups[group.Name] {
+			continue
+		}
 		err = createClickHouseShardGroup(ctx, config, d, group)
 		if err != nil {
 			return err
@@ -782,8 +814,18 @@ func resourceYandexMDBClickHouseClusterCreate(d *schema.ResourceData, meta inter
 	if err != nil {
 		return err
 	}
-
+	existingFormatSchemas := make(map[string]bool)
+	schemas, err := listClickHouseFormatSchemas(ctx, config, d.Id())
+	if err != nil {
+		return err
+	}
+	for _, item := range schemas {
+		existingFormatSchemas[item.Name] = true
+	}
 	for _, formatSchema := range formatSchemas {
+		if existingFormatSchemas[formatSchema.Name] {
+			continue
+		}
 		err = createClickHouseFormatSchema(ctx, config, d, formatSchema)
 		if err != nil {
 			return err
@@ -794,8 +836,18 @@ func resourceYandexMDBClickHouseClusterCreate(d *schema.ResourceData, meta inter
 	if err != nil {
 		return err
 	}
-
+	existingMlModels := make(map[string]bool)
+	models, err := listClickHouseMlModels(ctx, config, d.Id())
+	if err != nil {
+		return err
+	}
+	for _, item := range models {
+		existingMlModels[item.Name] = true
+	}
 	for _, mlModel := range mlModels {
+		if existingMlModels[mlModel.Name] {
+			continue
+		}
 		err = createClickHouseMlModel(ctx, config, d, mlModel)
 		if err != nil {
 			return err
@@ -807,13 +859,9 @@ func resourceYandexMDBClickHouseClusterCreate(d *schema.ResourceData, meta inter
 		return err
 	}
 	if mw != nil {
-		err = updateClickHouseMaintenanceWindow(ctx, config, d, mw)
-		if err != nil {
-			return err
-		}
+		return updateClickHouseMaintenanceWindow(ctx, config, d, mw)
 	}
-
-	return resourceYandexMDBClickHouseClusterRead(d, meta)
+	return nil
 }
 
 // Returns request for creating the Cluster and the map of the remaining shards to add.
@@ -917,26 +965,23 @@ func prepareCreateClickHouseCreateRequest(d *schema.ResourceData, meta *Config)
 	return &req, toAdd, nil
 }
 
-func resourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBClickHouseClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	cluster, err := config.sdk.MDB().Clickhouse().Cluster().Get(ctx, &clickhouse.GetClusterRequest{
 		ClusterId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string))))
 	}
 	chResources, err := flattenClickHouseResources(cluster.Config.Clickhouse.Resources)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	chConfig, err := flattenClickHouseConfig(d, cluster.Config.Clickhouse.Config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("clickhouse", []map[string]interface{}{
@@ -946,12 +991,12 @@ func resourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interfa
 		},
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	zkResources, err := flattenClickHouseResources(cluster.Config.Zookeeper.Resources)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("zookeeper", []map[string]interface{}{
 		{
@@ -959,108 +1004,108 @@ func resourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interfa
 		},
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bws := flattenClickHouseBackupWindowStart(cluster.Config.BackupWindowStart)
 	if err := d.Set("backup_window_start", bws); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ac := flattenClickHouseAccess(cluster.GetConfig().GetAccess())
 	if err := d.Set("access", ac); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	mw := flattenClickHouseMaintenanceWindow(cluster.MaintenanceWindow)
 	if err := d.Set("maintenance_window", mw); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hosts, err := listClickHouseHosts(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dHosts, err := expandClickHouseHosts(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hosts = sortClickHouseHosts(hosts, dHosts)
 	hs, err := flattenClickHouseHosts(hosts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("host", hs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	groups, err := listClickHouseShardGroups(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	sg, err := flattenClickHouseShardGroups(groups)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("shard_group", sg); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	formatSchemas, err := listClickHouseFormatSchemas(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	fs, err := flattenClickHouseFormatSchemas(formatSchemas)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("format_schema", fs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	mlModels, err := listClickHouseMlModels(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	ml, err := flattenClickHouseMlModels(mlModels)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("ml_model", ml); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	databases, err := listClickHouseDatabases(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbs := flattenClickHouseDatabases(databases)
 	if err := d.Set("database", dbs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dUsers, err := expandClickHouseUserSpecs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	passwords := clickHouseUsersPasswords(dUsers)
 
 	users, err := listClickHouseUsers(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	us := flattenClickHouseUsers(users, passwords)
 	if err := d.Set("user", us); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("created_at", getTimestamp(cluster.CreatedAt))
@@ -1080,61 +1125,61 @@ func resourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interfa
 
 	cs := flattenClickHouseCloudStorage(cluster.Config.CloudStorage)
 	if err := d.Set("cloud_storage", cs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("labels", cluster.Labels)
+	return diag.FromErr(d.Set("labels", cluster.Labels))
 }
 
-func resourceYandexMDBClickHouseClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBClickHouseClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Updating ClickHouse Cluster %q", d.Id())
 
 	d.Partial(true)
 
-	if err := updateClickHouseClusterParams(d, meta); err != nil {
-		return err
+	if err := updateClickHouseClusterParams(ctx, d, meta); err != nil {
+		return diagnosticsFromError(err)
 	}
 
 	if d.HasChange("database") {
-		if err := updateClickHouseClusterDatabases(d, meta); err != nil {
-			return err
+		if err := updateClickHouseClusterDatabases(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	if d.HasChange("user") {
-		if err := updateClickHouseClusterUsers(d, meta); err != nil {
-			return err
+		if err := updateClickHouseClusterUsers(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	if d.HasChange("host") {
-		if err := updateClickHouseClusterHosts(d, meta); err != nil {
-			return err
+		if err := updateClickHouseClusterHosts(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	if d.HasChange("shard_group") {
-		if err := updateClickHouseClusterShardGroups(d, meta); err != nil {
-			return err
+		if err := updateClickHouseClusterShardGroups(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	if d.HasChange("format_schema") {
-		if err := updateClickHouseFormatSchemas(d, meta); err != nil {
-			return err
+		if err := updateClickHouseFormatSchemas(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	if d.HasChange("ml_model") {
-		if err := updateClickHouseMlModels(d, meta); err != nil {
-			return err
+		if err := updateClickHouseMlModels(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	d.Partial(false)
 
 	log.Printf("[DEBUG] Finished updating ClickHouse Cluster %q", d.Id())
-	return resourceYandexMDBClickHouseClusterRead(d, meta)
+	return resourceYandexMDBClickHouseClusterRead(ctx, d, meta)
 }
 
 var mdbClickHouseUpdateFieldsMap = map[string]string{
@@ -1155,7 +1200,7 @@ var mdbClickHouseUpdateFieldsMap = map[string]string{
 	"deletion_protection":     "deletion_protection",
 }
 
-func updateClickHouseClusterParams(d *schema.ResourceData, meta interface{}) error {
+func updateClickHouseClusterParams(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	req, err := getClickHouseClusterUpdateRequest(d)
 	if err != nil {
@@ -1165,7 +1210,7 @@ func updateClickHouseClusterParams(d *schema.ResourceData, meta interface{}) err
 	onDone := []func(){}
 	updatePath := []string{}
 	for field, path := range mdbClickHouseUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasChangeWithDefaultLabels(d, field) {
 			updatePath = append(updatePath, path)
 			onDone = append(onDone, func() {
 
@@ -1175,9 +1220,6 @@ func updateClickHouseClusterParams(d *schema.ResourceData, meta interface{}) err
 
 	// We only can apply this if ZK subcluster already exists
 	if d.HasChange("zookeeper") {
-		ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-		defer cancel()
-
 		currHosts, err := listClickHouseHosts(ctx, config, d.Id())
 		if err != nil {
 			return err
@@ -1199,17 +1241,15 @@ func updateClickHouseClusterParams(d *schema.ResourceData, meta interface{}) err
 	}
 
 	req.UpdateMask = &field_mask.FieldMask{Paths: updatePath}
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update ClickHouse Cluster %q", d.Id()), err, mdbClickHouseUpdateFieldsMap)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while updating ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while updating ClickHouse Cluster %q", d.Id()), err, mdbClickHouseUpdateFieldsMap)
 	}
 
 	for _, f := range onDone {
@@ -1262,10 +1302,8 @@ func getClickHouseClusterUpdateRequest(d *schema.ResourceData) (*clickhouse.Upda
 	return req, nil
 }
 
-func updateClickHouseClusterDatabases(d *schema.ResourceData, meta interface{}) error {
+func updateClickHouseClusterDatabases(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	currDBs, err := listClickHouseDatabases(ctx, config, d.Id())
 	if err != nil {
@@ -1295,10 +1333,8 @@ func updateClickHouseClusterDatabases(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func updateClickHouseClusterUsers(d *schema.ResourceData, meta interface{}) error {
+func updateClickHouseClusterUsers(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 	currUsers, err := listClickHouseUsers(ctx, config, d.Id())
 	if err != nil {
 		return err
@@ -1334,10 +1370,8 @@ func updateClickHouseClusterUsers(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func updateClickHouseClusterHosts(d *schema.ResourceData, meta interface{}) error {
+func updateClickHouseClusterHosts(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	currHosts, err := listClickHouseHosts(ctx, config, d.Id())
 	if err != nil {
@@ -1457,10 +1491,8 @@ func updateClickHouseClusterHosts(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func updateClickHouseClusterShardGroups(d *schema.ResourceData, meta interface{}) error {
+func updateClickHouseClusterShardGroups(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 	currGroups, err := listClickHouseShardGroups(ctx, config, d.Id())
 	if err != nil {
 		return err
@@ -1495,10 +1527,8 @@ func updateClickHouseClusterShardGroups(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func updateClickHouseFormatSchemas(d *schema.ResourceData, meta interface{}) error {
+func updateClickHouseFormatSchemas(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 	currSchemas, err := listClickHouseFormatSchemas(ctx, config, d.Id())
 	if err != nil {
 		return err
@@ -1533,10 +1563,8 @@ func updateClickHouseFormatSchemas(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func updateClickHouseMlModels(d *schema.ResourceData, meta interface{}) error {
+func updateClickHouseMlModels(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 	currModels, err := listClickHouseMlModels(ctx, config, d.Id())
 	if err != nil {
 		return err
@@ -1581,7 +1609,7 @@ func createClickHouseDatabase(ctx context.Context, config *Config, d *schema.Res
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create database in ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create database in ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1599,7 +1627,7 @@ func deleteClickHouseDatabase(ctx context.Context, config *Config, d *schema.Res
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete database from ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete database from ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1617,7 +1645,7 @@ func createClickHouseUser(ctx context.Context, config *Config, d *schema.Resourc
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create user for ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create user for ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1635,7 +1663,7 @@ func deleteClickHouseUser(ctx context.Context, config *Config, d *schema.Resourc
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete user from ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete user from ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1657,7 +1685,7 @@ func updateClickHouseUser(ctx context.Context, config *Config, d *schema.Resourc
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update user in ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update user in ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1676,7 +1704,7 @@ func createClickHouseHost(ctx context.Context, config *Config, d *schema.Resourc
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to add host to ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to add host to ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1693,7 +1721,7 @@ func updateClickHouseHost(ctx context.Context, config *Config, d *schema.Resourc
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update host of ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update host of ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1710,7 +1738,7 @@ func deleteClickHouseHost(ctx context.Context, config *Config, d *schema.Resourc
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete host from ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete host from ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1729,7 +1757,7 @@ func createClickHouseShard(ctx context.Context, config *Config, d *schema.Resour
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to add shard to ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to add shard to ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1746,7 +1774,7 @@ func deleteClickHouseShard(ctx context.Context, config *Config, d *schema.Resour
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete shard from ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete shard from ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1765,7 +1793,7 @@ func createClickHouseShardGroup(ctx context.Context, config *Config, d *schema.R
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to add shard group to ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to add shard group to ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1785,7 +1813,7 @@ func updateClickHouseShardGroup(ctx context.Context, config *Config, d *schema.R
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update shard group to ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update shard group to ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1802,7 +1830,7 @@ func deleteClickHouseShardGroup(ctx context.Context, config *Config, d *schema.R
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete shard group from ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete shard group from ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1821,7 +1849,7 @@ func createClickHouseFormatSchema(ctx context.Context, config *Config, d *schema
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create format schema in ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create format schema in ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1840,7 +1868,7 @@ func updateClickHouseFormatSchema(ctx context.Context, config *Config, d *schema
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update format schema in ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update format schema in ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1857,7 +1885,7 @@ func deleteClickHouseFormatSchema(ctx context.Context, config *Config, d *schema
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete format schema from ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete format schema from ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1876,7 +1904,7 @@ func createClickHouseMlModel(ctx context.Context, config *Config, d *schema.Reso
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to add ml model to ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to add ml model to ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1895,7 +1923,7 @@ func updateClickHouseMlModel(ctx context.Context, config *Config, d *schema.Reso
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update ml model in ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update ml model in ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1912,7 +1940,7 @@ func deleteClickHouseMlModel(ctx context.Context, config *Config, d *schema.Reso
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete shard group from ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete shard group from ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1930,7 +1958,7 @@ func createClickHouseZooKeeper(ctx context.Context, config *Config, d *schema.Re
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create ZooKeeper subcluster in ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create ZooKeeper subcluster in ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1948,7 +1976,7 @@ func updateClickHouseMaintenanceWindow(ctx context.Context, config *Config, d *s
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update maintenance window in ClickHouse Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update maintenance window in ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -2041,7 +2069,7 @@ func listClickHouseShards(ctx context.Context, config *Config, id string) ([]*cl
 	return shards, nil
 }
 
-func resourceYandexMDBClickHouseClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBClickHouseClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting ClickHouse Cluster %q", d.Id())
@@ -2050,22 +2078,19 @@ func resourceYandexMDBClickHouseClusterDelete(d *schema.ResourceData, meta inter
 		ClusterId: d.Id(),
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ClickHouse Cluster %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("ClickHouse Cluster %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting ClickHouse Cluster %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting ClickHouse Cluster %q", d.Id())
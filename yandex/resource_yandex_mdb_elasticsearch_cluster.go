@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/elasticsearch/v1"
@@ -21,12 +22,12 @@ const (
 func resourceYandexMDBElasticsearchCluster() *schema.Resource {
 	return &schema.Resource{
 
-		Create: resourceYandexMDBElasticsearchClusterCreate,
-		Read:   resourceYandexMDBElasticsearchClusterRead,
-		Update: resourceYandexMDBElasticsearchClusterUpdate,
-		Delete: resourceYandexMDBElasticsearchClusterDelete,
+		CreateContext: resourceYandexMDBElasticsearchClusterCreate,
+		ReadContext:   resourceYandexMDBElasticsearchClusterRead,
+		UpdateContext: resourceYandexMDBElasticsearchClusterUpdate,
+		DeleteContext: resourceYandexMDBElasticsearchClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -301,17 +302,14 @@ var elasticsearchHostResource = &schema.Resource{
 	},
 }
 
-func resourceYandexMDBElasticsearchClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBElasticsearchClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	cluster, err := config.sdk.MDB().ElasticSearch().Cluster().Get(ctx, &elasticsearch.GetClusterRequest{
 		ClusterId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Id())))
 	}
 
 	d.Set("created_at", getTimestamp(cluster.CreatedAt))
@@ -327,7 +325,7 @@ func resourceYandexMDBElasticsearchClusterRead(d *schema.ResourceData, meta inte
 	d.Set("service_account_id", cluster.GetServiceAccountId())
 
 	if err := d.Set("labels", cluster.GetLabels()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	password := ""
@@ -337,7 +335,7 @@ func resourceYandexMDBElasticsearchClusterRead(d *schema.ResourceData, meta inte
 	clusterConfig := flattenElasticsearchClusterConfig(cluster.Config, password)
 
 	if err := d.Set("config", clusterConfig); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cluster.SecurityGroupIds == nil {
@@ -345,77 +343,74 @@ func resourceYandexMDBElasticsearchClusterRead(d *schema.ResourceData, meta inte
 	}
 
 	if err := d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	actualHosts, err := listElasticsearchHosts(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hosts, err := expandElasticsearchHosts(d.Get("host"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	result, err := flattenElasticsearchHosts(mapElasticsearchHostNames(actualHosts, hosts))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("host", result); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("deletion_protection", cluster.GetDeletionProtection())
 
 	mw := flattenElasticsearchMaintenanceWindow(cluster.MaintenanceWindow)
 	if err := d.Set("maintenance_window", mw); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceYandexMDBElasticsearchClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBElasticsearchClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	req, err := prepareCreateElasticsearchRequest(d, config)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().ElasticSearch().Cluster().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Elasticsearch Cluster: %s", err)
+		return errorDiagnostics("Error while requesting API to create Elasticsearch Cluster", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get Elasticsearch Cluster create operation metadata: %s", err)
+		return diag.Errorf("Error while get Elasticsearch Cluster create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*elasticsearch.CreateClusterMetadata)
 	if !ok {
-		return fmt.Errorf("Could not get Elasticsearch Cluster ID from create operation metadata")
+		return diag.Errorf("Could not get Elasticsearch Cluster ID from create operation metadata")
 	}
 
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting for operation to create Elasticsearch Cluster: %s", err)
+		return errorDiagnostics("Error while waiting for operation to create Elasticsearch Cluster", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Elasticsearch Cluster creation failed: %s", err)
+		return errorDiagnostics("Elasticsearch Cluster creation failed", err, nil)
 	}
 
-	return resourceYandexMDBElasticsearchClusterRead(d, meta)
+	return resourceYandexMDBElasticsearchClusterRead(ctx, d, meta)
 }
 
 func prepareCreateElasticsearchRequest(d *schema.ResourceData, meta *Config) (*elasticsearch.CreateClusterRequest, error) {
@@ -476,7 +471,7 @@ func prepareCreateElasticsearchRequest(d *schema.ResourceData, meta *Config) (*e
 	return req, nil
 }
 
-func resourceYandexMDBElasticsearchClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBElasticsearchClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Elasticsearch Cluster %q", d.Id())
@@ -485,22 +480,19 @@ func resourceYandexMDBElasticsearchClusterDelete(d *schema.ResourceData, meta in
 		ClusterId: d.Id(),
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().ElasticSearch().Cluster().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Elasticsearch Cluster %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Elasticsearch Cluster %q", d.Id())))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Elasticsearch Cluster %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Elasticsearch Cluster %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Elasticsearch Cluster %q", d.Id())
@@ -508,24 +500,24 @@ func resourceYandexMDBElasticsearchClusterDelete(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceYandexMDBElasticsearchClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBElasticsearchClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.Partial(true)
 
-	if err := updateElasticsearchClusterParams(d, meta); err != nil {
-		return err
+	if err := updateElasticsearchClusterParams(ctx, d, meta); err != nil {
+		return diagnosticsFromError(err)
 	}
 
 	if d.HasChange("host") {
-		if err := updateElasticsearchClusterHosts(d, meta); err != nil {
-			return err
+		if err := updateElasticsearchClusterHosts(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	d.Partial(false)
-	return resourceYandexMDBElasticsearchClusterRead(d, meta)
+	return resourceYandexMDBElasticsearchClusterRead(ctx, d, meta)
 }
 
-func updateElasticsearchClusterHosts(d *schema.ResourceData, meta interface{}) error {
+func updateElasticsearchClusterHosts(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	os, ns := d.GetChange("host")
 	oldHosts, newHosts := os.(*schema.Set), ns.(*schema.Set)
 
@@ -562,7 +554,7 @@ func updateElasticsearchClusterHosts(d *schema.ResourceData, meta interface{}) e
 
 	// api support only one by one
 	for _, host := range toCreate {
-		err := makeCreateElasticsearchHostRequest(d.Id(), host, d, meta)
+		err := makeCreateElasticsearchHostRequest(ctx, d.Id(), host, d, meta)
 		if err != nil {
 			return err
 		}
@@ -585,7 +577,7 @@ func updateElasticsearchClusterHosts(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[DEBUG] Delete Hosts Elasticsearch Cluster %q: %d", d.Id(), len(toDelete))
 
 	for _, host := range toDelete {
-		err := makeDeleteElasticsearchHostRequest(d.Id(), host, d, meta)
+		err := makeDeleteElasticsearchHostRequest(ctx, d.Id(), host, d, meta)
 		if err != nil {
 			return err
 		}
@@ -594,7 +586,7 @@ func updateElasticsearchClusterHosts(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func updateElasticsearchClusterParams(d *schema.ResourceData, meta interface{}) error {
+func updateElasticsearchClusterParams(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	req := &elasticsearch.UpdateClusterRequest{
 		ClusterId: d.Id(),
 		UpdateMask: &field_mask.FieldMask{
@@ -617,7 +609,7 @@ func updateElasticsearchClusterParams(d *schema.ResourceData, meta interface{})
 		changed = append(changed, "name")
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
 			return err
@@ -693,7 +685,7 @@ func updateElasticsearchClusterParams(d *schema.ResourceData, meta interface{})
 		return nil // nothing to update
 	}
 
-	err := makeElasticsearchClusterUpdateRequest(req, d, meta)
+	err := makeElasticsearchClusterUpdateRequest(ctx, req, d, meta)
 	if err != nil {
 		return err
 	}
@@ -701,15 +693,12 @@ func updateElasticsearchClusterParams(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func makeElasticsearchClusterUpdateRequest(req *elasticsearch.UpdateClusterRequest, d *schema.ResourceData, meta interface{}) error {
+func makeElasticsearchClusterUpdateRequest(ctx context.Context, req *elasticsearch.UpdateClusterRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().ElasticSearch().Cluster().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Elasticsearch Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update Elasticsearch Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -719,18 +708,15 @@ func makeElasticsearchClusterUpdateRequest(req *elasticsearch.UpdateClusterReque
 	return nil
 }
 
-func makeCreateElasticsearchHostRequest(clusterID string, host *ElasticsearchHost, d *schema.ResourceData, meta interface{}) error {
+func makeCreateElasticsearchHostRequest(ctx context.Context, clusterID string, host *ElasticsearchHost, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().ElasticSearch().Cluster().AddHosts(ctx, &elasticsearch.AddClusterHostsRequest{
 		ClusterId: clusterID,
 		HostSpecs: convertElasticsearchHostsToSpecs([]*ElasticsearchHost{host}),
 	}))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Elasticsearch Host %q for Cluster %q: %s", host.Name, clusterID, err)
+		return apiError(fmt.Sprintf("Error while requesting API to create Elasticsearch Host %q for Cluster %q", host.Name, clusterID), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -740,18 +726,15 @@ func makeCreateElasticsearchHostRequest(clusterID string, host *ElasticsearchHos
 	return nil
 }
 
-func makeDeleteElasticsearchHostRequest(clusterID string, host *ElasticsearchHost, d *schema.ResourceData, meta interface{}) error {
+func makeDeleteElasticsearchHostRequest(ctx context.Context, clusterID string, host *ElasticsearchHost, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().ElasticSearch().Cluster().DeleteHosts(ctx, &elasticsearch.DeleteClusterHostsRequest{
 		ClusterId: clusterID,
 		HostNames: []string{host.Fqdn},
 	}))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Elasticsearch Host %q for Cluster %q: %s", host.Fqdn, clusterID, err)
+		return apiError(fmt.Sprintf("Error while requesting API to create Elasticsearch Host %q for Cluster %q", host.Fqdn, clusterID), err, nil)
 	}
 
 	err = op.Wait(ctx)
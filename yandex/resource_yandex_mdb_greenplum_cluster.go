@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -20,12 +21,12 @@ const (
 
 func resourceYandexMDBGreenplumCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBGreenplumClusterCreate,
-		Read:   resourceYandexMDBGreenplumClusterRead,
-		Update: resourceYandexMDBGreenplumClusterUpdate,
-		Delete: resourceYandexMDBGreenplumClusterDelete,
+		CreateContext: resourceYandexMDBGreenplumClusterCreate,
+		ReadContext:   resourceYandexMDBGreenplumClusterRead,
+		UpdateContext: resourceYandexMDBGreenplumClusterUpdate,
+		DeleteContext: resourceYandexMDBGreenplumClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -327,37 +328,35 @@ func resourceYandexMDBGreenplumCluster() *schema.Resource {
 	}
 }
 
-func resourceYandexMDBGreenplumClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBGreenplumClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	req, err := prepareCreateGreenplumClusterRequest(d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().Greenplum().Cluster().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create Greenplum Cluster: %s", err)
+		return errorDiagnostics("error while requesting API to create Greenplum Cluster", err, nil)
 	}
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("error while get Greenplum create operation metadata: %s", err)
+		return diag.Errorf("error while get Greenplum create operation metadata: %s", err)
 	}
 	md, ok := protoMetadata.(*greenplum.CreateClusterMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Greenplum Cluster ID from create operation metadata")
+		return diag.Errorf("could not get Greenplum Cluster ID from create operation metadata")
 	}
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("error while waiting for operation to create Greenplum Cluster: %s", err)
+		return errorDiagnostics("error while waiting for operation to create Greenplum Cluster", err, mdbGreenplumUpdateFieldsMap)
 	}
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("failed to create Greenplum Cluster: %s", err)
+		return errorDiagnostics("failed to create Greenplum Cluster", err, mdbGreenplumUpdateFieldsMap)
 	}
-	return resourceYandexMDBGreenplumClusterRead(d, meta)
+	return resourceYandexMDBGreenplumClusterRead(ctx, d, meta)
 }
 
 func prepareCreateGreenplumClusterRequest(d *schema.ResourceData, meta *Config) (*greenplum.CreateClusterRequest, error) {
@@ -437,17 +436,14 @@ func prepareCreateGreenplumClusterRequest(d *schema.ResourceData, meta *Config)
 	}, nil
 }
 
-func resourceYandexMDBGreenplumClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBGreenplumClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	cluster, err := config.sdk.MDB().Greenplum().Cluster().Get(ctx, &greenplum.GetClusterRequest{
 		ClusterId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Id())))
 	}
 
 	d.Set("folder_id", cluster.GetFolderId())
@@ -476,63 +472,63 @@ func resourceYandexMDBGreenplumClusterRead(d *schema.ResourceData, meta interfac
 
 	poolConfig, err := flattenGreenplumPoolerConfig(cluster.GetClusterConfig().GetPool())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("pooler_config", poolConfig); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gpConfig, err := flattenGreenplumClusterConfig(cluster.ClusterConfig)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("greenplum_config", gpConfig); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("labels", cluster.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	masterHosts, err := listGreenplumMasterHosts(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	segmentHosts, err := listGreenplumSegmentHosts(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	mHost, sHost := flattenGreenplumHosts(masterHosts, segmentHosts)
 	if err := d.Set("master_hosts", mHost); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("segment_hosts", sHost); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("access", flattenGreenplumAccess(cluster.Config)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	maintenanceWindow, err := flattenGreenplumMaintenanceWindow(cluster.MaintenanceWindow)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("maintenance_window", maintenanceWindow); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("backup_window_start", flattenMDBBackupWindowStart(cluster.Config.BackupWindowStart)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("created_at", getTimestamp(cluster.CreatedAt)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -588,34 +584,31 @@ func listGreenplumSegmentHosts(ctx context.Context, config *Config, id string) (
 	return hosts, nil
 }
 
-func resourceYandexMDBGreenplumClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBGreenplumClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.Partial(true)
 
 	config := meta.(*Config)
 	req, err := prepareUpdateGreenplumClusterRequest(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(req.UpdateMask.Paths) == 0 {
 		return nil
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().Greenplum().Cluster().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update Greenplum Cluster %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to update Greenplum Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while updating Greenplum Cluster %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("error while updating Greenplum Cluster %q", d.Id()), err, nil)
 	}
 
 	d.Partial(false)
-	return resourceYandexMDBGreenplumClusterRead(d, meta)
+	return resourceYandexMDBGreenplumClusterRead(ctx, d, meta)
 }
 
 func prepareUpdateGreenplumClusterRequest(d *schema.ResourceData) (*greenplum.UpdateClusterRequest, error) {
@@ -672,7 +665,7 @@ func prepareUpdateGreenplumClusterRequest(d *schema.ResourceData) (*greenplum.Up
 	}, nil
 }
 
-func resourceYandexMDBGreenplumClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBGreenplumClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Greenplum Cluster %q", d.Id())
@@ -681,22 +674,19 @@ func resourceYandexMDBGreenplumClusterDelete(d *schema.ResourceData, meta interf
 		ClusterId: d.Id(),
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().Greenplum().Cluster().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Greenplum Cluster %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Greenplum Cluster %q", d.Id())))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Greenplum Cluster %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Greenplum Cluster %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Greenplum Cluster %q", d.Id())
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -26,12 +27,12 @@ const (
 
 func resourceYandexMDBKafkaCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBKafkaClusterCreate,
-		Read:   resourceYandexMDBKafkaClusterRead,
-		Update: resourceYandexMDBKafkaClusterUpdate,
-		Delete: resourceYandexMDBKafkaClusterDelete,
+		CreateContext: resourceYandexMDBKafkaClusterCreate,
+		ReadContext:   resourceYandexMDBKafkaClusterRead,
+		UpdateContext: resourceYandexMDBKafkaClusterUpdate,
+		DeleteContext: resourceYandexMDBKafkaClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -550,48 +551,45 @@ func resourceYandexMDBKafkaHost() *schema.Resource {
 	}
 }
 
-func resourceYandexMDBKafkaClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBKafkaClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	req, err := prepareKafkaCreateRequest(d, config)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	log.Printf("[DEBUG] Creating Kafka cluster: %+v", req)
 
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().Kafka().Cluster().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create Kafka Cluster: %s", err)
+		return errorDiagnostics("error while requesting API to create Kafka Cluster", err, mdbKafkaUpdateFieldsMap)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("error while getting Kafka create operation metadata: %s", err)
+		return diag.Errorf("error while getting Kafka create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*kafka.CreateClusterMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Cluster ID from create operation metadata")
+		return diag.Errorf("could not get Cluster ID from create operation metadata")
 	}
 
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("error while waiting for operation to create Kafka Cluster: %s", err)
+		return errorDiagnostics("error while waiting for operation to create Kafka Cluster", err, mdbKafkaUpdateFieldsMap)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("kafka cluster creation failed: %s", err)
+		return errorDiagnostics("kafka cluster creation failed", err, mdbKafkaUpdateFieldsMap)
 	}
 	log.Printf("[DEBUG] Finished creating Kafka cluster %q", md.ClusterId)
 
-	return resourceYandexMDBKafkaClusterRead(d, meta)
+	return resourceYandexMDBKafkaClusterRead(ctx, d, meta)
 }
 
 // Returns request for creating the Cluster.
@@ -666,17 +664,14 @@ func prepareKafkaCreateRequest(d *schema.ResourceData, meta *Config) (*kafka.Cre
 	return &req, nil
 }
 
-func resourceYandexMDBKafkaClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBKafkaClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	cluster, err := config.sdk.MDB().Kafka().Cluster().Get(ctx, &kafka.GetClusterRequest{
 		ClusterId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string))))
 	}
 
 	d.Set("created_at", getTimestamp(cluster.CreatedAt))
@@ -690,106 +685,106 @@ func resourceYandexMDBKafkaClusterRead(d *schema.ResourceData, meta interface{})
 
 	cfg, err := flattenKafkaConfig(cluster)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("config", cfg); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	stateTopics := d.Get("topic").([]interface{})
 	if len(stateTopics) == 0 {
 		if err := d.Set("topic", []map[string]interface{}{}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		topics, err := listKafkaTopics(ctx, config, d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		topicSpecs, err := expandKafkaTopics(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		sortKafkaTopics(topics, topicSpecs)
 
 		if err := d.Set("topic", flattenKafkaTopics(topics)); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	dUsers, err := expandKafkaUsers(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	passwords := kafkaUsersPasswords(dUsers)
 
 	users, err := listKafkaUsers(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("user", flattenKafkaUsers(users, passwords)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hosts, err := listKafkaHosts(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("host", flattenKafkaHosts(hosts)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("host_group_ids", cluster.HostGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("deletion_protection", cluster.DeletionProtection)
 
 	maintenanceWindow, err := flattenKafkaMaintenanceWindow(cluster.MaintenanceWindow)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("maintenance_window", maintenanceWindow); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("labels", cluster.Labels)
+	return diag.FromErr(d.Set("labels", cluster.Labels))
 }
 
-func resourceYandexMDBKafkaClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBKafkaClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Updating Kafka Cluster %q", d.Id())
 
 	d.Partial(true)
 
-	if err := updateKafkaClusterParams(d, meta); err != nil {
-		return err
+	if err := updateKafkaClusterParams(ctx, d, meta); err != nil {
+		return diagnosticsFromError(err)
 	}
 
 	if d.HasChange("topic") {
 		topicModifier := NewKafkaTopicManager(meta.(*Config))
-		if err := updateKafkaClusterTopics(d, topicModifier); err != nil {
-			return err
+		if err := updateKafkaClusterTopics(ctx, d, topicModifier); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("user") {
-		if err := updateKafkaClusterUsers(d, meta); err != nil {
-			return err
+		if err := updateKafkaClusterUsers(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	d.Partial(false)
 
 	log.Printf("[DEBUG] Finished updating Kafka Cluster %q", d.Id())
-	return resourceYandexMDBKafkaClusterRead(d, meta)
+	return resourceYandexMDBKafkaClusterRead(ctx, d, meta)
 }
 
-func resourceYandexMDBKafkaClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBKafkaClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Kafka Cluster %q", d.Id())
@@ -798,22 +793,19 @@ func resourceYandexMDBKafkaClusterDelete(d *schema.ResourceData, meta interface{
 		ClusterId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().Kafka().Cluster().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Kafka Cluster %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Kafka Cluster %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Kafka Cluster %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Kafka Cluster %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Kafka Cluster %q", d.Id())
@@ -956,7 +948,7 @@ func kafkaClusterUpdateRequestWithMask(d *schema.ResourceData) (*kafka.UpdateClu
 
 	updatePath := []string{}
 	for field, path := range mdbKafkaUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasChangeWithDefaultLabels(d, field) {
 			updatePath = append(updatePath, strings.Replace(path, "{version}", getSuffixVerion(d), -1))
 		}
 	}
@@ -975,7 +967,7 @@ func getSuffixVerion(d *schema.ResourceData) string {
 	return strings.Replace(d.Get("config.0.version").(string), ".", "_", -1)
 }
 
-func updateKafkaClusterParams(d *schema.ResourceData, meta interface{}) error {
+func updateKafkaClusterParams(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	req, err := kafkaClusterUpdateRequestWithMask(d)
 	if err != nil {
@@ -985,29 +977,23 @@ func updateKafkaClusterParams(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
 		log.Printf("[DEBUG] Sending Kafka cluster update request: %+v", req)
 		return config.sdk.MDB().Kafka().Cluster().Update(ctx, req)
 	})
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update Kafka Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update Kafka Cluster %q", d.Id()), err, mdbKafkaUpdateFieldsMap)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while updating Kafka Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while updating Kafka Cluster %q", d.Id()), err, mdbKafkaUpdateFieldsMap)
 	}
 
 	return nil
 }
 
-func updateKafkaClusterTopics(d *schema.ResourceData, topicModifier KafkaTopicModifier) error {
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
+func updateKafkaClusterTopics(ctx context.Context, d *schema.ResourceData, topicModifier KafkaTopicModifier) error {
 	versionI, ok := d.GetOk("config.0.version")
 	if !ok {
 		return fmt.Errorf("you must specify version of Kafka")
@@ -1059,7 +1045,7 @@ func deleteKafkaTopic(ctx context.Context, config *Config, d *schema.ResourceDat
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete topic from Kafka Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete topic from Kafka Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1077,7 +1063,7 @@ func createKafkaTopic(ctx context.Context, config *Config, d *schema.ResourceDat
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create topic in Kafka Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create topic in Kafka Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1101,7 +1087,7 @@ func updateKafkaTopic(ctx context.Context, config *Config, d *schema.ResourceDat
 		config.sdk.MDB().Kafka().Topic().Update(ctx, request),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update topic in Kafka Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update topic in Kafka Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1112,10 +1098,8 @@ func updateKafkaTopic(ctx context.Context, config *Config, d *schema.ResourceDat
 	return nil
 }
 
-func updateKafkaClusterUsers(d *schema.ResourceData, meta interface{}) error {
+func updateKafkaClusterUsers(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	currUsers, err := listKafkaUsers(ctx, config, d.Id())
 	if err != nil {
@@ -1161,7 +1145,7 @@ func deleteKafkaUser(ctx context.Context, config *Config, d *schema.ResourceData
 		return config.sdk.MDB().Kafka().User().Delete(ctx, req)
 	})
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete user from Kafka Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete user from Kafka Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1183,7 +1167,7 @@ func createKafkaUser(ctx context.Context, config *Config, d *schema.ResourceData
 		return config.sdk.MDB().Kafka().User().Create(ctx, req)
 	})
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create user in Kafka Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create user in Kafka Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1243,7 +1227,7 @@ func updateKafkaUser(ctx context.Context, config *Config, d *schema.ResourceData
 		return config.sdk.MDB().Kafka().User().Update(ctx, req)
 	})
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update user in Kafka Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update user in Kafka Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -825,7 +825,7 @@ func TestUpdateKafkaClusterTopics(t *testing.T) {
 			return nil
 		}).Times(1)
 
-	err := updateKafkaClusterTopics(resourceData, topicModifier)
+	err := updateKafkaClusterTopics(context.Background(), resourceData, topicModifier)
 
 	require.NoError(t, err)
 }
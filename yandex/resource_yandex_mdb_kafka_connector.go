@@ -1,11 +1,14 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
@@ -13,12 +16,24 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 )
 
+const yandexMDBKafkaConnectorDefaultTimeout = 10 * time.Minute
+
 func resourceYandexMDBKafkaConnector() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBKafkaConnectorCreate,
-		Read:   resourceYandexMDBKafkaConnectorRead,
-		Update: resourceYandexMDBKafkaConnectorUpdate,
-		Delete: resourceYandexMDBKafkaConnectorDelete,
+		CreateContext: resourceYandexMDBKafkaConnectorCreate,
+		ReadContext:   resourceYandexMDBKafkaConnectorRead,
+		UpdateContext: resourceYandexMDBKafkaConnectorUpdate,
+		DeleteContext: resourceYandexMDBKafkaConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexMDBKafkaConnectorImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBKafkaConnectorDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBKafkaConnectorDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexMDBKafkaConnectorDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBKafkaConnectorDefaultTimeout),
+		},
 
 		SchemaVersion: 0,
 
@@ -121,15 +136,12 @@ func resourceYandexMDBKafkaClusterConnectionSpec() *schema.Resource {
 	}
 }
 
-func resourceYandexMDBKafkaConnectorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBKafkaConnectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	connectorSpec, err := buildKafkaConnectorSpec(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	req := &kafka.CreateConnectorRequest{
@@ -141,34 +153,31 @@ func resourceYandexMDBKafkaConnectorCreate(d *schema.ResourceData, meta interfac
 		return config.sdk.MDB().Kafka().Connector().Create(ctx, req)
 	})
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create Kafka connector: %s", err)
+		return errorDiagnostics("error while requesting API to create Kafka connector", err, mdbKafkaConnectorUpdateFieldsMap)
 	}
 
 	conectorName := constructResourceId(req.ClusterId, req.ConnectorSpec.Name)
 	d.SetId(conectorName)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("error while waiting for Kafka conector create operation: %s", err)
+		return errorDiagnostics("error while waiting for Kafka conector create operation", err, mdbKafkaConnectorUpdateFieldsMap)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("kafka conector creation failed: %s", err)
+		return errorDiagnostics("kafka conector creation failed", err, mdbKafkaConnectorUpdateFieldsMap)
 	}
 	log.Printf("[DEBUG] Finished creating Kafka conector %q", conectorName)
 
-	return resourceYandexMDBKafkaConnectorRead(d, meta)
+	return resourceYandexMDBKafkaConnectorRead(ctx, d, meta)
 }
 
-func resourceYandexMDBKafkaConnectorRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBKafkaConnectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	parts := strings.SplitN(d.Id(), ":", 2)
 	if len(parts) != 2 {
-		return fmt.Errorf("invalid topic resource id format: %q", d.Id())
+		return diag.Errorf("invalid topic resource id format: %q", d.Id())
 	}
 
 	clusterID := parts[0]
@@ -179,7 +188,7 @@ func resourceYandexMDBKafkaConnectorRead(d *schema.ResourceData, meta interface{
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Connector %q", connectorName))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Connector %q", connectorName)))
 	}
 	d.Set("cluster_id", clusterID)
 	d.Set("name", conn.Name)
@@ -188,11 +197,38 @@ func resourceYandexMDBKafkaConnectorRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceYandexMDBKafkaConnectorUpdate(d *schema.ResourceData, meta interface{}) error {
+// resourceYandexMDBKafkaConnectorImport takes "cluster_id:connector_name" ID and reads connector configuration,
+// which is not refreshed by Read. SASL passwords of external clusters are never returned by API.
+func resourceYandexMDBKafkaConnectorImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid Kafka connector import id %q, expected \"cluster_id:connector_name\"", d.Id())
+	}
+
+	conn, err := config.sdk.MDB().Kafka().Connector().Get(ctx, &kafka.GetConnectorRequest{
+		ClusterId:     parts[0],
+		ConnectorName: parts[1],
+	})
+	if err != nil {
+		return nil, apiError(fmt.Sprintf("error while requesting API to get Kafka connector %q", d.Id()), err, nil)
+	}
+
+	d.Set("cluster_id", parts[0])
+	d.Set("name", conn.Name)
+	if err := d.Set("properties", conn.Properties); err != nil {
+		return nil, err
+	}
+	if err := d.Set("connector_config_mirrormaker", flattenKafkaMirrorMakerConfig(conn.GetConnectorConfigMirrormaker())); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceYandexMDBKafkaConnectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
 
 	var updatePath []string
 	for field, path := range mdbKafkaConnectorUpdateFieldsMap {
@@ -206,7 +242,7 @@ func resourceYandexMDBKafkaConnectorUpdate(d *schema.ResourceData, meta interfac
 
 	connSpec, err := buildKafkaConnectorUpdateSpec(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -223,26 +259,23 @@ func resourceYandexMDBKafkaConnectorUpdate(d *schema.ResourceData, meta interfac
 		return config.sdk.MDB().Kafka().Connector().Update(ctx, request)
 	})
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update connector %q in Kafka Cluster %q: %s",
-			connName, clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to update connector %q in Kafka Cluster %q", connName, clusterID),
+			err, mdbKafkaConnectorUpdateFieldsMap)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while updating connector in Kafka Cluster %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("error while updating connector in Kafka Cluster %q", d.Id()), err, mdbKafkaConnectorUpdateFieldsMap)
 	}
 
 	log.Printf("[DEBUG] Finished updating Kafka connector %q", connName)
 
-	return resourceYandexMDBKafkaConnectorRead(d, meta)
+	return resourceYandexMDBKafkaConnectorRead(ctx, d, meta)
 }
 
-func resourceYandexMDBKafkaConnectorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBKafkaConnectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	connName := d.Get("name").(string)
 	clusterID := d.Get("cluster_id").(string)
 	request := &kafka.DeleteConnectorRequest{
@@ -255,12 +288,12 @@ func resourceYandexMDBKafkaConnectorDelete(d *schema.ResourceData, meta interfac
 		return config.sdk.MDB().Kafka().Connector().Delete(ctx, request)
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Kafka connector %q", connName))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Kafka connector %q", connName)))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while deleting connector %q from Kafka Cluster %q: %s", connName, clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while deleting connector %q from Kafka Cluster %q", connName, clusterID), err, mdbKafkaConnectorUpdateFieldsMap)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Kafka connector %q", connName)
@@ -344,6 +377,43 @@ func buildKafkaClusterConnectionSpec(d *schema.ResourceData, prefixKey string) *
 	return spec
 }
 
+func flattenKafkaMirrorMakerConfig(c *kafka.ConnectorConfigMirrorMaker) []interface{} {
+	if c == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"topics":             c.Topics,
+			"replication_factor": int(c.ReplicationFactor.GetValue()),
+			"source_cluster":     flattenKafkaClusterConnection(c.SourceCluster),
+			"target_cluster":     flattenKafkaClusterConnection(c.TargetCluster),
+		},
+	}
+}
+
+func flattenKafkaClusterConnection(c *kafka.ClusterConnection) []interface{} {
+	if c == nil {
+		return nil
+	}
+	res := map[string]interface{}{
+		"alias": c.Alias,
+	}
+	if c.GetThisCluster() != nil {
+		res["this_cluster"] = []interface{}{map[string]interface{}{}}
+	}
+	if ext := c.GetExternalCluster(); ext != nil {
+		res["external_cluster"] = []interface{}{
+			map[string]interface{}{
+				"bootstrap_servers": ext.BootstrapServers,
+				"sasl_username":     ext.SaslUsername,
+				"sasl_mechanism":    ext.SaslMechanism,
+				"security_protocol": ext.SecurityProtocol,
+			},
+		}
+	}
+	return []interface{}{res}
+}
+
 var mdbKafkaConnectorUpdateFieldsMap = map[string]string{}
 
 func init() {
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -82,3 +84,111 @@ func TestBuildKafkaConnectorSpec(t *testing.T) {
 	assert.Equal(t, expected, connSpec)
 
 }
+
+func TestFlattenKafkaMirrorMakerConfig(t *testing.T) {
+	config := &kafka.ConnectorConfigMirrorMaker{
+		Topics:            "data.*",
+		ReplicationFactor: &wrappers.Int64Value{Value: 1},
+		SourceCluster: &kafka.ClusterConnection{
+			Alias: "source",
+			ClusterConnection: &kafka.ClusterConnection_ExternalCluster{
+				ExternalCluster: &kafka.ExternalClusterConnection{
+					BootstrapServers: "broker:9091",
+					SaslUsername:     "user",
+					SaslMechanism:    "SCRAM-SHA-512",
+					SecurityProtocol: "SASL_SSL",
+				},
+			},
+		},
+		TargetCluster: &kafka.ClusterConnection{
+			Alias:             "target",
+			ClusterConnection: &kafka.ClusterConnection_ThisCluster{ThisCluster: &kafka.ThisCluster{}},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"topics":             "data.*",
+			"replication_factor": 1,
+			"source_cluster": []interface{}{
+				map[string]interface{}{
+					"alias": "source",
+					"external_cluster": []interface{}{
+						map[string]interface{}{
+							"bootstrap_servers": "broker:9091",
+							"sasl_username":     "user",
+							"sasl_mechanism":    "SCRAM-SHA-512",
+							"security_protocol": "SASL_SSL",
+						},
+					},
+				},
+			},
+			"target_cluster": []interface{}{
+				map[string]interface{}{
+					"alias":        "target",
+					"this_cluster": []interface{}{map[string]interface{}{}},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, flattenKafkaMirrorMakerConfig(config))
+	assert.Nil(t, flattenKafkaMirrorMakerConfig(nil))
+}
+
+func TestAccMDBKafkaConnector(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-kafka")
+	connectorResource := "yandex_mdb_kafka_connector.mirror"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBKafkaConnectorConfig(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(connectorResource, "name", "replication"),
+					resource.TestCheckResourceAttr(connectorResource, "tasks_max", "3"),
+				),
+			},
+			{
+				ResourceName:      connectorResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"connector_config_mirrormaker.0.source_cluster.0.external_cluster.0.sasl_password",
+				},
+			},
+		},
+	})
+}
+
+func testAccMDBKafkaConnectorConfig(name string) string {
+	return testAccMDBKafkaTopicConfigStep0(name) + `
+resource "yandex_mdb_kafka_connector" "mirror" {
+  cluster_id = yandex_mdb_kafka_cluster.foo.id
+  name       = "replication"
+  tasks_max  = 3
+  properties = {
+    "refresh.topics.enabled" = "true"
+  }
+  connector_config_mirrormaker {
+    topics             = "data.*"
+    replication_factor = 1
+    source_cluster {
+      alias = "source"
+      external_cluster {
+        bootstrap_servers = "somebroker1:9091,somebroker2:9091"
+        sasl_username     = "someuser"
+        sasl_password     = "somepassword"
+        sasl_mechanism    = "SCRAM-SHA-512"
+        security_protocol = "SASL_SSL"
+      }
+    }
+    target_cluster {
+      alias = "target"
+      this_cluster {}
+    }
+  }
+}
+`
+}
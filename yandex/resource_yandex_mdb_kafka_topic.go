@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
@@ -25,12 +26,12 @@ const (
 
 func resourceYandexMDBKafkaTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBKafkaTopicCreate,
-		Read:   resourceYandexMDBKafkaTopicRead,
-		Update: resourceYandexMDBKafkaTopicUpdate,
-		Delete: resourceYandexMDBKafkaTopicDelete,
+		CreateContext: resourceYandexMDBKafkaTopicCreate,
+		ReadContext:   resourceYandexMDBKafkaTopicRead,
+		UpdateContext: resourceYandexMDBKafkaTopicUpdate,
+		DeleteContext: resourceYandexMDBKafkaTopicDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -71,20 +72,17 @@ func resourceYandexMDBKafkaTopic() *schema.Resource {
 	}
 }
 
-func resourceYandexMDBKafkaTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBKafkaTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	version, err := getKafkaVersion(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	topicSpec, err := buildKafkaTopicSpec(d, "", version)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	req := &kafka.CreateTopicRequest{
@@ -97,23 +95,23 @@ func resourceYandexMDBKafkaTopicCreate(d *schema.ResourceData, meta interface{})
 		return config.sdk.MDB().Kafka().Topic().Create(ctx, req)
 	})
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create Kafka topic: %s", err)
+		return errorDiagnostics("error while requesting API to create Kafka topic", err, mdbKafkaTopicUpdateFieldsMap)
 	}
 
 	topicID := constructResourceId(req.ClusterId, req.TopicSpec.Name)
 	d.SetId(topicID)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("error while waiting for Kafka topic create operation: %s", err)
+		return errorDiagnostics("error while waiting for Kafka topic create operation", err, mdbKafkaTopicUpdateFieldsMap)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("kafka topic creation failed: %s", err)
+		return errorDiagnostics("kafka topic creation failed", err, mdbKafkaTopicUpdateFieldsMap)
 	}
 	log.Printf("[DEBUG] Finished creating Kafka topic %q", req.TopicSpec.Name)
 
-	return resourceYandexMDBKafkaTopicRead(d, meta)
+	return resourceYandexMDBKafkaTopicRead(ctx, d, meta)
 }
 
 func getKafkaVersion(ctx context.Context, d *schema.ResourceData, config *Config) (string, error) {
@@ -167,15 +165,12 @@ func buildKafkaTopicSpec(d *schema.ResourceData, prefixKey string, version strin
 	return topicSpec, nil
 }
 
-func resourceYandexMDBKafkaTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBKafkaTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	parts := strings.SplitN(d.Id(), ":", 2)
 	if len(parts) != 2 {
-		return fmt.Errorf("invalid topic resource id format: %q", d.Id())
+		return diag.Errorf("invalid topic resource id format: %q", d.Id())
 	}
 
 	clusterID := parts[0]
@@ -185,7 +180,7 @@ func resourceYandexMDBKafkaTopicRead(d *schema.ResourceData, meta interface{}) e
 		TopicName: topicName,
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Topic %q", topicName))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Topic %q", topicName)))
 	}
 	d.Set("cluster_id", clusterID)
 	d.Set("name", topic.Name)
@@ -204,27 +199,24 @@ func resourceYandexMDBKafkaTopicRead(d *schema.ResourceData, meta interface{}) e
 	}
 	if len(cfg) != 0 {
 		if err := d.Set("topic_config", []map[string]interface{}{cfg}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceYandexMDBKafkaTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBKafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	version, err := getKafkaVersion(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	topicSpec, err := buildKafkaTopicSpec(d, "", version)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -252,17 +244,17 @@ func resourceYandexMDBKafkaTopicUpdate(d *schema.ResourceData, meta interface{})
 		return config.sdk.MDB().Kafka().Topic().Update(ctx, request)
 	})
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update topic %q in Kafka Cluster %q: %s",
-			topicName, clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to update topic %q in Kafka Cluster %q", topicName, clusterID),
+			err, mdbKafkaTopicUpdateFieldsMap)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while updating topic in Kafka Cluster %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("error while updating topic in Kafka Cluster %q", d.Id()), err, mdbKafkaTopicUpdateFieldsMap)
 	}
 
 	log.Printf("[DEBUG] Finished updating Kafka topic %q", topicName)
-	return resourceYandexMDBKafkaTopicRead(d, meta)
+	return resourceYandexMDBKafkaTopicRead(ctx, d, meta)
 }
 
 var mdbKafkaTopicUpdateFieldsMap = map[string]string{
@@ -279,12 +271,9 @@ func init() {
 	}
 }
 
-func resourceYandexMDBKafkaTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBKafkaTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	topicName := d.Get("name").(string)
 	clusterID := d.Get("cluster_id").(string)
 	request := &kafka.DeleteTopicRequest{
@@ -297,12 +286,12 @@ func resourceYandexMDBKafkaTopicDelete(d *schema.ResourceData, meta interface{})
 		return config.sdk.MDB().Kafka().Topic().Delete(ctx, request)
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Kafka topic %q", topicName))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Kafka topic %q", topicName)))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while deleting topic %q from Kafka Cluster %q: %s", topicName, clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while deleting topic %q from Kafka Cluster %q", topicName, clusterID), err, mdbKafkaTopicUpdateFieldsMap)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Kafka topic %q", topicName)
@@ -477,7 +477,7 @@ func resourceYandexMDBMongodbClusterCreate(ctx context.Context, d *schema.Resour
 
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().MongoDB().Cluster().Create(ctx, req))
 	if err != nil {
-		return diag.Errorf("error while requesting API to create Mongodb Cluster: %s", err)
+		return errorDiagnostics("error while requesting API to create Mongodb Cluster", err, mdbMongodbUpdateFieldsMap)
 	}
 
 	protoMetadata, err := op.Metadata()
@@ -494,11 +494,11 @@ func resourceYandexMDBMongodbClusterCreate(ctx context.Context, d *schema.Resour
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return diag.Errorf("error while waiting for operation to create Mongodb Cluster: %s", err)
+		return errorDiagnostics("error while waiting for operation to create Mongodb Cluster", err, mdbMongodbUpdateFieldsMap)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return diag.Errorf("Mongodb Cluster creation failed: %s", err)
+		return errorDiagnostics("Mongodb Cluster creation failed", err, mdbMongodbUpdateFieldsMap)
 	}
 
 	mw, err := expandMongoDBMaintenanceWindow(d)
@@ -508,7 +508,7 @@ func resourceYandexMDBMongodbClusterCreate(ctx context.Context, d *schema.Resour
 	if mw != nil {
 		err = updateMongoDBMaintenanceWindow(ctx, config, d, mw)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagnosticsFromError(err)
 		}
 	}
 
@@ -524,7 +524,7 @@ func updateMongoDBMaintenanceWindow(ctx context.Context, config *Config, d *sche
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update maintenance window in MongoDB Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update maintenance window in MongoDB Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -560,7 +560,7 @@ func resourceYandexMDBMongodbClusterRead(ctx context.Context, d *schema.Resource
 		ClusterId: d.Id(),
 	})
 	if err != nil {
-		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string))))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string))))
 	}
 
 	if err := d.Set("created_at", getTimestamp(cluster.CreatedAt)); err != nil {
@@ -695,24 +695,24 @@ func resourceYandexMDBMongodbClusterUpdate(ctx context.Context, d *schema.Resour
 	d.Partial(true)
 
 	if err := updateMongodbClusterParams(ctx, d, meta); err != nil {
-		return diag.FromErr(err)
+		return diagnosticsFromError(err)
 	}
 
 	if d.HasChange("database") {
 		if err := updateMongodbClusterDatabases(ctx, d, meta); err != nil {
-			return diag.FromErr(err)
+			return diagnosticsFromError(err)
 		}
 	}
 
 	if d.HasChange("user") {
 		if err := updateMongodbClusterUsers(ctx, d, meta); err != nil {
-			return diag.FromErr(err)
+			return diagnosticsFromError(err)
 		}
 	}
 
 	if d.HasChange("host") {
 		if err := updateMongoDBClusterHosts(ctx, d, meta); err != nil {
-			return diag.FromErr(err)
+			return diagnosticsFromError(err)
 		}
 	}
 
@@ -731,17 +731,17 @@ func resourceYandexMDBMongodbClusterDelete(ctx context.Context, d *schema.Resour
 
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().MongoDB().Cluster().Delete(ctx, req))
 	if err != nil {
-		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Mongodb Cluster %q", d.Get("name").(string))))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Mongodb Cluster %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return diag.FromErr(err)
+		return errorDiagnostics(fmt.Sprintf("error deleting Mongodb Cluster %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return diag.FromErr(err)
+		return errorDiagnostics(fmt.Sprintf("error deleting Mongodb Cluster %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Mongodb Cluster %q", d.Id())
@@ -840,7 +840,7 @@ func updateMongodbClusterParams(ctx context.Context, d *schema.ResourceData, met
 
 	var updatePath []string
 	for field, path := range mdbMongodbUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasChangeWithDefaultLabels(d, field) {
 			updatePath = append(updatePath, path)
 		}
 	}
@@ -882,12 +882,12 @@ func updateMongodbClusterParams(ctx context.Context, d *schema.ResourceData, met
 
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().MongoDB().Cluster().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update MongoDB Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update MongoDB Cluster %q", d.Id()), err, mdbMongodbUpdateFieldsMap)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while updating MongoDB Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while updating MongoDB Cluster %q", d.Id()), err, mdbMongodbUpdateFieldsMap)
 	}
 
 	return nil
@@ -1008,7 +1008,7 @@ func createMongoDBDatabase(ctx context.Context, config *Config, d *schema.Resour
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create database in MongoDB Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create database in MongoDB Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1026,7 +1026,7 @@ func deleteMongoDBDatabase(ctx context.Context, config *Config, d *schema.Resour
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete database from MongoDB Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete database from MongoDB Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1044,7 +1044,7 @@ func createMongoDBUser(ctx context.Context, config *Config, d *schema.ResourceDa
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create user for MongoDB Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create user for MongoDB Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1062,7 +1062,7 @@ func deleteMongoDBUser(ctx context.Context, config *Config, d *schema.ResourceDa
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete user from MongoDB Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete user from MongoDB Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1082,7 +1082,7 @@ func updateMongoDBUser(ctx context.Context, config *Config, d *schema.ResourceDa
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update user in MongoDB Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update user in MongoDB Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1100,7 +1100,7 @@ func createMongoDBHost(ctx context.Context, config *Config, d *schema.ResourceDa
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to add host to MongoDB Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to add host to MongoDB Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -1117,7 +1117,7 @@ func deleteMongoDBHost(ctx context.Context, config *Config, d *schema.ResourceDa
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete host from MongoDB Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete host from MongoDB Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -21,12 +22,12 @@ const (
 
 func resourceYandexMDBMySQLCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBMySQLClusterCreate,
-		Read:   resourceYandexMDBMySQLClusterRead,
-		Update: resourceYandexMDBMySQLClusterUpdate,
-		Delete: resourceYandexMDBMySQLClusterDelete,
+		CreateContext: resourceYandexMDBMySQLClusterCreate,
+		ReadContext:   resourceYandexMDBMySQLClusterRead,
+		UpdateContext: resourceYandexMDBMySQLClusterUpdate,
+		DeleteContext: resourceYandexMDBMySQLClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -403,62 +404,72 @@ func resourceYandexMDBMySQLCluster() *schema.Resource {
 	}
 }
 
-func resourceYandexMDBMySQLClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBMySQLClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	err := validateClusterConfig(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	req, err := prepareCreateMySQLRequest(d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if backupID, ok := d.GetOk("restore.0.backup_id"); ok && backupID != "" {
-		return resourceYandexMDBMySQLClusterRestore(d, meta, req, backupID.(string))
+		if err := resourceYandexMDBMySQLClusterRestore(ctx, d, meta, req, backupID.(string)); err != nil {
+			return diagnosticsFromError(err)
+		}
+		return resourceYandexMDBMySQLClusterRead(ctx, d, meta)
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().MySQL().Cluster().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create MySQL Cluster: %s", err)
+		return errorDiagnostics("Error while requesting API to create MySQL Cluster", err, mdbMysqlUpdateFieldsMap)
 	}
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get MySQL create operation metadata: %s", err)
+		return diag.Errorf("Error while get MySQL create operation metadata: %s", err)
 	}
 	md, ok := protoMetadata.(*mysql.CreateClusterMetadata)
 	if !ok {
-		return fmt.Errorf("Could not get MySQL Cluster ID from create operation metadata")
+		return diag.Errorf("Could not get MySQL Cluster ID from create operation metadata")
 	}
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting for operation to create MySQL Cluster: %s", err)
+		return errorDiagnostics("Error while waiting for operation to create MySQL Cluster", err, mdbMysqlUpdateFieldsMap)
 	}
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("MySQL Cluster creation failed: %s", err)
+		return errorDiagnostics("MySQL Cluster creation failed", err, mdbMysqlUpdateFieldsMap)
+	}
+
+	if err := finishResumableCreate(ctx, d, meta, op.Id(), finishMySQLClusterCreate); err != nil {
+		return diag.FromErr(err)
 	}
 
+	return resourceYandexMDBMySQLClusterRead(ctx, d, meta)
+}
+
+// finishMySQLClusterCreate updates hosts and settings which can't be set on creation,
+// both steps compare the cluster with the configuration, so they can be repeated.
+func finishMySQLClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// Update hosts after creation (e.g. configure cascade replicas)
 	log.Printf("[INFO] Updating cluster hosts after creation (if needed)...")
-	if err := updateMysqlClusterHosts(d, config); err != nil {
+	if err := updateMysqlClusterHosts(ctx, d, meta); err != nil {
 		return fmt.Errorf("MySQL Cluster %v update params failed: %s", d.Id(), err)
 	}
 
 	log.Printf("[INFO] Updating cluster after creation (if needed)...")
-	if err := updateMySQLClusterAfterCreate(d, meta); err != nil {
+	if err := updateMySQLClusterAfterCreate(ctx, d, meta); err != nil {
 		return fmt.Errorf("MySQL Cluster %v update params failed: %s", d.Id(), err)
 	}
-
-	return resourceYandexMDBMySQLClusterRead(d, meta)
+	return nil
 }
 
-func resourceYandexMDBMySQLClusterRestore(d *schema.ResourceData, meta interface{}, createClusterRequest *mysql.CreateClusterRequest, backupID string) error {
+func resourceYandexMDBMySQLClusterRestore(ctx context.Context, d *schema.ResourceData, meta interface{}, createClusterRequest *mysql.CreateClusterRequest, backupID string) error {
 	config := meta.(*Config)
 	req, err := prepareCreateMySQLRequest(d, config)
 	if err != nil {
@@ -476,8 +487,6 @@ func resourceYandexMDBMySQLClusterRestore(d *schema.ResourceData, meta interface
 
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().MySQL().Cluster().Restore(ctx, &mysql.RestoreClusterRequest{
 		BackupId: backupID,
 		Time: &timestamp.Timestamp{
@@ -495,7 +504,7 @@ func resourceYandexMDBMySQLClusterRestore(d *schema.ResourceData, meta interface
 		HostGroupIds:     req.HostGroupIds,
 	}))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create MySQL Cluster from backup %v: %s", backupID, err)
+		return apiError(fmt.Sprintf("Error while requesting API to create MySQL Cluster from backup %v", backupID), err, nil)
 	}
 	protoMetadata, err := op.Metadata()
 	if err != nil {
@@ -509,17 +518,17 @@ func resourceYandexMDBMySQLClusterRestore(d *schema.ResourceData, meta interface
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error while waiting for operation to create MySQL Cluster from backup %v: %s", backupID, err)
+		return apiError(fmt.Sprintf("Error while waiting for operation to create MySQL Cluster from backup %v", backupID), err, nil)
 	}
 	if _, err := op.Response(); err != nil {
 		return fmt.Errorf("MySQL Cluster creation from backup %v failed: %s", backupID, err)
 	}
 
-	if err := updateMysqlClusterHosts(d, config); err != nil {
+	if err := updateMysqlClusterHosts(ctx, d, config); err != nil {
 		return fmt.Errorf("MySQL Cluster %v hosts creation from backup %v failed: %s", d.Id(), backupID, err)
 	}
 
-	return resourceYandexMDBMySQLClusterRead(d, meta)
+	return nil
 }
 
 func prepareCreateMySQLRequest(d *schema.ResourceData, meta *Config) (*mysql.CreateClusterRequest, error) {
@@ -587,17 +596,14 @@ func prepareCreateMySQLRequest(d *schema.ResourceData, meta *Config) (*mysql.Cre
 	}, nil
 }
 
-func resourceYandexMDBMySQLClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBMySQLClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	cluster, err := config.sdk.MDB().MySQL().Cluster().Get(ctx, &mysql.GetClusterRequest{
 		ClusterId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Id())))
 	}
 
 	d.Set("folder_id", cluster.GetFolderId())
@@ -610,17 +616,17 @@ func resourceYandexMDBMySQLClusterRead(d *schema.ResourceData, meta interface{})
 	d.Set("version", cluster.GetConfig().GetVersion())
 
 	if err := d.Set("labels", cluster.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hosts, err := listMysqlHosts(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	fHosts, err := flattenMysqlHosts(d, hosts, false)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] reading cluster:")
 	for i, h := range fHosts {
@@ -628,154 +634,154 @@ func resourceYandexMDBMySQLClusterRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if err := d.Set("host", fHosts); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	stateUser := d.Get("user").([]interface{})
 	if len(stateUser) == 0 {
 		if err := d.Set("user", []map[string]interface{}{}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		users, err := listMysqlUsers(ctx, config, d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		userSpecs, err := expandMySQLUsers(nil, d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		passwords := mysqlUsersPasswords(userSpecs)
 
 		fUsers, err := flattenMysqlUsers(users, passwords)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		sortInterfaceListByResourceData(fUsers, d, "user", "name")
 
 		if err := d.Set("user", fUsers); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	stateDatabases := d.Get("database").(*schema.Set).List()
 	if len(stateDatabases) == 0 {
 		if err := d.Set("database", []map[string]interface{}{}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		databases, err := listMysqlDatabases(ctx, config, d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		fDatabases := flattenMysqlDatabases(databases)
 		if err := d.Set("database", fDatabases); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	mysqlResources, err := flattenMysqlResources(cluster.GetConfig().GetResources())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("resources", mysqlResources)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	backupWindowStart := flattenMDBBackupWindowStart(cluster.GetConfig().GetBackupWindowStart())
 	if err := d.Set("backup_window_start", backupWindowStart); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterConfig, err := flattenMySQLConfig(cluster.Config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("mysql_config", clusterConfig); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	access, err := flattenMySQLAccess(cluster.Config.Access)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("access", access); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	maintenanceWindow, err := flattenMysqlMaintenanceWindow(cluster.MaintenanceWindow)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("maintenance_window", maintenanceWindow); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("deletion_protection", cluster.DeletionProtection); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	perfDiag, err := flattenMyPerformanceDiagnostics(cluster.Config.PerformanceDiagnostics)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("performance_diagnostics", perfDiag); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("host_group_ids", cluster.HostGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("created_at", getTimestamp(cluster.CreatedAt))
+	return diag.FromErr(d.Set("created_at", getTimestamp(cluster.CreatedAt)))
 }
 
-func resourceYandexMDBMySQLClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBMySQLClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	d.Partial(true)
 
 	err := validateClusterConfig(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if err := updateMysqlClusterParams(d, meta); err != nil {
-		return err
+	if err := updateMysqlClusterParams(ctx, d, meta); err != nil {
+		return diagnosticsFromError(err)
 	}
 
 	stateDatabase := d.Get("database").(*schema.Set).List()
 	if d.HasChange("database") && len(stateDatabase) > 0 {
-		if err := updateMysqlClusterDatabases(d, meta); err != nil {
-			return err
+		if err := updateMysqlClusterDatabases(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	stateUser := d.Get("user").([]interface{})
 	if d.HasChange("user") && len(stateUser) > 0 {
-		if err := updateMysqlClusterUsers(d, meta); err != nil {
-			return err
+		if err := updateMysqlClusterUsers(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	if d.HasChange("host") {
-		if err := updateMysqlClusterHosts(d, config); err != nil {
-			return err
+		if err := updateMysqlClusterHosts(ctx, d, config); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	d.Partial(false)
-	return resourceYandexMDBMySQLClusterRead(d, meta)
+	return resourceYandexMDBMySQLClusterRead(ctx, d, meta)
 }
 
 var mdbMysqlUpdateFieldsMap = map[string]string{
@@ -792,7 +798,7 @@ var mdbMysqlUpdateFieldsMap = map[string]string{
 	"deletion_protection":     "deletion_protection",
 }
 
-func updateMysqlClusterParams(d *schema.ResourceData, meta interface{}) error {
+func updateMysqlClusterParams(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	request, err := prepareMySQLClusterUpdateRequest(d)
 	if err != nil {
 		return err
@@ -803,23 +809,21 @@ func updateMysqlClusterParams(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().MySQL().Cluster().Update(ctx, request))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update MySQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update MySQL Cluster %q", d.Id()), err, mdbMysqlUpdateFieldsMap)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while updating MySQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while updating MySQL Cluster %q", d.Id()), err, mdbMysqlUpdateFieldsMap)
 	}
 
 	return nil
 }
 
-func updateMySQLClusterAfterCreate(d *schema.ResourceData, meta interface{}) error {
+func updateMySQLClusterAfterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	maintenanceWindow, err := expandMySQLMaintenanceWindow(d)
 	if err != nil {
@@ -837,17 +841,15 @@ func updateMySQLClusterAfterCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().MySQL().Cluster().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update MySQL Cluster after creation %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update MySQL Cluster after creation %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while waiting for operation to update MySQL Cluster after creation %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while waiting for operation to update MySQL Cluster after creation %q", d.Id()), err, nil)
 	}
 
 	return nil
@@ -875,7 +877,7 @@ func prepareMySQLClusterUpdateRequest(d *schema.ResourceData) (*mysql.UpdateClus
 
 	updatePaths := []string{}
 	for field, path := range mdbMysqlUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasChangeWithDefaultLabels(d, field) {
 			updatePaths = append(updatePaths, path)
 		}
 	}
@@ -898,10 +900,8 @@ func prepareMySQLClusterUpdateRequest(d *schema.ResourceData) (*mysql.UpdateClus
 	}, nil
 }
 
-func updateMysqlClusterDatabases(d *schema.ResourceData, meta interface{}) error {
+func updateMysqlClusterDatabases(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	currDBs, err := listMysqlDatabases(ctx, config, d.Id())
 	if err != nil {
@@ -965,7 +965,7 @@ func deleteMysqlDatabase(ctx context.Context, config *Config, d *schema.Resource
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete database from MySQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete database from MySQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -985,7 +985,7 @@ func createMysqlDatabase(ctx context.Context, config *Config, d *schema.Resource
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create database in MySQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create database in MySQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -995,10 +995,8 @@ func createMysqlDatabase(ctx context.Context, config *Config, d *schema.Resource
 	return nil
 }
 
-func updateMysqlClusterUsers(d *schema.ResourceData, meta interface{}) error {
+func updateMysqlClusterUsers(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 	currUsers, err := listMysqlUsers(ctx, config, d.Id())
 	if err != nil {
 		return err
@@ -1078,7 +1076,7 @@ func deleteMysqlUser(ctx context.Context, config *Config, d *schema.ResourceData
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete user from MySQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete user from MySQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1096,7 +1094,7 @@ func createMysqlUser(ctx context.Context, config *Config, d *schema.ResourceData
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create user for MySQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create user for MySQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1153,7 +1151,7 @@ func updateMysqlUser(ctx context.Context, config *Config, d *schema.ResourceData
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update user in MySQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update user in MySQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1163,7 +1161,7 @@ func updateMysqlUser(ctx context.Context, config *Config, d *schema.ResourceData
 	return nil
 }
 
-func updateMysqlClusterHosts(d *schema.ResourceData, meta interface{}) error {
+func updateMysqlClusterHosts(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// Ideas:
 	// 1. In order to do it safely for clients: firstly add new hosts and only then delete unneeded hosts
 	// 2. Batch Add/Update operations are not supported, so we should update hosts one by one
@@ -1172,8 +1170,6 @@ func updateMysqlClusterHosts(d *schema.ResourceData, meta interface{}) error {
 	//    Solution: update HA-replicas first, then use BFS (using `compareMySQLHostsInfoResult.hierarchyExists`)
 
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	// Step 1: Add new hosts (as HA-hosts):
 	err := createMysqlClusterHosts(ctx, config, d)
@@ -1299,7 +1295,7 @@ func deleteMysqlHost(ctx context.Context, config *Config, d *schema.ResourceData
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete host from MySQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete host from MySQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1314,7 +1310,7 @@ func deleteMysqlHost(ctx context.Context, config *Config, d *schema.ResourceData
 	return nil
 }
 
-func resourceYandexMDBMySQLClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBMySQLClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting MySQL Cluster %q", d.Id())
@@ -1323,22 +1319,19 @@ func resourceYandexMDBMySQLClusterDelete(d *schema.ResourceData, meta interface{
 		ClusterId: d.Id(),
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().MySQL().Cluster().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("MySQL Cluster %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("MySQL Cluster %q", d.Id())))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting MySQL Cluster %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting MySQL Cluster %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting MySQL Cluster %q", d.Id())
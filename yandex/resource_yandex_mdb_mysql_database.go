@@ -1,10 +1,12 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
@@ -19,12 +21,12 @@ const (
 
 func resourceYandexMDBMySQLDatabase() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBMySQLDatabaseCreate,
-		Read:   resourceYandexMDBMySQLDatabaseRead,
-		Update: resourceYandexMDBMySQLDatabaseUpdate,
-		Delete: resourceYandexMDBMySQLDatabaseDelete,
+		CreateContext: resourceYandexMDBMySQLDatabaseCreate,
+		ReadContext:   resourceYandexMDBMySQLDatabaseRead,
+		UpdateContext: resourceYandexMDBMySQLDatabaseUpdate,
+		DeleteContext: resourceYandexMDBMySQLDatabaseDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -49,10 +51,10 @@ func resourceYandexMDBMySQLDatabase() *schema.Resource {
 	}
 }
 
-func resourceYandexMDBMySQLDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBMySQLDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
 	clusterID := d.Get("cluster_id").(string)
@@ -72,29 +74,26 @@ func resourceYandexMDBMySQLDatabaseCreate(d *schema.ResourceData, meta interface
 	d.SetId(databaseID)
 
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create database in MySQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to create database in MySQL Cluster %q", clusterID), err, nil)
 	}
 
-	if err := op.Wait(ctx); err != nil {
-		return fmt.Errorf("error while adding database to MySQL Cluster %q: %s", clusterID, err)
+	if err := waitResumableOperation(ctx, d, op); err != nil {
+		return diag.Errorf("error while adding database to MySQL Cluster %q: %s", clusterID, err)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("creating database for MySQL Cluster %q failed: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("creating database for MySQL Cluster %q failed", clusterID), err, nil)
 	}
 
-	return resourceYandexMDBMySQLDatabaseRead(d, meta)
+	return resourceYandexMDBMySQLDatabaseRead(ctx, d, meta)
 }
 
-func resourceYandexMDBMySQLDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBMySQLDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	clusterID, dbname, err := deconstructResourceId(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	db, err := config.sdk.MDB().MySQL().Database().Get(ctx, &mysql.GetDatabaseRequest{
@@ -102,7 +101,7 @@ func resourceYandexMDBMySQLDatabaseRead(d *schema.ResourceData, meta interface{}
 		DatabaseName: dbname,
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Database %q", dbname))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Database %q", dbname)))
 	}
 
 	d.Set("cluster_id", clusterID)
@@ -110,16 +109,13 @@ func resourceYandexMDBMySQLDatabaseRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceYandexMDBMySQLDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
-	return fmt.Errorf("changing resource_yandex_mdb_mysql_database is not supported")
+func resourceYandexMDBMySQLDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.Errorf("changing resource_yandex_mdb_mysql_database is not supported")
 }
 
-func resourceYandexMDBMySQLDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBMySQLDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	dbname := d.Get("name").(string)
 	clusterID := d.Get("cluster_id").(string)
 
@@ -133,15 +129,15 @@ func resourceYandexMDBMySQLDatabaseDelete(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete database from MySQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to delete database from MySQL Cluster %q", clusterID), err, nil)
 	}
 
 	if err := op.Wait(ctx); err != nil {
-		return fmt.Errorf("error while deleting database from MySQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while deleting database from MySQL Cluster %q", clusterID), err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("deleting database from MySQL Cluster %q failed: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("deleting database from MySQL Cluster %q failed", clusterID), err, nil)
 	}
 
 	return nil
@@ -1,10 +1,12 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
@@ -20,12 +22,12 @@ const (
 
 func resourceYandexMDBMySQLUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBMySQLUserCreate,
-		Read:   resourceYandexMDBMySQLUserRead,
-		Update: resourceYandexMDBMySQLUserUpdate,
-		Delete: resourceYandexMDBMySQLUserDelete,
+		CreateContext: resourceYandexMDBMySQLUserCreate,
+		ReadContext:   resourceYandexMDBMySQLUserRead,
+		UpdateContext: resourceYandexMDBMySQLUserUpdate,
+		DeleteContext: resourceYandexMDBMySQLUserDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -128,16 +130,16 @@ func resourceYandexMDBMySQLUserConnectionLimits() *schema.Resource {
 	}
 }
 
-func resourceYandexMDBMySQLUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBMySQLUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
 	clusterID := d.Get("cluster_id").(string)
 	userSpec, err := expandMySQLUserSpec(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	request := &mysql.CreateUserRequest{
 		ClusterId: clusterID,
@@ -152,18 +154,18 @@ func resourceYandexMDBMySQLUserCreate(d *schema.ResourceData, meta interface{})
 	d.SetId(userID)
 
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create user for MySQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to create user for MySQL Cluster %q", clusterID), err, nil)
 	}
 
-	if err = op.Wait(ctx); err != nil {
-		return fmt.Errorf("error while creating user for MySQL Cluster %q: %s", clusterID, err)
+	if err = waitResumableOperation(ctx, d, op); err != nil {
+		return errorDiagnostics(fmt.Sprintf("error while creating user for MySQL Cluster %q", clusterID), err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("creating user for MySQL Cluster %q failed: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("creating user for MySQL Cluster %q failed", clusterID), err, nil)
 	}
 
-	return resourceYandexMDBMySQLUserRead(d, meta)
+	return resourceYandexMDBMySQLUserRead(ctx, d, meta)
 }
 
 func expandMySQLUserSpec(d *schema.ResourceData) (*mysql.UserSpec, error) {
@@ -209,15 +211,12 @@ func expandMySQLUserSpec(d *schema.ResourceData) (*mysql.UserSpec, error) {
 	return user, nil
 }
 
-func resourceYandexMDBMySQLUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBMySQLUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	clusterID, username, err := deconstructResourceId(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	user, err := config.sdk.MDB().MySQL().User().Get(ctx, &mysql.GetUserRequest{
@@ -225,12 +224,12 @@ func resourceYandexMDBMySQLUserRead(d *schema.ResourceData, meta interface{}) er
 		UserName:  username,
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("User %q", username))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("User %q", username)))
 	}
 
 	permissions, err := flattenMysqlUserPermissions(user.Permissions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	connectionLimits := flattenMysqlUserConnectionLimits(user)
@@ -247,15 +246,15 @@ func resourceYandexMDBMySQLUserRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceYandexMDBMySQLUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBMySQLUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	defer cancel()
 
 	user, err := expandMySQLUserSpec(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -275,25 +274,22 @@ func resourceYandexMDBMySQLUserUpdate(d *schema.ResourceData, meta interface{})
 	})
 
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update user in MySQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to update user in MySQL Cluster %q", clusterID), err, nil)
 	}
 
 	if err = op.Wait(ctx); err != nil {
-		return fmt.Errorf("error while updating user in MySQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while updating user in MySQL Cluster %q", clusterID), err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("updating user for MySQL Cluster %q failed: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("updating user for MySQL Cluster %q failed", clusterID), err, nil)
 	}
 	return nil
 }
 
-func resourceYandexMDBMySQLUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBMySQLUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	clusterID := d.Get("cluster_id").(string)
 	username := d.Get("name").(string)
 
@@ -307,15 +303,15 @@ func resourceYandexMDBMySQLUserDelete(d *schema.ResourceData, meta interface{})
 	})
 
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete user from MySQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to delete user from MySQL Cluster %q", clusterID), err, nil)
 	}
 
 	if err = op.Wait(ctx); err != nil {
-		return fmt.Errorf("error while deleting user from MySQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while deleting user from MySQL Cluster %q", clusterID), err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("deleting user from MySQL Cluster %q failed: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("deleting user from MySQL Cluster %q failed", clusterID), err, nil)
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -24,12 +25,12 @@ const (
 
 func resourceYandexMDBPostgreSQLCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBPostgreSQLClusterCreate,
-		Read:   resourceYandexMDBPostgreSQLClusterRead,
-		Update: resourceYandexMDBPostgreSQLClusterUpdate,
-		Delete: resourceYandexMDBPostgreSQLClusterDelete,
+		CreateContext: resourceYandexMDBPostgreSQLClusterCreate,
+		ReadContext:   resourceYandexMDBPostgreSQLClusterRead,
+		UpdateContext: resourceYandexMDBPostgreSQLClusterUpdate,
+		DeleteContext: resourceYandexMDBPostgreSQLClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -493,17 +494,14 @@ func resourceYandexMDBPostgreSQLClusterMaintenanceWindow() *schema.Resource {
 	}
 }
 
-func resourceYandexMDBPostgreSQLClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBPostgreSQLClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	cluster, err := config.sdk.MDB().PostgreSQL().Cluster().Get(ctx, &postgresql.GetClusterRequest{
 		ClusterId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Id())))
 	}
 
 	d.Set("created_at", getTimestamp(cluster.CreatedAt))
@@ -516,105 +514,105 @@ func resourceYandexMDBPostgreSQLClusterRead(d *schema.ResourceData, meta interfa
 	d.Set("network_id", cluster.GetNetworkId())
 
 	if err := d.Set("labels", cluster.GetLabels()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	pgClusterConf, err := flattenPGClusterConfig(cluster.Config, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("config", pgClusterConf); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	stateDatabases := d.Get("database").([]interface{})
 	if len(stateDatabases) == 0 {
 		if err := d.Set("database", []map[string]interface{}{}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		databases, err := listPGDatabases(ctx, config, d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		databaseSpecs, err := expandPGDatabaseSpecs(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		sortPGDatabases(databases, databaseSpecs)
 
 		if err := d.Set("database", flattenPGDatabases(databases)); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	stateUsers := d.Get("user").([]interface{})
 	if len(stateUsers) == 0 {
 		if err := d.Set("user", []map[string]interface{}{}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		userSpecs, err := expandPGUserSpecs(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		passwords := pgUsersPasswords(userSpecs)
 		users, err := listPGUsers(ctx, config, d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		sortPGUsers(users, userSpecs)
 
 		fUsers, err := flattenPGUsers(users, passwords, mdbPGUserSettingsFieldsInfo)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err := d.Set("user", fUsers); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	hosts, err := listPGHosts(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	orderedHostInfos, err := flattenPGHostsInfo(d, hosts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	fHosts := flattenPGHostsFromHostInfos(orderedHostInfos, false)
 	masterHostname := getMasterHostname(orderedHostInfos)
 
 	if err := d.Set("host", fHosts); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := d.Set("host_master_name", masterHostname); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	maintenanceWindow, err := flattenPGMaintenanceWindow(cluster.MaintenanceWindow)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("maintenance_window", maintenanceWindow); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("deletion_protection", cluster.DeletionProtection); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("host_group_ids", cluster.HostGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -642,67 +640,78 @@ func sortPGDatabases(databases []*postgresql.Database, specs []*postgresql.Datab
 	}
 }
 
-func resourceYandexMDBPostgreSQLClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBPostgreSQLClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	request, err := prepareCreatePostgreSQLRequest(d, config)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if backupID, ok := d.GetOk("restore.0.backup_id"); ok && backupID != "" {
-		return resourceYandexMDBPostgreSQLClusterRestore(d, meta, request, backupID.(string))
+		if err := resourceYandexMDBPostgreSQLClusterRestore(ctx, d, meta, request, backupID.(string)); err != nil {
+			return diagnosticsFromError(err)
+		}
+		return resourceYandexMDBPostgreSQLClusterRead(ctx, d, meta)
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
 		log.Printf("[DEBUG] Sending PostgreSQL cluster create request: %+v", request)
 		return config.sdk.MDB().PostgreSQL().Cluster().Create(ctx, request)
 	})
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create PostgreSQL Cluster: %s", err)
+		return errorDiagnostics("Error while requesting API to create PostgreSQL Cluster", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get PostgreSQL Cluster create operation metadata: %s", err)
+		return diag.Errorf("Error while get PostgreSQL Cluster create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*postgresql.CreateClusterMetadata)
 	if !ok {
-		return fmt.Errorf("Could not get PostgreSQL Cluster ID from create operation metadata")
+		return diag.Errorf("Could not get PostgreSQL Cluster ID from create operation metadata")
 	}
 
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting for operation to create PostgreSQL Cluster: %s", err)
+		return errorDiagnostics("Error while waiting for operation to create PostgreSQL Cluster", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("PostgreSQL Cluster creation failed: %s", err)
+		return errorDiagnostics("PostgreSQL Cluster creation failed", err, nil)
+	}
+
+	if err := finishResumableCreate(ctx, d, meta, op.Id(), finishPGClusterCreate); err != nil {
+		return diag.FromErr(err)
 	}
 
+	return resourceYandexMDBPostgreSQLClusterRead(ctx, d, meta)
+}
+
+// finishPGClusterCreate adds hosts, which can't be created along with the cluster, and applies the settings
+// which are set by update only. Every step compares the cluster with the configuration, so it can be repeated.
+func finishPGClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
 	if err := createPGClusterHosts(ctx, config, d); err != nil {
 		return fmt.Errorf("PostgreSQL Cluster %v hosts creation failed: %s", d.Id(), err)
 	}
 
-	if err := updateMasterPGClusterHosts(d, meta); err != nil {
+	if err := updateMasterPGClusterHosts(ctx, d, meta); err != nil {
 		return fmt.Errorf("PostgreSQL Cluster %v hosts set master failed: %s", d.Id(), err)
 	}
 
-	if err := updatePGClusterAfterCreate(d, meta); err != nil {
+	if err := updatePGClusterAfterCreate(ctx, d, meta); err != nil {
 		return fmt.Errorf("PostgreSQL Cluster %v update params failed: %s", d.Id(), err)
 	}
-
-	return resourceYandexMDBPostgreSQLClusterRead(d, meta)
+	return nil
 }
 
-func resourceYandexMDBPostgreSQLClusterRestore(d *schema.ResourceData, meta interface{}, createClusterRequest *postgresql.CreateClusterRequest, backupID string) error {
+func resourceYandexMDBPostgreSQLClusterRestore(ctx context.Context, d *schema.ResourceData, meta interface{}, createClusterRequest *postgresql.CreateClusterRequest, backupID string) error {
 	config := meta.(*Config)
 
 	timeBackup := time.Now()
@@ -720,8 +729,6 @@ func resourceYandexMDBPostgreSQLClusterRestore(d *schema.ResourceData, meta inte
 		timeInclusive = timeInclusiveData.(bool)
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
 	request := &postgresql.RestoreClusterRequest{
 		BackupId: backupID,
 		Time: &timestamp.Timestamp{
@@ -746,7 +753,7 @@ func resourceYandexMDBPostgreSQLClusterRestore(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create PostgreSQL Cluster from backup %v: %s", backupID, err)
+		return apiError(fmt.Sprintf("Error while requesting API to create PostgreSQL Cluster from backup %v", backupID), err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
@@ -763,7 +770,7 @@ func resourceYandexMDBPostgreSQLClusterRestore(d *schema.ResourceData, meta inte
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error while waiting for operation to create PostgreSQL Cluster from backup %v: %s", backupID, err)
+		return apiError(fmt.Sprintf("Error while waiting for operation to create PostgreSQL Cluster from backup %v", backupID), err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
@@ -774,11 +781,11 @@ func resourceYandexMDBPostgreSQLClusterRestore(d *schema.ResourceData, meta inte
 		return fmt.Errorf("PostgreSQL Cluster %v hosts creation from backup %v failed: %s", d.Id(), backupID, err)
 	}
 
-	if err := updateMasterPGClusterHosts(d, meta); err != nil {
+	if err := updateMasterPGClusterHosts(ctx, d, meta); err != nil {
 		return fmt.Errorf("PostgreSQL Cluster %v hosts set master failed: %s", d.Id(), err)
 	}
 
-	return resourceYandexMDBPostgreSQLClusterRead(d, meta)
+	return nil
 }
 
 func prepareCreatePostgreSQLRequest(d *schema.ResourceData, meta *Config) (*postgresql.CreateClusterRequest, error) {
@@ -851,7 +858,7 @@ func prepareCreatePostgreSQLRequest(d *schema.ResourceData, meta *Config) (*post
 	return req, nil
 }
 
-func updatePGClusterAfterCreate(d *schema.ResourceData, meta interface{}) error {
+func updatePGClusterAfterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	maintenanceWindow, err := expandPGMaintenanceWindow(d)
 	if err != nil {
@@ -869,8 +876,6 @@ func updatePGClusterAfterCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
 		log.Printf("[DEBUG] Sending PostgreSQL cluster update request: %+v", request)
@@ -878,68 +883,68 @@ func updatePGClusterAfterCreate(d *schema.ResourceData, meta interface{}) error
 	})
 
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update PostgreSQL Cluster after creation %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update PostgreSQL Cluster after creation %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while waiting for operation to update PostgreSQL Cluster after creation %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while waiting for operation to update PostgreSQL Cluster after creation %q", d.Id()), err, nil)
 	}
 
 	return nil
 }
 
-func resourceYandexMDBPostgreSQLClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBPostgreSQLClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.Partial(true)
 
-	if err := setPGFolderID(d, meta); err != nil {
-		return err
+	if err := setPGFolderID(ctx, d, meta); err != nil {
+		return diagnosticsFromError(err)
 	}
 
-	if err := updatePGClusterParams(d, meta); err != nil {
-		return err
+	if err := updatePGClusterParams(ctx, d, meta); err != nil {
+		return diagnosticsFromError(err)
 	}
 
 	stateUser := d.Get("user").([]interface{})
 	if d.HasChange("user") && len(stateUser) > 0 {
-		if err := updatePGClusterUsersAdd(d, meta); err != nil {
-			return err
+		if err := updatePGClusterUsersAdd(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	stateDatabase := d.Get("database").([]interface{})
 	if d.HasChange("database") && len(stateDatabase) > 0 {
-		if err := updatePGClusterDatabases(d, meta); err != nil {
-			return err
+		if err := updatePGClusterDatabases(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	if d.HasChange("user") && len(stateUser) > 0 {
-		if err := updatePGClusterUsersUpdateAndDrop(d, meta); err != nil {
-			return err
+		if err := updatePGClusterUsersUpdateAndDrop(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	if d.HasChange("host") {
-		if err := updatePGClusterHosts(d, meta); err != nil {
-			return err
+		if err := updatePGClusterHosts(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	if d.HasChange("host_master_name") {
 
-		if err := updateMasterPGClusterHosts(d, meta); err != nil {
-			return err
+		if err := updateMasterPGClusterHosts(ctx, d, meta); err != nil {
+			return diagnosticsFromError(err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceYandexMDBPostgreSQLClusterRead(d, meta)
+	return resourceYandexMDBPostgreSQLClusterRead(ctx, d, meta)
 }
 
-func updatePGClusterParams(d *schema.ResourceData, meta interface{}) error {
+func updatePGClusterParams(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	request, updateFieldConfigName, err := getPGClusterUpdateRequest(d)
 	if err != nil {
 		return err
@@ -969,7 +974,7 @@ func updatePGClusterParams(d *schema.ResourceData, meta interface{}) error {
 	onDone := []func(){}
 	updatePath := []string{}
 	for field, path := range mdbPGUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasChangeWithDefaultLabels(d, field) {
 			updatePath = append(updatePath, path)
 			onDone = append(onDone, func() {
 
@@ -984,8 +989,6 @@ func updatePGClusterParams(d *schema.ResourceData, meta interface{}) error {
 	request.UpdateMask = &field_mask.FieldMask{Paths: updatePath}
 
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
 		log.Printf("[DEBUG] Sending PostgreSQL cluster update request: %+v", request)
@@ -993,12 +996,12 @@ func updatePGClusterParams(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update PostgreSQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update PostgreSQL Cluster %q", d.Id()), err, mdbPGUpdateFieldsMap)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while waiting for operation to update PostgreSQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while waiting for operation to update PostgreSQL Cluster %q", d.Id()), err, mdbPGUpdateFieldsMap)
 	}
 
 	for _, f := range onDone {
@@ -1041,10 +1044,8 @@ func getPGClusterUpdateRequest(d *schema.ResourceData) (ucr *postgresql.UpdateCl
 	}, updateFieldConfigName, nil
 }
 
-func updatePGClusterDatabases(d *schema.ResourceData, meta interface{}) error {
+func updatePGClusterDatabases(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	currDBs, err := listPGDatabases(ctx, config, d.Id())
 	if err != nil {
@@ -1088,12 +1089,9 @@ func updatePGClusterDatabases(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func updatePGClusterUsersAdd(d *schema.ResourceData, meta interface{}) error {
+func updatePGClusterUsersAdd(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	currUsers, err := listPGUsers(ctx, config, d.Id())
 	if err != nil {
 		return err
@@ -1113,12 +1111,9 @@ func updatePGClusterUsersAdd(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func updatePGClusterUsersUpdateAndDrop(d *schema.ResourceData, meta interface{}) error {
+func updatePGClusterUsersUpdateAndDrop(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	currUsers, err := listPGUsers(ctx, config, d.Id())
 	if err != nil {
 		return err
@@ -1154,7 +1149,7 @@ func updatePGClusterUsersUpdateAndDrop(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func updatePGClusterHosts(d *schema.ResourceData, meta interface{}) error {
+func updatePGClusterHosts(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// Ideas:
 	// 1. In order to do it safely for clients: firstly add new hosts and only then delete unneeded hosts
 	// 2. Batch Add/Update operations are not supported, so we should update hosts one by one
@@ -1163,8 +1158,6 @@ func updatePGClusterHosts(d *schema.ResourceData, meta interface{}) error {
 	//    Solution: update HA-replicas first, then use BFS (using `comparePGHostsInfoResult.hierarchyExists`)
 
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	// Step 1: Add new hosts (as HA-hosts):
 	err := createPGClusterHosts(ctx, config, d)
@@ -1256,10 +1249,8 @@ func createPGClusterHosts(ctx context.Context, config *Config, d *schema.Resourc
 	return nil
 }
 
-func updateMasterPGClusterHosts(d *schema.ResourceData, meta interface{}) error {
+func updateMasterPGClusterHosts(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
 
 	currHosts, err := listPGHosts(ctx, config, d.Id())
 	if err != nil {
@@ -1287,7 +1278,7 @@ func updateMasterPGClusterHosts(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceYandexMDBPostgreSQLClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBPostgreSQLClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting PostgreSQL Cluster %q", d.Id())
@@ -1296,25 +1287,22 @@ func resourceYandexMDBPostgreSQLClusterDelete(d *schema.ResourceData, meta inter
 		ClusterId: d.Id(),
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
 		log.Printf("[DEBUG] Sending PostgreSQL cluster delete request: %+v", request)
 		return config.sdk.MDB().PostgreSQL().Cluster().Delete(ctx, request)
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("PostgreSQL Cluster %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("PostgreSQL Cluster %q", d.Id())))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting PostgreSQL Cluster %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting PostgreSQL Cluster %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting PostgreSQL Cluster %q", d.Id())
@@ -1330,7 +1318,7 @@ func createPGUser(ctx context.Context, config *Config, d *schema.ResourceData, u
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create user for PostgreSQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create user for PostgreSQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1398,7 +1386,7 @@ func updatePGUser(ctx context.Context, config *Config, d *schema.ResourceData, u
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update user in PostgreSQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update user in PostgreSQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1424,7 +1412,7 @@ func deletePGUser(ctx context.Context, config *Config, d *schema.ResourceData, n
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete user from PostgreSQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete user from PostgreSQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1479,7 +1467,7 @@ func createPGDatabase(ctx context.Context, config *Config, d *schema.ResourceDat
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create database in PostgreSQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create database in PostgreSQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1503,7 +1491,7 @@ func updatePGDatabase(ctx context.Context, config *Config, d *schema.ResourceDat
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update database in PostgreSQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update database in PostgreSQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1526,7 +1514,7 @@ func deletePGDatabase(ctx context.Context, config *Config, d *schema.ResourceDat
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete database from PostgreSQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete database from PostgreSQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1576,7 +1564,7 @@ func addPGHost(ctx context.Context, config *Config, d *schema.ResourceData, host
 		return config.sdk.MDB().PostgreSQL().Cluster().AddHosts(ctx, request)
 	})
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create host for PostgreSQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create host for PostgreSQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1601,7 +1589,7 @@ func deletePGHost(ctx context.Context, config *Config, d *schema.ResourceData, n
 		return config.sdk.MDB().PostgreSQL().Cluster().DeleteHosts(ctx, request)
 	})
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete host from PostgreSQL Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete host from PostgreSQL Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1626,7 +1614,7 @@ func startPGFailover(ctx context.Context, config *Config, d *schema.ResourceData
 		return config.sdk.MDB().PostgreSQL().Cluster().StartFailover(ctx, request)
 	})
 	if err != nil {
-		return fmt.Errorf("error while requesting API to start failover host in PostgreSQL Cluster %q - host %v: %s", d.Id(), hostName, err)
+		return apiError(fmt.Sprintf("error while requesting API to start failover host in PostgreSQL Cluster %q - host %v", d.Id(), hostName), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1651,7 +1639,7 @@ func updatePGHost(ctx context.Context, config *Config, d *schema.ResourceData, h
 		return config.sdk.MDB().PostgreSQL().Cluster().UpdateHosts(ctx, request)
 	})
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update host for PostgreSQL Cluster %q - host %v: %s", d.Id(), host.HostName, err)
+		return apiError(fmt.Sprintf("error while requesting API to update host for PostgreSQL Cluster %q - host %v", d.Id(), host.HostName), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1691,12 +1679,9 @@ func listPGHosts(ctx context.Context, config *Config, id string) ([]*postgresql.
 	return hosts, nil
 }
 
-func setPGFolderID(d *schema.ResourceData, meta interface{}) error {
+func setPGFolderID(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	cluster, err := config.sdk.MDB().PostgreSQL().Cluster().Get(ctx, &postgresql.GetClusterRequest{
 		ClusterId: d.Id(),
 	})
@@ -1722,7 +1707,7 @@ func setPGFolderID(d *schema.ResourceData, meta interface{}) error {
 			return config.sdk.MDB().PostgreSQL().Cluster().Move(ctx, request)
 		})
 		if err != nil {
-			return fmt.Errorf("error while requesting API to move PostgreSQL Cluster %q to folder %v: %s", d.Id(), folderID, err)
+			return apiError(fmt.Sprintf("error while requesting API to move PostgreSQL Cluster %q to folder %v", d.Id(), folderID), err, nil)
 		}
 
 		err = op.Wait(ctx)
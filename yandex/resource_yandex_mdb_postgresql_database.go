@@ -1,10 +1,12 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
@@ -19,12 +21,12 @@ const (
 
 func resourceYandexMDBPostgreSQLDatabase() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBPostgreSQLDatabaseCreate,
-		Read:   resourceYandexMDBPostgreSQLDatabaseRead,
-		Update: resourceYandexMDBPostgreSQLDatabaseUpdate,
-		Delete: resourceYandexMDBPostgreSQLDatabaseDelete,
+		CreateContext: resourceYandexMDBPostgreSQLDatabaseCreate,
+		ReadContext:   resourceYandexMDBPostgreSQLDatabaseRead,
+		UpdateContext: resourceYandexMDBPostgreSQLDatabaseUpdate,
+		DeleteContext: resourceYandexMDBPostgreSQLDatabaseDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -88,16 +90,16 @@ func resourceYandexMDBPostgreSQLDatabase() *schema.Resource {
 	}
 }
 
-func resourceYandexMDBPostgreSQLDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBPostgreSQLDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
 	clusterID := d.Get("cluster_id").(string)
 	databaseSpec, err := expandPgDatabaseSpec(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	request := &postgresql.CreateDatabaseRequest{
 		ClusterId:    clusterID,
@@ -113,18 +115,18 @@ func resourceYandexMDBPostgreSQLDatabaseCreate(d *schema.ResourceData, meta inte
 	d.SetId(databaseID)
 
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create database in PostgreSQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to create database in PostgreSQL Cluster %q", clusterID), err, nil)
 	}
 
-	if err := op.Wait(ctx); err != nil {
-		return fmt.Errorf("error while adding database to PostgreSQL Cluster %q: %s", clusterID, err)
+	if err := waitResumableOperation(ctx, d, op); err != nil {
+		return diag.Errorf("error while adding database to PostgreSQL Cluster %q: %s", clusterID, err)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("creating database for PostgreSQL Cluster %q failed: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("creating database for PostgreSQL Cluster %q failed", clusterID), err, nil)
 	}
 
-	return resourceYandexMDBPostgreSQLDatabaseRead(d, meta)
+	return resourceYandexMDBPostgreSQLDatabaseRead(ctx, d, meta)
 }
 
 func expandPgDatabaseSpec(d *schema.ResourceData) (*postgresql.DatabaseSpec, error) {
@@ -158,15 +160,12 @@ func expandPgDatabaseSpec(d *schema.ResourceData) (*postgresql.DatabaseSpec, err
 	return out, nil
 }
 
-func resourceYandexMDBPostgreSQLDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBPostgreSQLDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	clusterID, dbname, err := deconstructResourceId(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	db, err := config.sdk.MDB().PostgreSQL().Database().Get(ctx, &postgresql.GetDatabaseRequest{
@@ -174,7 +173,7 @@ func resourceYandexMDBPostgreSQLDatabaseRead(d *schema.ResourceData, meta interf
 		DatabaseName: dbname,
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Database %q", dbname))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Database %q", dbname)))
 	}
 
 	d.Set("cluster_id", clusterID)
@@ -187,9 +186,9 @@ func resourceYandexMDBPostgreSQLDatabaseRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceYandexMDBPostgreSQLDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBPostgreSQLDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	defer cancel()
 
 	clusterID := d.Get("cluster_id").(string)
@@ -210,26 +209,23 @@ func resourceYandexMDBPostgreSQLDatabaseUpdate(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update database in PostgreSQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to update database in PostgreSQL Cluster %q", clusterID), err, nil)
 	}
 
 	if err := op.Wait(ctx); err != nil {
-		return fmt.Errorf("error while updating database in PostgreSQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while updating database in PostgreSQL Cluster %q", clusterID), err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("updating database for PostgreSQL Cluster %q failed: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("updating database for PostgreSQL Cluster %q failed", clusterID), err, nil)
 	}
 
 	return nil
 }
 
-func resourceYandexMDBPostgreSQLDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBPostgreSQLDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	dbName := d.Get("name").(string)
 	clusterID := d.Get("cluster_id").(string)
 
@@ -243,15 +239,15 @@ func resourceYandexMDBPostgreSQLDatabaseDelete(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete database from PostgreSQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to delete database from PostgreSQL Cluster %q", clusterID), err, nil)
 	}
 
 	if err := op.Wait(ctx); err != nil {
-		return fmt.Errorf("error while deleting database from PostgreSQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while deleting database from PostgreSQL Cluster %q", clusterID), err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("deleting database from PostgreSQL Cluster %q failed: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("deleting database from PostgreSQL Cluster %q failed", clusterID), err, nil)
 	}
 
 	return nil
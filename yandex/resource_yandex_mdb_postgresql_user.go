@@ -1,12 +1,14 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
@@ -21,12 +23,12 @@ const (
 
 func resourceYandexMDBPostgreSQLUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBPostgreSQLUserCreate,
-		Read:   resourceYandexMDBPostgreSQLUserRead,
-		Update: resourceYandexMDBPostgreSQLUserUpdate,
-		Delete: resourceYandexMDBPostgreSQLUserDelete,
+		CreateContext: resourceYandexMDBPostgreSQLUserCreate,
+		ReadContext:   resourceYandexMDBPostgreSQLUserRead,
+		UpdateContext: resourceYandexMDBPostgreSQLUserUpdate,
+		DeleteContext: resourceYandexMDBPostgreSQLUserDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -98,16 +100,16 @@ func resourceYandexMDBPostgreSQLUser() *schema.Resource {
 	}
 }
 
-func resourceYandexMDBPostgreSQLUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBPostgreSQLUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
 	clusterID := d.Get("cluster_id").(string)
 	userSpec, err := expandPgUserSpec(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	request := &postgresql.CreateUserRequest{
 		ClusterId: clusterID,
@@ -122,18 +124,18 @@ func resourceYandexMDBPostgreSQLUserCreate(d *schema.ResourceData, meta interfac
 	d.SetId(userID)
 
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create user for PostgreSQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to create user for PostgreSQL Cluster %q", clusterID), err, nil)
 	}
 
-	if err = op.Wait(ctx); err != nil {
-		return fmt.Errorf("error while creating user for PostgreSQL Cluster %q: %s", clusterID, err)
+	if err = waitResumableOperation(ctx, d, op); err != nil {
+		return errorDiagnostics(fmt.Sprintf("error while creating user for PostgreSQL Cluster %q", clusterID), err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("creating user for PostgreSQL Cluster %q failed: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("creating user for PostgreSQL Cluster %q failed", clusterID), err, nil)
 	}
 
-	return resourceYandexMDBPostgreSQLUserRead(d, meta)
+	return resourceYandexMDBPostgreSQLUserRead(ctx, d, meta)
 }
 
 func expandPgUserSpec(d *schema.ResourceData) (*postgresql.UserSpec, error) {
@@ -184,15 +186,12 @@ func expandPgUserSpec(d *schema.ResourceData) (*postgresql.UserSpec, error) {
 	return user, nil
 }
 
-func resourceYandexMDBPostgreSQLUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBPostgreSQLUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	clusterID, username, err := deconstructResourceId(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	user, err := config.sdk.MDB().PostgreSQL().User().Get(ctx, &postgresql.GetUserRequest{
@@ -200,7 +199,7 @@ func resourceYandexMDBPostgreSQLUserRead(d *schema.ResourceData, meta interface{
 		UserName:  username,
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("User %q", username))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("User %q", username)))
 	}
 
 	d.Set("cluster_id", clusterID)
@@ -214,22 +213,22 @@ func resourceYandexMDBPostgreSQLUserRead(d *schema.ResourceData, meta interface{
 	}
 	settings, err := flattenResourceGenerateMapS(user.Settings, false, mdbPGUserSettingsFieldsInfo, false, true, knownDefault)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("settings", settings)
 
 	return nil
 }
 
-func resourceYandexMDBPostgreSQLUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBPostgreSQLUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	defer cancel()
 
 	user, err := expandPgUserSpec(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -249,25 +248,22 @@ func resourceYandexMDBPostgreSQLUserUpdate(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update user in PostgreSQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to update user in PostgreSQL Cluster %q", clusterID), err, nil)
 	}
 
 	if err = op.Wait(ctx); err != nil {
-		return fmt.Errorf("error while updating user in PostgreSQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while updating user in PostgreSQL Cluster %q", clusterID), err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("updating user for PostgreSQL Cluster %q failed: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("updating user for PostgreSQL Cluster %q failed", clusterID), err, nil)
 	}
 	return nil
 }
 
-func resourceYandexMDBPostgreSQLUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBPostgreSQLUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	clusterID := d.Get("cluster_id").(string)
 	username := d.Get("name").(string)
 
@@ -281,15 +277,15 @@ func resourceYandexMDBPostgreSQLUserDelete(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete user from PostgreSQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while requesting API to delete user from PostgreSQL Cluster %q", clusterID), err, nil)
 	}
 
 	if err = op.Wait(ctx); err != nil {
-		return fmt.Errorf("error while deleting user from PostgreSQL Cluster %q: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("error while deleting user from PostgreSQL Cluster %q", clusterID), err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("deleting user from PostgreSQL Cluster %q failed: %s", clusterID, err)
+		return errorDiagnostics(fmt.Sprintf("deleting user from PostgreSQL Cluster %q failed", clusterID), err, nil)
 	}
 
 	return nil
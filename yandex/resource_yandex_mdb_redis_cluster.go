@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -25,12 +26,12 @@ const (
 
 func resourceYandexMDBRedisCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBRedisClusterCreate,
-		Read:   resourceYandexMDBRedisClusterRead,
-		Update: resourceYandexMDBRedisClusterUpdate,
-		Delete: resourceYandexMDBRedisClusterDelete,
+		CreateContext: resourceYandexMDBRedisClusterCreate,
+		ReadContext:   resourceYandexMDBRedisClusterRead,
+		UpdateContext: resourceYandexMDBRedisClusterUpdate,
+		DeleteContext: resourceYandexMDBRedisClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -259,57 +260,59 @@ func resourceYandexMDBRedisCluster() *schema.Resource {
 	}
 }
 
-func resourceYandexMDBRedisClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBRedisClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	req, err := prepareCreateRedisRequest(d, config)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().Redis().Cluster().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Redis Cluster: %s", err)
+		return errorDiagnostics("Error while requesting API to create Redis Cluster", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get redis create operation metadata: %s", err)
+		return diag.Errorf("Error while get redis create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*redis.CreateClusterMetadata)
 	if !ok {
-		return fmt.Errorf("Could not get Cluster ID from create operation metadata")
+		return diag.Errorf("Could not get Cluster ID from create operation metadata")
 	}
 
 	d.SetId(md.ClusterId)
 	log.Printf("[DEBUG] Creating Redis Cluster %q", md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting for operation to create Redis Cluster: %s", err)
+		return errorDiagnostics("Error while waiting for operation to create Redis Cluster", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Redis Cluster creation failed: %s", err)
+		return errorDiagnostics("Redis Cluster creation failed", err, nil)
+	}
+
+	if err := finishResumableCreate(ctx, d, meta, op.Id(), finishRedisClusterCreate); err != nil {
+		return diag.FromErr(err)
 	}
 
+	return resourceYandexMDBRedisClusterRead(ctx, d, meta)
+}
+
+// finishRedisClusterCreate sets the maintenance window, which can't be set on creation.
+func finishRedisClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	mw, err := expandRedisMaintenanceWindow(d)
 	if err != nil {
 		return err
 	}
-	if mw != nil {
-		err = updateRedisMaintenanceWindow(ctx, config, d, mw)
-		if err != nil {
-			return err
-		}
+	if mw == nil {
+		return nil
 	}
-
-	return resourceYandexMDBRedisClusterRead(d, meta)
+	return updateRedisMaintenanceWindow(ctx, meta.(*Config), d, mw)
 }
 
 func prepareCreateRedisRequest(d *schema.ResourceData, meta *Config) (*redis.CreateClusterRequest, error) {
@@ -382,22 +385,19 @@ func prepareCreateRedisRequest(d *schema.ResourceData, meta *Config) (*redis.Cre
 	return &req, nil
 }
 
-func resourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBRedisClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	cluster, err := config.sdk.MDB().Redis().Cluster().Get(ctx, &redis.GetClusterRequest{
 		ClusterId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string))))
 	}
 
 	hosts, err := listRedisHosts(ctx, config, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("created_at", getTimestamp(cluster.CreatedAt))
@@ -414,7 +414,7 @@ func resourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{})
 
 	resources, err := flattenRedisResources(cluster.Config.Resources)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	conf := extractRedisConfig(cluster.Config)
@@ -438,64 +438,64 @@ func resourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{})
 		},
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("resources", resources); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Do not change the state if only order of hosts differs.
 	dHosts, err := expandRedisHosts(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	sortRedisHosts(cluster.Sharded, hosts, dHosts)
 
 	hs, err := flattenRedisHosts(cluster.Sharded, hosts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("host", hs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	mw := flattenRedisMaintenanceWindow(cluster.MaintenanceWindow)
 	if err := d.Set("maintenance_window", mw); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("deletion_protection", cluster.DeletionProtection)
 
-	return d.Set("labels", cluster.Labels)
+	return diag.FromErr(d.Set("labels", cluster.Labels))
 }
 
-func resourceYandexMDBRedisClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBRedisClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.Partial(true)
 
 	if d.HasChange("resources.0.disk_type_id") {
-		return fmt.Errorf("Changing disk_type_id is not supported for Redis Cluster. Id: %v", d.Id())
+		return diag.Errorf("Changing disk_type_id is not supported for Redis Cluster. Id: %v", d.Id())
 	}
 
-	if err := updateRedisClusterParams(d, meta); err != nil {
-		return err
+	if err := updateRedisClusterParams(ctx, d, meta); err != nil {
+		return diagnosticsFromError(err)
 	}
 
-	if err := updateRedisClusterHosts(d, meta); err != nil {
-		return err
+	if err := updateRedisClusterHosts(ctx, d, meta); err != nil {
+		return diagnosticsFromError(err)
 	}
 
 	d.Partial(false)
-	return resourceYandexMDBRedisClusterRead(d, meta)
+	return resourceYandexMDBRedisClusterRead(ctx, d, meta)
 }
 
-func updateRedisClusterParams(d *schema.ResourceData, meta interface{}) error {
+func updateRedisClusterParams(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	req := &redis.UpdateClusterRequest{
 		ClusterId: d.Id(),
 		UpdateMask: &field_mask.FieldMask{
@@ -527,7 +527,7 @@ func updateRedisClusterParams(d *schema.ResourceData, meta interface{}) error {
 		})
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
 			return err
@@ -643,7 +643,7 @@ func updateRedisClusterParams(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	err := makeRedisClusterUpdateRequest(req, d, meta)
+	err := makeRedisClusterUpdateRequest(ctx, req, d, meta)
 	if err != nil {
 		return err
 	}
@@ -706,14 +706,12 @@ func deleteHosts(ctx context.Context, d *schema.ResourceData, config *Config, sh
 	return nil
 }
 
-func updateRedisClusterHosts(d *schema.ResourceData, meta interface{}) error {
+func updateRedisClusterHosts(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	if !d.HasChange("host") {
 		return nil
 	}
 
 	config := meta.(*Config)
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
 
 	sharded := d.Get("sharded").(bool)
 
@@ -737,9 +735,6 @@ func updateRedisClusterHosts(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	ctx, cancel = context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	err = addHosts(ctx, d, config, sharded, currShards, toAdd)
 	if err != nil {
 		return err
@@ -767,7 +762,7 @@ func updateRedisMaintenanceWindow(ctx context.Context, config *Config, d *schema
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update maintenance window in Redis Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update maintenance window in Redis Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -827,7 +822,7 @@ func createRedisShard(ctx context.Context, config *Config, d *schema.ResourceDat
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to add shard to Redis Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to add shard to Redis Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -839,7 +834,7 @@ func createRedisShard(ctx context.Context, config *Config, d *schema.ResourceDat
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to rebalance the Redis Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to rebalance the Redis Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -857,7 +852,7 @@ func createRedisHosts(ctx context.Context, config *Config, d *schema.ResourceDat
 			}),
 		)
 		if err != nil {
-			return fmt.Errorf("Error while requesting API to add host to Redis Cluster %q: %s", d.Id(), err)
+			return apiError(fmt.Sprintf("Error while requesting API to add host to Redis Cluster %q", d.Id()), err, nil)
 		}
 		err = op.Wait(ctx)
 		if err != nil {
@@ -916,7 +911,7 @@ func updateRedisHost(ctx context.Context, config *Config, d *schema.ResourceData
 		return config.sdk.MDB().Redis().Cluster().UpdateHosts(ctx, request)
 	})
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update host for Redis Cluster %q - host %v: %s", d.Id(), host.HostName, err)
+		return apiError(fmt.Sprintf("error while requesting API to update host for Redis Cluster %q - host %v", d.Id(), host.HostName), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -951,7 +946,7 @@ func deleteRedisShard(ctx context.Context, config *Config, d *schema.ResourceDat
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to delete shard from Redis Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to delete shard from Redis Cluster %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -969,7 +964,7 @@ func deleteRedisHosts(ctx context.Context, config *Config, d *schema.ResourceDat
 			}),
 		)
 		if err != nil {
-			return fmt.Errorf("Error while requesting API to delete host %s from Redis Cluster %q: %s", fqdn, d.Id(), err)
+			return apiError(fmt.Sprintf("Error while requesting API to delete host %s from Redis Cluster %q", fqdn, d.Id()), err, nil)
 		}
 		err = op.Wait(ctx)
 		if err != nil {
@@ -979,15 +974,12 @@ func deleteRedisHosts(ctx context.Context, config *Config, d *schema.ResourceDat
 	return nil
 }
 
-func makeRedisClusterUpdateRequest(req *redis.UpdateClusterRequest, d *schema.ResourceData, meta interface{}) error {
+func makeRedisClusterUpdateRequest(ctx context.Context, req *redis.UpdateClusterRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().Redis().Cluster().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Redis Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update Redis Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -997,7 +989,7 @@ func makeRedisClusterUpdateRequest(req *redis.UpdateClusterRequest, d *schema.Re
 	return nil
 }
 
-func resourceYandexMDBRedisClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBRedisClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Redis Cluster %q", d.Id())
@@ -1006,22 +998,19 @@ func resourceYandexMDBRedisClusterDelete(d *schema.ResourceData, meta interface{
 		ClusterId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().Redis().Cluster().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Redis Cluster %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Redis Cluster %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Redis Cluster %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Redis Cluster %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Redis Cluster %q", d.Id())
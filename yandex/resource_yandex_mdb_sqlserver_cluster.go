@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
@@ -19,12 +20,12 @@ const (
 
 func resourceYandexMDBSQLServerCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBSQLServerClusterCreate,
-		Read:   resourceYandexMDBSQLServerClusterRead,
-		Update: resourceYandexMDBSQLServerClusterUpdate,
-		Delete: resourceYandexMDBSQLServerClusterDelete,
+		CreateContext: resourceYandexMDBSQLServerClusterCreate,
+		ReadContext:   resourceYandexMDBSQLServerClusterRead,
+		UpdateContext: resourceYandexMDBSQLServerClusterUpdate,
+		DeleteContext: resourceYandexMDBSQLServerClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -240,37 +241,35 @@ func resourceYandexMDBSQLServerCluster() *schema.Resource {
 	}
 }
 
-func resourceYandexMDBSQLServerClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBSQLServerClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	req, err := prepareCreateSQLServerRequest(d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().SQLServer().Cluster().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create SQLServer Cluster: %s", err)
+		return errorDiagnostics("Error while requesting API to create SQLServer Cluster", err, mdbSQLServerUpdateFieldsMap)
 	}
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get SQLServer create operation metadata: %s", err)
+		return diag.Errorf("Error while get SQLServer create operation metadata: %s", err)
 	}
 	md, ok := protoMetadata.(*sqlserver.CreateClusterMetadata)
 	if !ok {
-		return fmt.Errorf("Could not get SQLServer Cluster ID from create operation metadata")
+		return diag.Errorf("Could not get SQLServer Cluster ID from create operation metadata")
 	}
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting for operation to create SQLServer Cluster: %s", err)
+		return errorDiagnostics("Error while waiting for operation to create SQLServer Cluster", err, mdbSQLServerUpdateFieldsMap)
 	}
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("SQLServer Cluster creation failed: %s", err)
+		return errorDiagnostics("SQLServer Cluster creation failed", err, mdbSQLServerUpdateFieldsMap)
 	}
-	return resourceYandexMDBSQLServerClusterRead(d, meta)
+	return resourceYandexMDBSQLServerClusterRead(ctx, d, meta)
 }
 
 func prepareCreateSQLServerRequest(d *schema.ResourceData, meta *Config) (*sqlserver.CreateClusterRequest, error) {
@@ -345,17 +344,14 @@ func prepareCreateSQLServerRequest(d *schema.ResourceData, meta *Config) (*sqlse
 	return &req, nil
 }
 
-func resourceYandexMDBSQLServerClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBSQLServerClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	cluster, err := config.sdk.MDB().SQLServer().Cluster().Get(ctx, &sqlserver.GetClusterRequest{
 		ClusterId: d.Id(),
 	})
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Id())))
 	}
 
 	d.Set("folder_id", cluster.GetFolderId())
@@ -368,16 +364,16 @@ func resourceYandexMDBSQLServerClusterRead(d *schema.ResourceData, meta interfac
 	d.Set("version", cluster.GetConfig().GetVersion())
 
 	if err := d.Set("labels", cluster.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("resources", flattenSQLServerResources(cluster.Config.Resources)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	usersSpec, err := listSQLServerUsers(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	passwords := expandSQLServerUserPasswords(d)
@@ -385,36 +381,36 @@ func resourceYandexMDBSQLServerClusterRead(d *schema.ResourceData, meta interfac
 	users, err := flattenSQLServerUsers(usersSpec, passwords)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	sortInterfaceListByResourceData(users, d, "user", "name")
 
 	if err = d.Set("user", users); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("host_group_ids", cluster.HostGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hostsSpec, err := listSQLServerHosts(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	hosts, err := flattenSQLServerHosts(d, hostsSpec)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("host", hosts); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	databasesSpec, err := listSQLServerDatabases(ctx, config, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	databases := flattenSQLServerDatabases(databasesSpec)
@@ -422,27 +418,27 @@ func resourceYandexMDBSQLServerClusterRead(d *schema.ResourceData, meta interfac
 	sortInterfaceListByResourceData(databases, d, "database", "name")
 
 	if err = d.Set("database", databases); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	backupWindowStart := flattenMDBBackupWindowStart(cluster.GetConfig().GetBackupWindowStart())
 	if err = d.Set("backup_window_start", backupWindowStart); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterConfig, err := flattenSQLServerSettings(cluster.Config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("sqlserver_config", clusterConfig); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("deletion_protection", cluster.DeletionProtection)
 	d.Set("sqlcollation", cluster.Sqlcollation)
 
-	return d.Set("created_at", getTimestamp(cluster.CreatedAt))
+	return diag.FromErr(d.Set("created_at", getTimestamp(cluster.CreatedAt)))
 }
 
 func listSQLServerUsers(ctx context.Context, config *Config, id string) ([]*sqlserver.User, error) {
@@ -516,41 +512,41 @@ func listSQLServerDatabases(ctx context.Context, config *Config, id string) ([]*
 	return databases, nil
 }
 
-func resourceYandexMDBSQLServerClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBSQLServerClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
 	defer cancel()
 
 	if d.HasChange("version") {
-		return fmt.Errorf("Changing version is not supported for SQLServer Cluster. Id: %v", d.Id())
+		return diag.Errorf("Changing version is not supported for SQLServer Cluster. Id: %v", d.Id())
 	}
 
 	if d.HasChange("host") {
-		return fmt.Errorf("Changing hosts is not supported for SQLServer Cluster. Id: %v", d.Id())
+		return diag.Errorf("Changing hosts is not supported for SQLServer Cluster. Id: %v", d.Id())
 	}
 
 	if d.HasChange("resources.0.disk_type_id") {
-		return fmt.Errorf("Changing disk_type_id is not supported for SQLServer Cluster. Id: %v", d.Id())
+		return diag.Errorf("Changing disk_type_id is not supported for SQLServer Cluster. Id: %v", d.Id())
 	}
 
 	if err := sqlserverClusterUpdate(ctx, config, d); err != nil {
-		return err
+		return diagnosticsFromError(err)
 	}
 
 	if d.HasChange("database") {
 		if err := sqlserverDatabaseUpdate(ctx, config, d); err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 	}
 
 	if d.HasChange("user") {
 		if err := sqlserverUserUpdate(ctx, config, d); err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 	}
 
-	return resourceYandexMDBSQLServerClusterRead(d, meta)
+	return resourceYandexMDBSQLServerClusterRead(ctx, d, meta)
 }
 
 var mdbSQLServerUpdateFieldsMap = map[string]string{
@@ -599,7 +595,7 @@ func sqlserverClusterUpdate(ctx context.Context, config *Config, d *schema.Resou
 
 	updatePath := []string{}
 	for field, path := range mdbSQLServerUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasChangeWithDefaultLabels(d, field) {
 			updatePath = append(updatePath, path)
 		}
 	}
@@ -618,12 +614,12 @@ func sqlserverClusterUpdate(ctx context.Context, config *Config, d *schema.Resou
 
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().SQLServer().Cluster().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update SQLServer Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update SQLServer Cluster %q", d.Id()), err, mdbSQLServerUpdateFieldsMap)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error while updating SQLServer Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while updating SQLServer Cluster %q", d.Id()), err, mdbSQLServerUpdateFieldsMap)
 	}
 
 	return nil
@@ -692,7 +688,7 @@ func createMDBSQLServerUser(ctx context.Context, config *Config, d *schema.Resou
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create user for SQLServer Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create user for SQLServer Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -712,7 +708,7 @@ func updateSQLServerUser(ctx context.Context, config *Config, d *schema.Resource
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update user in SQLServer Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update user in SQLServer Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -730,7 +726,7 @@ func deleteSQLServerUser(ctx context.Context, config *Config, d *schema.Resource
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete user from SQLServer Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete user from SQLServer Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -748,7 +744,7 @@ func createMDBSQLServerDatabase(ctx context.Context, config *Config, d *schema.R
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create databse for SQLServer Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to create databse for SQLServer Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -766,7 +762,7 @@ func deleteSQLServerDatabase(ctx context.Context, config *Config, d *schema.Reso
 		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to delete databse from SQLServer Cluster %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to delete databse from SQLServer Cluster %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -776,7 +772,7 @@ func deleteSQLServerDatabase(ctx context.Context, config *Config, d *schema.Reso
 	return nil
 }
 
-func resourceYandexMDBSQLServerClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMDBSQLServerClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting SQLServer Cluster %q", d.Id())
@@ -785,22 +781,19 @@ func resourceYandexMDBSQLServerClusterDelete(d *schema.ResourceData, meta interf
 		ClusterId: d.Id(),
 	}
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().SQLServer().Cluster().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("SQLServer Cluster %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("SQLServer Cluster %q", d.Id())))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting SQLServer Cluster %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting SQLServer Cluster %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting SQLServer Cluster %q", d.Id())
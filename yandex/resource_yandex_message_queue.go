@@ -1,6 +1,7 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -14,12 +15,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const yandexMessageQueueDefaultTimeout = 2 * time.Minute
+
 const defaultYMQRegion = "ru-central1"
 
 var sqsQueueAttributeMap = map[string]string{
@@ -36,12 +40,18 @@ var sqsQueueAttributeMap = map[string]string{
 
 func resourceYandexMessageQueue() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMessageQueueCreate,
-		Read:   resourceYandexMessageQueueRead,
-		Update: resourceYandexMessageQueueUpdate,
-		Delete: resourceYandexMessageQueueDelete,
+		CreateContext: resourceYandexMessageQueueCreate,
+		ReadContext:   resourceYandexMessageQueueRead,
+		UpdateContext: resourceYandexMessageQueueUpdate,
+		DeleteContext: resourceYandexMessageQueueDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMessageQueueDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexMessageQueueDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexMessageQueueDefaultTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -136,10 +146,10 @@ func resourceYandexMessageQueue() *schema.Resource {
 	}
 }
 
-func resourceYandexMessageQueueCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMessageQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ymqClient, err := newYMQClient(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var name string
@@ -162,16 +172,16 @@ func resourceYandexMessageQueueCreate(d *schema.ResourceData, meta interface{})
 	contentBasedDeduplication := d.Get("content_based_deduplication").(bool)
 
 	if !isFifo && contentBasedDeduplication {
-		return fmt.Errorf("Content based deduplication can only be set with FIFO queues")
+		return diag.Errorf("Content based deduplication can only be set with FIFO queues")
 	}
 
 	if isFifo {
 		if errors := validateFifoQueueName(name); len(errors) > 0 {
-			return fmt.Errorf("Error validating the FIFO queue name: %v", errors)
+			return diag.Errorf("Error validating the FIFO queue name: %v", errors)
 		}
 	} else {
 		if errors := validateNonFifoQueueName(name); len(errors) > 0 {
-			return fmt.Errorf("Error validating message queue name: %v", errors)
+			return diag.Errorf("Error validating message queue name: %v", errors)
 		}
 	}
 
@@ -208,7 +218,7 @@ func resourceYandexMessageQueueCreate(d *schema.ResourceData, meta interface{})
 	var output *sqs.CreateQueueOutput
 	err = resource.Retry(70*time.Second, func() *resource.RetryError {
 		var err error
-		output, err = ymqClient.CreateQueue(req)
+		output, err = ymqClient.CreateQueueWithContext(ctx, req)
 		if err != nil {
 			if isAWSSQSErr(err, sqs.ErrCodeQueueDeletedRecently) {
 				return resource.RetryableError(err)
@@ -218,20 +228,20 @@ func resourceYandexMessageQueueCreate(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Error creating message queue: %s", err)
+		return errorDiagnostics("Error creating message queue", err, nil)
 	}
 
 	log.Printf("[INFO] Message queue with name %s was created. Queue url: %s", name, *output.QueueUrl)
 
 	d.SetId(aws.StringValue(output.QueueUrl))
 
-	return resourceYandexMessageQueueReadImpl(d, meta, true)
+	return diag.FromErr(resourceYandexMessageQueueReadImpl(ctx, d, meta, true))
 }
 
-func resourceYandexMessageQueueUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMessageQueueUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ymqClient, err := newYMQClient(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	attributes := make(map[string]*string)
@@ -262,37 +272,37 @@ func resourceYandexMessageQueueUpdate(d *schema.ResourceData, meta interface{})
 			QueueUrl:   aws.String(d.Id()),
 			Attributes: attributes,
 		}
-		if _, err := ymqClient.SetQueueAttributes(req); err != nil {
-			return fmt.Errorf("Error updating message queue attributes: %s", err)
+		if _, err := ymqClient.SetQueueAttributesWithContext(ctx, req); err != nil {
+			return errorDiagnostics("Error updating message queue attributes", err, nil)
 		}
 
 		log.Printf("[INFO] New message queue attributes for queue %s were successfully set", d.Id())
 	}
 
-	return resourceYandexMessageQueueReadImpl(d, meta, false)
+	return diag.FromErr(resourceYandexMessageQueueReadImpl(ctx, d, meta, false))
 }
 
-func resourceYandexMessageQueueRead(d *schema.ResourceData, meta interface{}) error {
-	return resourceYandexMessageQueueReadImpl(d, meta, false)
+func resourceYandexMessageQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.FromErr(resourceYandexMessageQueueReadImpl(ctx, d, meta, false))
 }
 
-func resourceYandexMessageQueueDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexMessageQueueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ymqClient, err := newYMQClient(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Delete message queue: %s", d.Id())
-	_, err = ymqClient.DeleteQueue(&sqs.DeleteQueueInput{
+	_, err = ymqClient.DeleteQueueWithContext(ctx, &sqs.DeleteQueueInput{
 		QueueUrl: aws.String(d.Id()),
 	})
 	if err == nil {
 		log.Printf("[INFO] Message queue %s was successfully deleted", d.Id())
 	}
-	return err
+	return diag.FromErr(err)
 }
 
-func resourceYandexMessageQueueReadImpl(d *schema.ResourceData, meta interface{}, assumeQueueCreatedRecently bool) error {
+func resourceYandexMessageQueueReadImpl(ctx context.Context, d *schema.ResourceData, meta interface{}, assumeQueueCreatedRecently bool) error {
 	log.Printf("[DEBUG] Reading message queue %s properties", d.Id())
 	ymqClient, err := newYMQClient(d, meta)
 	if err != nil {
@@ -302,7 +312,7 @@ func resourceYandexMessageQueueReadImpl(d *schema.ResourceData, meta interface{}
 	var attributeOutput *sqs.GetQueueAttributesOutput
 	err = resource.Retry(30*time.Second, func() *resource.RetryError {
 		var err error
-		attributeOutput, err = ymqClient.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		attributeOutput, err = ymqClient.GetQueueAttributesWithContext(ctx, &sqs.GetQueueAttributesInput{
 			QueueUrl:       aws.String(d.Id()),
 			AttributeNames: []*string{aws.String("All")},
 		})
@@ -561,6 +571,7 @@ func newYMQClientConfigFromKeys(accessKey, secretKey string, providerConfig *Con
 		Credentials: credentials.NewStaticCredentials(accessKey, secretKey, ""),
 		Endpoint:    aws.String(providerConfig.YMQEndpoint),
 		Region:      aws.String(providerConfig.Region),
+		HTTPClient:  providerConfig.awsHTTPClient(),
 	}
 }
 
@@ -595,7 +606,13 @@ func newYMQClient(d *schema.ResourceData, meta interface{}) (*sqs.SQS, error) {
 	}
 	log.Printf("[DEBUG] YMQ config: %v", config)
 
-	return newYMQClientFromConfig(config)
+	client, err := newYMQClientFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	meta.(*Config).initAWSHandlers(&client.Handlers)
+
+	return client, nil
 }
 
 func regionFromYRN(yrn string) (string, error) {
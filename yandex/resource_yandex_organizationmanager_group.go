@@ -0,0 +1,202 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const yandexOrganizationManagerGroupDefaultTimeout = 1 * time.Minute
+
+func resourceYandexOrganizationManagerGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceYandexOrganizationManagerGroupCreate,
+		ReadContext:   resourceYandexOrganizationManagerGroupRead,
+		UpdateContext: resourceYandexOrganizationManagerGroupUpdate,
+		DeleteContext: resourceYandexOrganizationManagerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexOrganizationManagerGroupDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexOrganizationManagerGroupDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexOrganizationManagerGroupDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexOrganizationManagerGroupDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"organization_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceYandexOrganizationManagerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	organizationID, err := getOrganizationID(d, config)
+	if err != nil {
+		return errorDiagnostics("Error getting organization ID while creating Group", err, updateOrganizationManagerGroupFieldsMap)
+	}
+
+	groups, err := config.organizationManagerGroups(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := organizationmanager.CreateGroupRequest{
+		OrganizationId: organizationID,
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+	}
+
+	op, err := config.sdk.WrapOperation(groups.Create(ctx, &req))
+	if err != nil {
+		return errorDiagnostics("Error while requesting API to create Group", err, updateOrganizationManagerGroupFieldsMap)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return diag.Errorf("Error while get Group create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*organizationmanager.CreateGroupMetadata)
+	if !ok {
+		return diag.Errorf("could not get Group ID from create operation metadata")
+	}
+
+	d.SetId(md.GroupId)
+
+	err = waitResumableOperation(ctx, d, op)
+	if err != nil {
+		return errorDiagnostics("Error while waiting operation to create Group", err, updateOrganizationManagerGroupFieldsMap)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return errorDiagnostics("Group creation failed", err, updateOrganizationManagerGroupFieldsMap)
+	}
+
+	return resourceYandexOrganizationManagerGroupRead(ctx, d, meta)
+}
+
+func resourceYandexOrganizationManagerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	groups, err := config.organizationManagerGroups(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group, err := groups.Get(ctx, &organizationmanager.GetGroupRequest{GroupId: d.Id()})
+	if err != nil {
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Group %q", d.Id())))
+	}
+
+	d.Set("created_at", getTimestamp(group.CreatedAt))
+	d.Set("name", group.Name)
+	d.Set("organization_id", group.OrganizationId)
+	d.Set("description", group.Description)
+
+	return nil
+}
+
+var updateOrganizationManagerGroupFieldsMap = map[string]string{
+	"name":        "name",
+	"description": "description",
+}
+
+func resourceYandexOrganizationManagerGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	groups, err := config.organizationManagerGroups(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &organizationmanager.UpdateGroupRequest{
+		GroupId:     d.Id(),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	var updatePath []string
+	for field, path := range updateOrganizationManagerGroupFieldsMap {
+		if d.HasChange(field) {
+			updatePath = append(updatePath, path)
+		}
+	}
+
+	req.UpdateMask = &field_mask.FieldMask{Paths: updatePath}
+	if len(req.UpdateMask.Paths) == 0 {
+		return diag.Errorf("No fields were updated for Group %s", d.Id())
+	}
+
+	op, err := config.sdk.WrapOperation(groups.Update(ctx, req))
+	if err != nil {
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to update Group %q", d.Id()), err, updateOrganizationManagerGroupFieldsMap)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return errorDiagnostics(fmt.Sprintf("Error updating Group %q", d.Id()), err, updateOrganizationManagerGroupFieldsMap)
+	}
+
+	return resourceYandexOrganizationManagerGroupRead(ctx, d, meta)
+}
+
+func resourceYandexOrganizationManagerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting Group %q", d.Id())
+
+	groups, err := config.organizationManagerGroups(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	op, err := config.sdk.WrapOperation(groups.Delete(ctx, &organizationmanager.DeleteGroupRequest{GroupId: d.Id()}))
+	if err != nil {
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Group %q", d.Id())))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return errorDiagnostics(fmt.Sprintf("error deleting Group %q", d.Id()), err, nil)
+	}
+
+	_, err = op.Response()
+	if err != nil {
+		return errorDiagnostics(fmt.Sprintf("error deleting Group %q", d.Id()), err, nil)
+	}
+
+	log.Printf("[DEBUG] Finished deleting Group %q", d.Id())
+	return nil
+}
@@ -0,0 +1,109 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+	"google.golang.org/grpc/codes"
+)
+
+const yandexOrganizationManagerGroupMemberDefaultTimeout = 1 * time.Minute
+
+func resourceYandexOrganizationManagerGroupMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceYandexOrganizationManagerGroupMemberCreate,
+		ReadContext:   resourceYandexOrganizationManagerGroupMemberRead,
+		DeleteContext: resourceYandexOrganizationManagerGroupMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexOrganizationManagerGroupMemberImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexOrganizationManagerGroupMemberDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexOrganizationManagerGroupMemberDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexOrganizationManagerGroupMemberDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceYandexOrganizationManagerGroupMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	groupID := d.Get("group_id").(string)
+	member := d.Get("member").(string)
+
+	deltas := []*organizationmanager.MemberDelta{{Action: organizationmanager.MemberDelta_ADD, SubjectId: member}}
+	if err := updateOrganizationManagerGroupMembers(ctx, config, groupID, deltas); err != nil {
+		return diag.Errorf("Error adding member %q to Group %q: %s", member, groupID, err)
+	}
+
+	d.SetId(groupID + "/" + member)
+	return resourceYandexOrganizationManagerGroupMemberRead(ctx, d, meta)
+}
+
+func resourceYandexOrganizationManagerGroupMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	groupID := d.Get("group_id").(string)
+	member := d.Get("member").(string)
+
+	members, err := listOrganizationManagerGroupMembers(ctx, config, groupID)
+	if err != nil {
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Member %q of Group %q", member, groupID)))
+	}
+
+	if !members.Contains(member) {
+		log.Printf("[WARN] Removing Member %q of Group %q because it is not a member anymore", member, groupID)
+		d.SetId("")
+	}
+	return nil
+}
+
+func resourceYandexOrganizationManagerGroupMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	groupID := d.Get("group_id").(string)
+	member := d.Get("member").(string)
+
+	log.Printf("[DEBUG] Deleting Member %q of Group %q", member, groupID)
+
+	deltas := []*organizationmanager.MemberDelta{{Action: organizationmanager.MemberDelta_REMOVE, SubjectId: member}}
+	err := updateOrganizationManagerGroupMembers(ctx, config, groupID, deltas)
+	if err != nil && !isStatusWithCode(err, codes.NotFound) {
+		return diag.Errorf("Error removing member %q from Group %q: %s", member, groupID, err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting Member %q of Group %q", member, groupID)
+	return nil
+}
+
+// resourceYandexOrganizationManagerGroupMemberImport takes the ID in `group_id/member` format.
+func resourceYandexOrganizationManagerGroupMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected format is group_id/member", d.Id())
+	}
+
+	d.Set("group_id", parts[0])
+	d.Set("member", parts[1])
+	return []*schema.ResourceData{d}, nil
+}
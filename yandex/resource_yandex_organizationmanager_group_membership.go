@@ -0,0 +1,190 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/pagination"
+)
+
+const yandexOrganizationManagerGroupMembershipDefaultTimeout = 1 * time.Minute
+
+func resourceYandexOrganizationManagerGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceYandexOrganizationManagerGroupMembershipCreate,
+		ReadContext:   resourceYandexOrganizationManagerGroupMembershipRead,
+		UpdateContext: resourceYandexOrganizationManagerGroupMembershipUpdate,
+		DeleteContext: resourceYandexOrganizationManagerGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexOrganizationManagerGroupMembershipImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexOrganizationManagerGroupMembershipDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexOrganizationManagerGroupMembershipDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexOrganizationManagerGroupMembershipDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexOrganizationManagerGroupMembershipDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"members": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceYandexOrganizationManagerGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	groupID := d.Get("group_id").(string)
+
+	// Members added outside of Terraform are removed as well, the resource is authoritative.
+	current, err := listOrganizationManagerGroupMembers(ctx, config, groupID)
+	if err != nil {
+		return diag.Errorf("Error reading members of Group %q: %s", groupID, err)
+	}
+
+	deltas := organizationManagerGroupMembershipDeltas(current, d.Get("members").(*schema.Set))
+	if err := updateOrganizationManagerGroupMembers(ctx, config, groupID, deltas); err != nil {
+		return errorDiagnostics(fmt.Sprintf("Error updating members of Group %q", groupID), err, nil)
+	}
+
+	d.SetId(groupID)
+	return resourceYandexOrganizationManagerGroupMembershipRead(ctx, d, meta)
+}
+
+func resourceYandexOrganizationManagerGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	members, err := listOrganizationManagerGroupMembers(ctx, config, d.Id())
+	if err != nil {
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Members of Group %q", d.Id())))
+	}
+
+	d.Set("group_id", d.Id())
+	return diag.FromErr(d.Set("members", members))
+}
+
+func resourceYandexOrganizationManagerGroupMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	o, n := d.GetChange("members")
+	deltas := organizationManagerGroupMembershipDeltas(o.(*schema.Set), n.(*schema.Set))
+	if err := updateOrganizationManagerGroupMembers(ctx, config, d.Id(), deltas); err != nil {
+		return errorDiagnostics(fmt.Sprintf("Error updating members of Group %q", d.Id()), err, nil)
+	}
+
+	return resourceYandexOrganizationManagerGroupMembershipRead(ctx, d, meta)
+}
+
+func resourceYandexOrganizationManagerGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting members of Group %q", d.Id())
+
+	deltas := organizationManagerGroupMemberDeltas(organizationmanager.MemberDelta_REMOVE, d.Get("members").(*schema.Set))
+	err := updateOrganizationManagerGroupMembers(ctx, config, d.Id(), deltas)
+	if err != nil && !isStatusWithCode(err, codes.NotFound) {
+		return errorDiagnostics(fmt.Sprintf("Error deleting members of Group %q", d.Id()), err, nil)
+	}
+
+	log.Printf("[DEBUG] Finished deleting members of Group %q", d.Id())
+	return nil
+}
+
+func resourceYandexOrganizationManagerGroupMembershipImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("group_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+// listOrganizationManagerGroupMembers returns IDs of all members of the group.
+func listOrganizationManagerGroupMembers(ctx context.Context, config *Config, groupID string) (*schema.Set, error) {
+	members, err := listOrganizationManagerGroupMembersWithTypes(ctx, config, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := schema.NewSet(schema.HashString, nil)
+	for _, member := range members {
+		ids.Add(member.SubjectId)
+	}
+	return ids, nil
+}
+
+func listOrganizationManagerGroupMembersWithTypes(ctx context.Context, config *Config, groupID string) ([]*organizationmanager.GroupMember, error) {
+	groups, err := config.organizationManagerGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := pagination.List(ctx, func(ctx context.Context, pageToken string) (proto.Message, error) {
+		return groups.ListMembers(ctx, &organizationmanager.ListGroupMembersRequest{
+			GroupId:   groupID,
+			PageSize:  pagination.PageSize,
+			PageToken: pageToken,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*organizationmanager.GroupMember, 0, len(list))
+	for _, item := range list {
+		members = append(members, item.(*organizationmanager.GroupMember))
+	}
+	return members, nil
+}
+
+func updateOrganizationManagerGroupMembers(ctx context.Context, config *Config, groupID string, deltas []*organizationmanager.MemberDelta) error {
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	groups, err := config.organizationManagerGroups(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating members of Group %q with %d deltas", groupID, len(deltas))
+	op, err := config.sdk.WrapOperation(groups.UpdateMembers(ctx, &organizationmanager.UpdateGroupMembersRequest{
+		GroupId:      groupID,
+		MemberDeltas: deltas,
+	}))
+	if err != nil {
+		return err
+	}
+	return op.Wait(ctx)
+}
+
+// organizationManagerGroupMembershipDeltas returns deltas changing the members of a group from one set to another.
+func organizationManagerGroupMembershipDeltas(from, to *schema.Set) []*organizationmanager.MemberDelta {
+	deltas := organizationManagerGroupMemberDeltas(organizationmanager.MemberDelta_REMOVE, from.Difference(to))
+	return append(deltas, organizationManagerGroupMemberDeltas(organizationmanager.MemberDelta_ADD, to.Difference(from))...)
+}
+
+func organizationManagerGroupMemberDeltas(action organizationmanager.MemberDelta_MemberAction, members *schema.Set) []*organizationmanager.MemberDelta {
+	var deltas []*organizationmanager.MemberDelta
+	for _, member := range members.List() {
+		deltas = append(deltas, &organizationmanager.MemberDelta{Action: action, SubjectId: member.(string)})
+	}
+	return deltas
+}
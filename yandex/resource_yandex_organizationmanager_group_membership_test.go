@@ -0,0 +1,80 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+func TestOrganizationManagerGroupMembership_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t, fakecloud.WithMaxPageSize(1))
+	group := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_organizationmanager_group"), nil, map[string]interface{}{
+		"name":            "developers",
+		"organization_id": fakecloud.OrganizationID,
+	})
+
+	// The member added outside of Terraform is removed by the authoritative resource.
+	require.NoError(t, updateOrganizationManagerGroupMembers(context.Background(), config, group.ID, []*organizationmanager.MemberDelta{
+		{Action: organizationmanager.MemberDelta_ADD, SubjectId: "stranger"},
+	}))
+
+	r := fakeCloudResource(t, "yandex_organizationmanager_group_membership")
+	raw := map[string]interface{}{
+		"group_id": group.ID,
+		"members":  []interface{}{"alice", "bob"},
+	}
+	state := fakeCloudApply(t, config, r, nil, raw)
+	assert.Equal(t, group.ID, state.ID)
+	assertOrganizationManagerGroupMembers(t, config, group.ID, "alice", "bob")
+
+	imported := fakeCloudImport(t, config, r, group.ID)
+	assert.Equal(t, state.Attributes, imported.Attributes)
+
+	raw["members"] = []interface{}{"bob", "carol"}
+	state = fakeCloudApply(t, config, r, state, raw)
+	assertOrganizationManagerGroupMembers(t, config, group.ID, "bob", "carol")
+
+	fakeCloudDestroy(t, config, r, state)
+	assertOrganizationManagerGroupMembers(t, config, group.ID)
+}
+
+func TestOrganizationManagerGroupMember_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	group := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_organizationmanager_group"), nil, map[string]interface{}{
+		"name":            "developers",
+		"organization_id": fakecloud.OrganizationID,
+	})
+	robot := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_iam_service_account"), nil, map[string]interface{}{
+		"name": "robot",
+	})
+
+	r := fakeCloudResource(t, "yandex_organizationmanager_group_member")
+	alice := fakeCloudApply(t, config, r, nil, map[string]interface{}{"group_id": group.ID, "member": "alice"})
+	fakeCloudApply(t, config, r, nil, map[string]interface{}{"group_id": group.ID, "member": robot.ID})
+	assert.Equal(t, group.ID+"/alice", alice.ID)
+	assertOrganizationManagerGroupMembers(t, config, group.ID, "alice", robot.ID)
+
+	imported := fakeCloudImport(t, config, r, alice.ID)
+	assert.Equal(t, alice.Attributes, imported.Attributes)
+
+	// Other members are kept by the non-authoritative resource.
+	fakeCloudDestroy(t, config, r, alice)
+	assertOrganizationManagerGroupMembers(t, config, group.ID, robot.ID)
+	assert.Nil(t, fakeCloudRefresh(t, config, r, alice))
+}
+
+func assertOrganizationManagerGroupMembers(t *testing.T, config *Config, groupID string, expected ...string) {
+	members, err := listOrganizationManagerGroupMembers(context.Background(), config, groupID)
+	require.NoError(t, err)
+
+	var actual []string
+	for _, member := range members.List() {
+		actual = append(actual, member.(string))
+	}
+	assert.ElementsMatch(t, expected, actual)
+}
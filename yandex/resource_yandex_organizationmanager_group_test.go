@@ -0,0 +1,87 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+func TestOrganizationManagerGroup_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	config.OrganizationID = fakecloud.OrganizationID
+	r := fakeCloudResource(t, "yandex_organizationmanager_group")
+	raw := map[string]interface{}{
+		"name":        "developers",
+		"description": "created offline",
+	}
+
+	state := fakeCloudApply(t, config, r, nil, raw)
+	assert.Equal(t, "developers", state.Attributes["name"])
+	assert.Equal(t, fakecloud.OrganizationID, state.Attributes["organization_id"])
+	assert.NotEmpty(t, state.Attributes["created_at"])
+
+	imported := fakeCloudImport(t, config, r, state.ID)
+	assert.Equal(t, state.Attributes, imported.Attributes)
+
+	raw["description"] = "updated offline"
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.Equal(t, "updated offline", state.Attributes["description"])
+
+	// The group deleted outside of Terraform is removed from the state on refresh.
+	groups, err := config.organizationManagerGroups(context.Background())
+	require.NoError(t, err)
+	op, err := config.sdk.WrapOperation(groups.Delete(context.Background(), &organizationmanager.DeleteGroupRequest{GroupId: state.ID}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(context.Background()))
+	assert.Nil(t, fakeCloudRefresh(t, config, r, state))
+}
+
+func TestOrganizationManagerGroupAsIAMMember_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	group := fakeCloudApply(t, config, fakeCloudResource(t, "yandex_organizationmanager_group"), nil, map[string]interface{}{
+		"name":            "auditors",
+		"organization_id": fakecloud.OrganizationID,
+	})
+
+	r := fakeCloudResource(t, "yandex_resourcemanager_folder_iam_member")
+	member := fakeCloudApply(t, config, r, nil, map[string]interface{}{
+		"folder_id": fakecloud.FolderID,
+		"role":      "viewer",
+		"member":    "group:" + group.ID,
+	})
+	assert.Equal(t, "group:"+group.ID, member.Attributes["member"])
+	assert.NotNil(t, fakeCloudRefresh(t, config, r, member))
+}
+
+func TestOrganizationManagerConnClosedOnStop_fakeCloud(t *testing.T) {
+	server, err := fakecloud.Start()
+	require.NoError(t, err)
+	t.Cleanup(server.Stop)
+
+	stopCtx, stop := context.WithCancel(context.Background())
+	defer stop()
+	config := &Config{
+		Endpoint:  server.Addr(),
+		Plaintext: true,
+		Token:     fakecloud.Token,
+		CloudID:   fakecloud.CloudID,
+		FolderID:  fakecloud.FolderID,
+		Zone:      testConfigZone,
+	}
+	require.NoError(t, config.initAndValidate(stopCtx, testTerraformVersion, false))
+
+	_, err = config.organizationManagerGroups(context.Background())
+	require.NoError(t, err)
+
+	stop()
+	assert.Eventually(t, func() bool {
+		_, err := config.organizationManagerGroups(context.Background())
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1/saml"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -17,12 +18,12 @@ const yandexOrganizationManagerSamlFederationDefaultTimeout = 1 * time.Minute
 
 func resourceYandexOrganizationManagerSamlFederation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexOrganizationManagerSamlFederationCreate,
-		Read:   resourceYandexOrganizationManagerSamlFederationRead,
-		Update: resourceYandexOrganizationManagerSamlFederationUpdate,
-		Delete: resourceYandexOrganizationManagerSamlFederationDelete,
+		CreateContext: resourceYandexOrganizationManagerSamlFederationCreate,
+		ReadContext:   resourceYandexOrganizationManagerSamlFederationRead,
+		UpdateContext: resourceYandexOrganizationManagerSamlFederationUpdate,
+		DeleteContext: resourceYandexOrganizationManagerSamlFederationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -149,27 +150,27 @@ func flattenSamlFederationSecuritySettings(fss *saml.FederationSecuritySettings)
 	}
 }
 
-func resourceYandexOrganizationManagerSamlFederationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexOrganizationManagerSamlFederationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	organizationID, err := getOrganizationID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting organization ID while creating SAML Federation: %s", err)
+		return errorDiagnostics("Error getting organization ID while creating SAML Federation", err, updateSamlFederationFieldsMap)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating SAML Federation: %s", err)
+		return diag.Errorf("Error expanding labels while creating SAML Federation: %s", err)
 	}
 
 	ssoBinding, err := getSamlFederationSSOBinding(d)
 	if err != nil {
-		return fmt.Errorf("Error getting SAML Federation SSO binding: %s", err)
+		return diag.Errorf("Error getting SAML Federation SSO binding: %s", err)
 	}
 
 	cookieMaxAge, err := parseDuration(d.Get("cookie_max_age").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	req := saml.CreateFederationRequest{
@@ -186,46 +187,43 @@ func resourceYandexOrganizationManagerSamlFederationCreate(d *schema.ResourceDat
 		SecuritySettings:         getSamlFederationSecuritySettings(d),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.OrganizationManagerSAML().Federation().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create SAML Federation: %s", err)
+		return errorDiagnostics("Error while requesting API to create SAML Federation", err, updateSamlFederationFieldsMap)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get SAML Federation create operation metadata: %s", err)
+		return diag.Errorf("Error while get SAML Federation create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*saml.CreateFederationMetadata)
 	if !ok {
-		return fmt.Errorf("could not get SAML Federation ID from create operation metadata")
+		return diag.Errorf("could not get SAML Federation ID from create operation metadata")
 	}
 
 	d.SetId(md.FederationId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create SAML Federation: %s", err)
+		return errorDiagnostics("Error while waiting operation to create SAML Federation", err, updateSamlFederationFieldsMap)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("SAML Federation creation failed: %s", err)
+		return errorDiagnostics("SAML Federation creation failed", err, updateSamlFederationFieldsMap)
 	}
 
-	return resourceYandexOrganizationManagerSamlFederationRead(d, meta)
+	return resourceYandexOrganizationManagerSamlFederationRead(ctx, d, meta)
 }
 
-func resourceYandexOrganizationManagerSamlFederationRead(d *schema.ResourceData, meta interface{}) error {
-	return flattenSamlFederation(d.Id(), d, meta)
+func resourceYandexOrganizationManagerSamlFederationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.FromErr(flattenSamlFederation(ctx, d.Id(), d, meta))
 }
 
-func flattenSamlFederation(federationID string, d *schema.ResourceData, meta interface{}) error {
+func flattenSamlFederation(ctx context.Context, federationID string, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	federation, err := config.sdk.OrganizationManagerSAML().Federation().Get(context.Background(),
+	federation, err := config.sdk.OrganizationManagerSAML().Federation().Get(ctx,
 		&saml.GetFederationRequest{
 			FederationId: federationID,
 		})
@@ -261,20 +259,20 @@ var updateSamlFederationFieldsMap = map[string]string{
 	"security_settings.0.encrypted_assertions": "security_settings",
 }
 
-func resourceYandexOrganizationManagerSamlFederationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexOrganizationManagerSamlFederationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	labelsProp, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ssoBinding, err := getSamlFederationSSOBinding(d)
 	if err != nil {
-		return fmt.Errorf("Error getting SAML Federation SSO binding: %s", err)
+		return diag.Errorf("Error getting SAML Federation SSO binding: %s", err)
 	}
 
 	cookieMaxAge, err := parseDuration(d.Get("cookie_max_age").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	req := &saml.UpdateFederationRequest{
@@ -294,25 +292,25 @@ func resourceYandexOrganizationManagerSamlFederationUpdate(d *schema.ResourceDat
 
 	var updatePath []string
 	for field, path := range updateSamlFederationFieldsMap {
-		if d.HasChange(field) {
+		if hasChangeWithDefaultLabels(d, field) {
 			updatePath = append(updatePath, path)
 		}
 	}
 
 	req.UpdateMask = &field_mask.FieldMask{Paths: updatePath}
 	if len(req.UpdateMask.Paths) == 0 {
-		return fmt.Errorf("No fields were updated for SAML Federation %s", d.Id())
+		return diag.Errorf("No fields were updated for SAML Federation %s", d.Id())
 	}
 
-	err = makeSamlFederationUpdateRequest(req, d, meta)
+	err = makeSamlFederationUpdateRequest(ctx, req, d, meta)
 	if err != nil {
-		return err
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexOrganizationManagerSamlFederationRead(d, meta)
+	return resourceYandexOrganizationManagerSamlFederationRead(ctx, d, meta)
 }
 
-func resourceYandexOrganizationManagerSamlFederationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexOrganizationManagerSamlFederationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting SAML Federation %q", d.Id())
@@ -321,42 +319,36 @@ func resourceYandexOrganizationManagerSamlFederationDelete(d *schema.ResourceDat
 		FederationId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.OrganizationManagerSAML().Federation().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("SAML Federation %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("SAML Federation %q", d.Id())))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting SAML Federation %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting SAML Federation %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting SAML Federation %q", d.Id())
 	return nil
 }
 
-func makeSamlFederationUpdateRequest(req *saml.UpdateFederationRequest, d *schema.ResourceData, meta interface{}) error {
+func makeSamlFederationUpdateRequest(ctx context.Context, req *saml.UpdateFederationRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.OrganizationManagerSAML().Federation().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update SAML Federation %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update SAML Federation %q", d.Id()), err, updateSamlFederationFieldsMap)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error updating SAML Federation %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error updating SAML Federation %q", d.Id()), err, updateSamlFederationFieldsMap)
 	}
 
 	return nil
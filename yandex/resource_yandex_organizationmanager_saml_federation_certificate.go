@@ -0,0 +1,201 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1/saml"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const yandexOrganizationManagerSamlFederationCertificateDefaultTimeout = 1 * time.Minute
+
+func resourceYandexOrganizationManagerSamlFederationCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceYandexOrganizationManagerSamlFederationCertificateCreate,
+		ReadContext:   resourceYandexOrganizationManagerSamlFederationCertificateRead,
+		UpdateContext: resourceYandexOrganizationManagerSamlFederationCertificateUpdate,
+		DeleteContext: resourceYandexOrganizationManagerSamlFederationCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexOrganizationManagerSamlFederationCertificateDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexOrganizationManagerSamlFederationCertificateDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexOrganizationManagerSamlFederationCertificateDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexOrganizationManagerSamlFederationCertificateDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"federation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"data": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: shouldSuppressDiffForSamlFederationCertificateData,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// shouldSuppressDiffForSamlFederationCertificateData ignores leading and trailing whitespace of PEM data,
+// which is usually read from files ending with a newline.
+func shouldSuppressDiffForSamlFederationCertificateData(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+func resourceYandexOrganizationManagerSamlFederationCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	req := saml.CreateCertificateRequest{
+		FederationId: d.Get("federation_id").(string),
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		Data:         d.Get("data").(string),
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.OrganizationManagerSAML().Certificate().Create(ctx, &req))
+	if err != nil {
+		return errorDiagnostics("Error while requesting API to create SAML Federation Certificate", err, updateSamlFederationCertificateFieldsMap)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return diag.Errorf("Error while get SAML Federation Certificate create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*saml.CreateCertificateMetadata)
+	if !ok {
+		return diag.Errorf("could not get SAML Federation Certificate ID from create operation metadata")
+	}
+
+	d.SetId(md.CertificateId)
+
+	err = waitResumableOperation(ctx, d, op)
+	if err != nil {
+		return errorDiagnostics("Error while waiting operation to create SAML Federation Certificate", err, updateSamlFederationCertificateFieldsMap)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return errorDiagnostics("SAML Federation Certificate creation failed", err, updateSamlFederationCertificateFieldsMap)
+	}
+
+	return resourceYandexOrganizationManagerSamlFederationCertificateRead(ctx, d, meta)
+}
+
+func resourceYandexOrganizationManagerSamlFederationCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	certificate, err := config.sdk.OrganizationManagerSAML().Certificate().Get(ctx, &saml.GetCertificateRequest{
+		CertificateId: d.Id(),
+	})
+	if err != nil {
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("SAML Federation Certificate %q", d.Id())))
+	}
+
+	d.Set("created_at", getTimestamp(certificate.CreatedAt))
+	d.Set("federation_id", certificate.FederationId)
+	d.Set("name", certificate.Name)
+	d.Set("description", certificate.Description)
+	d.Set("data", certificate.Data)
+
+	return nil
+}
+
+var updateSamlFederationCertificateFieldsMap = map[string]string{
+	"name":        "name",
+	"description": "description",
+	"data":        "data",
+}
+
+func resourceYandexOrganizationManagerSamlFederationCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	req := &saml.UpdateCertificateRequest{
+		CertificateId: d.Id(),
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		Data:          d.Get("data").(string),
+	}
+
+	var updatePath []string
+	for field, path := range updateSamlFederationCertificateFieldsMap {
+		if d.HasChange(field) {
+			updatePath = append(updatePath, path)
+		}
+	}
+
+	req.UpdateMask = &field_mask.FieldMask{Paths: updatePath}
+	if len(req.UpdateMask.Paths) == 0 {
+		return diag.Errorf("No fields were updated for SAML Federation Certificate %s", d.Id())
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.OrganizationManagerSAML().Certificate().Update(ctx, req))
+	if err != nil {
+		return diagnosticsFromError(apiError(fmt.Sprintf("Error while requesting API to update SAML Federation Certificate %q", d.Id()), err, updateSamlFederationCertificateFieldsMap))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return diagnosticsFromError(apiError(fmt.Sprintf("Error updating SAML Federation Certificate %q", d.Id()), err, updateSamlFederationCertificateFieldsMap))
+	}
+
+	return resourceYandexOrganizationManagerSamlFederationCertificateRead(ctx, d, meta)
+}
+
+func resourceYandexOrganizationManagerSamlFederationCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting SAML Federation Certificate %q", d.Id())
+
+	req := &saml.DeleteCertificateRequest{
+		CertificateId: d.Id(),
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.OrganizationManagerSAML().Certificate().Delete(ctx, req))
+	if err != nil {
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("SAML Federation Certificate %q", d.Id())))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return errorDiagnostics(fmt.Sprintf("error deleting SAML Federation Certificate %q", d.Id()), err, nil)
+	}
+
+	_, err = op.Response()
+	if err != nil {
+		return errorDiagnostics(fmt.Sprintf("error deleting SAML Federation Certificate %q", d.Id()), err, nil)
+	}
+
+	log.Printf("[DEBUG] Finished deleting SAML Federation Certificate %q", d.Id())
+	return nil
+}
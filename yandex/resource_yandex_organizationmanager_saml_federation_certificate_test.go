@@ -0,0 +1,60 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1/saml"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+func TestSamlFederationCertificate_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	federation := fakeCloudSamlFederation(t, config)
+
+	r := fakeCloudResource(t, "yandex_organizationmanager_saml_federation_certificate")
+	raw := map[string]interface{}{
+		"federation_id": federation.ID,
+		"name":          "idp-2022",
+		"data":          "-----BEGIN CERTIFICATE-----\nold\n-----END CERTIFICATE-----\n",
+	}
+	state := fakeCloudApply(t, config, r, nil, raw)
+	assert.Equal(t, federation.ID, state.Attributes["federation_id"])
+	assert.NotEmpty(t, state.Attributes["created_at"])
+
+	// Whitespace around PEM data is not a change.
+	raw["data"] = "-----BEGIN CERTIFICATE-----\nold\n-----END CERTIFICATE-----"
+	assert.True(t, fakeCloudPlan(t, config, r, state, raw).Empty())
+
+	imported := fakeCloudImport(t, config, r, state.ID)
+	assert.Equal(t, state.Attributes, imported.Attributes)
+
+	// The certificate is updated in place on rollover of the IdP key.
+	id := state.ID
+	raw["name"] = "idp-2023"
+	raw["data"] = "-----BEGIN CERTIFICATE-----\nnew\n-----END CERTIFICATE-----\n"
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.Equal(t, id, state.ID)
+	assert.Equal(t, "idp-2023", state.Attributes["name"])
+
+	certificate, err := config.sdk.OrganizationManagerSAML().Certificate().Get(context.Background(), &saml.GetCertificateRequest{CertificateId: id})
+	require.NoError(t, err)
+	assert.Equal(t, raw["data"], certificate.Data)
+
+	fakeCloudDestroy(t, config, r, state)
+	assert.Nil(t, fakeCloudRefresh(t, config, r, state))
+}
+
+func fakeCloudSamlFederation(t *testing.T, config *Config) *terraform.InstanceState {
+	return fakeCloudApply(t, config, fakeCloudResource(t, "yandex_organizationmanager_saml_federation"), nil, map[string]interface{}{
+		"name":            "idp",
+		"organization_id": fakecloud.OrganizationID,
+		"issuer":          "http://idp.example.com",
+		"sso_binding":     "POST",
+		"sso_url":         "https://idp.example.com/sso",
+	})
+}
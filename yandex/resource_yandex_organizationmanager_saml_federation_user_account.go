@@ -0,0 +1,144 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1/saml"
+	"google.golang.org/grpc/codes"
+)
+
+const yandexOrganizationManagerSamlFederationUserAccountDefaultTimeout = 1 * time.Minute
+
+func resourceYandexOrganizationManagerSamlFederationUserAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceYandexOrganizationManagerSamlFederationUserAccountCreate,
+		ReadContext:   resourceYandexOrganizationManagerSamlFederationUserAccountRead,
+		DeleteContext: resourceYandexOrganizationManagerSamlFederationUserAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexOrganizationManagerSamlFederationUserAccountDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexOrganizationManagerSamlFederationUserAccountDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexOrganizationManagerSamlFederationUserAccountDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"federation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceYandexOrganizationManagerSamlFederationUserAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	federationID := d.Get("federation_id").(string)
+	nameID := d.Get("name_id").(string)
+
+	// The account of a known name ID is returned as is, so the user who has already logged in is adopted.
+	op, err := config.sdk.WrapOperation(config.sdk.OrganizationManagerSAML().Federation().AddUserAccounts(ctx, &saml.AddFederatedUserAccountsRequest{
+		FederationId: federationID,
+		NameIds:      []string{nameID},
+	}))
+	if err != nil {
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to add user account %q to SAML Federation %q", nameID, federationID), err, nil)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return errorDiagnostics(fmt.Sprintf("Error while waiting operation to add user account %q to SAML Federation %q", nameID, federationID), err, nil)
+	}
+
+	rawResp, err := op.Response()
+	if err != nil {
+		return errorDiagnostics(fmt.Sprintf("Adding user account %q to SAML Federation %q failed", nameID, federationID), err, nil)
+	}
+
+	resp, ok := rawResp.(*saml.AddFederatedUserAccountsResponse)
+	if !ok || len(resp.UserAccounts) == 0 {
+		return diag.Errorf("could not get user account ID from add user accounts operation response")
+	}
+
+	d.SetId(resp.UserAccounts[0].Id)
+
+	return resourceYandexOrganizationManagerSamlFederationUserAccountRead(ctx, d, meta)
+}
+
+func resourceYandexOrganizationManagerSamlFederationUserAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	account, err := config.sdk.IAM().UserAccount().Get(ctx, &iam.GetUserAccountRequest{
+		UserAccountId: d.Id(),
+	})
+	if err != nil {
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("SAML Federation user account %q", d.Id())))
+	}
+
+	samlAccount := account.GetSamlUserAccount()
+	if samlAccount == nil {
+		return diag.Errorf("user account %q is not a SAML federation user account", d.Id())
+	}
+
+	d.Set("federation_id", samlAccount.FederationId)
+	d.Set("name_id", samlAccount.NameId)
+
+	return nil
+}
+
+// resourceYandexOrganizationManagerSamlFederationUserAccountDelete removes the user from the organization of
+// the federation, the API has no way to delete a federated user account otherwise.
+func resourceYandexOrganizationManagerSamlFederationUserAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting SAML Federation user account %q", d.Id())
+
+	federationID := d.Get("federation_id").(string)
+	federation, err := config.sdk.OrganizationManagerSAML().Federation().Get(ctx, &saml.GetFederationRequest{
+		FederationId: federationID,
+	})
+	if err != nil {
+		// Accounts of a deleted federation are gone along with it.
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("SAML Federation %q", federationID)))
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.OrganizationManager().User().DeleteMembership(ctx, &organizationmanager.DeleteMembershipRequest{
+		OrganizationId: federation.OrganizationId,
+		SubjectId:      d.Id(),
+	}))
+	if err != nil {
+		if isStatusWithCode(err, codes.NotFound) {
+			return nil
+		}
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to delete SAML Federation user account %q", d.Id()), err, nil)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return errorDiagnostics(fmt.Sprintf("Error deleting SAML Federation user account %q", d.Id()), err, nil)
+	}
+
+	log.Printf("[DEBUG] Finished deleting SAML Federation user account %q", d.Id())
+	return nil
+}
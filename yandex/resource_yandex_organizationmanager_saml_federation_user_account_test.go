@@ -0,0 +1,36 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSamlFederationUserAccount_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	federation := fakeCloudSamlFederation(t, config)
+
+	r := fakeCloudResource(t, "yandex_organizationmanager_saml_federation_user_account")
+	raw := map[string]interface{}{
+		"federation_id": federation.ID,
+		"name_id":       "alice@example.com",
+	}
+	state := fakeCloudApply(t, config, r, nil, raw)
+	assert.NotEmpty(t, state.ID)
+	assert.Equal(t, "alice@example.com", state.Attributes["name_id"])
+
+	imported := fakeCloudImport(t, config, r, state.ID)
+	assert.Equal(t, state.Attributes, imported.Attributes)
+
+	// The account pre-provisioned by the resource is found by the data source.
+	ds := Provider().DataSourcesMap["yandex_organizationmanager_saml_federation_user_account"]
+	d := schema.TestResourceDataRaw(t, ds.Schema, raw)
+	require.Empty(t, ds.ReadContext(context.Background(), d, config))
+	assert.Equal(t, state.ID, d.Id())
+
+	fakeCloudDestroy(t, config, r, state)
+	assert.Nil(t, fakeCloudRefresh(t, config, r, state))
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -17,12 +18,12 @@ const yandexResourceManagerCloudDeleteTimeout = 30 * time.Minute
 
 func resourceYandexResourceManagerCloud() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexResourceManagerCloudCreate,
-		Read:   resourceYandexResourceManagerCloudRead,
-		Update: resourceYandexResourceManagerCloudUpdate,
-		Delete: resourceYandexResourceManagerCloudDelete,
+		CreateContext: resourceYandexResourceManagerCloudCreate,
+		ReadContext:   resourceYandexResourceManagerCloudRead,
+		UpdateContext: resourceYandexResourceManagerCloudUpdate,
+		DeleteContext: resourceYandexResourceManagerCloudDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -67,17 +68,17 @@ func resourceYandexResourceManagerCloud() *schema.Resource {
 	}
 }
 
-func resourceYandexResourceManagerCloudCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexResourceManagerCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	organizationID, err := getOrganizationID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting cloud ID while creating Cloud: %s", err)
+		return errorDiagnostics("Error getting cloud ID while creating Cloud", err, nil)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating Cloud: %s", err)
+		return diag.Errorf("Error expanding labels while creating Cloud: %s", err)
 	}
 
 	req := resourcemanager.CreateCloudRequest{
@@ -87,48 +88,45 @@ func resourceYandexResourceManagerCloudCreate(d *schema.ResourceData, meta inter
 		Description:    d.Get("description").(string),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ResourceManager().Cloud().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Cloud: %s", err)
+		return errorDiagnostics("Error while requesting API to create Cloud", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get Cloud create operation metadata: %s", err)
+		return diag.Errorf("Error while get Cloud create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*resourcemanager.CreateCloudMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Cloud ID from create operation metadata")
+		return diag.Errorf("could not get Cloud ID from create operation metadata")
 	}
 
 	d.SetId(md.CloudId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create Cloud: %s", err)
+		return errorDiagnostics("Error while waiting operation to create Cloud", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Cloud creation failed: %s", err)
+		return errorDiagnostics("Cloud creation failed", err, nil)
 	}
 
-	return resourceYandexResourceManagerCloudRead(d, meta)
+	return resourceYandexResourceManagerCloudRead(ctx, d, meta)
 }
 
-func resourceYandexResourceManagerCloudRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexResourceManagerCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	cloud, err := config.sdk.ResourceManager().Cloud().Get(context.Background(),
+	cloud, err := config.sdk.ResourceManager().Cloud().Get(ctx,
 		&resourcemanager.GetCloudRequest{
 			CloudId: d.Id(),
 		})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cloud %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cloud %q", d.Id())))
 	}
 
 	d.Set("created_at", getTimestamp(cloud.CreatedAt))
@@ -136,19 +134,19 @@ func resourceYandexResourceManagerCloudRead(d *schema.ResourceData, meta interfa
 	d.Set("organization_id", cloud.OrganizationId)
 	d.Set("description", cloud.Description)
 
-	return d.Set("labels", cloud.Labels)
+	return diag.FromErr(d.Set("labels", cloud.Labels))
 }
 
-func resourceYandexResourceManagerCloudUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexResourceManagerCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	req := &resourcemanager.UpdateCloudRequest{
 		CloudId:    d.Id(),
 		UpdateMask: &field_mask.FieldMask{},
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req.Labels = labelsProp
@@ -166,18 +164,18 @@ func resourceYandexResourceManagerCloudUpdate(d *schema.ResourceData, meta inter
 	}
 
 	if len(req.UpdateMask.Paths) == 0 {
-		return fmt.Errorf("No fields were updated for Cloud %s", d.Id())
+		return diag.Errorf("No fields were updated for Cloud %s", d.Id())
 	}
 
-	err := makeCloudUpdateRequest(req, d, meta)
+	err := makeCloudUpdateRequest(ctx, req, d, meta)
 	if err != nil {
-		return err
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexResourceManagerCloudRead(d, meta)
+	return resourceYandexResourceManagerCloudRead(ctx, d, meta)
 }
 
-func resourceYandexResourceManagerCloudDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexResourceManagerCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Cloud %q", d.Id())
@@ -187,37 +185,31 @@ func resourceYandexResourceManagerCloudDelete(d *schema.ResourceData, meta inter
 		DeleteAfter: timestamppb.Now(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ResourceManager().Cloud().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Cloud %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Cloud %q", d.Id())))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Cloud %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Cloud %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Cloud %q", d.Id())
 	return nil
 }
 
-func makeCloudUpdateRequest(req *resourcemanager.UpdateCloudRequest, d *schema.ResourceData, meta interface{}) error {
+func makeCloudUpdateRequest(ctx context.Context, req *resourcemanager.UpdateCloudRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ResourceManager().Cloud().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Cloud %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update Cloud %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -1,6 +1,7 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sort"
@@ -164,7 +165,7 @@ func testAccCheckCloudIam(resourceName, role string, members []string) resource.
 
 		cloudID := strings.SplitN(rs.Primary.ID, "/", 2)[0]
 
-		bindings, err := getCloudAccessBindings(config, cloudID)
+		bindings, err := getCloudAccessBindings(context.Background(), config, cloudID)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -17,12 +18,12 @@ const yandexResourceManagerFolderDeleteTimeout = 30 * time.Minute
 
 func resourceYandexResourceManagerFolder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexResourceManagerFolderCreate,
-		Read:   resourceYandexResourceManagerFolderRead,
-		Update: resourceYandexResourceManagerFolderUpdate,
-		Delete: resourceYandexResourceManagerFolderDelete,
+		CreateContext: resourceYandexResourceManagerFolderCreate,
+		ReadContext:   resourceYandexResourceManagerFolderRead,
+		UpdateContext: resourceYandexResourceManagerFolderUpdate,
+		DeleteContext: resourceYandexResourceManagerFolderDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -67,17 +68,17 @@ func resourceYandexResourceManagerFolder() *schema.Resource {
 	}
 }
 
-func resourceYandexResourceManagerFolderCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexResourceManagerFolderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	cloudID, err := getCloudID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting cloud ID while creating Folder: %s", err)
+		return errorDiagnostics("Error getting cloud ID while creating Folder", err, nil)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating Folder: %s", err)
+		return diag.Errorf("Error expanding labels while creating Folder: %s", err)
 	}
 
 	req := resourcemanager.CreateFolderRequest{
@@ -87,48 +88,45 @@ func resourceYandexResourceManagerFolderCreate(d *schema.ResourceData, meta inte
 		Description: d.Get("description").(string),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ResourceManager().Folder().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Folder: %s", err)
+		return errorDiagnostics("Error while requesting API to create Folder", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get Folder create operation metadata: %s", err)
+		return diag.Errorf("Error while get Folder create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*resourcemanager.CreateFolderMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Folder ID from create operation metadata")
+		return diag.Errorf("could not get Folder ID from create operation metadata")
 	}
 
 	d.SetId(md.FolderId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create Folder: %s", err)
+		return errorDiagnostics("Error while waiting operation to create Folder", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Folder creation failed: %s", err)
+		return errorDiagnostics("Folder creation failed", err, nil)
 	}
 
-	return resourceYandexResourceManagerFolderRead(d, meta)
+	return resourceYandexResourceManagerFolderRead(ctx, d, meta)
 }
 
-func resourceYandexResourceManagerFolderRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexResourceManagerFolderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	folder, err := config.sdk.ResourceManager().Folder().Get(context.Background(),
+	folder, err := config.sdk.ResourceManager().Folder().Get(ctx,
 		&resourcemanager.GetFolderRequest{
 			FolderId: d.Id(),
 		})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Folder %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Folder %q", d.Id())))
 	}
 
 	d.Set("created_at", getTimestamp(folder.CreatedAt))
@@ -136,19 +134,19 @@ func resourceYandexResourceManagerFolderRead(d *schema.ResourceData, meta interf
 	d.Set("cloud_id", folder.CloudId)
 	d.Set("description", folder.Description)
 
-	return d.Set("labels", folder.Labels)
+	return diag.FromErr(d.Set("labels", folder.Labels))
 }
 
-func resourceYandexResourceManagerFolderUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexResourceManagerFolderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	req := &resourcemanager.UpdateFolderRequest{
 		FolderId:   d.Id(),
 		UpdateMask: &field_mask.FieldMask{},
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req.Labels = labelsProp
@@ -166,18 +164,18 @@ func resourceYandexResourceManagerFolderUpdate(d *schema.ResourceData, meta inte
 	}
 
 	if len(req.UpdateMask.Paths) == 0 {
-		return fmt.Errorf("No fields were updated for Folder %s", d.Id())
+		return diag.Errorf("No fields were updated for Folder %s", d.Id())
 	}
 
-	err := makeFolderUpdateRequest(req, d, meta)
+	err := makeFolderUpdateRequest(ctx, req, d, meta)
 	if err != nil {
-		return err
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexResourceManagerFolderRead(d, meta)
+	return resourceYandexResourceManagerFolderRead(ctx, d, meta)
 }
 
-func resourceYandexResourceManagerFolderDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexResourceManagerFolderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Folder %q", d.Id())
@@ -187,37 +185,31 @@ func resourceYandexResourceManagerFolderDelete(d *schema.ResourceData, meta inte
 		DeleteAfter: timestamppb.Now(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ResourceManager().Folder().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Folder %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Folder %q", d.Id())))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Folder %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Folder %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Folder %q", d.Id())
 	return nil
 }
 
-func makeFolderUpdateRequest(req *resourcemanager.UpdateFolderRequest, d *schema.ResourceData, meta interface{}) error {
+func makeFolderUpdateRequest(ctx context.Context, req *resourcemanager.UpdateFolderRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ResourceManager().Folder().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Folder %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("Error while requesting API to update Folder %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -2,6 +2,7 @@ package yandex
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"reflect"
 	"sort"
@@ -9,8 +10,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
 )
 
 // Acceptance test functions for one type of resource share common prefix with underscore as separator.
@@ -112,7 +117,7 @@ func testAccCheckYandexResourceManagerFolderIamPolicyDestroy(s *terraform.State)
 
 		folderID := rs.Primary.Attributes["folder_id"]
 
-		bindings, err := getFolderAccessBindings(config, folderID)
+		bindings, err := getFolderAccessBindings(context.Background(), config, folderID)
 		if err != nil && bindings != nil && len(bindings) > 0 {
 			return fmt.Errorf("Folder '%s' policy hasn't been deleted", folderID)
 		}
@@ -133,7 +138,7 @@ func testAccCheckYandexResourceManagerFolderIamPolicy(n string, policy *Policy)
 
 		config := testAccProvider.Meta().(*Config)
 
-		bindings, err := getFolderAccessBindings(config, rs.Primary.ID)
+		bindings, err := getFolderAccessBindings(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -181,3 +186,79 @@ resource "yandex_resourcemanager_folder_iam_policy" "test" {
 }
 `, folderID, bindingBuffer.String(), deps)
 }
+
+func TestFolderIamPolicy_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	member := fakeCloudResource(t, "yandex_resourcemanager_folder_iam_member")
+	for _, m := range []string{"userAccount:alice", "userAccount:bob"} {
+		fakeCloudApply(t, config, member, nil, map[string]interface{}{
+			"folder_id": fakecloud.FolderID,
+			"role":      "editor",
+			"member":    m,
+		})
+	}
+
+	imported := fakeCloudImport(t, config, member, fakecloud.FolderID+" editor userAccount:alice")
+	assert.Equal(t, fakecloud.FolderID+"/editor/userAccount:alice", imported.ID)
+	assert.Equal(t, "userAccount:alice", imported.Attributes["member"])
+
+	policy := func(role, member string) string {
+		return marshalIamPolicy(&Policy{Bindings: []*access.AccessBinding{
+			roleMemberToAccessBinding(role, member),
+		}})
+	}
+	r := fakeCloudResource(t, "yandex_resourcemanager_folder_iam_policy")
+	raw := map[string]interface{}{
+		"folder_id":   fakecloud.FolderID,
+		"policy_data": policy("editor", "userAccount:alice"),
+	}
+
+	// Bindings which exist before the policy is created are shown in the plan too.
+	diff := fakeCloudPlan(t, config, r, nil, raw)
+	assert.Equal(t, "1", diff.Attributes["removed_bindings.#"].New)
+	assert.Equal(t, "editor", diff.Attributes["removed_bindings.0.role"].New)
+	assert.Equal(t, "userAccount:bob", diff.Attributes["removed_bindings.0.member"].New)
+
+	// The state keeps the bindings removed by apply, so it matches the plan.
+	state := fakeCloudApply(t, config, r, nil, raw)
+	assert.Equal(t, "1", state.Attributes["removed_bindings.#"])
+	assert.Equal(t, "userAccount:bob", state.Attributes["removed_bindings.0.member"])
+	state = fakeCloudRefresh(t, config, r, state)
+	assert.Equal(t, "1", state.Attributes["removed_bindings.#"])
+	assert.Empty(t, fakeCloudPlan(t, config, r, state, raw).Attributes)
+
+	raw["policy_data"] = policy("viewer", "userAccount:bob")
+	diff = fakeCloudPlan(t, config, r, state, raw)
+	require.Contains(t, diff.Attributes, "removed_bindings.0.member")
+	assert.Equal(t, "userAccount:alice", diff.Attributes["removed_bindings.0.member"].New)
+
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.Equal(t, "1", state.Attributes["removed_bindings.#"])
+	assert.Equal(t, "userAccount:alice", state.Attributes["removed_bindings.0.member"])
+	updater, err := newFolderIamUpdaterFromFolderID(fakecloud.FolderID, config)
+	require.NoError(t, err)
+	current, err := updater.GetResourceIamPolicy(context.Background())
+	require.NoError(t, err)
+	require.Len(t, current.Bindings, 1)
+	assert.Equal(t, "viewer", current.Bindings[0].RoleId)
+
+	fakeCloudDestroy(t, config, r, state)
+}
+
+func TestFlattenRemovedBindings(t *testing.T) {
+	current := &Policy{Bindings: []*access.AccessBinding{
+		roleMemberToAccessBinding("viewer", "userAccount:bob"),
+		roleMemberToAccessBinding("editor", "userAccount:bob"),
+		roleMemberToAccessBinding("editor", "userAccount:alice"),
+		roleMemberToAccessBinding("editor", "userAccount:bob"),
+	}}
+	policy := &Policy{Bindings: []*access.AccessBinding{
+		roleMemberToAccessBinding("viewer", "userAccount:bob"),
+	}}
+
+	assert.Equal(t, []map[string]interface{}{
+		{"role": "editor", "member": "userAccount:alice"},
+		{"role": "editor", "member": "userAccount:bob"},
+	}, flattenRemovedBindings(current, policy))
+	assert.Empty(t, flattenRemovedBindings(policy, current))
+}
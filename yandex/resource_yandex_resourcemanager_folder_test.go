@@ -10,8 +10,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
 )
 
 const folderPrefix = "tfacc"
@@ -132,3 +135,27 @@ resource "yandex_resourcemanager_folder" "foobar" {
 }
 `, info.Name, info.Description, info.LabelKey, info.LabelValue)
 }
+
+func TestResourceManagerFolder_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	r := fakeCloudResource(t, "yandex_resourcemanager_folder")
+	raw := map[string]interface{}{
+		"name":   "folder",
+		"labels": map[string]interface{}{"team": "infra"},
+	}
+
+	state := fakeCloudApply(t, config, r, nil, raw)
+	assert.Equal(t, fakecloud.CloudID, state.Attributes["cloud_id"])
+	assert.Equal(t, "infra", state.Attributes["labels.team"])
+
+	imported := fakeCloudImport(t, config, r, state.ID)
+	assert.Equal(t, state.Attributes["name"], imported.Attributes["name"])
+	assert.Equal(t, state.Attributes["cloud_id"], imported.Attributes["cloud_id"])
+
+	raw["labels"] = map[string]interface{}{"team": "platform"}
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.Equal(t, "platform", state.Attributes["labels.team"])
+
+	fakeCloudDestroy(t, config, r, state)
+	assert.Nil(t, fakeCloudRefresh(t, config, r, state))
+}
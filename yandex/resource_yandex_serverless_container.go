@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/c2h5oh/datasize"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 )
@@ -15,12 +16,12 @@ const yandexServerlessContainerDefaultTimeout = 5 * time.Minute
 
 func resourceYandexServerlessContainer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexServerlessContainerCreate,
-		Read:   resourceYandexServerlessContainerRead,
-		Update: resourceYandexServerlessContainerUpdate,
-		Delete: resourceYandexServerlessContainerDelete,
+		CreateContext: resourceYandexServerlessContainerCreate,
+		ReadContext:   resourceYandexServerlessContainerRead,
+		UpdateContext: resourceYandexServerlessContainerUpdate,
+		DeleteContext: resourceYandexServerlessContainerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -149,25 +150,21 @@ func resourceYandexServerlessContainer() *schema.Resource {
 	}
 }
 
-func resourceYandexServerlessContainerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexServerlessContainerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating Yandex Cloud Container: %s", err)
+		return diag.Errorf("Error expanding labels while creating Yandex Cloud Container: %s", err)
 	}
 
-	revisionReq, err := expandLastRevision(d)
-	if err != nil {
-		return err
+	if _, err := expandLastRevision(d); err != nil {
+		return diag.FromErr(err)
 	}
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating Yandex Cloud Container: %s", err)
+		return diag.Errorf("Error getting folder ID while creating Yandex Cloud Container: %s", err)
 	}
 
 	req := containers.CreateContainerRequest{
@@ -178,45 +175,72 @@ func resourceYandexServerlessContainerCreate(d *schema.ResourceData, meta interf
 	}
 	op, err := config.sdk.WrapOperation(config.sdk.Serverless().Containers().Container().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Yandex Cloud Container: %s", err)
+		return errorDiagnostics("Error while requesting API to create Yandex Cloud Container", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Yandex Cloud Container: %s", err)
+		return errorDiagnostics("Error while requesting API to create Yandex Cloud Container", err, nil)
 	}
 	md, ok := protoMetadata.(*containers.CreateContainerMetadata)
 	if !ok {
-		return fmt.Errorf("Could not get Yandex Cloud Container ID from create operation metadata")
+		return diag.Errorf("Could not get Yandex Cloud Container ID from create operation metadata")
 	}
 	d.SetId(md.ContainerId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Yandex Cloud Container: %s", err)
+		return errorDiagnostics("Error while requesting API to create Yandex Cloud Container", err, nil)
 	}
 
-	if revisionReq != nil {
-		revisionReq.ContainerId = md.ContainerId
-		op, err := config.sdk.Serverless().Containers().Container().DeployRevision(ctx, revisionReq)
-		err = waitOperation(ctx, config, op, err)
-		if err != nil {
-			return fmt.Errorf("Error while requesting API to deploy revision for Yandex Cloud Container: %s", err)
-		}
+	if err := finishResumableCreate(ctx, d, meta, op.Id(), finishServerlessContainerCreate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceYandexServerlessContainerRead(ctx, d, meta)
+}
+
+// finishServerlessContainerCreate deploys the first revision unless the container already has one.
+func finishServerlessContainerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	revisionReq, err := expandLastRevision(d)
+	if err != nil || revisionReq == nil {
+		return err
 	}
 
-	return resourceYandexServerlessContainerRead(d, meta)
+	revision, err := resolveContainerLastRevision(ctx, config, d.Id())
+	if err != nil {
+		return fmt.Errorf("Failed to resolve last revision of Yandex Cloud Container: %s", err)
+	}
+	if revision != nil {
+		return nil
+	}
+
+	revisionReq.ContainerId = d.Id()
+	op, err := config.sdk.WrapOperation(config.sdk.Serverless().Containers().Container().DeployRevision(ctx, revisionReq))
+	if err != nil {
+		return apiError("Error while requesting API to deploy revision for Yandex Cloud Container", err, nil)
+	}
+	// The deployment is awaited on the next run instead of starting another one.
+	if err := d.Set(pendingOperationIDKey, op.Id()); err != nil {
+		return err
+	}
+	if err := op.Wait(ctx); err != nil {
+		return apiError("Error while requesting API to deploy revision for Yandex Cloud Container", err, nil)
+	}
+	return nil
 }
 
-func resourceYandexServerlessContainerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexServerlessContainerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while updating Yandex Cloud Container: %s", err)
+		return diag.Errorf("Error expanding labels while updating Yandex Cloud Container: %s", err)
 	}
 
 	d.Partial(true)
@@ -228,7 +252,7 @@ func resourceYandexServerlessContainerUpdate(d *schema.ResourceData, meta interf
 	if d.HasChange("description") {
 		updatePaths = append(updatePaths, "description")
 	}
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		updatePaths = append(updatePaths, "labels")
 	}
 
@@ -244,7 +268,7 @@ func resourceYandexServerlessContainerUpdate(d *schema.ResourceData, meta interf
 	if len(revisionUpdatePaths) != 0 {
 		revisionReq, err = expandLastRevision(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -260,7 +284,7 @@ func resourceYandexServerlessContainerUpdate(d *schema.ResourceData, meta interf
 		op, err := config.sdk.Serverless().Containers().Container().Update(ctx, &req)
 		err = waitOperation(ctx, config, op, err)
 		if err != nil {
-			return fmt.Errorf("Error while requesting API to update Yandex Cloud Container: %s", err)
+			return errorDiagnostics("Error while requesting API to update Yandex Cloud Container", err, nil)
 		}
 	}
 
@@ -270,32 +294,29 @@ func resourceYandexServerlessContainerUpdate(d *schema.ResourceData, meta interf
 		op, err := config.sdk.Serverless().Containers().Container().DeployRevision(ctx, revisionReq)
 		err = waitOperation(ctx, config, op, err)
 		if err != nil {
-			return fmt.Errorf("Error while requesting API to deploy revision for Yandex Cloud Container: %s", err)
+			return errorDiagnostics("Error while requesting API to deploy revision for Yandex Cloud Container", err, nil)
 		}
 	}
 	d.Partial(false)
 
-	return resourceYandexServerlessContainerRead(d, meta)
+	return resourceYandexServerlessContainerRead(ctx, d, meta)
 }
 
-func resourceYandexServerlessContainerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexServerlessContainerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	req := containers.GetContainerRequest{ContainerId: d.Id()}
 	container, err := config.sdk.Serverless().Containers().Container().Get(ctx, &req)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Container %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Container %q", d.Id())))
 	}
 
 	revision, err := resolveContainerLastRevision(ctx, config, d.Id())
 	if err != nil {
-		return fmt.Errorf("Failed to resolve last revision of Yandex Cloud Container: %s", err)
+		return errorDiagnostics("Failed to resolve last revision of Yandex Cloud Container", err, nil)
 	}
 
-	return flattenYandexServerlessContainer(d, container, revision)
+	return diag.FromErr(flattenYandexServerlessContainer(d, container, revision))
 }
 
 func resolveContainerLastRevision(ctx context.Context, config *Config, containerID string) (*containers.Revision, error) {
@@ -313,12 +334,9 @@ func resolveContainerLastRevision(ctx context.Context, config *Config, container
 	return resp.Revisions[0], nil
 }
 
-func resourceYandexServerlessContainerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexServerlessContainerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	req := containers.DeleteContainerRequest{
 		ContainerId: d.Id(),
 	}
@@ -326,7 +344,7 @@ func resourceYandexServerlessContainerDelete(d *schema.ResourceData, meta interf
 	op, err := config.sdk.Serverless().Containers().Container().Delete(ctx, &req)
 	err = waitOperation(ctx, config, op, err)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Container %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Container %q", d.Id())))
 	}
 
 	return nil
@@ -0,0 +1,61 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/containers/v1"
+)
+
+func TestAccServerlessContainerIamMember(t *testing.T) {
+	var container containers.Container
+	containerName := acctest.RandomWithPrefix("tf-container")
+	memory := (1 + acctest.RandIntRange(1, 4)) * 128
+
+	role := "serverless.containers.invoker"
+	member := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessContainerIamMemberBasic(containerName, memory, serverlessContainerTestImage1, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testYandexServerlessContainerExists(serverlessContainerResource, &container),
+					testAccCheckServerlessContainerIam(serverlessContainerResource, role, []string{member}),
+				),
+			},
+			{
+				ResourceName: "yandex_serverless_container_iam_member.invoker",
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return fmt.Sprintf("%s %s %s", container.Id, role, member), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccServerlessContainerIamMemberBasic(containerName string, memory int, url, role, member string) string {
+	return fmt.Sprintf(`
+resource "yandex_serverless_container" "test-container" {
+  name   = "%s"
+  memory = %d
+  image {
+    url = "%s"
+  }
+}
+
+resource "yandex_serverless_container_iam_member" "invoker" {
+  container_id = yandex_serverless_container.test-container.id
+  role         = "%s"
+  member       = "%s"
+}
+`, containerName, memory, url, role, member)
+}
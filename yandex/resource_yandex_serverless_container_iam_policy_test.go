@@ -0,0 +1,67 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/containers/v1"
+)
+
+func TestAccServerlessContainerIamPolicy(t *testing.T) {
+	var container containers.Container
+	containerName := acctest.RandomWithPrefix("tf-container")
+	memory := (1 + acctest.RandIntRange(1, 4)) * 128
+
+	role := "serverless.containers.invoker"
+	member := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessContainerIamPolicyBasic(containerName, memory, serverlessContainerTestImage1, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testYandexServerlessContainerExists(serverlessContainerResource, &container),
+					testAccCheckServerlessContainerIam(serverlessContainerResource, role, []string{member}),
+				),
+			},
+			{
+				ResourceName: "yandex_serverless_container_iam_policy.invoker",
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return container.Id, nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccServerlessContainerIamPolicyBasic(containerName string, memory int, url, role, member string) string {
+	return fmt.Sprintf(`
+resource "yandex_serverless_container" "test-container" {
+  name   = "%s"
+  memory = %d
+  image {
+    url = "%s"
+  }
+}
+
+data "yandex_iam_policy" "invoker" {
+  binding {
+    role    = "%s"
+    members = ["%s"]
+  }
+}
+
+resource "yandex_serverless_container_iam_policy" "invoker" {
+  container_id = yandex_serverless_container.test-container.id
+  policy_data  = data.yandex_iam_policy.invoker.policy_data
+}
+`, containerName, memory, url, role, member)
+}
@@ -2,6 +2,7 @@ package yandex
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -23,23 +24,33 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	awspolicy "github.com/jen20/awspolicyequivalence"
 )
 
+const yandexStorageBucketDefaultTimeout = 5 * time.Minute
+
 func resourceYandexStorageBucket() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexStorageBucketCreate,
-		Read:   resourceYandexStorageBucketRead,
-		Update: resourceYandexStorageBucketUpdate,
-		Delete: resourceYandexStorageBucketDelete,
+		CreateContext: resourceYandexStorageBucketCreate,
+		ReadContext:   resourceYandexStorageBucketRead,
+		UpdateContext: resourceYandexStorageBucketUpdate,
+		DeleteContext: resourceYandexStorageBucketDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexStorageBucketDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexStorageBucketDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexStorageBucketDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexStorageBucketDefaultTimeout),
+		},
+
 		SchemaVersion: 0,
 
 		Schema: map[string]*schema.Schema{
@@ -465,7 +476,7 @@ func resourceYandexStorageBucket() *schema.Resource {
 	}
 }
 
-func resourceYandexStorageBucketCreateBySDK(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexStorageBucketCreateBySDK(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	const (
 		aclOwnerFullControl = "bucket-owner-full-control"
 		aclPublicRead       = "public-read"
@@ -523,7 +534,6 @@ func resourceYandexStorageBucketCreateBySDK(d *schema.ResourceData, meta interfa
 	}
 
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	log.Printf("[INFO] Creating Storage S3 bucket using sdk: %s", protojson.Format(request))
 
@@ -549,12 +559,11 @@ func resourceYandexStorageBucketCreateBySDK(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceYandexStorageBucketCreateByS3Client(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexStorageBucketCreateByS3Client(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 	acl := d.Get("acl").(string)
 
 	config := meta.(*Config)
-	ctx := config.Context()
 
 	s3Client, err := getS3Client(d, config)
 	if err != nil {
@@ -564,7 +573,7 @@ func resourceYandexStorageBucketCreateByS3Client(d *schema.ResourceData, meta in
 	return resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		log.Printf("[INFO] Trying to create new Storage S3 Bucket: %q, ACL: %q", bucket, acl)
 
-		_, err := s3Client.CreateBucket(&s3.CreateBucketInput{
+		_, err := s3Client.CreateBucketWithContext(ctx, &s3.CreateBucketInput{
 			Bucket: aws.String(bucket),
 			ACL:    aws.String(acl),
 		})
@@ -584,7 +593,7 @@ func resourceYandexStorageBucketCreateByS3Client(d *schema.ResourceData, meta in
 	})
 }
 
-func resourceYandexStorageBucketCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexStorageBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get the bucket and acl
 	var bucket string
 	if v, ok := d.GetOk("bucket"); ok {
@@ -596,48 +605,48 @@ func resourceYandexStorageBucketCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if err := validateS3BucketName(bucket); err != nil {
-		return fmt.Errorf("error validating Storage Bucket name: %s", err)
+		return diag.Errorf("error validating Storage Bucket name: %s", err)
 	}
 
 	d.Set("bucket", bucket)
 
 	var err error
 	if folderID, ok := d.Get("folder_id").(string); ok && folderID != "" {
-		err = resourceYandexStorageBucketCreateBySDK(d, meta)
+		err = resourceYandexStorageBucketCreateBySDK(ctx, d, meta)
 	} else {
-		err = resourceYandexStorageBucketCreateByS3Client(d, meta)
+		err = resourceYandexStorageBucketCreateByS3Client(ctx, d, meta)
 	}
 	if err != nil {
-		return fmt.Errorf("error creating Storage S3 Bucket: %s", err)
+		return errorDiagnostics("error creating Storage S3 Bucket", err, nil)
 	}
 
 	d.SetId(bucket)
 
-	return resourceYandexStorageBucketUpdate(d, meta)
+	return resourceYandexStorageBucketUpdate(ctx, d, meta)
 }
 
-func resourceYandexStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error {
-	err := resourceYandexStorageBucketUpdateBasic(d, meta)
+func resourceYandexStorageBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := resourceYandexStorageBucketUpdateBasic(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resourceYandexStorageBucketUpdateExtended(d, meta)
+	err = resourceYandexStorageBucketUpdateExtended(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceYandexStorageBucketRead(d, meta)
+	return resourceYandexStorageBucketRead(ctx, d, meta)
 }
 
-func resourceYandexStorageBucketUpdateBasic(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexStorageBucketUpdateBasic(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	s3Client, err := getS3Client(d, config)
 	if err != nil {
 		return fmt.Errorf("error getting storage client: %s", err)
 	}
 
-	changeHandlers := map[string]func(*s3.S3, *schema.ResourceData) error{
+	changeHandlers := map[string]func(context.Context, *s3.S3, *schema.ResourceData) error{
 		"policy":                               resourceYandexStorageBucketPolicyUpdate,
 		"cors_rule":                            resourceYandexStorageBucketCORSUpdate,
 		"website":                              resourceYandexStorageBucketWebsiteUpdate,
@@ -658,7 +667,7 @@ func resourceYandexStorageBucketUpdateBasic(d *schema.ResourceData, meta interfa
 			continue
 		}
 
-		err := handler(s3Client, d)
+		err := handler(ctx, s3Client, d)
 		if err != nil {
 			return fmt.Errorf("handling %s: %w", name, err)
 		}
@@ -667,7 +676,7 @@ func resourceYandexStorageBucketUpdateBasic(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceYandexStorageBucketUpdateExtended(d *schema.ResourceData, meta interface{}) (err error) {
+func resourceYandexStorageBucketUpdateExtended(ctx context.Context, d *schema.ResourceData, meta interface{}) (err error) {
 	if d.Id() == "" {
 		// bucket has been deleted, skipping
 		return nil
@@ -726,9 +735,6 @@ func resourceYandexStorageBucketUpdateExtended(d *schema.ResourceData, meta inte
 	}
 
 	config := meta.(*Config)
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	bucketAPI := config.sdk.StorageAPI().Bucket()
 
 	if len(paths) > 0 {
@@ -829,13 +835,13 @@ func resourceYandexStorageBucketUpdateExtended(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceYandexStorageBucketRead(d *schema.ResourceData, meta interface{}) error {
-	err := resourceYandexStorageBucketReadBasic(d, meta)
+func resourceYandexStorageBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := resourceYandexStorageBucketReadBasic(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resourceYandexStorageBucketReadExtended(d, meta)
+	err = resourceYandexStorageBucketReadExtended(ctx, d, meta)
 	if err != nil {
 		log.Printf("[WARN] Got an error reading Storage Bucket's extended properties: %s", err)
 	}
@@ -843,7 +849,7 @@ func resourceYandexStorageBucketRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceYandexStorageBucketReadBasic(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexStorageBucketReadBasic(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	s3Client, err := getS3Client(d, config)
 
@@ -852,7 +858,7 @@ func resourceYandexStorageBucketReadBasic(d *schema.ResourceData, meta interface
 	}
 
 	resp, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3Client.HeadBucket(&s3.HeadBucketInput{
+		return s3Client.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
 			Bucket: aws.String(d.Id()),
 		})
 	})
@@ -876,7 +882,7 @@ func resourceYandexStorageBucketReadBasic(d *schema.ResourceData, meta interface
 
 	// Read the policy
 	pol, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3Client.GetBucketPolicy(&s3.GetBucketPolicyInput{
+		return s3Client.GetBucketPolicyWithContext(ctx, &s3.GetBucketPolicyInput{
 			Bucket: aws.String(d.Id()),
 		})
 	})
@@ -907,7 +913,7 @@ func resourceYandexStorageBucketReadBasic(d *schema.ResourceData, meta interface
 	}
 
 	corsResponse, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3Client.GetBucketCors(&s3.GetBucketCorsInput{
+		return s3Client.GetBucketCorsWithContext(ctx, &s3.GetBucketCorsInput{
 			Bucket: aws.String(d.Id()),
 		})
 	})
@@ -944,7 +950,7 @@ func resourceYandexStorageBucketReadBasic(d *schema.ResourceData, meta interface
 
 	// Read the website configuration
 	wsResponse, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3Client.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+		return s3Client.GetBucketWebsiteWithContext(ctx, &s3.GetBucketWebsiteInput{
 			Bucket: aws.String(d.Id()),
 		})
 	})
@@ -1034,7 +1040,7 @@ func resourceYandexStorageBucketReadBasic(d *schema.ResourceData, meta interface
 		}
 	} else {
 		apResponse, err := retryFlakyS3Responses(func() (interface{}, error) {
-			return s3Client.GetBucketAcl(&s3.GetBucketAclInput{
+			return s3Client.GetBucketAclWithContext(ctx, &s3.GetBucketAclInput{
 				Bucket: aws.String(d.Id()),
 			})
 		})
@@ -1064,7 +1070,7 @@ func resourceYandexStorageBucketReadBasic(d *schema.ResourceData, meta interface
 	// Read the versioning configuration
 
 	versioningResponse, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3Client.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		return s3Client.GetBucketVersioningWithContext(ctx, &s3.GetBucketVersioningInput{
 			Bucket: aws.String(d.Id()),
 		})
 	})
@@ -1089,7 +1095,7 @@ func resourceYandexStorageBucketReadBasic(d *schema.ResourceData, meta interface
 
 	// Read the logging configuration
 	loggingResponse, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3Client.GetBucketLogging(&s3.GetBucketLoggingInput{
+		return s3Client.GetBucketLoggingWithContext(ctx, &s3.GetBucketLoggingInput{
 			Bucket: aws.String(d.Id()),
 		})
 	})
@@ -1117,7 +1123,7 @@ func resourceYandexStorageBucketReadBasic(d *schema.ResourceData, meta interface
 	// Read the lifecycle configuration
 
 	lifecycleResponse, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3Client.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+		return s3Client.GetBucketLifecycleConfigurationWithContext(ctx, &s3.GetBucketLifecycleConfigurationInput{
 			Bucket: aws.String(d.Id()),
 		})
 	})
@@ -1234,7 +1240,7 @@ func resourceYandexStorageBucketReadBasic(d *schema.ResourceData, meta interface
 	// Read the bucket server side encryption configuration
 
 	encryptionResponse, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3Client.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+		return s3Client.GetBucketEncryptionWithContext(ctx, &s3.GetBucketEncryptionInput{
 			Bucket: aws.String(d.Id()),
 		})
 	})
@@ -1253,7 +1259,7 @@ func resourceYandexStorageBucketReadBasic(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceYandexStorageBucketReadExtended(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexStorageBucketReadExtended(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	if d.Id() == "" {
 		// bucket has been deleted, skipping read
 		return nil
@@ -1264,9 +1270,6 @@ func resourceYandexStorageBucketReadExtended(d *schema.ResourceData, meta interf
 
 	name := d.Get("bucket").(string)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	log.Println("[DEBUG] Getting S3 bucket extended parameters")
 
 	bucket, err := bucketAPI.Get(ctx, &storagepb.GetBucketRequest{
@@ -1343,17 +1346,17 @@ func resourceYandexStorageBucketReadExtended(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceYandexStorageBucketDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexStorageBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	s3Client, err := getS3Client(d, config)
 	if err != nil {
-		return fmt.Errorf("error getting storage client: %s", err)
+		return diag.Errorf("error getting storage client: %s", err)
 	}
 
 	log.Printf("[DEBUG] Storage Delete Bucket: %s", d.Id())
 
 	_, err = retryOnAwsCodes([]string{"AccessDenied", "Forbidden"}, func() (interface{}, error) {
-		return s3Client.DeleteBucket(&s3.DeleteBucketInput{
+		return s3Client.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
 			Bucket: aws.String(d.Id()),
 		})
 	})
@@ -1368,14 +1371,14 @@ func resourceYandexStorageBucketDelete(d *schema.ResourceData, meta interface{})
 			log.Printf("[DEBUG] Storage Bucket attempting to forceDestroy %+v", err)
 
 			bucket := d.Get("bucket").(string)
-			resp, err := s3Client.ListObjectVersions(
+			resp, err := s3Client.ListObjectVersionsWithContext(ctx,
 				&s3.ListObjectVersionsInput{
 					Bucket: aws.String(bucket),
 				},
 			)
 
 			if err != nil {
-				return fmt.Errorf("error listing Storage Bucket object versions: %s", err)
+				return diag.Errorf("error listing Storage Bucket object versions: %s", err)
 			}
 
 			objectsToDelete := make([]*s3.ObjectIdentifier, 0)
@@ -1405,14 +1408,14 @@ func resourceYandexStorageBucketDelete(d *schema.ResourceData, meta interface{})
 				},
 			}
 
-			_, err = s3Client.DeleteObjects(params)
+			_, err = s3Client.DeleteObjectsWithContext(ctx, params)
 
 			if err != nil {
-				return fmt.Errorf("error force_destroy deleting Storage Bucket (%s): %s", d.Id(), err)
+				return diag.Errorf("error force_destroy deleting Storage Bucket (%s): %s", d.Id(), err)
 			}
 
 			// this line recurses until all objects are deleted or an error is returned
-			return resourceYandexStorageBucketDelete(d, meta)
+			return resourceYandexStorageBucketDelete(ctx, d, meta)
 		}
 	}
 
@@ -1421,7 +1424,7 @@ func resourceYandexStorageBucketDelete(d *schema.ResourceData, meta interface{})
 			Bucket: aws.String(d.Id()),
 		}
 		err = waitConditionStable(func() (bool, error) {
-			_, err := s3Client.HeadBucket(req)
+			_, err := s3Client.HeadBucketWithContext(ctx, req)
 			if awsError, ok := err.(awserr.RequestFailure); ok && awsError.StatusCode() == 404 {
 				return true, nil
 			}
@@ -1430,13 +1433,13 @@ func resourceYandexStorageBucketDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting Storage Bucket (%s): %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("error deleting Storage Bucket (%s)", d.Id()), err, nil)
 	}
 
 	return nil
 }
 
-func resourceYandexStorageBucketCORSUpdate(s3Client *s3.S3, d *schema.ResourceData) error {
+func resourceYandexStorageBucketCORSUpdate(ctx context.Context, s3Client *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	rawCors := d.Get("cors_rule").([]interface{})
 
@@ -1445,12 +1448,12 @@ func resourceYandexStorageBucketCORSUpdate(s3Client *s3.S3, d *schema.ResourceDa
 		log.Printf("[DEBUG] Storage Bucket: %s, delete CORS", bucket)
 
 		_, err := retryFlakyS3Responses(func() (interface{}, error) {
-			return s3Client.DeleteBucketCors(&s3.DeleteBucketCorsInput{
+			return s3Client.DeleteBucketCorsWithContext(ctx, &s3.DeleteBucketCorsInput{
 				Bucket: aws.String(bucket),
 			})
 		})
 		if err == nil {
-			err = waitCorsDeleted(s3Client, bucket)
+			err = waitCorsDeleted(ctx, s3Client, bucket)
 		}
 		if err != nil {
 			return fmt.Errorf("error deleting storage CORS: %s", err)
@@ -1498,10 +1501,10 @@ func resourceYandexStorageBucketCORSUpdate(s3Client *s3.S3, d *schema.ResourceDa
 		log.Printf("[DEBUG] Storage Bucket: %s, put CORS: %#v", bucket, corsInput)
 
 		_, err := retryFlakyS3Responses(func() (interface{}, error) {
-			return s3Client.PutBucketCors(corsInput)
+			return s3Client.PutBucketCorsWithContext(ctx, corsInput)
 		})
 		if err == nil {
-			err = waitCorsPut(s3Client, bucket, corsConfiguration)
+			err = waitCorsPut(ctx, s3Client, bucket, corsConfiguration)
 		}
 		if err != nil {
 			return fmt.Errorf("error putting bucket CORS: %s", err)
@@ -1511,11 +1514,11 @@ func resourceYandexStorageBucketCORSUpdate(s3Client *s3.S3, d *schema.ResourceDa
 	return nil
 }
 
-func resourceYandexStorageBucketWebsiteUpdate(s3Client *s3.S3, d *schema.ResourceData) error {
+func resourceYandexStorageBucketWebsiteUpdate(ctx context.Context, s3Client *s3.S3, d *schema.ResourceData) error {
 	ws := d.Get("website").([]interface{})
 
 	if len(ws) == 0 {
-		return resourceYandexStorageBucketWebsiteDelete(s3Client, d)
+		return resourceYandexStorageBucketWebsiteDelete(ctx, s3Client, d)
 	}
 
 	var w map[string]interface{}
@@ -1525,10 +1528,10 @@ func resourceYandexStorageBucketWebsiteUpdate(s3Client *s3.S3, d *schema.Resourc
 		w = make(map[string]interface{})
 	}
 
-	return resourceYandexStorageBucketWebsitePut(s3Client, d, w)
+	return resourceYandexStorageBucketWebsitePut(ctx, s3Client, d, w)
 }
 
-func resourceYandexStorageBucketWebsitePut(s3Client *s3.S3, d *schema.ResourceData, website map[string]interface{}) error {
+func resourceYandexStorageBucketWebsitePut(ctx context.Context, s3Client *s3.S3, d *schema.ResourceData, website map[string]interface{}) error {
 	bucket := d.Get("bucket").(string)
 
 	var indexDocument, errorDocument, redirectAllRequestsTo, routingRules string
@@ -1594,10 +1597,10 @@ func resourceYandexStorageBucketWebsitePut(s3Client *s3.S3, d *schema.ResourceDa
 	log.Printf("[DEBUG] Storage put bucket website: %#v", putInput)
 
 	_, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3Client.PutBucketWebsite(putInput)
+		return s3Client.PutBucketWebsiteWithContext(ctx, putInput)
 	})
 	if err == nil {
-		err = waitWebsitePut(s3Client, bucket, websiteConfiguration)
+		err = waitWebsitePut(ctx, s3Client, bucket, websiteConfiguration)
 	}
 	if err != nil {
 		return fmt.Errorf("error putting storage website: %s", err)
@@ -1606,17 +1609,17 @@ func resourceYandexStorageBucketWebsitePut(s3Client *s3.S3, d *schema.ResourceDa
 	return nil
 }
 
-func resourceYandexStorageBucketWebsiteDelete(s3Client *s3.S3, d *schema.ResourceData) error {
+func resourceYandexStorageBucketWebsiteDelete(ctx context.Context, s3Client *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	deleteInput := &s3.DeleteBucketWebsiteInput{Bucket: aws.String(bucket)}
 
 	log.Printf("[DEBUG] Storage delete bucket website: %#v", deleteInput)
 
 	_, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3Client.DeleteBucketWebsite(deleteInput)
+		return s3Client.DeleteBucketWebsiteWithContext(ctx, deleteInput)
 	})
 	if err == nil {
-		err = waitWebsiteDeleted(s3Client, bucket)
+		err = waitWebsiteDeleted(ctx, s3Client, bucket)
 	}
 	if err != nil {
 		return fmt.Errorf("error deleting storage website: %s", err)
@@ -1649,7 +1652,7 @@ func WebsiteDomainURL() string {
 	return "website.yandexcloud.net"
 }
 
-func resourceYandexStorageBucketACLUpdate(s3Client *s3.S3, d *schema.ResourceData) error {
+func resourceYandexStorageBucketACLUpdate(ctx context.Context, s3Client *s3.S3, d *schema.ResourceData) error {
 	acl := d.Get("acl").(string)
 	bucket := d.Get("bucket").(string)
 
@@ -1660,7 +1663,7 @@ func resourceYandexStorageBucketACLUpdate(s3Client *s3.S3, d *schema.ResourceDat
 	log.Printf("[DEBUG] Storage put bucket ACL: %#v", i)
 
 	_, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3Client.PutBucketAcl(i)
+		return s3Client.PutBucketAclWithContext(ctx, i)
 	})
 	if err != nil {
 		return fmt.Errorf("error putting Storage Bucket ACL: %s", err)
@@ -1669,7 +1672,7 @@ func resourceYandexStorageBucketACLUpdate(s3Client *s3.S3, d *schema.ResourceDat
 	return nil
 }
 
-func resourceYandexStorageBucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceYandexStorageBucketVersioningUpdate(ctx context.Context, s3conn *s3.S3, d *schema.ResourceData) error {
 	v := d.Get("versioning").([]interface{})
 	bucket := d.Get("bucket").(string)
 	vc := &s3.VersioningConfiguration{}
@@ -1694,7 +1697,7 @@ func resourceYandexStorageBucketVersioningUpdate(s3conn *s3.S3, d *schema.Resour
 	log.Printf("[DEBUG] S3 put bucket versioning: %#v", i)
 
 	_, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3conn.PutBucketVersioning(i)
+		return s3conn.PutBucketVersioningWithContext(ctx, i)
 	})
 	if err != nil {
 		return fmt.Errorf("Error putting S3 versioning: %s", err)
@@ -1703,7 +1706,7 @@ func resourceYandexStorageBucketVersioningUpdate(s3conn *s3.S3, d *schema.Resour
 	return nil
 }
 
-func resourceYandexStorageBucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceYandexStorageBucketLoggingUpdate(ctx context.Context, s3conn *s3.S3, d *schema.ResourceData) error {
 	logging := d.Get("logging").(*schema.Set).List()
 	bucket := d.Get("bucket").(string)
 	loggingStatus := &s3.BucketLoggingStatus{}
@@ -1729,7 +1732,7 @@ func resourceYandexStorageBucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceD
 	log.Printf("[DEBUG] S3 put bucket logging: %#v", i)
 
 	_, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3conn.PutBucketLogging(i)
+		return s3conn.PutBucketLoggingWithContext(ctx, i)
 	})
 	if err != nil {
 		return fmt.Errorf("Error putting S3 logging: %s", err)
@@ -1804,11 +1807,11 @@ func waitConditionStable(check func() (bool, error)) error {
 	return fmt.Errorf("timeout exceeded")
 }
 
-func waitWebsitePut(s3Client *s3.S3, bucket string, configuration *s3.WebsiteConfiguration) error {
+func waitWebsitePut(ctx context.Context, s3Client *s3.S3, bucket string, configuration *s3.WebsiteConfiguration) error {
 	input := &s3.GetBucketWebsiteInput{Bucket: aws.String(bucket)}
 
 	check := func() (bool, error) {
-		output, err := s3Client.GetBucketWebsite(input)
+		output, err := s3Client.GetBucketWebsiteWithContext(ctx, input)
 		if err != nil && !isAWSErr(err, "NoSuchWebsiteConfiguration", "") {
 			return false, err
 		}
@@ -1831,11 +1834,11 @@ func waitWebsitePut(s3Client *s3.S3, bucket string, configuration *s3.WebsiteCon
 	return nil
 }
 
-func waitWebsiteDeleted(s3Client *s3.S3, bucket string) error {
+func waitWebsiteDeleted(ctx context.Context, s3Client *s3.S3, bucket string) error {
 	input := &s3.GetBucketWebsiteInput{Bucket: aws.String(bucket)}
 
 	check := func() (bool, error) {
-		_, err := s3Client.GetBucketWebsite(input)
+		_, err := s3Client.GetBucketWebsiteWithContext(ctx, input)
 		if isAWSErr(err, "NoSuchWebsiteConfiguration", "") {
 			return true, nil
 		}
@@ -1852,11 +1855,11 @@ func waitWebsiteDeleted(s3Client *s3.S3, bucket string) error {
 	return nil
 }
 
-func waitCorsPut(s3Client *s3.S3, bucket string, configuration *s3.CORSConfiguration) error {
+func waitCorsPut(ctx context.Context, s3Client *s3.S3, bucket string, configuration *s3.CORSConfiguration) error {
 	input := &s3.GetBucketCorsInput{Bucket: aws.String(bucket)}
 
 	check := func() (bool, error) {
-		output, err := s3Client.GetBucketCors(input)
+		output, err := s3Client.GetBucketCorsWithContext(ctx, input)
 		if err != nil && !isAWSErr(err, "NoSuchCORSConfiguration", "") {
 			return false, err
 		}
@@ -1882,11 +1885,11 @@ func waitCorsPut(s3Client *s3.S3, bucket string, configuration *s3.CORSConfigura
 	return nil
 }
 
-func waitCorsDeleted(s3Client *s3.S3, bucket string) error {
+func waitCorsDeleted(ctx context.Context, s3Client *s3.S3, bucket string) error {
 	input := &s3.GetBucketCorsInput{Bucket: aws.String(bucket)}
 
 	check := func() (bool, error) {
-		_, err := s3Client.GetBucketCors(input)
+		_, err := s3Client.GetBucketCorsWithContext(ctx, input)
 		if isAWSErr(err, "NoSuchCORSConfiguration", "") {
 			return true, nil
 		}
@@ -1904,9 +1907,9 @@ func waitCorsDeleted(s3Client *s3.S3, bucket string) error {
 }
 
 // Returns true if the error matches all these conditions:
-//  * err is of type awserr.Error
-//  * Error.Code() matches code
-//  * Error.Message() contains message
+//   - err is of type awserr.Error
+//   - Error.Code() matches code
+//   - Error.Message() contains message
 func isAWSErr(err error, code string, message string) bool {
 	if err, ok := err.(awserr.Error); ok {
 		return err.Code() == code && strings.Contains(err.Message(), message)
@@ -1992,14 +1995,14 @@ func transitionHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func resourceYandexStorageBucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceYandexStorageBucketPolicyUpdate(ctx context.Context, s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	policy := d.Get("policy").(string)
 
 	if policy == "" {
 		log.Printf("[DEBUG] S3 bucket: %s, delete policy: %s", bucket, policy)
 		_, err := retryFlakyS3Responses(func() (interface{}, error) {
-			return s3conn.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{
+			return s3conn.DeleteBucketPolicyWithContext(ctx, &s3.DeleteBucketPolicyInput{
 				Bucket: aws.String(bucket),
 			})
 		})
@@ -2017,7 +2020,7 @@ func resourceYandexStorageBucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceDa
 	}
 
 	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := s3conn.PutBucketPolicy(params)
+		_, err := s3conn.PutBucketPolicyWithContext(ctx, params)
 		if isAWSErr(err, "MalformedPolicy", "") || isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
 			return resource.RetryableError(err)
 		}
@@ -2033,18 +2036,18 @@ func resourceYandexStorageBucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceDa
 	return nil
 }
 
-func resourceYandexStorageBucketGrantsUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceYandexStorageBucketGrantsUpdate(ctx context.Context, s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	rawGrants := d.Get("grant").(*schema.Set).List()
 
 	if len(rawGrants) == 0 {
 		log.Printf("[DEBUG] Storage Bucket: %s, Grants fallback to canned ACL", bucket)
-		if err := resourceYandexStorageBucketACLUpdate(s3conn, d); err != nil {
+		if err := resourceYandexStorageBucketACLUpdate(ctx, s3conn, d); err != nil {
 			return fmt.Errorf("error fallback to canned ACL, %s", err)
 		}
 	} else {
 		apResponse, err := retryFlakyS3Responses(func() (interface{}, error) {
-			return s3conn.GetBucketAcl(&s3.GetBucketAclInput{
+			return s3conn.GetBucketAclWithContext(ctx, &s3.GetBucketAclInput{
 				Bucket: aws.String(bucket),
 			})
 		})
@@ -2095,7 +2098,7 @@ func resourceYandexStorageBucketGrantsUpdate(s3conn *s3.S3, d *schema.ResourceDa
 		log.Printf("[DEBUG] Bucket: %s, put Grants: %#v", bucket, grantsInput)
 
 		_, err = retryFlakyS3Responses(func() (interface{}, error) {
-			return s3conn.PutBucketAcl(grantsInput)
+			return s3conn.PutBucketAclWithContext(ctx, grantsInput)
 		})
 
 		if err != nil {
@@ -2105,7 +2108,7 @@ func resourceYandexStorageBucketGrantsUpdate(s3conn *s3.S3, d *schema.ResourceDa
 	return nil
 }
 
-func resourceYandexStorageBucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceYandexStorageBucketLifecycleUpdate(ctx context.Context, s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 
 	lifecycleRules := d.Get("lifecycle_rule").([]interface{})
@@ -2115,7 +2118,7 @@ func resourceYandexStorageBucketLifecycleUpdate(s3conn *s3.S3, d *schema.Resourc
 			Bucket: aws.String(bucket),
 		}
 
-		_, err := s3conn.DeleteBucketLifecycle(i)
+		_, err := s3conn.DeleteBucketLifecycleWithContext(ctx, i)
 		if err != nil {
 			return fmt.Errorf("Error removing S3 lifecycle: %s", err)
 		}
@@ -2247,7 +2250,7 @@ func resourceYandexStorageBucketLifecycleUpdate(s3conn *s3.S3, d *schema.Resourc
 	}
 
 	_, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3conn.PutBucketLifecycleConfiguration(i)
+		return s3conn.PutBucketLifecycleConfigurationWithContext(ctx, i)
 	})
 	if err != nil {
 		return fmt.Errorf("Error putting S3 lifecycle: %s", err)
@@ -2256,7 +2259,7 @@ func resourceYandexStorageBucketLifecycleUpdate(s3conn *s3.S3, d *schema.Resourc
 	return nil
 }
 
-func resourceYandexStorageBucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceYandexStorageBucketServerSideEncryptionConfigurationUpdate(ctx context.Context, s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	serverSideEncryptionConfiguration := d.Get("server_side_encryption_configuration").([]interface{})
 	if len(serverSideEncryptionConfiguration) == 0 {
@@ -2265,7 +2268,7 @@ func resourceYandexStorageBucketServerSideEncryptionConfigurationUpdate(s3conn *
 			Bucket: aws.String(bucket),
 		}
 
-		_, err := s3conn.DeleteBucketEncryption(i)
+		_, err := s3conn.DeleteBucketEncryptionWithContext(ctx, i)
 		if err != nil {
 			return fmt.Errorf("error removing S3 bucket server side encryption: %s", err)
 		}
@@ -2304,7 +2307,7 @@ func resourceYandexStorageBucketServerSideEncryptionConfigurationUpdate(s3conn *
 	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
 
 	_, err := retryFlakyS3Responses(func() (interface{}, error) {
-		return s3conn.PutBucketEncryption(i)
+		return s3conn.PutBucketEncryptionWithContext(ctx, i)
 	})
 	if err != nil {
 		return fmt.Errorf("error putting S3 server side encryption configuration: %s", err)
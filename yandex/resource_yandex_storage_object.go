@@ -2,26 +2,41 @@ package yandex
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 )
 
+const yandexStorageObjectDefaultTimeout = 5 * time.Minute
+
 func resourceYandexStorageObject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexStorageObjectCreate,
-		Read:   resourceYandexStorageObjectRead,
-		Update: resourceYandexStorageObjectUpdate,
-		Delete: resourceYandexStorageObjectDelete,
+		CreateContext: resourceYandexStorageObjectCreate,
+		ReadContext:   resourceYandexStorageObjectRead,
+		UpdateContext: resourceYandexStorageObjectUpdate,
+		DeleteContext: resourceYandexStorageObjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexStorageObjectImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexStorageObjectDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexStorageObjectDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexStorageObjectDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexStorageObjectDefaultTimeout),
+		},
 
 		SchemaVersion: 0,
 
@@ -79,11 +94,11 @@ func resourceYandexStorageObject() *schema.Resource {
 	}
 }
 
-func resourceYandexStorageObjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexStorageObjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	s3conn, err := getS3Client(d, config)
 	if err != nil {
-		return fmt.Errorf("error getting storage client: %s", err)
+		return diag.Errorf("error getting storage client: %s", err)
 	}
 
 	var body io.ReadSeeker
@@ -92,11 +107,11 @@ func resourceYandexStorageObjectCreate(d *schema.ResourceData, meta interface{})
 		source := v.(string)
 		path, err := homedir.Expand(source)
 		if err != nil {
-			return fmt.Errorf("error expanding homedir in source (%s): %s", source, err)
+			return diag.Errorf("error expanding homedir in source (%s): %s", source, err)
 		}
 		file, err := os.Open(path)
 		if err != nil {
-			return fmt.Errorf("error opening storage bucket object source (%s): %s", path, err)
+			return diag.Errorf("error opening storage bucket object source (%s): %s", path, err)
 		}
 
 		body = file
@@ -115,11 +130,11 @@ func resourceYandexStorageObjectCreate(d *schema.ResourceData, meta interface{})
 		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
 		contentRaw, err := base64.StdEncoding.DecodeString(content)
 		if err != nil {
-			return fmt.Errorf("error decoding content_base64: %s", err)
+			return diag.Errorf("error decoding content_base64: %s", err)
 		}
 		body = bytes.NewReader(contentRaw)
 	} else {
-		return fmt.Errorf("\"source\", \"content\", or \"content_base64\" field must be specified")
+		return diag.Errorf("\"source\", \"content\", or \"content_base64\" field must be specified")
 	}
 
 	bucket := d.Get("bucket").(string)
@@ -138,26 +153,26 @@ func resourceYandexStorageObjectCreate(d *schema.ResourceData, meta interface{})
 		putObjectInput.ContentType = aws.String(v.(string))
 	}
 
-	if _, err := s3conn.PutObject(putObjectInput); err != nil {
-		return fmt.Errorf("error putting object in bucket %q: %s", bucket, err)
+	if _, err := s3conn.PutObjectWithContext(ctx, putObjectInput); err != nil {
+		return diag.Errorf("error putting object in bucket %q: %s", bucket, err)
 	}
 
 	d.SetId(key)
 
-	return resourceYandexStorageObjectRead(d, meta)
+	return resourceYandexStorageObjectRead(ctx, d, meta)
 }
 
-func resourceYandexStorageObjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexStorageObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	s3conn, err := getS3Client(d, config)
 	if err != nil {
-		return fmt.Errorf("error getting storage client: %s", err)
+		return diag.Errorf("error getting storage client: %s", err)
 	}
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	resp, err := s3conn.HeadObject(
+	resp, err := s3conn.HeadObjectWithContext(ctx,
 		&s3.HeadObjectInput{
 			Bucket: aws.String(bucket),
 			Key:    aws.String(key),
@@ -169,7 +184,7 @@ func resourceYandexStorageObjectRead(d *schema.ResourceData, meta interface{}) e
 			log.Printf("[WARN] Error Reading Object (%s), object not found (HTTP status 404)", key)
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] Reading storage object meta: %s", resp)
 
@@ -178,7 +193,22 @@ func resourceYandexStorageObjectRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceYandexStorageObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+// resourceYandexStorageObjectImport takes "bucket/key" ID. Object is read with provider storage keys,
+// content and ACL are not imported.
+func resourceYandexStorageObjectImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid storage object import id %q, expected \"bucket/key\"", d.Id())
+	}
+
+	d.SetId(parts[1])
+	d.Set("bucket", parts[0])
+	d.Set("key", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceYandexStorageObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	for _, key := range []string{
 		"source",
 		"content",
@@ -186,7 +216,7 @@ func resourceYandexStorageObjectUpdate(d *schema.ResourceData, meta interface{})
 		"content_type",
 	} {
 		if d.HasChange(key) {
-			return resourceYandexStorageObjectCreate(d, meta)
+			return resourceYandexStorageObjectCreate(ctx, d, meta)
 		}
 	}
 
@@ -194,26 +224,26 @@ func resourceYandexStorageObjectUpdate(d *schema.ResourceData, meta interface{})
 		config := meta.(*Config)
 		s3Client, err := getS3Client(d, config)
 		if err != nil {
-			return fmt.Errorf("error getting storage client: %s", err)
+			return diag.Errorf("error getting storage client: %s", err)
 		}
-		_, err = s3Client.PutObjectAcl(&s3.PutObjectAclInput{
+		_, err = s3Client.PutObjectAclWithContext(ctx, &s3.PutObjectAclInput{
 			Bucket: aws.String(d.Get("bucket").(string)),
 			Key:    aws.String(d.Get("key").(string)),
 			ACL:    aws.String(d.Get("acl").(string)),
 		})
 		if err != nil {
-			return fmt.Errorf("error putting storage object ACL: %s", err)
+			return diag.Errorf("error putting storage object ACL: %s", err)
 		}
 	}
 
 	return nil
 }
 
-func resourceYandexStorageObjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexStorageObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	s3client, err := getS3Client(d, config)
 	if err != nil {
-		return fmt.Errorf("error getting storage client: %s", err)
+		return diag.Errorf("error getting storage client: %s", err)
 	}
 
 	bucket := d.Get("bucket").(string)
@@ -224,11 +254,11 @@ func resourceYandexStorageObjectDelete(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] Storage Delete Object: %s/%s", bucket, key)
 
-	if _, err := s3client.DeleteObject(&s3.DeleteObjectInput{
+	if _, err := s3client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 	}); err != nil {
-		return fmt.Errorf("error deleting storage object %q in bucket %q: %s ", key, bucket, err)
+		return diag.Errorf("error deleting storage object %q in bucket %q: %s ", key, bucket, err)
 	}
 
 	return nil
@@ -149,6 +149,39 @@ func TestAccStorageObject_content(t *testing.T) {
 	})
 }
 
+// Imported objects are read with provider storage keys, so the test needs them in environment.
+func TestAccStorageObject_import(t *testing.T) {
+	if os.Getenv("YC_STORAGE_ACCESS_KEY") == "" || os.Getenv("YC_STORAGE_SECRET_KEY") == "" {
+		t.Skip("YC_STORAGE_ACCESS_KEY and YC_STORAGE_SECRET_KEY must be set to test import of storage objects")
+	}
+
+	var obj s3.GetObjectOutput
+	resourceName := "yandex_storage_object.test"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageObjectConfigProviderKeys(rInt, "some_bucket_content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageObjectExists(resourceName, &obj),
+					testAccCheckStorageObjectBody(&obj, "some_bucket_content"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("tf-object-test-bucket-%d/test-key", rInt),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "acl"},
+			},
+		},
+	})
+}
+
 func TestAccStorageObject_contentBase64(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "yandex_storage_object.test"
@@ -397,6 +430,20 @@ resource "yandex_storage_object" "test" {
 `, randInt, content) + testAccCommonIamDependenciesEditorConfig(randInt)
 }
 
+func testAccStorageObjectConfigProviderKeys(randInt int, content string) string {
+	return fmt.Sprintf(`
+resource "yandex_storage_bucket" "test" {
+	bucket = "tf-object-test-bucket-%[1]d"
+}
+
+resource "yandex_storage_object" "test" {
+	bucket  = yandex_storage_bucket.test.bucket
+	key     = "test-key"
+	content = "%[2]s"
+}
+`, randInt, content)
+}
+
 func testAccStorageObjectConfigContentBase64(randInt int, contentBase64 string) string {
 	return fmt.Sprintf(`
 resource "yandex_storage_bucket" "test" {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -22,13 +23,13 @@ func handleAddressNotFoundError(err error, d *schema.ResourceData, id string) er
 
 func resourceYandexVPCAddress() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceYandexVPCAddressRead,
-		Create: resourceYandexVPCAddressCreate,
-		Update: resourceYandexVPCAddressUpdate,
-		Delete: resourceYandexVPCAddressDelete,
+		ReadContext:   resourceYandexVPCAddressRead,
+		CreateContext: resourceYandexVPCAddressCreate,
+		UpdateContext: resourceYandexVPCAddressUpdate,
+		DeleteContext: resourceYandexVPCAddressDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -110,12 +111,9 @@ func resourceYandexVPCAddress() *schema.Resource {
 	}
 }
 
-func yandexVPCAddressRead(d *schema.ResourceData, meta interface{}, id string) error {
+func yandexVPCAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	req := &vpc.GetAddressRequest{AddressId: id}
 	address, err := config.sdk.VPC().Address().Get(ctx, req)
 
@@ -150,26 +148,26 @@ func yandexVPCAddressRead(d *schema.ResourceData, meta interface{}, id string) e
 	return d.Set("used", address.GetUsed())
 }
 
-func resourceYandexVPCAddressRead(d *schema.ResourceData, meta interface{}) error {
-	return yandexVPCAddressRead(d, meta, d.Id())
+func resourceYandexVPCAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diagnosticsFromError(yandexVPCAddressRead(ctx, d, meta, d.Id()))
 }
 
-func resourceYandexVPCAddressCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return addressError("expanding labels while creating address: %s", err)
+		return diag.FromErr(addressError("expanding labels while creating address: %s", err))
 	}
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return addressError("expanding folder ID while creating address: %s", err)
+		return diag.FromErr(addressError("expanding folder ID while creating address: %s", err))
 	}
 
 	spec, err := expandExternalIpv4Address(d)
 	if err != nil {
-		return addressError("expanding external ipv4 address while creating address: %s", err)
+		return diag.FromErr(addressError("expanding external ipv4 address while creating address: %s", err))
 	}
 
 	req := vpc.CreateAddressRequest{
@@ -183,39 +181,36 @@ func resourceYandexVPCAddressCreate(d *schema.ResourceData, meta interface{}) er
 		},
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().Address().Create(ctx, &req))
 	if err != nil {
-		return addressError("while requesting API to create address: %s", err)
+		return diag.FromErr(addressError("while requesting API to create address: %s", err))
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return addressError("while get address create operation metadata: %s", err)
+		return diag.FromErr(addressError("while get address create operation metadata: %s", err))
 	}
 
 	md, ok := protoMetadata.(*vpc.CreateAddressMetadata)
 	if !ok {
-		return addressError("could not get Address ID from create operation metadata")
+		return diag.FromErr(addressError("could not get Address ID from create operation metadata"))
 	}
 
 	d.SetId(md.AddressId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return addressError("while waiting operation to create address: %s", err)
+		return diag.FromErr(addressError("while waiting operation to create address: %s", err))
 	}
 
 	if _, err := op.Response(); err != nil {
-		return addressError("creation failed: %s", err)
+		return diag.FromErr(addressError("creation failed: %s", err))
 	}
 
-	return resourceYandexVPCAddressRead(d, meta)
+	return resourceYandexVPCAddressRead(ctx, d, meta)
 }
 
-func resourceYandexVPCAddressUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCAddressUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	d.Partial(true)
@@ -226,10 +221,10 @@ func resourceYandexVPCAddressUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	const addrLabelsPropName = "labels"
-	if d.HasChange(addrLabelsPropName) {
+	if hasChangeWithDefaultLabels(d, addrLabelsPropName) {
 		labelsProp, err := expandLabels(d.Get(addrLabelsPropName))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req.Labels = labelsProp
@@ -248,47 +243,41 @@ func resourceYandexVPCAddressUpdate(d *schema.ResourceData, meta interface{}) er
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, addrDescPropName)
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().Address().Update(ctx, req))
 	if err != nil {
-		return addressError("while requesting API to update Address %q: %s", d.Id(), err)
+		return diag.FromErr(addressError("while requesting API to update Address %q: %s", d.Id(), err))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return addressError("updating Address %q: %s", d.Id(), err)
+		return diag.FromErr(addressError("updating Address %q: %s", d.Id(), err))
 	}
 
 	d.Partial(false)
 
-	return resourceYandexVPCAddressRead(d, meta)
+	return resourceYandexVPCAddressRead(ctx, d, meta)
 }
 
-func resourceYandexVPCAddressDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	req := &vpc.DeleteAddressRequest{
 		AddressId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().Address().Delete(ctx, req))
 	if err != nil {
-		return handleAddressNotFoundError(err, d, d.Id())
+		return diagnosticsFromError(handleAddressNotFoundError(err, d, d.Id()))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Address %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Address %q", d.Id()), err, nil)
 	}
 
 	return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -26,13 +27,13 @@ func yandexVPCDefaultSecurityGroupSchema() map[string]*schema.Schema {
 
 func resourceYandexVPCDefaultSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexVPCDefaultSecurityGroupCreate,
-		Read:   resourceYandexVPCDefaultSecurityGroupRead,
-		Update: resourceYandexVPCDefaultSecurityGroupUpdate,
-		Delete: resourceYandexVPCDefaultSecurityGroupDelete,
+		CreateContext: resourceYandexVPCDefaultSecurityGroupCreate,
+		ReadContext:   resourceYandexVPCDefaultSecurityGroupRead,
+		UpdateContext: resourceYandexVPCDefaultSecurityGroupUpdate,
+		DeleteContext: resourceYandexVPCDefaultSecurityGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -47,24 +48,21 @@ func resourceYandexVPCDefaultSecurityGroup() *schema.Resource {
 	}
 }
 
-func resourceYandexVPCDefaultSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCDefaultSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	networkId := d.Get("network_id").(string)
 	network, err := config.sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{
 		NetworkId: networkId,
 	})
 	if err != nil {
-		return fmt.Errorf("error while receiving network %s: %s", networkId, err)
+		return diag.Errorf("error while receiving network %s: %s", networkId, err)
 	}
 
 	sgId := network.GetDefaultSecurityGroupId()
 
 	if sgId == "" {
-		return fmt.Errorf("network %s has no default security group", networkId)
+		return diag.Errorf("network %s has no default security group", networkId)
 	}
 
 	upd := &vpc.UpdateSecurityGroupRequest{
@@ -79,7 +77,7 @@ func resourceYandexVPCDefaultSecurityGroupCreate(d *schema.ResourceData, meta in
 	if l, ok := d.GetOk("labels"); ok {
 		labels, err := expandLabels(l)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		upd.Labels = labels
 	} else {
@@ -97,7 +95,7 @@ func resourceYandexVPCDefaultSecurityGroupCreate(d *schema.ResourceData, meta in
 		for _, v := range v.(*schema.Set).List() {
 			ruleSpec, err := securityRuleDescriptionToRuleSpec(dir, v)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			upd.RuleSpecs = append(upd.RuleSpecs, ruleSpec)
@@ -106,28 +104,28 @@ func resourceYandexVPCDefaultSecurityGroupCreate(d *schema.ResourceData, meta in
 
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().SecurityGroup().Update(ctx, upd))
 	if err != nil {
-		return fmt.Errorf("error while updating security group %s: %s", sgId, err)
+		return errorDiagnostics(fmt.Sprintf("error while updating security group %s", sgId), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error updating security group %s: %s", sgId, err)
+		return errorDiagnostics(fmt.Sprintf("error updating security group %s", sgId), err, nil)
 	}
 
 	d.SetId(sgId)
 
-	return resourceYandexVPCDefaultSecurityGroupRead(d, meta)
+	return resourceYandexVPCDefaultSecurityGroupRead(ctx, d, meta)
 }
 
-func resourceYandexVPCDefaultSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	return yandexVPCSecurityGroupRead(d, meta, d.Id())
+func resourceYandexVPCDefaultSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diagnosticsFromError(yandexVPCSecurityGroupRead(ctx, d, meta, d.Id()))
 }
 
-func resourceYandexVPCDefaultSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceYandexVPCSecurityGroupUpdate(d, meta)
+func resourceYandexVPCDefaultSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceYandexVPCSecurityGroupUpdate(ctx, d, meta)
 }
 
-func resourceYandexVPCDefaultSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCDefaultSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// no need to actually delete sg or it rules
 	d.SetId("")
 
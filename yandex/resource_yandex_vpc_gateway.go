@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -16,12 +17,12 @@ const yandexVPCGatewayDefaultTimeout = 1 * time.Minute
 
 func resourceYandexVPCGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexVPCGatewayCreate,
-		Read:   resourceYandexVPCGatewayRead,
-		Update: resourceYandexVPCGatewayUpdate,
-		Delete: resourceYandexVPCGatewayDelete,
+		CreateContext: resourceYandexVPCGatewayCreate,
+		ReadContext:   resourceYandexVPCGatewayRead,
+		UpdateContext: resourceYandexVPCGatewayUpdate,
+		DeleteContext: resourceYandexVPCGatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -78,17 +79,17 @@ func resourceYandexVPCGateway() *schema.Resource {
 
 }
 
-func resourceYandexVPCGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating gateway: %s", err)
+		return diag.Errorf("Error expanding labels while creating gateway: %s", err)
 	}
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating gateway: %s", err)
+		return diag.Errorf("Error getting folder ID while creating gateway: %s", err)
 	}
 
 	req := vpc.CreateGatewayRequest{
@@ -104,48 +105,42 @@ func resourceYandexVPCGatewayCreate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().Gateway().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create VPC Gateway: %s", err)
+		return errorDiagnostics("Error while requesting API to create VPC Gateway", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get gateway create operation metadata: %s", err)
+		return diag.Errorf("Error while get gateway create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*vpc.CreateGatewayMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Gateway ID from create operation metadata")
+		return diag.Errorf("could not get Gateway ID from create operation metadata")
 	}
 
 	d.SetId(md.GatewayId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create gateway: %s", err)
+		return errorDiagnostics("Error while waiting operation to create gateway", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Gateway creation failed: %s", err)
+		return errorDiagnostics("Gateway creation failed", err, nil)
 	}
 
-	return resourceYandexVPCGatewayRead(d, meta)
+	return resourceYandexVPCGatewayRead(ctx, d, meta)
 }
 
-func resourceYandexVPCGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	return yandexVPCGatewayRead(d, meta, d.Id())
+func resourceYandexVPCGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diagnosticsFromError(yandexVPCGatewayRead(ctx, d, meta, d.Id()))
 }
 
-func yandexVPCGatewayRead(d *schema.ResourceData, meta interface{}, id string) error {
+func yandexVPCGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	gateway, err := config.sdk.VPC().Gateway().Get(ctx, &vpc.GetGatewayRequest{
 		GatewayId: id,
 	})
@@ -167,7 +162,7 @@ func yandexVPCGatewayRead(d *schema.ResourceData, meta interface{}, id string) e
 	return d.Set("labels", gateway.Labels)
 }
 
-func resourceYandexVPCGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	d.Partial(true)
@@ -177,10 +172,10 @@ func resourceYandexVPCGatewayUpdate(d *schema.ResourceData, meta interface{}) er
 		UpdateMask: &field_mask.FieldMask{},
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req.Labels = labelsProp
@@ -197,25 +192,22 @@ func resourceYandexVPCGatewayUpdate(d *schema.ResourceData, meta interface{}) er
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().Gateway().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update VPC Gateway %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to update VPC Gateway %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error updating VPC Gateway %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error updating VPC Gateway %q", d.Id()), err, nil)
 	}
 
 	d.Partial(false)
 
-	return resourceYandexVPCGatewayRead(d, meta)
+	return resourceYandexVPCGatewayRead(ctx, d, meta)
 }
 
-func resourceYandexVPCGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting VPC Gateway %q", d.Id())
@@ -224,22 +216,19 @@ func resourceYandexVPCGatewayDelete(d *schema.ResourceData, meta interface{}) er
 		GatewayId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().Gateway().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("VPC Gateway %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("VPC Gateway %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting VPC Gateway %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting VPC Gateway %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting VPC Gateway %q", d.Id())
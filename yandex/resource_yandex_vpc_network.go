@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -16,12 +17,12 @@ const yandexVPCNetworkDefaultTimeout = 1 * time.Minute
 
 func resourceYandexVPCNetwork() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexVPCNetworkCreate,
-		Read:   resourceYandexVPCNetworkRead,
-		Update: resourceYandexVPCNetworkUpdate,
-		Delete: resourceYandexVPCNetworkDelete,
+		CreateContext: resourceYandexVPCNetworkCreate,
+		ReadContext:   resourceYandexVPCNetworkRead,
+		UpdateContext: resourceYandexVPCNetworkUpdate,
+		DeleteContext: resourceYandexVPCNetworkDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -81,17 +82,17 @@ func resourceYandexVPCNetwork() *schema.Resource {
 
 }
 
-func resourceYandexVPCNetworkCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating network: %s", err)
+		return diag.Errorf("Error expanding labels while creating network: %s", err)
 	}
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating network: %s", err)
+		return diag.Errorf("Error getting folder ID while creating network: %s", err)
 	}
 
 	req := vpc.CreateNetworkRequest{
@@ -101,48 +102,42 @@ func resourceYandexVPCNetworkCreate(d *schema.ResourceData, meta interface{}) er
 		Labels:      labels,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().Network().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create network: %s", err)
+		return errorDiagnostics("Error while requesting API to create network", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get network create operation metadata: %s", err)
+		return diag.Errorf("Error while get network create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*vpc.CreateNetworkMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Network ID from create operation metadata")
+		return diag.Errorf("could not get Network ID from create operation metadata")
 	}
 
 	d.SetId(md.NetworkId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create network: %s", err)
+		return errorDiagnostics("Error while waiting operation to create network", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Network creation failed: %s", err)
+		return errorDiagnostics("Network creation failed", err, nil)
 	}
 
-	return resourceYandexVPCNetworkRead(d, meta)
+	return resourceYandexVPCNetworkRead(ctx, d, meta)
 }
 
-func resourceYandexVPCNetworkRead(d *schema.ResourceData, meta interface{}) error {
-	return yandexVPCNetworkRead(d, meta, d.Id())
+func resourceYandexVPCNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diagnosticsFromError(yandexVPCNetworkRead(ctx, d, meta, d.Id()))
 }
 
-func yandexVPCNetworkRead(d *schema.ResourceData, meta interface{}, id string) error {
+func yandexVPCNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	network, err := config.sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{
 		NetworkId: id,
 	})
@@ -176,7 +171,7 @@ func yandexVPCNetworkRead(d *schema.ResourceData, meta interface{}, id string) e
 	return d.Set("labels", network.Labels)
 }
 
-func resourceYandexVPCNetworkUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	d.Partial(true)
@@ -186,10 +181,10 @@ func resourceYandexVPCNetworkUpdate(d *schema.ResourceData, meta interface{}) er
 		UpdateMask: &field_mask.FieldMask{},
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req.Labels = labelsProp
@@ -206,25 +201,22 @@ func resourceYandexVPCNetworkUpdate(d *schema.ResourceData, meta interface{}) er
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().Network().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Network %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to update Network %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error updating Network %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error updating Network %q", d.Id()), err, nil)
 	}
 
 	d.Partial(false)
 
-	return resourceYandexVPCNetworkRead(d, meta)
+	return resourceYandexVPCNetworkRead(ctx, d, meta)
 }
 
-func resourceYandexVPCNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Network %q", d.Id())
@@ -233,22 +225,19 @@ func resourceYandexVPCNetworkDelete(d *schema.ResourceData, meta interface{}) er
 		NetworkId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().Network().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Network %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Network %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Network %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Network %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Network %q", d.Id())
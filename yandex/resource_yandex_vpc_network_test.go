@@ -9,8 +9,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
 )
 
 func init() {
@@ -344,3 +349,42 @@ resource "yandex_vpc_subnet" "bar2" {
 }
 `, name, description)
 }
+
+func TestVPCNetwork_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	r := fakeCloudResource(t, "yandex_vpc_network")
+	raw := map[string]interface{}{
+		"name":        "network",
+		"description": "created offline",
+		"labels":      map[string]interface{}{"env": "test"},
+	}
+
+	state := fakeCloudApply(t, config, r, nil, raw)
+	require.NotEmpty(t, state.ID)
+	assert.Equal(t, "network", state.Attributes["name"])
+	assert.Equal(t, fakecloud.FolderID, state.Attributes["folder_id"])
+	assert.Equal(t, "test", state.Attributes["labels.env"])
+	assert.Empty(t, fakeCloudPlan(t, config, r, state, raw).Attributes)
+
+	imported := fakeCloudImport(t, config, r, state.ID)
+	assert.Equal(t, state.Attributes, imported.Attributes)
+
+	// Rename the network behind Terraform's back, refresh must detect the drift and apply must fix it.
+	op, err := config.sdk.WrapOperation(config.sdk.VPC().Network().Update(context.Background(), &vpc.UpdateNetworkRequest{
+		NetworkId:  state.ID,
+		Name:       "renamed",
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"name"}},
+	}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(context.Background()))
+
+	state = fakeCloudRefresh(t, config, r, state)
+	assert.Equal(t, "renamed", state.Attributes["name"])
+	assert.Contains(t, fakeCloudPlan(t, config, r, state, raw).Attributes, "name")
+
+	state = fakeCloudApply(t, config, r, state, raw)
+	assert.Equal(t, "network", state.Attributes["name"])
+
+	fakeCloudDestroy(t, config, r, state)
+	assert.Nil(t, fakeCloudRefresh(t, config, r, state))
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/hashcode"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -19,12 +20,12 @@ const yandexVPCRouteTableDefaultTimeout = 3 * time.Minute
 
 func resourceYandexVPCRouteTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexVPCRouteTableCreate,
-		Read:   resourceYandexVPCRouteTableRead,
-		Update: resourceYandexVPCRouteTableUpdate,
-		Delete: resourceYandexVPCRouteTableDelete,
+		CreateContext: resourceYandexVPCRouteTableCreate,
+		ReadContext:   resourceYandexVPCRouteTableRead,
+		UpdateContext: resourceYandexVPCRouteTableUpdate,
+		DeleteContext: resourceYandexVPCRouteTableDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -99,22 +100,22 @@ func resourceYandexVPCRouteTable() *schema.Resource {
 
 }
 
-func resourceYandexVPCRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating route table: %s", err)
+		return diag.Errorf("Error getting folder ID while creating route table: %s", err)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating route table: %s", err)
+		return diag.Errorf("Error expanding labels while creating route table: %s", err)
 	}
 
 	staticRoutes, err := expandStaticRoutes(d.Get("static_route"))
 	if err != nil {
-		return fmt.Errorf("Error expanding static routes while creating route table: %s", err)
+		return diag.Errorf("Error expanding static routes while creating route table: %s", err)
 	}
 
 	req := vpc.CreateRouteTableRequest{
@@ -126,47 +127,44 @@ func resourceYandexVPCRouteTableCreate(d *schema.ResourceData, meta interface{})
 		StaticRoutes: staticRoutes,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().RouteTable().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create route table: %s", err)
+		return errorDiagnostics("Error while requesting API to create route table", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get route table create operation metadata: %s", err)
+		return diag.Errorf("Error while get route table create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*vpc.CreateRouteTableMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Route Table ID from create operation metadata")
+		return diag.Errorf("could not get Route Table ID from create operation metadata")
 	}
 
 	d.SetId(md.RouteTableId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create route table: %s", err)
+		return errorDiagnostics("Error while waiting operation to create route table", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("route table creation failed: %s", err)
+		return errorDiagnostics("route table creation failed", err, nil)
 	}
 
-	return resourceYandexVPCRouteTableRead(d, meta)
+	return resourceYandexVPCRouteTableRead(ctx, d, meta)
 }
 
-func resourceYandexVPCRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCRouteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	routeTable, err := config.sdk.VPC().RouteTable().Get(config.Context(), &vpc.GetRouteTableRequest{
+	routeTable, err := config.sdk.VPC().RouteTable().Get(ctx, &vpc.GetRouteTableRequest{
 		RouteTableId: d.Id(),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Route table %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Route table %q", d.Get("name").(string))))
 	}
 
 	d.Set("created_at", getTimestamp(routeTable.CreatedAt))
@@ -176,13 +174,13 @@ func resourceYandexVPCRouteTableRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("network_id", routeTable.NetworkId)
 
 	if err := d.Set("labels", routeTable.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("static_route", flattenStaticRoutes(routeTable))
+	return diag.FromErr(d.Set("static_route", flattenStaticRoutes(routeTable)))
 }
 
-func resourceYandexVPCRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	d.Partial(true)
@@ -192,10 +190,10 @@ func resourceYandexVPCRouteTableUpdate(d *schema.ResourceData, meta interface{})
 		UpdateMask:   &field_mask.FieldMask{},
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req.Labels = labelsProp
@@ -220,7 +218,7 @@ func resourceYandexVPCRouteTableUpdate(d *schema.ResourceData, meta interface{})
 		for _, route := range nrs {
 			sr, err := routeDescriptionToStaticRoute(route)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			newRoutes = append(newRoutes, sr)
 		}
@@ -229,25 +227,22 @@ func resourceYandexVPCRouteTableUpdate(d *schema.ResourceData, meta interface{})
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "static_routes")
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().RouteTable().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Route table %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to update Route table %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error updating Route table %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error updating Route table %q", d.Id()), err, nil)
 	}
 
 	d.Partial(false)
 
-	return resourceYandexVPCRouteTableRead(d, meta)
+	return resourceYandexVPCRouteTableRead(ctx, d, meta)
 }
 
-func resourceYandexVPCRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Route table %q", d.Id())
@@ -256,22 +251,19 @@ func resourceYandexVPCRouteTableDelete(d *schema.ResourceData, meta interface{})
 		RouteTableId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().RouteTable().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Route table %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Route table %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Route table %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Route table %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Route table %q", d.Id())
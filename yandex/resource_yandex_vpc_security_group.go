@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
@@ -81,12 +82,12 @@ func yandexVPCSecurityGroupSchema() map[string]*schema.Schema {
 
 func resourceYandexVPCSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexVPCSecurityGroupCreate,
-		Read:   resourceYandexVPCSecurityGroupRead,
-		Update: resourceYandexVPCSecurityGroupUpdate,
-		Delete: resourceYandexVPCSecurityGroupDelete,
+		CreateContext: resourceYandexVPCSecurityGroupCreate,
+		ReadContext:   resourceYandexVPCSecurityGroupRead,
+		UpdateContext: resourceYandexVPCSecurityGroupUpdate,
+		DeleteContext: resourceYandexVPCSecurityGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -165,22 +166,22 @@ func resourceYandexSecurityGroupRule() *schema.Resource {
 	}
 }
 
-func resourceYandexVPCSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("error expanding labels while creating security group: %s", err)
+		return diag.Errorf("error expanding labels while creating security group: %s", err)
 	}
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("error getting folder ID while creating security group: %s", err)
+		return diag.Errorf("error getting folder ID while creating security group: %s", err)
 	}
 
 	rules, err := expandSecurityGroupRulesSpec(d)
 	if err != nil {
-		return fmt.Errorf("error getting rules while creating security group: %s", err)
+		return errorDiagnostics("error getting rules while creating security group", err, nil)
 	}
 
 	req := vpc.CreateSecurityGroupRequest{
@@ -192,48 +193,42 @@ func resourceYandexVPCSecurityGroupCreate(d *schema.ResourceData, meta interface
 		RuleSpecs:   rules,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().SecurityGroup().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to create security group: %s", err)
+		return errorDiagnostics("error while requesting API to create security group", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("error while get security group create operation metadata: %s", err)
+		return diag.Errorf("error while get security group create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*vpc.CreateSecurityGroupMetadata)
 	if !ok {
-		return fmt.Errorf("could not get SecurityGroup ID from create operation metadata")
+		return diag.Errorf("could not get SecurityGroup ID from create operation metadata")
 	}
 
 	d.SetId(md.SecurityGroupId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("error while waiting operation to create security group: %s", err)
+		return errorDiagnostics("error while waiting operation to create security group", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("security group creation failed: %s", err)
+		return errorDiagnostics("security group creation failed", err, nil)
 	}
 
-	return resourceYandexVPCSecurityGroupRead(d, meta)
+	return resourceYandexVPCSecurityGroupRead(ctx, d, meta)
 }
 
-func resourceYandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	return yandexVPCSecurityGroupRead(d, meta, d.Id())
+func resourceYandexVPCSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diagnosticsFromError(yandexVPCSecurityGroupRead(ctx, d, meta, d.Id()))
 }
 
-func yandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface{}, id string) error {
+func yandexVPCSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
 	securityGroup, err := config.sdk.VPC().SecurityGroup().Get(ctx, &vpc.GetSecurityGroupRequest{
 		SecurityGroupId: id,
 	})
@@ -273,7 +268,7 @@ func yandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface{}, id str
 	return d.Set("labels", securityGroup.GetLabels())
 }
 
-func resourceYandexVPCSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	d.Partial(true)
@@ -283,10 +278,10 @@ func resourceYandexVPCSecurityGroupUpdate(d *schema.ResourceData, meta interface
 		UpdateMask:      &field_mask.FieldMask{},
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req.Labels = labelsProp
@@ -303,32 +298,29 @@ func resourceYandexVPCSecurityGroupUpdate(d *schema.ResourceData, meta interface
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	if len(req.UpdateMask.Paths) > 0 {
 		op, err := config.sdk.WrapOperation(config.sdk.VPC().SecurityGroup().Update(ctx, req))
 		if err != nil {
-			return fmt.Errorf("error while requesting API to update Security group %q: %s", d.Id(), err)
+			return errorDiagnostics(fmt.Sprintf("error while requesting API to update Security group %q", d.Id()), err, nil)
 		}
 
 		err = op.Wait(ctx)
 		if err != nil {
-			return fmt.Errorf("error updating Security group %q: %s", d.Id(), err)
+			return errorDiagnostics(fmt.Sprintf("error updating Security group %q", d.Id()), err, nil)
 		}
 
 	}
 
 	if d.HasChange("egress") || d.HasChange("ingress") {
 		if err := resourceYandexVPCSecurityGroupUpdateRules(ctx, d, config); err != nil {
-			return err
+			return diagnosticsFromError(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceYandexVPCSecurityGroupRead(d, meta)
+	return resourceYandexVPCSecurityGroupRead(ctx, d, meta)
 }
 
 func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.ResourceData, config *Config) error {
@@ -411,7 +403,7 @@ func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.Re
 	}
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().SecurityGroup().UpdateRules(ctx, req))
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update Security group rules %q: %s", d.Id(), err)
+		return apiError(fmt.Sprintf("error while requesting API to update Security group rules %q", d.Id()), err, nil)
 	}
 	err = op.Wait(ctx)
 	if err != nil {
@@ -461,29 +453,26 @@ func ruleChanged(r1 *vpc.SecurityGroupRule, r2 *vpc.SecurityGroupRuleSpec) bool
 	return false
 }
 
-func resourceYandexVPCSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	req := &vpc.DeleteSecurityGroupRequest{
 		SecurityGroupId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().SecurityGroup().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Security group %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Security group %q", d.Id())))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Security group %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Security group %q", d.Id()), err, nil)
 	}
 
 	return nil
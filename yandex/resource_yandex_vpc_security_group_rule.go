@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
@@ -13,13 +14,13 @@ import (
 
 func resourceYandexVpcSecurityGroupRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexVpcSecurityGroupRuleCreate,
-		Read:   resourceYandexVpcSecurityGroupRuleRead,
-		Update: resourceYandexVpcSecurityGroupRuleUpdate,
-		Delete: resourceYandexVpcSecurityGroupRuleDelete,
+		CreateContext: resourceYandexVpcSecurityGroupRuleCreate,
+		ReadContext:   resourceYandexVpcSecurityGroupRuleRead,
+		UpdateContext: resourceYandexVpcSecurityGroupRuleUpdate,
+		DeleteContext: resourceYandexVpcSecurityGroupRuleDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -115,76 +116,67 @@ func resourceYandexVpcSecurityGroupRule() *schema.Resource {
 	}
 }
 
-func resourceYandexVpcSecurityGroupRuleCreate(data *schema.ResourceData, meta interface{}) error {
+func resourceYandexVpcSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), data.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
-	ruleSpec, err := securityRuleResourceToSpec(data)
+	ruleSpec, err := securityRuleResourceToSpec(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	sgId := data.Get("security_group_binding").(string)
+	sgId := d.Get("security_group_binding").(string)
 
 	mutexKV.Lock(sgId)
 	defer mutexKV.Unlock(sgId)
 
 	ruleId, err := addRuleToSecurityGroup(sgId, ruleSpec, config, ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	data.SetId(ruleId)
+	d.SetId(ruleId)
 
-	return resourceYandexVpcSecurityGroupRuleRead(data, meta)
+	return resourceYandexVpcSecurityGroupRuleRead(ctx, d, meta)
 }
 
-func resourceYandexVpcSecurityGroupRuleRead(data *schema.ResourceData, meta interface{}) error {
+func resourceYandexVpcSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), data.Timeout(schema.TimeoutRead))
-	defer cancel()
+	sgId := d.Get("security_group_binding").(string)
 
-	sgId := data.Get("security_group_binding").(string)
-
-	rule, err := findRule(data, config, ctx, sgId, data.Id())
+	rule, err := findRule(d, config, ctx, sgId, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return writeSecurityGroupRuleToData(rule, data)
+	return diag.FromErr(writeSecurityGroupRuleToData(rule, d))
 }
 
-func resourceYandexVpcSecurityGroupRuleUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceYandexVpcSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	data.Partial(true)
+	d.Partial(true)
 
-	ctx, cancel := context.WithTimeout(config.Context(), data.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
-	sgId := data.Get("security_group_binding").(string)
+	sgId := d.Get("security_group_binding").(string)
 
 	mutexKV.Lock(sgId)
 	defer mutexKV.Unlock(sgId)
 
 	req := &vpc.UpdateSecurityGroupRuleRequest{
-		RuleId:          data.Id(),
+		RuleId:          d.Id(),
 		SecurityGroupId: sgId,
 		UpdateMask:      &field_mask.FieldMask{},
 	}
 
-	if data.HasChange("description") {
-		req.Description = data.Get("description").(string)
+	if d.HasChange("description") {
+		req.Description = d.Get("description").(string)
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
 	}
 
-	if data.HasChange("labels") {
-		labelsProp, err := expandLabels(data.Get("labels"))
+	if hasChangeWithDefaultLabels(d, "labels") {
+		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req.Labels = labelsProp
@@ -194,54 +186,51 @@ func resourceYandexVpcSecurityGroupRuleUpdate(data *schema.ResourceData, meta in
 	if len(req.UpdateMask.Paths) > 0 {
 		op, err := config.sdk.WrapOperation(config.sdk.VPC().SecurityGroup().UpdateRule(ctx, req))
 		if err != nil {
-			return err
+			return errorDiagnostics("error while requesting API to update security group rule", err, nil)
 		}
 
 		err = op.Wait(ctx)
 		if err != nil {
-			return err
+			return errorDiagnostics("error while requesting API to update security group rule", err, nil)
 		}
 
 		if _, err := op.Response(); err != nil {
-			return err
+			return errorDiagnostics("error while requesting API to update security group rule", err, nil)
 		}
 	}
 
-	data.Partial(false)
+	d.Partial(false)
 
-	return resourceYandexVpcSecurityGroupRuleRead(data, meta)
+	return resourceYandexVpcSecurityGroupRuleRead(ctx, d, meta)
 }
 
-func resourceYandexVpcSecurityGroupRuleDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceYandexVpcSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), data.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
-	sgId := data.Get("security_group_binding").(string)
+	sgId := d.Get("security_group_binding").(string)
 
 	mutexKV.Lock(sgId)
 	defer mutexKV.Unlock(sgId)
 
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().SecurityGroup().UpdateRules(ctx, &vpc.UpdateSecurityGroupRulesRequest{
 		SecurityGroupId: sgId,
-		DeletionRuleIds: []string{data.Id()},
+		DeletionRuleIds: []string{d.Id()},
 	}))
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting security group rule %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting security group rule %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting security group rule %q", d.Id()), err, nil)
 	}
 
-	data.SetId("")
+	d.SetId("")
 
 	return nil
 }
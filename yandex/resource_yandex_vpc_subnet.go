@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/protobuf/field_mask"
 
@@ -16,12 +17,12 @@ const yandexVPCSubnetDefaultTimeout = 3 * time.Minute
 
 func resourceYandexVPCSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexVPCSubnetCreate,
-		Read:   resourceYandexVPCSubnetRead,
-		Update: resourceYandexVPCSubnetUpdate,
-		Delete: resourceYandexVPCSubnetDelete,
+		CreateContext: resourceYandexVPCSubnetCreate,
+		ReadContext:   resourceYandexVPCSubnetRead,
+		UpdateContext: resourceYandexVPCSubnetUpdate,
+		DeleteContext: resourceYandexVPCSubnetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -128,22 +129,22 @@ func resourceYandexVPCSubnet() *schema.Resource {
 
 }
 
-func resourceYandexVPCSubnetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	zone, err := getZone(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting zone while creating subnet: %s", err)
+		return errorDiagnostics("Error getting zone while creating subnet", err, nil)
 	}
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating subnet: %s", err)
+		return diag.Errorf("Error getting folder ID while creating subnet: %s", err)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating subnet: %s", err)
+		return diag.Errorf("Error expanding labels while creating subnet: %s", err)
 	}
 
 	rangesV4 := []string{}
@@ -157,7 +158,7 @@ func resourceYandexVPCSubnetCreate(d *schema.ResourceData, meta interface{}) err
 
 	dhcpOptions, err := expandDhcpOptions(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding dhcp options while creating subnet: %s", err)
+		return diag.Errorf("Error expanding dhcp options while creating subnet: %s", err)
 	}
 
 	req := vpc.CreateSubnetRequest{
@@ -172,47 +173,44 @@ func resourceYandexVPCSubnetCreate(d *schema.ResourceData, meta interface{}) err
 		DhcpOptions:  dhcpOptions,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().Subnet().Create(ctx, &req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create subnet: %s", err)
+		return errorDiagnostics("Error while requesting API to create subnet", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return fmt.Errorf("Error while get subnet create operation metadata: %s", err)
+		return diag.Errorf("Error while get subnet create operation metadata: %s", err)
 	}
 
 	md, ok := protoMetadata.(*vpc.CreateSubnetMetadata)
 	if !ok {
-		return fmt.Errorf("could not get Subnet ID from create operation metadata")
+		return diag.Errorf("could not get Subnet ID from create operation metadata")
 	}
 
 	d.SetId(md.SubnetId)
 
-	err = op.Wait(ctx)
+	err = waitResumableOperation(ctx, d, op)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create subnet: %s", err)
+		return errorDiagnostics("Error while waiting operation to create subnet", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Subnet creation failed: %s", err)
+		return errorDiagnostics("Subnet creation failed", err, nil)
 	}
 
-	return resourceYandexVPCSubnetRead(d, meta)
+	return resourceYandexVPCSubnetRead(ctx, d, meta)
 }
 
-func resourceYandexVPCSubnetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	subnet, err := config.sdk.VPC().Subnet().Get(config.Context(), &vpc.GetSubnetRequest{
+	subnet, err := config.sdk.VPC().Subnet().Get(ctx, &vpc.GetSubnetRequest{
 		SubnetId: d.Id(),
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Subnet %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Subnet %q", d.Get("name").(string))))
 	}
 
 	d.Set("created_at", getTimestamp(subnet.CreatedAt))
@@ -224,21 +222,21 @@ func resourceYandexVPCSubnetRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("route_table_id", subnet.RouteTableId)
 
 	if err := d.Set("labels", subnet.Labels); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("v4_cidr_blocks", convertStringArrToInterface(subnet.V4CidrBlocks)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("v6_cidr_blocks", convertStringArrToInterface(subnet.V6CidrBlocks)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("dhcp_options", flattenDhcpOptions(subnet.DhcpOptions))
+	return diag.FromErr(d.Set("dhcp_options", flattenDhcpOptions(subnet.DhcpOptions)))
 }
 
-func resourceYandexVPCSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	d.Partial(true)
@@ -248,10 +246,10 @@ func resourceYandexVPCSubnetUpdate(d *schema.ResourceData, meta interface{}) err
 		UpdateMask: &field_mask.FieldMask{},
 	}
 
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		req.Labels = labelsProp
@@ -276,31 +274,28 @@ func resourceYandexVPCSubnetUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("dhcp_options") {
 		dhcpOptions, err := expandDhcpOptions(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		req.DhcpOptions = dhcpOptions
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "dhcp_options")
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().Subnet().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update Subnet %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error while requesting API to update Subnet %q", d.Id()), err, nil)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error updating Subnet %q: %s", d.Id(), err)
+		return errorDiagnostics(fmt.Sprintf("Error updating Subnet %q", d.Id()), err, nil)
 	}
 
 	d.Partial(false)
 
-	return resourceYandexVPCSubnetRead(d, meta)
+	return resourceYandexVPCSubnetRead(ctx, d, meta)
 }
 
-func resourceYandexVPCSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexVPCSubnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	log.Printf("[DEBUG] Deleting Subnet %q", d.Id())
@@ -309,22 +304,19 @@ func resourceYandexVPCSubnetDelete(d *schema.ResourceData, meta interface{}) err
 		SubnetId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().Subnet().Delete(ctx, req))
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Subnet %q", d.Get("name").(string)))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("Subnet %q", d.Get("name").(string))))
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Subnet %q", d.Id()), err, nil)
 	}
 
 	_, err = op.Response()
 	if err != nil {
-		return err
+		return errorDiagnostics(fmt.Sprintf("error deleting Subnet %q", d.Id()), err, nil)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Subnet %q", d.Id())
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
 )
@@ -564,3 +565,38 @@ resource "yandex_vpc_subnet" "foo" {
 }
 	`, networkName, subnetName)
 }
+
+func TestVPCSubnet_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t)
+	networkResource := fakeCloudResource(t, "yandex_vpc_network")
+	r := fakeCloudResource(t, "yandex_vpc_subnet")
+
+	network := fakeCloudApply(t, config, networkResource, nil, map[string]interface{}{"name": "network"})
+	raw := map[string]interface{}{
+		"name":           "subnet",
+		"network_id":     network.ID,
+		"zone":           "ru-central1-b",
+		"v4_cidr_blocks": []interface{}{"10.10.0.0/24"},
+	}
+
+	state := fakeCloudApply(t, config, r, nil, raw)
+	assert.Equal(t, "ru-central1-b", state.Attributes["zone"])
+	assert.Equal(t, "10.10.0.0/24", state.Attributes["v4_cidr_blocks.0"])
+	assert.Empty(t, fakeCloudPlan(t, config, r, state, raw).Attributes)
+
+	imported := fakeCloudImport(t, config, r, state.ID)
+	assert.Equal(t, state.Attributes, imported.Attributes)
+
+	network = fakeCloudRefresh(t, config, networkResource, network)
+	assert.Equal(t, state.ID, network.Attributes["subnet_ids.0"])
+
+	// Changing CIDR blocks recreates the subnet.
+	raw["v4_cidr_blocks"] = []interface{}{"10.20.0.0/24"}
+	assert.True(t, fakeCloudPlan(t, config, r, state, raw).RequiresNew())
+	recreated := fakeCloudApply(t, config, r, state, raw)
+	assert.NotEqual(t, state.ID, recreated.ID)
+	assert.Nil(t, fakeCloudRefresh(t, config, r, state))
+
+	fakeCloudDestroy(t, config, r, recreated)
+	fakeCloudDestroy(t, config, networkResource, network)
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/ydb/v1"
@@ -16,12 +17,12 @@ const yandexYDBDedicatedDefaultTimeout = 10 * time.Minute
 
 func resourceYandexYDBDatabaseDedicated() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexYDBDatabaseDedicatedCreate,
-		Read:   resourceYandexYDBDatabaseDedicatedRead,
-		Update: resourceYandexYDBDatabaseDedicatedUpdate,
-		Delete: performYandexYDBDatabaseDelete,
+		CreateContext: resourceYandexYDBDatabaseDedicatedCreate,
+		ReadContext:   resourceYandexYDBDatabaseDedicatedRead,
+		UpdateContext: resourceYandexYDBDatabaseDedicatedUpdate,
+		DeleteContext: performYandexYDBDatabaseDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -165,6 +166,11 @@ func resourceYandexYDBDatabaseDedicated() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"ydb_full_endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -198,42 +204,42 @@ func resourceYandexYDBDatabaseDedicated() *schema.Resource {
 	}
 }
 
-func resourceYandexYDBDatabaseDedicatedCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexYDBDatabaseDedicatedCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating database: %s", err)
+		return diag.Errorf("Error getting folder ID while creating database: %s", err)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating database: %s", err)
+		return diag.Errorf("Error expanding labels while creating database: %s", err)
 	}
 
 	subnetIDs := convertStringSet(d.Get("subnet_ids").(*schema.Set))
 	if len(subnetIDs) == 0 {
-		return fmt.Errorf("Error expanding subnet IDs while creating database: %s", err)
+		return diag.Errorf("Error expanding subnet IDs while creating database: %s", err)
 	}
 	for _, subnetID := range subnetIDs {
 		if len(subnetID) == 0 {
-			return fmt.Errorf("Error checking subnet IDs while creating database: %s", err)
+			return errorDiagnostics("Error checking subnet IDs while creating database", err, nil)
 		}
 	}
 
 	storageConfig, err := expandYDBStorageConfigSpec(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding storage configuration while creating database: %s", err)
+		return diag.Errorf("Error expanding storage configuration while creating database: %s", err)
 	}
 
 	scalePolicy, err := expandYDBScalePolicySpec(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding scale policy while creating database: %s", err)
+		return diag.Errorf("Error expanding scale policy while creating database: %s", err)
 	}
 
 	dbType, err := expandYDBLocationSpec(d)
 	if err != nil {
-		return fmt.Errorf("Error expanding database type while creating database: %s", err)
+		return diag.Errorf("Error expanding database type while creating database: %s", err)
 	}
 	req := ydb.CreateDatabaseRequest{
 		FolderId:         folderID,
@@ -250,20 +256,19 @@ func resourceYandexYDBDatabaseDedicatedCreate(d *schema.ResourceData, meta inter
 		Labels:           labels,
 	}
 
-	if err := performYandexYDBDatabaseCreate(d, config, &req); err != nil {
-		return err
+	if err := performYandexYDBDatabaseCreate(ctx, d, config, &req); err != nil {
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexYDBDatabaseDedicatedRead(d, meta)
+	return resourceYandexYDBDatabaseDedicatedRead(ctx, d, meta)
 }
 
-func performYandexYDBDatabaseCreate(d *schema.ResourceData, config *Config, req *ydb.CreateDatabaseRequest) error {
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
+func performYandexYDBDatabaseCreate(ctx context.Context, d *schema.ResourceData, config *Config, req *ydb.CreateDatabaseRequest) error {
+	req.DeletionProtection = d.Get("deletion_protection").(bool)
 
 	op, err := config.sdk.WrapOperation(config.sdk.YDB().Database().Create(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create database: %s", err)
+		return apiError("Error while requesting API to create database", err, nil)
 	}
 
 	protoMetadata, err := op.Metadata()
@@ -280,7 +285,7 @@ func performYandexYDBDatabaseCreate(d *schema.ResourceData, config *Config, req
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create database: %s", err)
+		return apiError("Error while waiting operation to create database", err, nil)
 	}
 
 	if _, err := op.Response(); err != nil {
@@ -289,7 +294,7 @@ func performYandexYDBDatabaseCreate(d *schema.ResourceData, config *Config, req
 	return nil
 }
 
-func resourceYandexYDBDatabaseDedicatedUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexYDBDatabaseDedicatedUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	req := ydb.UpdateDatabaseRequest{
@@ -310,7 +315,7 @@ func resourceYandexYDBDatabaseDedicatedUpdate(d *schema.ResourceData, meta inter
 	if d.HasChange("storage_config") {
 		storageConfig, err := expandYDBStorageConfigSpec(d)
 		if err != nil {
-			return fmt.Errorf("Error expanding storage configuration while updating database: %s", err)
+			return diag.Errorf("Error expanding storage configuration while updating database: %s", err)
 		}
 		req.StorageConfig = storageConfig
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "storage_config")
@@ -319,23 +324,23 @@ func resourceYandexYDBDatabaseDedicatedUpdate(d *schema.ResourceData, meta inter
 	if d.HasChange("scale_policy") {
 		scalePolicy, err := expandYDBScalePolicySpec(d)
 		if err != nil {
-			return fmt.Errorf("Error expanding scale policy while updating database: %s", err)
+			return diag.Errorf("Error expanding scale policy while updating database: %s", err)
 		}
 		req.ScalePolicy = scalePolicy
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "scale_policy")
 	}
 
-	if err := performYandexYDBDatabaseUpdate(d, config, &req); err != nil {
-		return err
+	if err := performYandexYDBDatabaseUpdate(ctx, d, config, &req); err != nil {
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexYDBDatabaseDedicatedRead(d, meta)
+	return resourceYandexYDBDatabaseDedicatedRead(ctx, d, meta)
 }
 
-func performYandexYDBDatabaseUpdate(d *schema.ResourceData, config *Config, req *ydb.UpdateDatabaseRequest) error {
+func performYandexYDBDatabaseUpdate(ctx context.Context, d *schema.ResourceData, config *Config, req *ydb.UpdateDatabaseRequest) error {
 	d.Partial(true)
 	// common parameters
-	if d.HasChange("labels") {
+	if hasChangeWithDefaultLabels(d, "labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
 			return err
@@ -355,12 +360,14 @@ func performYandexYDBDatabaseUpdate(d *schema.ResourceData, config *Config, req
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
+	if d.HasChange("deletion_protection") {
+		req.DeletionProtection = d.Get("deletion_protection").(bool)
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "deletion_protection")
+	}
 
 	op, err := config.sdk.WrapOperation(config.sdk.YDB().Database().Update(ctx, req))
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to update database: %s", err)
+		return apiError("Error while requesting API to update database", err, nil)
 	}
 
 	err = op.Wait(ctx)
@@ -373,36 +380,30 @@ func performYandexYDBDatabaseUpdate(d *schema.ResourceData, config *Config, req
 	return nil
 }
 
-func performYandexYDBDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+func performYandexYDBDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.YDB().Database().Delete(ctx, &ydb.DeleteDatabaseRequest{DatabaseId: d.Id()})
 	err = waitOperation(ctx, config, op, err)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("YDB Database %q", d.Id()))
+		return diagnosticsFromError(handleNotFoundError(err, d, fmt.Sprintf("YDB Database %q", d.Id())))
 	}
 
 	return nil
 }
 
-func resourceYandexYDBDatabaseDedicatedRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexYDBDatabaseDedicatedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	database, err := performYandexYDBDatabaseRead(d, config)
+	database, err := performYandexYDBDatabaseRead(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return flattenYandexYDBDatabaseDedicated(d, database)
+	return diag.FromErr(flattenYandexYDBDatabaseDedicated(d, database))
 }
 
-func performYandexYDBDatabaseRead(d *schema.ResourceData, config *Config) (*ydb.Database, error) {
-	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
-	defer cancel()
-
+func performYandexYDBDatabaseRead(ctx context.Context, d *schema.ResourceData, config *Config) (*ydb.Database, error) {
 	database, err := config.sdk.YDB().Database().Get(ctx, &ydb.GetDatabaseRequest{
 		DatabaseId: d.Id(),
 	})
@@ -482,6 +483,7 @@ func flattenYandexYDBDatabase(d *schema.ResourceData, database *ydb.Database) er
 	d.Set("ydb_api_endpoint", baseEP)
 	d.Set("database_path", dbPath)
 	d.Set("tls_enabled", useTLS)
+	d.Set("deletion_protection", database.DeletionProtection)
 
 	return d.Set("status", database.Status.String())
 }
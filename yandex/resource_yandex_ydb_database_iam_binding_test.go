@@ -1,6 +1,7 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sort"
@@ -157,5 +158,5 @@ func getYDBDatabaseResourceAccessBindings(s *terraform.State, resourceName strin
 		return nil, fmt.Errorf("can't find %s in state", resourceName)
 	}
 
-	return getYDBDatabaseAccessBindings(config, rs.Primary.ID)
+	return getYDBDatabaseAccessBindings(context.Background(), config, rs.Primary.ID)
 }
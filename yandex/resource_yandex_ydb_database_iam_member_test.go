@@ -0,0 +1,52 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/ydb/v1"
+)
+
+func TestAccYDBDatabaseIamMember(t *testing.T) {
+	var database ydb.Database
+	databaseName := acctest.RandomWithPrefix("tf-ydb-database")
+
+	role := "ydb.viewer"
+	member := "system:allAuthenticatedUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccYDBDatabaseIamMemberBasic(databaseName, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testYandexYDBDatabaseServerlessExists(ydbDatabaseResource, &database),
+					testAccCheckYDBDatabaseIam(ydbDatabaseResource, role, []string{member}),
+				),
+			},
+			{
+				ResourceName: "yandex_ydb_database_iam_member.viewer",
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return fmt.Sprintf("%s %s %s", database.Id, role, member), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccYDBDatabaseIamMemberBasic(databaseName, role, member string) string {
+	return testAccYDBDatabase(databaseName) + fmt.Sprintf(`
+resource "yandex_ydb_database_iam_member" "viewer" {
+  database_id = yandex_ydb_database_serverless.test-database.id
+  role        = "%s"
+  member      = "%s"
+}
+`, role, member)
+}
@@ -0,0 +1,58 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/ydb/v1"
+)
+
+func TestAccYDBDatabaseIamPolicy(t *testing.T) {
+	var database ydb.Database
+	databaseName := acctest.RandomWithPrefix("tf-ydb-database")
+
+	role := "ydb.viewer"
+	member := "system:allAuthenticatedUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccYDBDatabaseIamPolicyBasic(databaseName, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testYandexYDBDatabaseServerlessExists(ydbDatabaseResource, &database),
+					testAccCheckYDBDatabaseIam(ydbDatabaseResource, role, []string{member}),
+				),
+			},
+			{
+				ResourceName: "yandex_ydb_database_iam_policy.viewer",
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return database.Id, nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccYDBDatabaseIamPolicyBasic(databaseName, role, member string) string {
+	return testAccYDBDatabase(databaseName) + fmt.Sprintf(`
+data "yandex_iam_policy" "viewer" {
+  binding {
+    role    = "%s"
+    members = ["%s"]
+  }
+}
+
+resource "yandex_ydb_database_iam_policy" "viewer" {
+  database_id = yandex_ydb_database_serverless.test-database.id
+  policy_data = data.yandex_iam_policy.viewer.policy_data
+}
+`, role, member)
+}
@@ -1,9 +1,11 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/ydb/v1"
@@ -14,12 +16,12 @@ const yandexYDBServerlessDefaultTimeout = 10 * time.Minute
 
 func resourceYandexYDBDatabaseServerless() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexYDBDatabaseServerlessCreate,
-		Read:   resourceYandexYDBDatabaseServerlessRead,
-		Update: resourceYandexYDBDatabaseServerlessUpdate,
-		Delete: performYandexYDBDatabaseDelete,
+		CreateContext: resourceYandexYDBDatabaseServerlessCreate,
+		ReadContext:   resourceYandexYDBDatabaseServerlessRead,
+		UpdateContext: resourceYandexYDBDatabaseServerlessUpdate,
+		DeleteContext: performYandexYDBDatabaseDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -62,6 +64,11 @@ func resourceYandexYDBDatabaseServerless() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"document_api_endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -101,17 +108,17 @@ func resourceYandexYDBDatabaseServerless() *schema.Resource {
 	}
 }
 
-func resourceYandexYDBDatabaseServerlessCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexYDBDatabaseServerlessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	folderID, err := getFolderID(d, config)
 	if err != nil {
-		return fmt.Errorf("Error getting folder ID while creating database: %s", err)
+		return diag.Errorf("Error getting folder ID while creating database: %s", err)
 	}
 
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
-		return fmt.Errorf("Error expanding labels while creating database: %s", err)
+		return diag.Errorf("Error expanding labels while creating database: %s", err)
 	}
 
 	req := ydb.CreateDatabaseRequest{
@@ -125,14 +132,14 @@ func resourceYandexYDBDatabaseServerlessCreate(d *schema.ResourceData, meta inte
 		Labels:     labels,
 	}
 
-	if err := performYandexYDBDatabaseCreate(d, config, &req); err != nil {
-		return err
+	if err := performYandexYDBDatabaseCreate(ctx, d, config, &req); err != nil {
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexYDBDatabaseServerlessRead(d, meta)
+	return resourceYandexYDBDatabaseServerlessRead(ctx, d, meta)
 }
 
-func resourceYandexYDBDatabaseServerlessUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexYDBDatabaseServerlessUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	req := ydb.UpdateDatabaseRequest{
@@ -140,22 +147,22 @@ func resourceYandexYDBDatabaseServerlessUpdate(d *schema.ResourceData, meta inte
 		UpdateMask: &field_mask.FieldMask{},
 	}
 
-	if err := performYandexYDBDatabaseUpdate(d, config, &req); err != nil {
-		return err
+	if err := performYandexYDBDatabaseUpdate(ctx, d, config, &req); err != nil {
+		return diagnosticsFromError(err)
 	}
 
-	return resourceYandexYDBDatabaseServerlessRead(d, meta)
+	return resourceYandexYDBDatabaseServerlessRead(ctx, d, meta)
 }
 
-func resourceYandexYDBDatabaseServerlessRead(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexYDBDatabaseServerlessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	database, err := performYandexYDBDatabaseRead(d, config)
+	database, err := performYandexYDBDatabaseRead(ctx, d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return flattenYandexYDBDatabaseServerless(d, database)
+	return diag.FromErr(flattenYandexYDBDatabaseServerless(d, database))
 }
 
 func flattenYandexYDBDatabaseServerless(d *schema.ResourceData, database *ydb.Database) error {
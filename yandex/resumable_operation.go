@@ -0,0 +1,237 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
+	"google.golang.org/grpc/codes"
+)
+
+// Operations which were not awaited because Terraform was interrupted or timed out are resumed on the next run:
+//   - waitResumableOperation stores id of the running operation in the computed "pending_operation_id"
+//     attribute, the resource id is already set from operation metadata, so Create returns the partial state
+//     with a warning and the resource is not tainted;
+//   - resources whose Create makes more calls after the creation operation run them with finishResumableCreate,
+//     which keeps the operation id in the state until they succeed;
+//   - Read reattaches to the pending operation, waits for it and runs the remaining create steps,
+//     Update and Delete only wait for it, so the next run neither creates a duplicate nor fails with
+//     a conflicting operation.
+
+const pendingOperationIDKey = "pending_operation_id"
+
+// interruptedCreateKey is the context key of the id of the creation operation which waitResumableOperation
+// left running, it is set by the Create wrapper.
+type interruptedCreateKey struct{}
+
+// resumableCreateResources lists resources whose Create waits for the creation operation with waitResumableOperation.
+var resumableCreateResources = []string{
+	"yandex_alb_backend_group",
+	"yandex_alb_http_router",
+	"yandex_alb_load_balancer",
+	"yandex_alb_target_group",
+	"yandex_alb_virtual_host",
+	"yandex_api_gateway",
+	"yandex_compute_disk",
+	"yandex_compute_disk_placement_group",
+	"yandex_compute_image",
+	"yandex_compute_instance",
+	"yandex_compute_placement_group",
+	"yandex_compute_snapshot",
+	"yandex_container_registry",
+	"yandex_container_repository",
+	"yandex_dataproc_cluster",
+	"yandex_datatransfer_endpoint",
+	"yandex_function",
+	"yandex_function_trigger",
+	"yandex_iam_service_account",
+	"yandex_iot_core_broker",
+	"yandex_iot_core_device",
+	"yandex_iot_core_registry",
+	"yandex_kms_symmetric_key",
+	"yandex_kubernetes_cluster",
+	"yandex_kubernetes_node_group",
+	"yandex_lb_network_load_balancer",
+	"yandex_lb_target_group",
+	"yandex_mdb_clickhouse_cluster",
+	"yandex_mdb_elasticsearch_cluster",
+	"yandex_mdb_greenplum_cluster",
+	"yandex_mdb_kafka_cluster",
+	"yandex_mdb_kafka_connector",
+	"yandex_mdb_kafka_topic",
+	"yandex_mdb_mysql_cluster",
+	"yandex_mdb_mysql_database",
+	"yandex_mdb_mysql_user",
+	"yandex_mdb_postgresql_cluster",
+	"yandex_mdb_postgresql_database",
+	"yandex_mdb_postgresql_user",
+	"yandex_mdb_redis_cluster",
+	"yandex_mdb_sqlserver_cluster",
+	"yandex_organizationmanager_group",
+	"yandex_organizationmanager_saml_federation",
+	"yandex_organizationmanager_saml_federation_certificate",
+	"yandex_resourcemanager_cloud",
+	"yandex_resourcemanager_folder",
+	"yandex_serverless_container",
+	"yandex_vpc_address",
+	"yandex_vpc_gateway",
+	"yandex_vpc_network",
+	"yandex_vpc_route_table",
+	"yandex_vpc_security_group",
+	"yandex_vpc_subnet",
+}
+
+// createFinisherFunc makes the calls of Create which follow the creation operation. It is called again by
+// Read if Create failed or was interrupted, so it must skip the steps which are already done.
+type createFinisherFunc func(ctx context.Context, d *schema.ResourceData, meta interface{}) error
+
+// createFinishers are the remaining create steps of resources, keyed by resource name.
+var createFinishers = map[string]createFinisherFunc{
+	"yandex_function":               finishFunctionCreate,
+	"yandex_iot_core_device":        finishIoTCoreDeviceCreate,
+	"yandex_iot_core_registry":      finishIoTCoreRegistryCreate,
+	"yandex_mdb_clickhouse_cluster": finishClickHouseClusterCreate,
+	"yandex_mdb_mysql_cluster":      finishMySQLClusterCreate,
+	"yandex_mdb_postgresql_cluster": finishPGClusterCreate,
+	"yandex_mdb_redis_cluster":      finishRedisClusterCreate,
+	"yandex_serverless_container":   finishServerlessContainerCreate,
+}
+
+func addResumableOperations(resources map[string]*schema.Resource) {
+	for _, name := range resumableCreateResources {
+		if r, ok := resources[name]; ok {
+			withResumableOperations(r, createFinishers[name])
+		}
+	}
+}
+
+func withResumableOperations(r *schema.Resource, finish createFinisherFunc) *schema.Resource {
+	r.Schema[pendingOperationIDKey] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "ID of the operation which was still running when Terraform was interrupted. It is awaited on the next run.",
+	}
+
+	if r.CreateContext != nil {
+		r.CreateContext = wrapResumableCreateContext(r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = wrapAwaitPendingOperationContext(r.ReadContext, finish)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrapAwaitPendingOperationContext(r.UpdateContext, nil)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = wrapAwaitPendingOperationContext(r.DeleteContext, nil)
+	}
+	return r
+}
+
+// wrapResumableCreateContext turns the error of Create interrupted while waiting for the creation operation
+// into a warning, so the partial state is saved without tainting the resource and the next refresh completes it.
+func wrapResumableCreateContext(f crudContextFunc) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var operationID string
+		diags := f(context.WithValue(ctx, interruptedCreateKey{}, &operationID), d, meta)
+		if operationID == "" || d.Id() == "" || !diags.HasError() {
+			return diags
+		}
+
+		warnings := make(diag.Diagnostics, 0, len(diags))
+		for _, diagnostic := range diags {
+			if diagnostic.Severity == diag.Error {
+				log.Printf("[WARN] Creation of resource %q was interrupted: %s", d.Id(), diagnostic.Summary)
+				continue
+			}
+			warnings = append(warnings, diagnostic)
+		}
+		return append(warnings, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Operation %s creating resource %q is still running", operationID, d.Id()),
+			Detail:   "The resource is saved in the state, the operation is awaited and the creation is completed on the next refresh.",
+		})
+	}
+}
+
+func wrapAwaitPendingOperationContext(f crudContextFunc, finish createFinisherFunc) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := awaitPendingOperation(ctx, d, meta, finish); err != nil {
+			return diag.FromErr(err)
+		}
+		return f(ctx, d, meta)
+	}
+}
+
+// waitResumableOperation waits for the operation like op.Wait, but keeps its id in the state if waiting was
+// interrupted, so the next run can reattach to the operation. Resource id should be set before the call.
+func waitResumableOperation(ctx context.Context, d *schema.ResourceData, op *sdkoperation.Operation) error {
+	err := op.Wait(ctx)
+	if err == nil || ctx.Err() == nil || op.Done() {
+		return err
+	}
+
+	if setErr := d.Set(pendingOperationIDKey, op.Id()); setErr != nil {
+		log.Printf("[WARN] Failed to save pending operation %q: %s", op.Id(), setErr)
+		return err
+	}
+	if operationID, ok := ctx.Value(interruptedCreateKey{}).(*string); ok {
+		*operationID = op.Id()
+	}
+	return fmt.Errorf("operation %s is still running, the resource is completed on the next run: %s", op.Id(), err)
+}
+
+// finishResumableCreate runs the remaining create steps after the creation operation is done. The operation id
+// stays in the state until the steps succeed, so if they fail or Terraform is interrupted, the next Read runs them again.
+func finishResumableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, operationID string, finish createFinisherFunc) error {
+	if err := d.Set(pendingOperationIDKey, operationID); err != nil {
+		return err
+	}
+	if err := finish(ctx, d, meta); err != nil {
+		return err
+	}
+	return d.Set(pendingOperationIDKey, "")
+}
+
+// awaitPendingOperation reattaches to the operation stored by waitResumableOperation, waits for it
+// and runs the remaining create steps if there are any.
+func awaitPendingOperation(ctx context.Context, d *schema.ResourceData, meta interface{}, finish createFinisherFunc) error {
+	operationID, _ := d.Get(pendingOperationIDKey).(string)
+	if operationID == "" {
+		return nil
+	}
+
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Waiting for pending operation %q of resource %q", operationID, d.Id())
+	op, err := config.sdk.WrapOperation(config.sdk.Operation().Get(ctx, &operation.GetOperationRequest{
+		OperationId: operationID,
+	}))
+	if err != nil && !isStatusWithCode(err, codes.NotFound) {
+		return fmt.Errorf("Error while requesting API to get pending operation %q: %s", operationID, err)
+	}
+
+	if err == nil {
+		if err := op.Wait(ctx); err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("Error while waiting for pending operation %q: %s", operationID, err)
+			}
+			// the result of the operation is seen by the following Read
+			log.Printf("[WARN] Pending operation %q failed: %s", operationID, err)
+			return d.Set(pendingOperationIDKey, "")
+		}
+	}
+
+	if finish != nil {
+		log.Printf("[DEBUG] Finishing creation of resource %q", d.Id())
+		if err := finish(ctx, d, meta); err != nil {
+			return fmt.Errorf("Error while finishing creation of resource %q: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Pending operation %q of resource %q has completed", operationID, d.Id())
+	return d.Set(pendingOperationIDKey, "")
+}
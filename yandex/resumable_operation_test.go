@@ -0,0 +1,176 @@
+package yandex
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+func testResumableResource(finish createFinisherFunc) *schema.Resource {
+	return withResumableOperations(&schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(d.Set("name", "read"))
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true},
+		},
+	}, finish)
+}
+
+func TestResumableOperationsAddedToResourcesWithOperations(t *testing.T) {
+	resources := Provider().ResourcesMap
+	assert.Contains(t, resources["yandex_vpc_network"].Schema, pendingOperationIDKey)
+	assert.Contains(t, resources["yandex_mdb_postgresql_cluster"].Schema, pendingOperationIDKey)
+	assert.NotContains(t, resources["yandex_resourcemanager_folder_iam_member"].Schema, pendingOperationIDKey)
+	assert.NotContains(t, resources["yandex_resourcemanager_folder_iam_binding"].Schema, pendingOperationIDKey)
+
+	for name := range createFinishers {
+		assert.Contains(t, resumableCreateResources, name)
+	}
+}
+
+// startFakeCloudNetworkCreate starts creation of a network, the operation is done after it was polled twice.
+func startFakeCloudNetworkCreate(t *testing.T, config *Config) string {
+	op, err := config.sdk.VPC().Network().Create(context.Background(), &vpc.CreateNetworkRequest{
+		FolderId: fakecloud.FolderID,
+		Name:     "network",
+	})
+	require.NoError(t, err)
+	return op.Id
+}
+
+func TestResumableOperationsCreateInterrupted_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t, fakecloud.WithOperationPolls(1000000))
+
+	r := testResumableResource(nil)
+	d := r.TestResourceData()
+	d.SetId("resource-id")
+
+	op, err := config.sdk.WrapOperation(config.sdk.VPC().Network().Create(context.Background(), &vpc.CreateNetworkRequest{
+		FolderId: fakecloud.FolderID,
+		Name:     "network",
+	}))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = waitResumableOperation(ctx, d, op)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is still running")
+	assert.Equal(t, op.Id(), d.Get(pendingOperationIDKey))
+}
+
+func TestResumableOperationsInterruptedCreateWarns_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t, fakecloud.WithOperationPolls(1000000))
+
+	var operationID string
+	r := testResumableResource(nil)
+	r.CreateContext = wrapResumableCreateContext(func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		op, err := config.sdk.WrapOperation(config.sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{
+			FolderId: fakecloud.FolderID,
+			Name:     "network",
+		}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		operationID = op.Id()
+		d.SetId("resource-id")
+
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		return errorDiagnostics("Error while waiting operation to create network", waitResumableOperation(ctx, d, op), nil)
+	})
+
+	// The partial state is kept without an error, so Terraform doesn't taint the resource.
+	d := r.TestResourceData()
+	diags := r.CreateContext(context.Background(), d, config)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Contains(t, diags[0].Summary, operationID)
+	assert.Equal(t, "resource-id", d.Id())
+	assert.Equal(t, operationID, d.Get(pendingOperationIDKey))
+
+	// Other errors of Create are kept.
+	r.CreateContext = wrapResumableCreateContext(func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		d.SetId("resource-id")
+		return diag.Errorf("hosts creation failed")
+	})
+	assert.True(t, r.CreateContext(context.Background(), r.TestResourceData(), config).HasError())
+}
+
+func TestResumableOperationsReadFinishesCreate_fakeCloud(t *testing.T) {
+	config, _ := newFakeCloudConfig(t, fakecloud.WithOperationPolls(2))
+
+	var finished int
+	finishErr := errors.New("hosts creation failed")
+	r := testResumableResource(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		finished++
+		return finishErr
+	})
+
+	d := r.TestResourceData()
+	d.SetId("resource-id")
+	operationID := startFakeCloudNetworkCreate(t, config)
+	require.NoError(t, d.Set(pendingOperationIDKey, operationID))
+
+	// The operation stays pending while the remaining create steps fail.
+	require.True(t, r.ReadContext(context.Background(), d, config).HasError())
+	assert.Equal(t, 1, finished)
+	assert.Equal(t, operationID, d.Get(pendingOperationIDKey))
+
+	finishErr = nil
+	require.Empty(t, r.ReadContext(context.Background(), d, config))
+	assert.Equal(t, 2, finished)
+	assert.Equal(t, "", d.Get(pendingOperationIDKey))
+	assert.Equal(t, "read", d.Get("name"))
+
+	// Update and Delete wait for the operation only.
+	require.NoError(t, d.Set(pendingOperationIDKey, startFakeCloudNetworkCreate(t, config)))
+	require.Empty(t, r.DeleteContext(context.Background(), d, config))
+	assert.Equal(t, 2, finished)
+}
+
+func TestResumableOperationsFinishCreate(t *testing.T) {
+	r := testResumableResource(nil)
+	d := r.TestResourceData()
+	d.SetId("resource-id")
+
+	err := finishResumableCreate(context.Background(), d, nil, "operation-id", func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		assert.Equal(t, "operation-id", d.Get(pendingOperationIDKey))
+		return errors.New("interrupted")
+	})
+	require.Error(t, err)
+	assert.Equal(t, "operation-id", d.Get(pendingOperationIDKey))
+
+	err = finishResumableCreate(context.Background(), d, nil, "operation-id", func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "", d.Get(pendingOperationIDKey))
+}
+
+func TestResumableOperationsReadWithoutPendingOperation(t *testing.T) {
+	r := testResumableResource(nil)
+
+	d := r.TestResourceData()
+	d.SetId("resource-id")
+	require.Empty(t, r.ReadContext(context.Background(), d, &Config{}))
+	assert.Equal(t, "read", d.Get("name"))
+}
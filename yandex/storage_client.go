@@ -21,7 +21,7 @@ func getS3ClientByKeys(accessKey, secretKey string, c *Config) (*s3.S3, error) {
 		return c.defaultS3Client, nil
 	}
 
-	return newS3Client(c.StorageEndpoint, accessKey, secretKey)
+	return c.newS3Client(accessKey, secretKey)
 }
 
 func getS3Client(d *schema.ResourceData, c *Config) (*s3.S3, error) {
@@ -71,15 +71,16 @@ func getS3Keys(b *schema.ResourceData) (accessKey, secretKey string, err error)
 	return accessKey, secretKey, nil
 }
 
-func newS3Client(url, accessKey, secretKey string) (*s3.S3, error) {
-	if url == "" {
+func (c *Config) newS3Client(accessKey, secretKey string) (*s3.S3, error) {
+	if c.StorageEndpoint == "" {
 		return nil, fmt.Errorf("failed to create storage client, endpoint url is not specified")
 	}
 
 	s3Config := &aws.Config{
 		Credentials: credentials.NewStaticCredentials(accessKey, secretKey, ""),
-		Endpoint:    aws.String(url),
+		Endpoint:    aws.String(c.StorageEndpoint),
 		Region:      aws.String(defaultS3Region),
+		HTTPClient:  c.awsHTTPClient(),
 	}
 
 	newSession, err := session.NewSession(s3Config)
@@ -88,5 +89,8 @@ func newS3Client(url, accessKey, secretKey string) (*s3.S3, error) {
 		return nil, err
 	}
 
-	return s3.New(newSession), nil
+	client := s3.New(newSession)
+	c.initAWSHandlers(&client.Handlers)
+
+	return client, nil
 }
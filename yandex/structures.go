@@ -249,7 +249,7 @@ func expandInstanceResourcesSpec(d *schema.ResourceData) (*compute.ResourcesSpec
 	return rs, nil
 }
 
-func expandInstanceBootDiskSpec(d *schema.ResourceData, config *Config) (*compute.AttachedDiskSpec, error) {
+func expandInstanceBootDiskSpec(ctx context.Context, d *schema.ResourceData, config *Config) (*compute.AttachedDiskSpec, error) {
 	ads := &compute.AttachedDiskSpec{}
 
 	if v, ok := d.GetOk("boot_disk.0.auto_delete"); ok {
@@ -279,7 +279,7 @@ func expandInstanceBootDiskSpec(d *schema.ResourceData, config *Config) (*comput
 
 	// create new one disk
 	if _, ok := d.GetOk("boot_disk.0.initialize_params"); ok {
-		bootDiskSpec, err := expandBootDiskSpec(d, config)
+		bootDiskSpec, err := expandBootDiskSpec(ctx, d, config)
 		if err != nil {
 			return nil, err
 		}
@@ -292,7 +292,7 @@ func expandInstanceBootDiskSpec(d *schema.ResourceData, config *Config) (*comput
 	return ads, nil
 }
 
-func expandBootDiskSpec(d *schema.ResourceData, config *Config) (*compute.AttachedDiskSpec_DiskSpec, error) {
+func expandBootDiskSpec(ctx context.Context, d *schema.ResourceData, config *Config) (*compute.AttachedDiskSpec_DiskSpec, error) {
 	diskSpec := &compute.AttachedDiskSpec_DiskSpec{}
 
 	if v, ok := d.GetOk("boot_disk.0.initialize_params.0.name"); ok {
@@ -314,7 +314,7 @@ func expandBootDiskSpec(d *schema.ResourceData, config *Config) (*compute.Attach
 			ImageId: imageID,
 		}
 
-		size, err := getImageMinStorageSize(imageID, config)
+		size, err := getImageMinStorageSize(ctx, imageID, config)
 		if err != nil {
 			return nil, err
 		}
@@ -327,7 +327,7 @@ func expandBootDiskSpec(d *schema.ResourceData, config *Config) (*compute.Attach
 			SnapshotId: snapshotID,
 		}
 
-		size, err := getSnapshotMinStorageSize(snapshotID, config)
+		size, err := getSnapshotMinStorageSize(ctx, snapshotID, config)
 		if err != nil {
 			return nil, err
 		}
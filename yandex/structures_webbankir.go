@@ -17,3 +17,6 @@ type MDBResourceItem struct {
 }
 
 var cpuPlatforms = []string{"Intel Broadwell", "Intel Cascade Lake", "Intel Ice Lake"}
+
+// mdbResourcesListConcurrency limits number of clusters whose hosts are listed at once.
+const mdbResourcesListConcurrency = 4
@@ -0,0 +1,180 @@
+package yandex
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// initTransport builds TLS settings and HTTP client shared by gRPC, S3 and YMQ clients.
+func (c *Config) initTransport() error {
+	c.tlsConfig = &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	if c.CABundleFile != "" {
+		contents, _, err := pathOrContents(c.CABundleFile)
+		if err != nil {
+			return fmt.Errorf("Error loading CA bundle: %s", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(contents)) {
+			return fmt.Errorf("Error loading CA bundle: no PEM encoded certificates found in %q", c.CABundleFile)
+		}
+		c.tlsConfig.RootCAs = pool
+	}
+
+	if c.ClientCertificateFile != "" || c.ClientKeyFile != "" {
+		if c.ClientCertificateFile == "" || c.ClientKeyFile == "" {
+			return fmt.Errorf("both client certificate and client key should be specified or not specified")
+		}
+		certPEM, _, err := pathOrContents(c.ClientCertificateFile)
+		if err != nil {
+			return fmt.Errorf("Error loading client certificate: %s", err)
+		}
+		keyPEM, _, err := pathOrContents(c.ClientKeyFile)
+		if err != nil {
+			return fmt.Errorf("Error loading client key: %s", err)
+		}
+		cert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+		if err != nil {
+			return fmt.Errorf("Error loading client certificate: %s", err)
+		}
+		c.tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if c.ProxyURL != "" {
+		u, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return fmt.Errorf("Error parsing proxy URL: %s", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("Error parsing proxy URL: unsupported scheme %q, should be http or https", u.Scheme)
+		}
+		c.proxyURL = u
+	}
+
+	return nil
+}
+
+// awsHTTPClient returns HTTP client for S3 and YMQ clients, nil means default one.
+func (c *Config) awsHTTPClient() *http.Client {
+	var base http.RoundTripper
+	if c.tlsConfig != nil && (c.tlsConfig.RootCAs != nil || c.tlsConfig.Certificates != nil || c.proxyURL != nil) {
+		transport := cleanhttp.DefaultPooledTransport()
+		transport.TLSClientConfig = c.tlsConfig.Clone()
+		if c.proxyURL != nil {
+			transport.Proxy = http.ProxyURL(c.proxyURL)
+		}
+		base = transport
+	}
+
+	if apiCassette != nil {
+		return &http.Client{Transport: apiCassette.Transport(base)}
+	}
+	if base == nil {
+		return nil
+	}
+	return &http.Client{Transport: base}
+}
+
+// newProxyDialer returns gRPC dialer connecting to addr through HTTP CONNECT proxy.
+func newProxyDialer(proxyURL *url.URL, proxyTLSConfig *tls.Config) func(context.Context, string) (net.Conn, error) {
+	return func(ctx context.Context, addr string) (net.Conn, error) {
+		proxyAddr := proxyURL.Host
+		if proxyURL.Port() == "" {
+			port := "80"
+			if proxyURL.Scheme == "https" {
+				port = "443"
+			}
+			proxyAddr = net.JoinHostPort(proxyURL.Hostname(), port)
+		}
+
+		dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+		conn, err := dialer.DialContext(ctx, "tcp", proxyAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		if deadline, ok := ctx.Deadline(); ok {
+			_ = conn.SetDeadline(deadline)
+		}
+
+		if proxyURL.Scheme == "https" {
+			tlsConfig := proxyTLSConfig.Clone()
+			tlsConfig.ServerName = proxyURL.Hostname()
+			tlsConn := tls.Client(conn, tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				conn.Close()
+				return nil, fmt.Errorf("TLS handshake with proxy %s failed: %s", proxyAddr, err)
+			}
+			conn = tlsConn
+		}
+
+		conn, err = doHTTPConnectHandshake(conn, addr, proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("connecting to %s through proxy %s failed: %s", addr, proxyAddr, err)
+		}
+		_ = conn.SetDeadline(time.Time{})
+		return conn, nil
+	}
+}
+
+func doHTTPConnectHandshake(conn net.Conn, addr string, proxyURL *url.URL) (_ net.Conn, err error) {
+	defer func() {
+		if err != nil {
+			conn.Close()
+		}
+	}()
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Host: addr},
+		Host:   addr,
+		Header: http.Header{},
+	}
+	if u := proxyURL.User; u != nil {
+		password, _ := u.Password()
+		auth := base64.StdEncoding.EncodeToString([]byte(u.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+auth)
+	}
+
+	if err := req.Write(conn); err != nil {
+		return nil, err
+	}
+
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected proxy response status: %s", resp.Status)
+	}
+
+	return &bufConn{Conn: conn, r: r}, nil
+}
+
+// bufConn keeps bytes already read by bufio.Reader during CONNECT handshake.
+type bufConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c *bufConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
@@ -93,18 +93,20 @@ func getFolderID(d *schema.ResourceData, config *Config) (string, error) {
 	return res.(string), nil
 }
 
-func cloudIDOfFolderID(config *Config, folderID string) (string, error) {
-	folder, err := config.sdk.ResourceManager().Folder().Get(config.Context(), &resourcemanager.GetFolderRequest{
-		FolderId: folderID,
+func cloudIDOfFolderID(ctx context.Context, config *Config, folderID string) (string, error) {
+	folder, err := config.cachedLookup("resourcemanager.folder/"+folderID, func() (interface{}, error) {
+		return config.sdk.ResourceManager().Folder().Get(ctx, &resourcemanager.GetFolderRequest{
+			FolderId: folderID,
+		})
 	})
 	if err != nil {
 		return "", err
 	}
-	return folder.CloudId, nil
+	return folder.(*resourcemanager.Folder).CloudId, nil
 }
 
-func lockCloudByFolderID(config *Config, folderID string) (func(), error) {
-	cloudID, err := cloudIDOfFolderID(config, folderID)
+func lockCloudByFolderID(ctx context.Context, config *Config, folderID string) (func(), error) {
+	cloudID, err := cloudIDOfFolderID(ctx, config, folderID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting cloud ID of `folder_id` %s: %s", folderID, err)
 	}
@@ -263,7 +265,7 @@ func handleNotFoundError(err error, d *schema.ResourceData, resourceName string)
 		d.SetId("")
 		return nil
 	}
-	return fmt.Errorf("error reading %s: %s", resourceName, err)
+	return apiError(fmt.Sprintf("error reading %s", resourceName), err, nil)
 }
 
 func isStatusWithCode(err error, code codes.Code) bool {
@@ -363,22 +365,6 @@ func roleToMembersList(role string, bindings []*access.AccessBinding) []string {
 	return members
 }
 
-func removeRoleFromBindings(roleForRemove string, bindings []*access.AccessBinding) []*access.AccessBinding {
-	bm := rolesToMembersMap(bindings)
-	var rb []*access.AccessBinding
-
-	for role, members := range bm {
-		if role == roleForRemove {
-			continue
-		}
-		for member := range members {
-			rb = append(rb, roleMemberToAccessBinding(role, member))
-		}
-	}
-
-	return rb
-}
-
 func (p Policy) String() string {
 	result := ""
 	for i, binding := range p.Bindings {
@@ -610,32 +596,47 @@ func resolveObjectIDByNameAndFolderID(ctx context.Context, config *Config, name,
 	return objectID, nil
 }
 
-func getSnapshotMinStorageSize(snapshotID string, config *Config) (size int64, err error) {
-	ctx := config.Context()
-
-	snapshot, err := config.sdk.Compute().Snapshot().Get(ctx, &compute.GetSnapshotRequest{
-		SnapshotId: snapshotID,
+func getSnapshotMinStorageSize(ctx context.Context, snapshotID string, config *Config) (size int64, err error) {
+	snapshot, err := config.cachedLookup("compute.snapshot/"+snapshotID, func() (interface{}, error) {
+		return config.sdk.Compute().Snapshot().Get(ctx, &compute.GetSnapshotRequest{
+			SnapshotId: snapshotID,
+		})
 	})
 
 	if err != nil {
 		return 0, fmt.Errorf("Error on retrieve snapshot properties: %s", err)
 	}
 
-	return snapshot.DiskSize, nil
+	return snapshot.(*compute.Snapshot).DiskSize, nil
 }
 
-func getImageMinStorageSize(imageID string, config *Config) (size int64, err error) {
-	ctx := config.Context()
-
-	image, err := config.sdk.Compute().Image().Get(ctx, &compute.GetImageRequest{
-		ImageId: imageID,
+func getImageMinStorageSize(ctx context.Context, imageID string, config *Config) (size int64, err error) {
+	image, err := config.cachedLookup("compute.image/"+imageID, func() (interface{}, error) {
+		return config.sdk.Compute().Image().Get(ctx, &compute.GetImageRequest{
+			ImageId: imageID,
+		})
 	})
 
 	if err != nil {
 		return 0, fmt.Errorf("Error on retrieve image properties: %s", err)
 	}
 
-	return image.MinDiskSize, nil
+	return image.(*compute.Image).MinDiskSize, nil
+}
+
+// getLatestImageByFamily returns the latest image of the family, the result is cached for the run,
+// so all resources created from the family use the same image.
+func getLatestImageByFamily(ctx context.Context, config *Config, folderID, family string) (*compute.Image, error) {
+	image, err := config.cachedLookup("compute.image_family/"+folderID+"/"+family, func() (interface{}, error) {
+		return config.sdk.Compute().Image().GetLatestByFamily(ctx, &compute.GetImageLatestByFamilyRequest{
+			FolderId: folderID,
+			Family:   family,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return image.(*compute.Image), nil
 }
 
 func templateConfig(tmpl string, ctx ...map[string]interface{}) string {
@@ -803,7 +804,7 @@ func generateFieldMasks(d *schema.ResourceData, fieldsMap map[string]string) []s
 	changedPaths := make(map[string]bool)
 
 	for longField, longPath := range fieldsMap {
-		if !d.HasChange(longField) {
+		if !hasChangeWithDefaultLabels(d, longField) {
 			continue
 		}
 
@@ -875,7 +876,7 @@ func generateEndpointFieldMasksForPath(d *schema.ResourceData, node *fieldTreeNo
 	terraformAttributePath := terraformPathPrefix + node.terraformAttributeName
 	protobufFieldPath := protobufPathPrefix + node.protobufFieldName
 
-	if !d.HasChange(terraformAttributePath) {
+	if !hasChangeWithDefaultLabels(d, terraformAttributePath) {
 		return nil // No changes => empty field mask
 	}
 	// There's a change at terraformAttributePath. Try to refine it by recursing into the attribute
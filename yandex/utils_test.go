@@ -106,7 +106,7 @@ func getFolderIamPolicyByFolderID(folderID string, config *Config) (*Policy, err
 		folderID: folderID,
 		Config:   config,
 	}
-	return f.GetResourceIamPolicy()
+	return f.GetResourceIamPolicy(context.Background())
 }
 
 func checkWithState(fn func() resource.TestCheckFunc) resource.TestCheckFunc {
@@ -124,7 +124,7 @@ func testAccCheckFunctionIam(resourceName, role string, members []string) resour
 			return fmt.Errorf("can't find %s in state", resourceName)
 		}
 
-		bindings, err := getFunctionAccessBindings(config, rs.Primary.ID)
+		bindings, err := getFunctionAccessBindings(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -156,7 +156,7 @@ func testAccCheckServerlessContainerIam(resourceName, role string, members []str
 			return fmt.Errorf("can't find %s in state", resourceName)
 		}
 
-		bindings, err := getServerlessContainerAccessBindings(config, rs.Primary.ID)
+		bindings, err := getServerlessContainerAccessBindings(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -188,7 +188,7 @@ func testAccCheckServiceAccountIam(resourceName, role string, members []string)
 			return fmt.Errorf("can't find %s in state", resourceName)
 		}
 
-		bindings, err := getServiceAccountAccessBindings(config, rs.Primary.ID)
+		bindings, err := getServiceAccountAccessBindings(context.Background(), config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}